@@ -0,0 +1,69 @@
+// Package netguard keeps requests made on behalf of users away from the local machine and private networks.
+package netguard
+
+import (
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInternalAddress is returned when a connection to an internal address is refused.
+var ErrInternalAddress = errors.New("internal addresses are not allowed")
+
+// internalNetworks are the ranges not covered by the net.IP methods used in IsInternalIP.
+var internalNetworks = mustParseCIDRs(
+	// "This network", which some systems route to the local machine.
+	"0.0.0.0/8",
+	// Shared address space of carrier-grade NAT, also used by Tailscale.
+	"100.64.0.0/10",
+	// NAT64 prefixes, which translate to arbitrary IPv4 addresses including internal ones.
+	"64:ff9b::/96",
+	"64:ff9b:1::/48",
+)
+
+// IsInternalIP reports whether the IP address belongs to the local machine or a private or shared network.
+func IsInternalIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDialer returns a dialer that refuses to connect to internal addresses.
+// The address is checked after the hostname is resolved, so hostnames resolving to an internal address
+// are refused too. HTTP transports using the dialer must not use a proxy, which would be checked instead.
+func NewDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || IsInternalIP(ip) {
+				return errors.Wrap(ErrInternalAddress, host)
+			}
+			return nil
+		},
+	}
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
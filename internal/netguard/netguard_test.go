@@ -0,0 +1,32 @@
+package netguard
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInternalIP(t *testing.T) {
+	for _, address := range []string{
+		"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "0.1.2.3",
+		"100.64.0.1", "100.127.255.254", "fc00::1", "fe80::1", "ff02::1", "ff01::1", "::ffff:10.0.0.1",
+		"64:ff9b::a00:1", "64:ff9b:1::1",
+	} {
+		require.True(t, IsInternalIP(net.ParseIP(address)), address)
+	}
+	for _, address := range []string{"1.1.1.1", "100.128.0.1", "2606:4700:4700::1111", "::ffff:8.8.8.8"} {
+		require.False(t, IsInternalIP(net.ParseIP(address)), address)
+	}
+}
+
+func TestNewDialer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	_, err = NewDialer(time.Second).DialContext(context.Background(), "tcp", listener.Addr().String())
+	require.ErrorIs(t, err, ErrInternalAddress)
+}
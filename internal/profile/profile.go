@@ -32,6 +32,9 @@ type Profile struct {
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
+	// AllowInternalWebhooks lets webhooks be sent to the local machine and private networks.
+	// Any user can then make the server send requests to internal services and read their responses.
+	AllowInternalWebhooks bool
 }
 
 func checkDataDir(dataDir string) (string, error) {
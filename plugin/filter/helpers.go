@@ -23,3 +23,29 @@ func AppendConditions(ctx context.Context, engine *Engine, filters []string, dia
 	}
 	return nil
 }
+
+// CollectSearchQueries compiles the provided filters and returns the queries of
+// every non-negated search() call, which can be used for relevance ranking.
+func CollectSearchQueries(ctx context.Context, engine *Engine, filters []string) ([]string, error) {
+	queries := []string{}
+	for _, filterStr := range filters {
+		program, err := engine.Compile(ctx, filterStr)
+		if err != nil {
+			return nil, err
+		}
+		queries = appendSearchQueries(queries, program.ConditionTree())
+	}
+	return queries, nil
+}
+
+func appendSearchQueries(queries []string, cond Condition) []string {
+	switch c := cond.(type) {
+	case *SearchCondition:
+		return append(queries, c.Query)
+	case *LogicalCondition:
+		queries = appendSearchQueries(queries, c.Left)
+		return appendSearchQueries(queries, c.Right)
+	default:
+		return queries
+	}
+}
@@ -81,6 +81,14 @@ type ContainsCondition struct {
 
 func (*ContainsCondition) isCondition() {}
 
+// SearchCondition models the search(<query>) full-text call.
+type SearchCondition struct {
+	Field string
+	Query string
+}
+
+func (*SearchCondition) isCondition() {}
+
 // ConstantCondition captures a literal boolean outcome.
 type ConstantCondition struct {
 	Value bool
@@ -96,6 +104,8 @@ type ValueExpr interface {
 // FieldRef references a named schema field.
 type FieldRef struct {
 	Name string
+	// Key selects an entry of a JSON map field, e.g. "status" in props.status.
+	Key string
 }
 
 func (*FieldRef) isValueExpr() {}
@@ -38,6 +38,22 @@ func buildCondition(expr *exprv1.Expr, schema Schema) (Condition, error) {
 		return &FieldPredicateCondition{Field: name}, nil
 	case *exprv1.Expr_ComprehensionExpr:
 		return buildComprehensionCondition(v.ComprehensionExpr, schema)
+	case *exprv1.Expr_SelectExpr:
+		// has(props.status) checks whether the map entry exists.
+		if !v.SelectExpr.TestOnly {
+			return nil, errors.New("field selection must be compared to a value")
+		}
+		ref, ok, err := getMapEntryRef(expr, schema)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("has() only supports map fields")
+		}
+		return &ElementInCondition{
+			Element: &LiteralValue{Value: ref.Key},
+			Field:   ref.Name,
+		}, nil
 	default:
 		return nil, errors.New("unsupported top-level expression")
 	}
@@ -94,6 +110,8 @@ func buildCallCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error
 		return buildInCondition(call, schema)
 	case "contains":
 		return buildContainsCondition(call, schema)
+	case "search":
+		return buildSearchCondition(call, schema)
 	default:
 		val, ok, err := evaluateBool(call)
 		if err != nil {
@@ -155,6 +173,28 @@ func buildInCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error)
 		return nil, errors.New("in operator expects two arguments")
 	}
 
+	// Handle map entry in list syntax, e.g. props.status in ["todo", "doing"].
+	if ref, ok, err := getMapEntryRef(call.Args[0], schema); err != nil {
+		return nil, err
+	} else if ok {
+		listExpr := call.Args[1].GetListExpr()
+		if listExpr == nil {
+			return nil, errors.New("in operator on a map entry expects a list")
+		}
+		values := make([]ValueExpr, 0, len(listExpr.Elements))
+		for _, element := range listExpr.Elements {
+			value, err := buildValueExpr(element, schema)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return &InCondition{
+			Left:   ref,
+			Values: values,
+		}, nil
+	}
+
 	// Handle identifier in list syntax.
 	if identName, err := getIdentName(call.Args[0]); err == nil {
 		if field, ok := schema.Field(identName); ok && field.Kind == FieldKindVirtualAlias {
@@ -232,7 +272,40 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	}, nil
 }
 
+func buildSearchCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error) {
+	if schema.SearchField == "" {
+		return nil, errors.Errorf("search() is not supported for %s filters", schema.Name)
+	}
+	if call.Target != nil {
+		return nil, errors.New("search() must be called as a global function")
+	}
+	if len(call.Args) != 1 {
+		return nil, errors.New("search expects exactly one argument")
+	}
+	value, err := getConstValue(call.Args[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "search only supports literal arguments")
+	}
+	query, ok := value.(string)
+	if !ok {
+		return nil, errors.New("search argument must be a string")
+	}
+	if len(searchTerms(query)) == 0 {
+		return nil, errors.New("search query must not be empty")
+	}
+	return &SearchCondition{
+		Field: schema.SearchField,
+		Query: query,
+	}, nil
+}
+
 func buildValueExpr(expr *exprv1.Expr, schema Schema) (ValueExpr, error) {
+	if ref, ok, err := getMapEntryRef(expr, schema); err != nil {
+		return nil, err
+	} else if ok {
+		return ref, nil
+	}
+
 	if identName, err := getIdentName(expr); err == nil {
 		if _, ok := schema.Field(identName); !ok {
 			return nil, errors.Errorf("unknown identifier %q", identName)
@@ -314,6 +387,41 @@ func getIdentName(expr *exprv1.Expr) (string, error) {
 	return "", errors.New("expression is not an identifier")
 }
 
+// getMapEntryRef resolves props.key and props["key"] to a reference to the map entry.
+// It reports false if the expression does not select an entry of a map field.
+func getMapEntryRef(expr *exprv1.Expr, schema Schema) (*FieldRef, bool, error) {
+	var operand *exprv1.Expr
+	var key string
+	if sel := expr.GetSelectExpr(); sel != nil {
+		operand, key = sel.Operand, sel.Field
+	} else if call := expr.GetCallExpr(); call != nil && call.Function == "_[_]" && len(call.Args) == 2 {
+		value, err := getConstValue(call.Args[1])
+		if err != nil {
+			return nil, false, errors.New("map keys must be string literals")
+		}
+		str, ok := value.(string)
+		if !ok {
+			return nil, false, errors.New("map keys must be string literals")
+		}
+		operand, key = call.Args[0], str
+	} else {
+		return nil, false, nil
+	}
+
+	name, err := getIdentName(operand)
+	if err != nil {
+		return nil, false, errors.New("field selection is only supported on identifiers")
+	}
+	field, ok := schema.Field(name)
+	if !ok {
+		return nil, false, errors.Errorf("unknown identifier %q", name)
+	}
+	if _, err := field.entryField(key); err != nil {
+		return nil, false, err
+	}
+	return &FieldRef{Name: name, Key: key}, true, nil
+}
+
 func getConstValue(expr *exprv1.Expr) (interface{}, error) {
 	v, ok := expr.ExprKind.(*exprv1.Expr_ConstExpr)
 	if !ok {
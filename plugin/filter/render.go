@@ -76,6 +76,8 @@ func (r *renderer) renderCondition(cond Condition) (renderResult, error) {
 		return r.renderContainsCondition(c)
 	case *ListComprehensionCondition:
 		return r.renderListComprehension(c)
+	case *SearchCondition:
+		return r.renderSearchCondition(c)
 	case *ConstantCondition:
 		if c.Value {
 			return renderResult{trivial: true}, nil
@@ -141,6 +143,8 @@ func (r *renderer) renderFieldPredicate(cond *FieldPredicateCondition) (renderRe
 			return renderResult{}, err
 		}
 		return renderResult{sql: sql}, nil
+	case FieldKindJSONExists:
+		return renderResult{sql: jsonExistsPredicate(r.dialect, field, true)}, nil
 	default:
 		return renderResult{}, errors.Errorf("field %q cannot be used as a predicate", cond.Field)
 	}
@@ -153,11 +157,20 @@ func (r *renderer) renderComparison(cond *ComparisonCondition) (renderResult, er
 		if !ok {
 			return renderResult{}, errors.Errorf("unknown field %q", left.Name)
 		}
+		if left.Key != "" {
+			entry, err := field.entryField(left.Key)
+			if err != nil {
+				return renderResult{}, err
+			}
+			return r.renderScalarComparison(entry, cond.Operator, cond.Right)
+		}
 		switch field.Kind {
 		case FieldKindBoolColumn:
 			return r.renderBoolColumnComparison(field, cond.Operator, cond.Right)
 		case FieldKindJSONBool:
 			return r.renderJSONBoolComparison(field, cond.Operator, cond.Right)
+		case FieldKindJSONExists:
+			return r.renderJSONExistsComparison(field, cond.Operator, cond.Right)
 		case FieldKindScalar:
 			return r.renderScalarComparison(field, cond.Operator, cond.Right)
 		default:
@@ -310,6 +323,21 @@ func (r *renderer) renderJSONBoolComparison(field Field, op ComparisonOperator,
 	}
 }
 
+func (r *renderer) renderJSONExistsComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
+	value, err := expectBool(right)
+	if err != nil {
+		return renderResult{}, err
+	}
+	switch op {
+	case CompareEq:
+		return renderResult{sql: jsonExistsPredicate(r.dialect, field, value)}, nil
+	case CompareNeq:
+		return renderResult{sql: jsonExistsPredicate(r.dialect, field, !value)}, nil
+	default:
+		return renderResult{}, errors.Errorf("operator %s not supported for boolean JSON field", op)
+	}
+}
+
 func (r *renderer) renderInCondition(cond *InCondition) (renderResult, error) {
 	fieldRef, ok := cond.Left.(*FieldRef)
 	if !ok {
@@ -325,6 +353,14 @@ func (r *renderer) renderInCondition(cond *InCondition) (renderResult, error) {
 		return renderResult{}, errors.Errorf("unknown field %q", fieldRef.Name)
 	}
 
+	if fieldRef.Key != "" {
+		entry, err := field.entryField(fieldRef.Key)
+		if err != nil {
+			return renderResult{}, err
+		}
+		return r.renderScalarInCondition(entry, cond.Values)
+	}
+
 	if field.Kind != FieldKindScalar {
 		return renderResult{}, errors.Errorf("field %q does not support IN()", fieldRef.Name)
 	}
@@ -386,6 +422,9 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	if !ok {
 		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
 	}
+	if field.Kind == FieldKindJSONMap {
+		return r.renderMapKeyInCondition(field, cond.Element)
+	}
 	if field.Kind != FieldKindJSONList {
 		return renderResult{}, errors.Errorf("field %q is not a tag list", cond.Field)
 	}
@@ -414,6 +453,25 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	}
 }
 
+// renderMapKeyInCondition renders `"key" in props` and has(props.key) as a check that the entry exists.
+func (r *renderer) renderMapKeyInCondition(field Field, element ValueExpr) (renderResult, error) {
+	lit, err := expectLiteral(element)
+	if err != nil {
+		return renderResult{}, err
+	}
+	key, ok := lit.(string)
+	if !ok {
+		return renderResult{}, errors.Errorf("field %q expects string keys", field.Name)
+	}
+	entry, err := field.entryField(key)
+	if err != nil {
+		return renderResult{}, err
+	}
+	return renderResult{
+		sql: fmt.Sprintf("%s IS NOT NULL", entry.columnExpr(r.dialect)),
+	}, nil
+}
+
 func (r *renderer) renderScalarInCondition(field Field, values []ValueExpr) (renderResult, error) {
 	placeholders := make([]string, 0, len(values))
 
@@ -463,6 +521,30 @@ func (r *renderer) renderContainsCondition(cond *ContainsCondition) (renderResul
 	}
 }
 
+func (r *renderer) renderSearchCondition(cond *SearchCondition) (renderResult, error) {
+	field, ok := r.schema.Field(cond.Field)
+	if !ok {
+		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
+	}
+	query := FormatSearchQuery(r.dialect, cond.Query)
+	switch r.dialect {
+	case DialectSQLite:
+		// The FTS5 table uses the memo row id as its rowid, see the sqlite migrations.
+		table := field.Column.Table
+		idColumn := qualifyColumn(r.dialect, Column{Table: table, Name: "id"})
+		sql := fmt.Sprintf("%s IN (SELECT `rowid` FROM `%s_fts` WHERE `%s_fts` MATCH %s)", idColumn, table, table, r.addArg(query))
+		return renderResult{sql: sql}, nil
+	case DialectMySQL:
+		sql := fmt.Sprintf("MATCH(%s) AGAINST (%s IN BOOLEAN MODE)", field.columnExpr(r.dialect), r.addArg(query))
+		return renderResult{sql: sql}, nil
+	case DialectPostgres:
+		sql := fmt.Sprintf("%s @@ plainto_tsquery('simple', %s)", PostgresSearchVector(field.columnExpr(r.dialect)), r.addArg(query))
+		return renderResult{sql: sql}, nil
+	default:
+		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+}
+
 func (r *renderer) renderListComprehension(cond *ListComprehensionCondition) (renderResult, error) {
 	field, ok := r.schema.Field(cond.Field)
 	if !ok {
@@ -704,6 +786,15 @@ func jsonExtractExpr(d DialectName, field Field) string {
 	}
 }
 
+// jsonExistsPredicate checks whether the JSON path of the field is present, or absent if exists is false.
+func jsonExistsPredicate(d DialectName, field Field, exists bool) string {
+	operator := "IS NOT NULL"
+	if !exists {
+		operator = "IS NULL"
+	}
+	return fmt.Sprintf("%s %s", jsonArrayExpr(d, field), operator)
+}
+
 func jsonArrayExpr(d DialectName, field Field) string {
 	column := qualifyColumn(d, field.Column)
 	switch d {
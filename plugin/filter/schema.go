@@ -2,11 +2,15 @@ package filter
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
+	"github.com/pkg/errors"
 )
 
 // DialectName enumerates supported SQL dialects.
@@ -35,7 +39,9 @@ const (
 	FieldKindScalar       FieldKind = "scalar"
 	FieldKindBoolColumn   FieldKind = "bool_column"
 	FieldKindJSONBool     FieldKind = "json_bool"
+	FieldKindJSONExists   FieldKind = "json_exists"
 	FieldKindJSONList     FieldKind = "json_list"
+	FieldKindJSONMap      FieldKind = "json_map"
 	FieldKindVirtualAlias FieldKind = "virtual_alias"
 )
 
@@ -63,6 +69,8 @@ type Schema struct {
 	Name       string
 	Fields     map[string]Field
 	EnvOptions []cel.EnvOption
+	// SearchField names the field backed by the full-text index, if any.
+	SearchField string
 }
 
 // Field returns the field metadata if present.
@@ -97,6 +105,15 @@ var nowFunction = cel.Function("now",
 	),
 )
 
+// searchFunction declares search(query) for full-text matching. It is only
+// translated to SQL and never evaluated by CEL itself.
+var searchFunction = cel.Function("search",
+	cel.Overload("search_string",
+		[]*cel.Type{cel.StringType},
+		cel.BoolType,
+	),
+)
+
 // NewSchema constructs the memo filter schema and CEL environment.
 func NewSchema() Schema {
 	fields := map[string]Field{
@@ -180,6 +197,20 @@ func NewSchema() Schema {
 			Type:     FieldTypeString,
 			AliasFor: "tags",
 		},
+		"mentions": {
+			Name:     "mentions",
+			Kind:     FieldKindJSONList,
+			Type:     FieldTypeString,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"mentions"},
+		},
+		"props": {
+			Name:     "props",
+			Kind:     FieldKindJSONMap,
+			Type:     FieldTypeString,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"properties"},
+		},
 		"has_task_list": {
 			Name:     "has_task_list",
 			Kind:     FieldKindJSONBool,
@@ -224,6 +255,18 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		// scheduled is true while the memo waits to be published.
+		"scheduled": {
+			Name:     "scheduled",
+			Kind:     FieldKindJSONExists,
+			Type:     FieldTypeBool,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"schedule"},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
@@ -234,18 +277,23 @@ func NewSchema() Schema {
 		cel.Variable("pinned", cel.BoolType),
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
+		cel.Variable("mentions", cel.ListType(cel.StringType)),
+		cel.Variable("props", cel.MapType(cel.StringType, cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
 		cel.Variable("has_task_list", cel.BoolType),
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("scheduled", cel.BoolType),
 		nowFunction,
+		searchFunction,
 	}
 
 	return Schema{
-		Name:       "memo",
-		Fields:     fields,
-		EnvOptions: envOptions,
+		Name:        "memo",
+		Fields:      fields,
+		EnvOptions:  envOptions,
+		SearchField: "content",
 	}
 }
 
@@ -317,3 +365,30 @@ func (f Field) columnExpr(d DialectName) string {
 	}
 	return base
 }
+
+// mapKeyPattern restricts JSON map keys to characters that are safe to embed in a JSON path.
+var mapKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// entryField returns a string field for the given key of a JSON map field,
+// so that entries such as props.status render like scalar columns.
+func (f Field) entryField(key string) (Field, error) {
+	if f.Kind != FieldKindJSONMap {
+		return Field{}, errors.Errorf("field %q is not a map", f.Name)
+	}
+	if !mapKeyPattern.MatchString(key) {
+		return Field{}, errors.Errorf("invalid key %q for field %q", key, f.Name)
+	}
+
+	path := fmt.Sprintf(`$.%s."%s"`, strings.Join(f.JSONPath, "."), key)
+	return Field{
+		Name:   fmt.Sprintf("%s.%s", f.Name, key),
+		Kind:   FieldKindScalar,
+		Type:   FieldTypeString,
+		Column: f.Column,
+		Expressions: map[DialectName]string{
+			DialectSQLite:   fmt.Sprintf("JSON_EXTRACT(%%s, '%s')", path),
+			DialectMySQL:    fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%%s, '%s'))", path),
+			DialectPostgres: buildPostgresJSONAccessor("%s", append(slices.Clone(f.JSONPath), key), true),
+		},
+	}, nil
+}
@@ -0,0 +1,43 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// searchTerms splits a raw search query into whitespace separated terms.
+func searchTerms(query string) []string {
+	return strings.Fields(query)
+}
+
+// FormatSearchQuery converts a raw search query into the dialect-specific
+// full-text query syntax. Every term is required to match and operator
+// characters in user input are treated literally.
+func FormatSearchQuery(d DialectName, query string) string {
+	terms := searchTerms(query)
+	switch d {
+	case DialectSQLite:
+		// FTS5 treats space separated strings as an implicit AND; quoting keeps
+		// characters such as '-', '*' or ':' from being parsed as operators.
+		quoted := make([]string, 0, len(terms))
+		for _, term := range terms {
+			quoted = append(quoted, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+		}
+		return strings.Join(quoted, " ")
+	case DialectMySQL:
+		// Boolean mode: "+" marks a term as required, quotes disable operators.
+		required := make([]string, 0, len(terms))
+		for _, term := range terms {
+			required = append(required, `+"`+strings.ReplaceAll(term, `"`, "")+`"`)
+		}
+		return strings.Join(required, " ")
+	default:
+		// plainto_tsquery already ANDs the terms and ignores punctuation.
+		return strings.Join(terms, " ")
+	}
+}
+
+// PostgresSearchVector returns the tsvector expression matching the GIN index on memo content.
+func PostgresSearchVector(column string) string {
+	return fmt.Sprintf("to_tsvector('simple', %s)", column)
+}
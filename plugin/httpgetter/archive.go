@@ -0,0 +1,211 @@
+package httpgetter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxArchivePageSize is the maximum number of bytes of a page read to archive it.
+const maxArchivePageSize = 5 << 20
+
+// Archive is a readable snapshot of the main content of a web page.
+type Archive struct {
+	// URL is the URL of the page after redirects.
+	URL   string
+	Title string
+	// HTML is a standalone HTML document without scripts, styles or external frames.
+	HTML []byte
+}
+
+// removedElements are dropped from archives together with their content,
+// as they are either active content or not part of the main content.
+var removedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Noscript: true,
+	atom.Style:    true,
+	atom.Link:     true,
+	atom.Meta:     true,
+	atom.Base:     true,
+	atom.Template: true,
+	atom.Iframe:   true,
+	atom.Frame:    true,
+	atom.Frameset: true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Applet:   true,
+	atom.Svg:      true,
+	atom.Math:     true,
+	atom.Canvas:   true,
+	atom.Form:     true,
+	atom.Input:    true,
+	atom.Button:   true,
+	atom.Select:   true,
+	atom.Textarea: true,
+	atom.Dialog:   true,
+	atom.Nav:      true,
+	atom.Aside:    true,
+}
+
+// allowedAttributes are kept in archives; all other attributes, including event handlers and styles, are dropped.
+var allowedAttributes = map[string]bool{
+	"href":     true,
+	"src":      true,
+	"alt":      true,
+	"title":    true,
+	"colspan":  true,
+	"rowspan":  true,
+	"datetime": true,
+	"cite":     true,
+	"lang":     true,
+}
+
+// GetArchive fetches the page and returns a readable snapshot of its main content.
+// Internal addresses are rejected like in GetHTMLMeta.
+func GetArchive(urlStr string) (*Archive, error) {
+	if err := validateURL(urlStr); err != nil {
+		return nil, err
+	}
+
+	response, err := httpClient.Get(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	mediatype, err := getMediatype(response)
+	if err != nil {
+		return nil, err
+	}
+	if mediatype != "text/html" {
+		return nil, errors.New("not a HTML page")
+	}
+
+	doc, err := html.Parse(io.LimitReader(response.Body, maxArchivePageSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse page")
+	}
+	return newArchive(doc, response.Request.URL, time.Now())
+}
+
+// newArchive renders the main content of the parsed page as a standalone document.
+func newArchive(doc *html.Node, pageURL *url.URL, archivedAt time.Time) (*Archive, error) {
+	title := ""
+	if node := findElement(doc, atom.Title); node != nil {
+		title = strings.TrimSpace(textContent(node))
+	}
+	content := findMainContent(doc)
+	if content == nil {
+		return nil, errors.New("page has no content")
+	}
+	sanitizeNode(content, pageURL)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>%s</title>
+<style>body{max-width:42rem;margin:2rem auto;padding:0 1rem;font-family:sans-serif;line-height:1.6}img{max-width:100%%;height:auto}</style>
+</head>
+<body>
+<p><small>Archived from <a href="%s">%s</a> on %s.</small></p>
+<article>
+`, html.EscapeString(title), html.EscapeString(pageURL.String()), html.EscapeString(pageURL.String()), archivedAt.UTC().Format(time.RFC1123))
+	for child := content.FirstChild; child != nil; child = child.NextSibling {
+		if err := html.Render(buf, child); err != nil {
+			return nil, errors.Wrap(err, "failed to render content")
+		}
+	}
+	buf.WriteString("\n</article>\n</body>\n</html>\n")
+
+	return &Archive{
+		URL:   pageURL.String(),
+		Title: title,
+		HTML:  buf.Bytes(),
+	}, nil
+}
+
+// findMainContent returns the element most likely to hold the main content of the page.
+func findMainContent(doc *html.Node) *html.Node {
+	for _, a := range []atom.Atom{atom.Article, atom.Main, atom.Body} {
+		if node := findElement(doc, a); node != nil {
+			return node
+		}
+	}
+	return nil
+}
+
+// findElement returns the first element of the given type in document order.
+func findElement(node *html.Node, a atom.Atom) *html.Node {
+	if node.Type == html.ElementNode && node.DataAtom == a {
+		return node
+	}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if found := findElement(child, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var sb strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		sb.WriteString(textContent(child))
+	}
+	return sb.String()
+}
+
+// sanitizeNode removes active content and comments from the children of node,
+// keeps only allowed attributes and resolves links against the page URL.
+func sanitizeNode(node *html.Node, pageURL *url.URL) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch {
+		case child.Type == html.CommentNode, child.Type == html.ElementNode && removedElements[child.DataAtom]:
+			node.RemoveChild(child)
+		case child.Type == html.ElementNode:
+			child.Attr = sanitizeAttributes(child.Attr, pageURL)
+			sanitizeNode(child, pageURL)
+		default:
+			// Text is kept as is; it is escaped when rendered.
+		}
+		child = next
+	}
+}
+
+func sanitizeAttributes(attrs []html.Attribute, pageURL *url.URL) []html.Attribute {
+	sanitized := []html.Attribute{}
+	for _, attr := range attrs {
+		if attr.Namespace != "" || !allowedAttributes[attr.Key] {
+			continue
+		}
+		if attr.Key == "href" || attr.Key == "src" || attr.Key == "cite" {
+			resolved, err := pageURL.Parse(strings.TrimSpace(attr.Val))
+			if err != nil {
+				continue
+			}
+			if resolved.Scheme != "http" && resolved.Scheme != "https" && !(attr.Key == "href" && resolved.Scheme == "mailto") {
+				continue
+			}
+			attr.Val = resolved.String()
+		}
+		sanitized = append(sanitized, attr)
+	}
+	return sanitized
+}
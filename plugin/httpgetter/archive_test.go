@@ -0,0 +1,53 @@
+package httpgetter
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+
+	"github.com/usememos/memos/internal/netguard"
+)
+
+func TestNewArchive(t *testing.T) {
+	page := `<html><head><title>An &amp; article</title><script>alert(1)</script></head>
+<body>
+<nav><a href="/">Home</a></nav>
+<article class="post" onclick="steal()">
+<h1 style="color:red">Heading</h1>
+<p>Read <a href="/more" target="_blank">more</a> or <a href="javascript:alert(1)">this</a>.</p>
+<img src="images/cover.png" onerror="alert(1)" alt="Cover">
+<iframe src="https://ads.example.com"></iframe>
+<!-- comment -->
+<script>alert(2)</script>
+</article>
+</body></html>`
+	doc, err := html.Parse(strings.NewReader(page))
+	require.NoError(t, err)
+	pageURL, err := url.Parse("https://example.com/posts/1")
+	require.NoError(t, err)
+
+	archive, err := newArchive(doc, pageURL, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/posts/1", archive.URL)
+	require.Equal(t, "An & article", archive.Title)
+	document := string(archive.HTML)
+	require.Contains(t, document, "<title>An &amp; article</title>")
+	require.Contains(t, document, `Archived from <a href="https://example.com/posts/1">https://example.com/posts/1</a> on Wed, 01 May 2024 12:00:00 UTC.`)
+	require.Contains(t, document, "<h1>Heading</h1>")
+	require.Contains(t, document, `Read <a href="https://example.com/more">more</a> or <a>this</a>.`)
+	require.Contains(t, document, `<img src="https://example.com/posts/images/cover.png" alt="Cover"/>`)
+	for _, removed := range []string{"alert", "steal", "script", "iframe", "comment", "Home", "class=", "style=\"color"} {
+		require.NotContains(t, document, removed)
+	}
+}
+
+func TestGetArchiveForInternal(t *testing.T) {
+	if _, err := GetArchive("http://10.0.0.1/article"); !errors.Is(err, netguard.ErrInternalAddress) {
+		t.Errorf("Expected error for internal IP, got %v", err)
+	}
+}
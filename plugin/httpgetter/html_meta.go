@@ -6,15 +6,26 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
+
+	"github.com/usememos/memos/internal/netguard"
 )
 
-var ErrInternalIP = errors.New("internal IP addresses are not allowed")
+// maxHTMLSize is the maximum number of bytes of a page read to find its metadata.
+const maxHTMLSize = 1 << 20
 
 var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		// Connect directly, without a proxy, so that the dialer checks the address of the actual server.
+		// This also rejects hostnames that resolve to an internal IP after validateURL has checked them.
+		DialContext:         netguard.NewDialer(5 * time.Second).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
 	CheckRedirect: func(req *http.Request, via []*http.Request) error {
 		if err := validateURL(req.URL.String()); err != nil {
 			return errors.Wrap(err, "redirect to internal IP")
@@ -51,9 +62,13 @@ func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
 		return nil, errors.New("not a HTML page")
 	}
 
-	// TODO: limit the size of the response body
-
-	htmlMeta := extractHTMLMeta(response.Body)
+	htmlMeta := extractHTMLMeta(io.LimitReader(response.Body, maxHTMLSize))
+	if htmlMeta.Image != "" {
+		// Resolve a relative og:image against the final page URL.
+		if image, err := response.Request.URL.Parse(htmlMeta.Image); err == nil {
+			htmlMeta.Image = image.String()
+		}
+	}
 	enrichSiteMeta(response.Request.URL, htmlMeta)
 	return htmlMeta, nil
 }
@@ -106,7 +121,8 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 func extractMetaProperty(token html.Token, prop string) (content string, ok bool) {
 	content, ok = "", false
 	for _, attr := range token.Attr {
-		if attr.Key == "property" && attr.Val == prop {
+		// Open Graph uses the property attribute, while standard metadata like description uses name.
+		if (attr.Key == "property" || attr.Key == "name") && attr.Val == prop {
 			ok = true
 		}
 		if attr.Key == "content" {
@@ -133,8 +149,8 @@ func validateURL(urlStr string) error {
 
 	// check if the hostname is an IP
 	if ip := net.ParseIP(host); ip != nil {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
-			return errors.Wrap(ErrInternalIP, ip.String())
+		if netguard.IsInternalIP(ip) {
+			return errors.Wrap(netguard.ErrInternalAddress, ip.String())
 		}
 		return nil
 	}
@@ -146,8 +162,8 @@ func validateURL(urlStr string) error {
 	}
 
 	for _, ip := range ips {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
-			return errors.Wrapf(ErrInternalIP, "host=%s, ip=%s", host, ip.String())
+		if netguard.IsInternalIP(ip) {
+			return errors.Wrapf(netguard.ErrInternalAddress, "host=%s, ip=%s", host, ip.String())
 		}
 	}
 
@@ -2,9 +2,12 @@ package httpgetter
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/netguard"
 )
 
 func TestGetHTMLMeta(t *testing.T) {
@@ -21,12 +24,33 @@ func TestGetHTMLMeta(t *testing.T) {
 
 func TestGetHTMLMetaForInternal(t *testing.T) {
 	// test for internal IP
-	if _, err := GetHTMLMeta("http://192.168.0.1"); !errors.Is(err, ErrInternalIP) {
+	if _, err := GetHTMLMeta("http://192.168.0.1"); !errors.Is(err, netguard.ErrInternalAddress) {
 		t.Errorf("Expected error for internal IP, got %v", err)
 	}
 
 	// test for resolved internal IP
-	if _, err := GetHTMLMeta("http://localhost"); !errors.Is(err, ErrInternalIP) {
+	if _, err := GetHTMLMeta("http://localhost"); !errors.Is(err, netguard.ErrInternalAddress) {
 		t.Errorf("Expected error for resolved internal IP, got %v", err)
 	}
 }
+
+func TestExtractHTMLMeta(t *testing.T) {
+	page := `<html><head>
+<title>Page title</title>
+<meta name="description" content="Page description">
+<meta property="og:title" content="OG title">
+<meta property="og:image" content="/cover.png">
+</head><body><meta property="og:description" content="Ignored"></body></html>`
+
+	require.Equal(t, HTMLMeta{
+		Title:       "OG title",
+		Description: "Page description",
+		Image:       "/cover.png",
+	}, *extractHTMLMeta(strings.NewReader(page)))
+}
+
+func TestGetImageForInternal(t *testing.T) {
+	if _, err := GetImage("http://127.0.0.1/image.png"); !errors.Is(err, netguard.ErrInternalAddress) {
+		t.Errorf("Expected error for internal IP, got %v", err)
+	}
+}
@@ -3,22 +3,23 @@ package httpgetter
 import (
 	"errors"
 	"io"
-	"net/http"
-	"net/url"
 	"strings"
 )
 
+// maxImageSize is the maximum number of bytes of an image.
+const maxImageSize = 10 << 20
+
 type Image struct {
 	Blob      []byte
 	Mediatype string
 }
 
 func GetImage(urlStr string) (*Image, error) {
-	if _, err := url.Parse(urlStr); err != nil {
+	if err := validateURL(urlStr); err != nil {
 		return nil, err
 	}
 
-	response, err := http.Get(urlStr)
+	response, err := httpClient.Get(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -32,10 +33,13 @@ func GetImage(urlStr string) (*Image, error) {
 		return nil, errors.New("wrong image mediatype")
 	}
 
-	bodyBytes, err := io.ReadAll(response.Body)
+	bodyBytes, err := io.ReadAll(io.LimitReader(response.Body, maxImageSize+1))
 	if err != nil {
 		return nil, err
 	}
+	if len(bodyBytes) > maxImageSize {
+		return nil, errors.New("image is too large")
+	}
 
 	image := &Image{
 		Blob:      bodyBytes,
@@ -0,0 +1,151 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// jsonWebKeySet is a JSON Web Key Set (RFC 7517) holding the signing keys of an issuer.
+type jsonWebKeySet struct {
+	Keys []*jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC and OKP keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// lookup returns the public key with the key ID that can verify the algorithm.
+// Without a key ID the key set must hold exactly one such key.
+func (s *jsonWebKeySet) lookup(kid, alg string) (any, error) {
+	var candidates []*jsonWebKey
+	for _, key := range s.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if key.Alg != "" && key.Alg != alg {
+			continue
+		}
+		if kid != "" && key.Kid != kid {
+			continue
+		}
+		if key.Kty != keyTypeOfAlgorithm(alg) {
+			continue
+		}
+		candidates = append(candidates, key)
+	}
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no signing key found for key ID %q", kid)
+	}
+	if len(candidates) > 1 {
+		return nil, errors.Errorf("multiple signing keys found for key ID %q", kid)
+	}
+	return candidates[0].publicKey()
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeKeyParameter(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeKeyParameter(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeKeyParameter(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeKeyParameter(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, errors.New("invalid EC point")
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 0x04
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC point")
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeKeyParameter(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// keyTypeOfAlgorithm returns the JWK key type used by the JWS algorithm.
+func keyTypeOfAlgorithm(alg string) string {
+	switch {
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		return "RSA"
+	case strings.HasPrefix(alg, "ES"):
+		return "EC"
+	case alg == "EdDSA":
+		return "OKP"
+	default:
+		return ""
+	}
+}
+
+func decodeKeyParameter(value string) ([]byte, error) {
+	if value == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid key parameter")
+	}
+	return data, nil
+}
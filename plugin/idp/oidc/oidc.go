@@ -0,0 +1,239 @@
+// Package oidc is the plugin for OpenID Connect Identity Provider.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/usememos/memos/plugin/idp"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	// DefaultIdentifierClaim is the ID token claim used as the username unless configured otherwise.
+	// The subject is the only claim that is stable and unique per issuer (OpenID Connect Core 5.7).
+	// Claims such as "preferred_username" or "email" may be changed by the user at the issuer,
+	// and using them lets whoever claims the name of an existing user sign in as that user.
+	DefaultIdentifierClaim = "sub"
+
+	// maxResponseSize bounds the discovery document and the JWKS.
+	maxResponseSize = 1 << 20
+	// clockSkew is the tolerated clock difference when validating the ID token times.
+	clockSkew = time.Minute
+)
+
+// DefaultScopes are requested if no scopes are configured.
+var DefaultScopes = []string{"openid", "profile", "email"}
+
+// signingMethods are the accepted ID token signature algorithms, "none" and HMAC are never accepted.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// ProviderMetadata is the subset of the discovery document used by memos.
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata.
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// IdentityProvider represents an OpenID Connect Identity Provider.
+type IdentityProvider struct {
+	config   *storepb.OIDCConfig
+	client   *http.Client
+	metadata *ProviderMetadata
+}
+
+// NewIdentityProvider initializes a new OpenID Connect Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.OIDCConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.Issuer:   "issuer",
+		config.ClientId: "clientId",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+
+	return &IdentityProvider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Discover fetches the discovery document of the issuer.
+// The document is fetched once per identity provider instance.
+func (p *IdentityProvider) Discover(ctx context.Context) (*ProviderMetadata, error) {
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	metadata := &ProviderMetadata{}
+	discoveryURL := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, discoveryURL, metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch discovery document")
+	}
+	// The issuer must match, otherwise another issuer could impersonate it (OpenID Connect Discovery 4.3).
+	// Only a trailing slash is tolerated, the ID tokens must then carry the issuer of the document.
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(p.config.Issuer, "/") {
+		return nil, errors.Errorf("issuer %q in discovery document does not match %q", metadata.Issuer, p.config.Issuer)
+	}
+	for v, field := range map[string]string{
+		metadata.AuthorizationEndpoint: "authorization_endpoint",
+		metadata.TokenEndpoint:         "token_endpoint",
+		metadata.JWKSURI:               "jwks_uri",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is missing in discovery document", field)
+		}
+	}
+	p.metadata = metadata
+	return metadata, nil
+}
+
+// ExchangeToken returns the ID token exchanged for the given authorization code.
+// If codeVerifier is provided, it will be used for PKCE (Proof Key for Code Exchange) validation.
+func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code, codeVerifier string) (string, error) {
+	metadata, err := p.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	conf := &oauth2.Config{
+		ClientID:     p.config.ClientId,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       p.Scopes(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   metadata.AuthorizationEndpoint,
+			TokenURL:  metadata.TokenEndpoint,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	opts := []oauth2.AuthCodeOption{}
+	if codeVerifier != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	}
+	token, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, opts...)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to exchange token")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return "", errors.New("missing id token from token response")
+	}
+	return rawIDToken, nil
+}
+
+// UserInfo verifies the ID token and returns the user information from its standard claims.
+// The token must be signed by a key of the issuer, be issued for the client and carry the nonce
+// of the authorization request.
+func (p *IdentityProvider) UserInfo(ctx context.Context, rawIDToken, nonce string) (*idp.IdentityProviderUserInfo, error) {
+	if nonce == "" {
+		return nil, errors.New("nonce is required")
+	}
+	metadata, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := &jsonWebKeySet{}
+	if err := p.getJSON(ctx, metadata.JWKSURI, keys); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch JWKS")
+	}
+
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return keys.lookup(kid, token.Method.Alg())
+	}); err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	}
+	if v, _ := claims["nonce"].(string); v != nonce {
+		return nil, errors.New("invalid id token: nonce mismatch")
+	}
+	// A token issued to several clients must name this client as the authorized party (OpenID Connect Core 3.1.3.7).
+	if azp, ok := claims["azp"].(string); ok && azp != p.config.ClientId {
+		return nil, errors.New("invalid id token: authorized party mismatch")
+	}
+	if subject, _ := claims.GetSubject(); subject == "" {
+		return nil, errors.New("invalid id token: missing subject")
+	}
+
+	identifierClaim := p.config.IdentifierClaim
+	if identifierClaim == "" {
+		identifierClaim = DefaultIdentifierClaim
+	}
+	userInfo := &idp.IdentityProviderUserInfo{}
+	if v, ok := claims[identifierClaim].(string); ok {
+		userInfo.Identifier = v
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the claim %q is not found in id token or has empty value", identifierClaim)
+	}
+	if v, ok := claims["name"].(string); ok {
+		userInfo.DisplayName = v
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if v, ok := claims["email"].(string); ok {
+		userInfo.Email = v
+	}
+	if v, ok := claims["picture"].(string); ok {
+		userInfo.AvatarURL = v
+	}
+	return userInfo, nil
+}
+
+// Scopes returns the scopes to request, always including "openid".
+func (p *IdentityProvider) Scopes() []string {
+	if len(p.config.Scopes) == 0 {
+		return DefaultScopes
+	}
+	if slices.Contains(p.config.Scopes, "openid") {
+		return p.config.Scopes
+	}
+	return append([]string{"openid"}, p.config.Scopes...)
+}
+
+func (p *IdentityProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to new http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response from %s", url)
+	}
+	return nil
+}
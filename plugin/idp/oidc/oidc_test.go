@@ -0,0 +1,225 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/oidc/oidctest"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, err := NewIdentityProvider(&storepb.OIDCConfig{ClientId: "memos"})
+	assert.ErrorContains(t, err, `the field "issuer" is empty but required`)
+	_, err = NewIdentityProvider(&storepb.OIDCConfig{Issuer: "https://accounts.example.com"})
+	assert.ErrorContains(t, err, `the field "clientId" is empty but required`)
+
+	provider, err := NewIdentityProvider(&storepb.OIDCConfig{Issuer: "https://accounts.example.com", ClientId: "memos"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"openid", "profile", "email"}, provider.Scopes())
+	provider, err = NewIdentityProvider(&storepb.OIDCConfig{Issuer: "https://accounts.example.com", ClientId: "memos", Scopes: []string{"email"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"openid", "email"}, provider.Scopes())
+}
+
+func TestDiscover(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("memos")
+	require.NoError(t, err)
+	defer issuer.Close()
+
+	provider, err := NewIdentityProvider(&storepb.OIDCConfig{Issuer: issuer.URL + "/", ClientId: "memos"})
+	require.NoError(t, err)
+	metadata, err := provider.Discover(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &ProviderMetadata{
+		Issuer:                issuer.URL,
+		AuthorizationEndpoint: issuer.URL + "/authorize",
+		TokenEndpoint:         issuer.URL + "/token",
+		JWKSURI:               issuer.URL + "/jwks",
+	}, metadata)
+
+	// A discovery document served for another issuer is rejected.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, issuer.URL+"/.well-known/openid-configuration", http.StatusFound)
+	})
+	impostor := httptest.NewServer(mux)
+	defer impostor.Close()
+	provider, err = NewIdentityProvider(&storepb.OIDCConfig{Issuer: impostor.URL, ClientId: "memos"})
+	require.NoError(t, err)
+	_, err = provider.Discover(ctx)
+	assert.ErrorContains(t, err, "does not match")
+
+	provider, err = NewIdentityProvider(&storepb.OIDCConfig{Issuer: issuer.URL + "/missing", ClientId: "memos"})
+	require.NoError(t, err)
+	_, err = provider.Discover(ctx)
+	assert.ErrorContains(t, err, "unexpected status 404")
+}
+
+func TestIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("memos")
+	require.NoError(t, err)
+	defer issuer.Close()
+
+	const (
+		nonce       = "test-nonce"
+		redirectURL = "https://memos.example.com/auth/callback"
+	)
+	newProvider := func(identifierClaim string) *IdentityProvider {
+		provider, err := NewIdentityProvider(&storepb.OIDCConfig{
+			Issuer:          issuer.URL,
+			ClientId:        "memos",
+			ClientSecret:    "test-client-secret",
+			IdentifierClaim: identifierClaim,
+		})
+		require.NoError(t, err)
+		return provider
+	}
+
+	claims := issuer.Claims("248289761001", nonce)
+	claims["preferred_username"] = "jane"
+	claims["name"] = "Jane Doe"
+	claims["email"] = "jane@example.com"
+	claims["picture"] = "https://example.com/jane.png"
+	idToken, err := issuer.SignIDToken(claims)
+	require.NoError(t, err)
+
+	provider := newProvider("")
+	code := issuer.IssueCode(idToken)
+	rawIDToken, err := provider.ExchangeToken(ctx, redirectURL, code, "")
+	require.NoError(t, err)
+	assert.Equal(t, idToken, rawIDToken)
+	userInfo, err := provider.UserInfo(ctx, rawIDToken, nonce)
+	require.NoError(t, err)
+	assert.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "248289761001",
+		DisplayName: "Jane Doe",
+		Email:       "jane@example.com",
+		AvatarURL:   "https://example.com/jane.png",
+	}, userInfo)
+
+	// Codes are single use.
+	_, err = provider.ExchangeToken(ctx, redirectURL, code, "")
+	assert.ErrorContains(t, err, "failed to exchange token")
+
+	userInfo, err = newProvider("preferred_username").UserInfo(ctx, rawIDToken, nonce)
+	require.NoError(t, err)
+	assert.Equal(t, "jane", userInfo.Identifier)
+	_, err = newProvider("nickname").UserInfo(ctx, rawIDToken, nonce)
+	assert.ErrorContains(t, err, `the claim "nickname" is not found`)
+
+	_, err = provider.UserInfo(ctx, rawIDToken, "")
+	assert.ErrorContains(t, err, "nonce is required")
+	_, err = provider.UserInfo(ctx, rawIDToken, "other-nonce")
+	assert.ErrorContains(t, err, "nonce mismatch")
+}
+
+func TestIdentityProviderRejectsInvalidIDTokens(t *testing.T) {
+	ctx := context.Background()
+	issuer, err := oidctest.NewIssuer("memos")
+	require.NoError(t, err)
+	defer issuer.Close()
+	provider, err := NewIdentityProvider(&storepb.OIDCConfig{Issuer: issuer.URL, ClientId: "memos"})
+	require.NoError(t, err)
+
+	const nonce = "test-nonce"
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tests := []struct {
+		name        string
+		sign        func(claims jwt.MapClaims) (string, error)
+		modify      func(claims jwt.MapClaims)
+		containsErr string
+	}{
+		{
+			name:        "wrong audience",
+			modify:      func(claims jwt.MapClaims) { claims["aud"] = "other-client" },
+			containsErr: "token has invalid audience",
+		},
+		{
+			name: "wrong authorized party",
+			modify: func(claims jwt.MapClaims) {
+				claims["aud"] = []string{"memos", "other-client"}
+				claims["azp"] = "other-client"
+			},
+			containsErr: "authorized party mismatch",
+		},
+		{
+			name:        "wrong issuer",
+			modify:      func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+			containsErr: "token has invalid issuer",
+		},
+		{
+			name:        "expired",
+			modify:      func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+			containsErr: "token is expired",
+		},
+		{
+			name:        "missing subject",
+			modify:      func(claims jwt.MapClaims) { delete(claims, "sub") },
+			containsErr: "missing subject",
+		},
+		{
+			name: "unknown key",
+			sign: func(claims jwt.MapClaims) (string, error) {
+				token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+				token.Header["kid"] = oidctest.KeyID
+				return token.SignedString(otherKey)
+			},
+			containsErr: "token signature is invalid",
+		},
+		{
+			name: "unknown key ID",
+			sign: func(claims jwt.MapClaims) (string, error) {
+				token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+				token.Header["kid"] = "other-key"
+				return token.SignedString(otherKey)
+			},
+			containsErr: `no signing key found for key ID "other-key"`,
+		},
+		{
+			name: "HMAC signed with the client ID",
+			sign: func(claims jwt.MapClaims) (string, error) {
+				return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("memos"))
+			},
+			containsErr: "signing method HS256 is invalid",
+		},
+		{
+			name: "unsigned",
+			sign: func(claims jwt.MapClaims) (string, error) {
+				return jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			},
+			containsErr: "signing method none is invalid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claims := issuer.Claims("jane", nonce)
+			claims["preferred_username"] = "jane"
+			if test.modify != nil {
+				test.modify(claims)
+			}
+			sign := issuer.SignIDToken
+			if test.sign != nil {
+				sign = test.sign
+			}
+			idToken, err := sign(claims)
+			require.NoError(t, err)
+			_, err = provider.UserInfo(ctx, idToken, nonce)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Package oidctest provides an in-process OpenID Connect issuer for testing sign-in flows.
+package oidctest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// KeyID is the key ID of the signing key published in the JWKS.
+const KeyID = "test-key"
+
+// Issuer is a fake OpenID Connect issuer serving discovery, JWKS and token endpoints.
+type Issuer struct {
+	// URL is the issuer URL.
+	URL string
+	// ClientID is the client the issued ID tokens are meant for.
+	ClientID string
+
+	server *httptest.Server
+	key    *ecdsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]string
+}
+
+// NewIssuer starts an issuer signing ES256 ID tokens for the client.
+func NewIssuer(clientID string) (*Issuer, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	issuer := &Issuer{
+		ClientID: clientID,
+		key:      key,
+		codes:    map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                issuer.URL,
+			"authorization_endpoint":                issuer.URL + "/authorize",
+			"token_endpoint":                        issuer.URL + "/token",
+			"jwks_uri":                              issuer.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"ES256"},
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{"keys": []any{issuer.jwk()}})
+	})
+	mux.HandleFunc("POST /token", issuer.handleToken)
+	issuer.server = httptest.NewServer(mux)
+	issuer.URL = issuer.server.URL
+	return issuer, nil
+}
+
+// Close shuts the issuer down.
+func (i *Issuer) Close() {
+	i.server.Close()
+}
+
+// IssueCode returns an authorization code that the token endpoint exchanges for the ID token.
+func (i *Issuer) IssueCode(idToken string) string {
+	code := base64.RawURLEncoding.EncodeToString(randomBytes(16))
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.codes[code] = idToken
+	return code
+}
+
+// Claims returns valid ID token claims for the subject, to be adjusted by tests.
+func (i *Issuer) Claims(subject, nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":   i.URL,
+		"sub":   subject,
+		"aud":   i.ClientID,
+		"nonce": nonce,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+	}
+}
+
+// SignIDToken signs the claims with the key published in the JWKS.
+func (i *Issuer) SignIDToken(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = KeyID
+	return token.SignedString(i.key)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("client_id") != i.ClientID {
+		writeError(w, "invalid_client")
+		return
+	}
+	i.mu.Lock()
+	idToken, ok := i.codes[r.PostForm.Get("code")]
+	delete(i.codes, r.PostForm.Get("code"))
+	i.mu.Unlock()
+	if !ok {
+		writeError(w, "invalid_grant")
+		return
+	}
+	writeJSON(w, map[string]any{
+		"access_token": base64.RawURLEncoding.EncodeToString(randomBytes(16)),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (i *Issuer) jwk() map[string]any {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	i.key.X.FillBytes(x)
+	i.key.Y.FillBytes(y)
+	return map[string]any{
+		"kty": "EC",
+		"kid": KeyID,
+		"use": "sig",
+		"alg": "ES256",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(x),
+		"y":   base64.RawURLEncoding.EncodeToString(y),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": code})
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return b
+}
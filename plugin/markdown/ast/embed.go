@@ -0,0 +1,31 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// EmbedNode represents a ![[memos/{uid}]] memo embed in the markdown AST.
+type EmbedNode struct {
+	gast.BaseInline
+
+	// Target without the surrounding brackets, e.g. memos/{uid}
+	Target []byte
+
+	// HTML is the rendered content of the embedded memo, or nil if the embed has not been resolved
+	HTML []byte
+}
+
+// KindEmbed is the NodeKind for EmbedNode.
+var KindEmbed = gast.NewNodeKind("Embed")
+
+// Kind returns KindEmbed.
+func (*EmbedNode) Kind() gast.NodeKind {
+	return KindEmbed
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *EmbedNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Target": string(n.Target),
+	}, nil)
+}
@@ -0,0 +1,29 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// FrontmatterNode represents a leading YAML frontmatter block delimited by --- lines.
+// The YAML lines, without the delimiters, are stored in Lines().
+type FrontmatterNode struct {
+	gast.BaseBlock
+}
+
+// KindFrontmatter is the NodeKind for FrontmatterNode.
+var KindFrontmatter = gast.NewNodeKind("Frontmatter")
+
+// Kind returns KindFrontmatter.
+func (*FrontmatterNode) Kind() gast.NodeKind {
+	return KindFrontmatter
+}
+
+// IsRaw returns true because the frontmatter is not parsed as markdown.
+func (*FrontmatterNode) IsRaw() bool {
+	return true
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *FrontmatterNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, nil, nil)
+}
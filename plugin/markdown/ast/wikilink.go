@@ -0,0 +1,28 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// WikiLinkNode represents a [[target]] link to another memo in the markdown AST.
+type WikiLinkNode struct {
+	gast.BaseInline
+
+	// Target without the surrounding brackets, either a memo name (memos/{uid}) or a memo title
+	Target []byte
+}
+
+// KindWikiLink is the NodeKind for WikiLinkNode.
+var KindWikiLink = gast.NewNodeKind("WikiLink")
+
+// Kind returns KindWikiLink.
+func (*WikiLinkNode) Kind() gast.NodeKind {
+	return KindWikiLink
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *WikiLinkNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Target": string(n.Target),
+	}, nil)
+}
@@ -0,0 +1,97 @@
+package markdown
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	gast "github.com/yuin/goldmark/ast"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	// MaxEmbedDepth is the maximum nesting depth of embedded memos.
+	MaxEmbedDepth = 3
+	// MaxEmbedCount is the maximum number of memos embedded in a single render.
+	MaxEmbedCount = 20
+	// MaxEmbedSize is the maximum total size in bytes of the content embedded in a single render.
+	MaxEmbedSize = 256 * 1024
+)
+
+// EmbedResolver resolves the memos embedded with ![[memos/{uid}]] when rendering HTML.
+// Implementations decide which memos the viewer is allowed to see.
+type EmbedResolver interface {
+	// ResolveEmbed returns the markdown content of the named memo.
+	// It returns false if the memo does not exist or is not visible to the viewer.
+	ResolveEmbed(ctx context.Context, name string) (string, bool, error)
+}
+
+// RenderOption configures a single render.
+type RenderOption func(*renderConfig)
+
+type renderConfig struct {
+	ctx           context.Context
+	embedResolver EmbedResolver
+}
+
+// WithEmbedResolver renders embedded memos inline using the resolver.
+// Without a resolver, embeds are rendered as their source text.
+func WithEmbedResolver(ctx context.Context, resolver EmbedResolver) RenderOption {
+	return func(c *renderConfig) {
+		c.ctx = ctx
+		c.embedResolver = resolver
+	}
+}
+
+// embedState tracks the embeds of a single render across nested memos.
+type embedState struct {
+	ctx      context.Context
+	resolver EmbedResolver
+	// stack holds the names of the memos being embedded, to guard against cycles.
+	stack     []string
+	count     int
+	remaining int
+}
+
+// resolveEmbeds renders the content of each embed under root.
+// Embeds that cannot be resolved, would exceed the limits, or would form a cycle are left unresolved.
+func (s *service) resolveEmbeds(root gast.Node, state *embedState) error {
+	embeds := []*mast.EmbedNode{}
+	_ = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if embedNode, ok := n.(*mast.EmbedNode); ok && entering {
+			embeds = append(embeds, embedNode)
+		}
+		return gast.WalkContinue, nil
+	})
+
+	for _, embedNode := range embeds {
+		name := string(embedNode.Target)
+		if !strings.HasPrefix(name, "memos/") || len(state.stack) >= MaxEmbedDepth || slices.Contains(state.stack, name) {
+			continue
+		}
+		if state.count >= MaxEmbedCount {
+			return nil
+		}
+
+		content, ok, err := state.resolver.ResolveEmbed(state.ctx, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve embedded memo %s", name)
+		}
+		if !ok || len(content) > state.remaining {
+			continue
+		}
+		state.count++
+		state.remaining -= len(content)
+
+		state.stack = append(state.stack, name)
+		html, err := s.renderHTML([]byte(content), state)
+		state.stack = state.stack[:len(state.stack)-1]
+		if err != nil {
+			return err
+		}
+		embedNode.HTML = []byte(html)
+	}
+	return nil
+}
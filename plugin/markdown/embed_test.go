@@ -0,0 +1,103 @@
+package markdown
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEmbedResolver map[string]string
+
+func (r fakeEmbedResolver) ResolveEmbed(_ context.Context, name string) (string, bool, error) {
+	content, ok := r[name]
+	return content, ok, nil
+}
+
+func TestRenderHTMLEmbeds(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(WithTagExtension(), WithEmbedExtension())
+
+	resolver := fakeEmbedResolver{
+		"memos/plain":  "Embedded **text**",
+		"memos/nested": "Outer ![[memos/plain]]",
+		"memos/a":      "A ![[memos/b]]",
+		"memos/b":      "B ![[memos/a]]",
+		"memos/html":   "<script>alert(1)</script>",
+	}
+
+	t.Run("without resolver", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("See ![[memos/plain]]"))
+		require.NoError(t, err)
+		assert.Equal(t, "<p>See ![[memos/plain]]</p>\n", html)
+	})
+
+	t.Run("resolved", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("See ![[memos/plain]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Equal(t, `<p>See <div class="memo-embed" data-memo="memos/plain"><p>Embedded <strong>text</strong></p>`+"\n</div></p>\n", html)
+	})
+
+	t.Run("unknown memo", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("![[memos/missing]] and ![[Some title]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Equal(t, "<p>![[memos/missing]] and ![[Some title]]</p>\n", html)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("![[memos/nested]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Contains(t, html, `data-memo="memos/nested"`)
+		assert.Contains(t, html, `data-memo="memos/plain"`)
+		assert.Contains(t, html, "<strong>text</strong>")
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("![[memos/a]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(html, `data-memo="memos/a"`))
+		assert.Equal(t, 1, strings.Count(html, `data-memo="memos/b"`))
+		assert.Contains(t, html, "B ![[memos/a]]")
+	})
+
+	t.Run("raw html is not rendered", func(t *testing.T) {
+		html, err := svc.RenderHTML([]byte("![[memos/html]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.NotContains(t, html, "<script>")
+	})
+}
+
+func TestRenderHTMLEmbedLimits(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(WithEmbedExtension())
+
+	t.Run("depth", func(t *testing.T) {
+		resolver := fakeEmbedResolver{
+			"memos/1": "one ![[memos/2]]",
+			"memos/2": "two ![[memos/3]]",
+			"memos/3": "three ![[memos/4]]",
+			"memos/4": "four",
+		}
+		html, err := svc.RenderHTML([]byte("![[memos/1]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Contains(t, html, "three ![[memos/4]]")
+		assert.NotContains(t, html, "four")
+	})
+
+	t.Run("count", func(t *testing.T) {
+		resolver := fakeEmbedResolver{"memos/x": "x"}
+		content := strings.Repeat("![[memos/x]] ", MaxEmbedCount+5)
+		html, err := svc.RenderHTML([]byte(content), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Equal(t, MaxEmbedCount, strings.Count(html, `class="memo-embed"`))
+	})
+
+	t.Run("size", func(t *testing.T) {
+		resolver := fakeEmbedResolver{"memos/big": strings.Repeat("a", MaxEmbedSize+1)}
+		html, err := svc.RenderHTML([]byte("![[memos/big]]"), WithEmbedResolver(ctx, resolver))
+		require.NoError(t, err)
+		assert.Equal(t, "<p>![[memos/big]]</p>\n", html)
+	})
+}
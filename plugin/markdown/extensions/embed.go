@@ -0,0 +1,63 @@
+package extensions
+
+import (
+	"html"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type embedExtension struct{}
+
+// EmbedExtension is a goldmark extension for ![[target]] memo embeds.
+var EmbedExtension = &embedExtension{}
+
+// Extend extends the goldmark parser and HTML renderer with embed support.
+func (*embedExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Priority 199 - run before standard link parser (200), which also triggers on ! for images
+			util.Prioritized(mparser.NewEmbedParser(), 199),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&embedHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// embedHTMLRenderer renders memo embeds to HTML.
+// Resolved embeds are wrapped in a div; unresolved embeds are rendered as their source text.
+type embedHTMLRenderer struct{}
+
+// RegisterFuncs registers the render function of EmbedNode.
+func (r *embedHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindEmbed, r.renderEmbed)
+}
+
+func (*embedHTMLRenderer) renderEmbed(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+
+	n, ok := node.(*mast.EmbedNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	target := html.EscapeString(string(n.Target))
+	if n.HTML == nil {
+		_, _ = w.WriteString("![[" + target + "]]")
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<div class="memo-embed" data-memo="` + target + `">`)
+	_, _ = w.Write(n.HTML)
+	_, _ = w.WriteString("</div>")
+	return gast.WalkContinue, nil
+}
@@ -0,0 +1,24 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/util"
+
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type frontmatterExtension struct{}
+
+// FrontmatterExtension is a goldmark extension for a leading YAML frontmatter block.
+var FrontmatterExtension = &frontmatterExtension{}
+
+// Extend extends the goldmark parser with frontmatter support.
+func (*frontmatterExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			// Priority 0 - run before setext headings (100) and thematic breaks (200)
+			util.Prioritized(mparser.NewFrontmatterParser(), 0),
+		),
+	)
+}
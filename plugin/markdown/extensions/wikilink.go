@@ -0,0 +1,24 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/util"
+
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type wikiLinkExtension struct{}
+
+// WikiLinkExtension is a goldmark extension for [[target]] syntax.
+var WikiLinkExtension = &wikiLinkExtension{}
+
+// Extend extends the goldmark parser with wiki link support.
+func (*wikiLinkExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Priority 199 - run before standard link parser (200), which also triggers on [
+			util.Prioritized(mparser.NewWikiLinkParser(), 199),
+		),
+	)
+}
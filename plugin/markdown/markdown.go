@@ -2,7 +2,12 @@ package markdown
 
 import (
 	"bytes"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
@@ -10,6 +15,7 @@ import (
 	east "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
+	"gopkg.in/yaml.v3"
 
 	mast "github.com/usememos/memos/plugin/markdown/ast"
 	"github.com/usememos/memos/plugin/markdown/extensions"
@@ -19,7 +25,16 @@ import (
 
 // ExtractedData contains all metadata extracted from markdown in a single pass.
 type ExtractedData struct {
-	Tags     []string
+	Tags      []string
+	Mentions  []string
+	WikiLinks []string
+	// Title is the plain text of the first heading, or empty if there is none.
+	Title string
+	// Properties are the scalar key/values of the leading YAML frontmatter block.
+	Properties map[string]string
+	Tasks      []*storepb.MemoPayload_Task
+	// Links are the distinct http(s) URLs of links and autolinks.
+	Links    []string
 	Property *storepb.MemoPayload_Property
 }
 
@@ -41,7 +56,7 @@ type Service interface {
 	RenderMarkdown(content []byte) (string, error)
 
 	// RenderHTML renders markdown content to HTML
-	RenderHTML(content []byte) (string, error)
+	RenderHTML(content []byte, opts ...RenderOption) (string, error)
 
 	// GenerateSnippet creates plain text summary
 	GenerateSnippet(content []byte, maxLength int) (string, error)
@@ -49,8 +64,12 @@ type Service interface {
 	// ValidateContent checks for syntax errors
 	ValidateContent(content []byte) error
 
-	// RenameTag renames all occurrences of oldTag to newTag in content
+	// RenameTag renames all occurrences of oldTag and its nested tags to newTag in content.
+	// Tags are matched case-insensitively, and an empty newTag removes them.
 	RenameTag(content []byte, oldTag, newTag string) (string, error)
+
+	// SetTaskCompleted checks or unchecks the task at the given index in content
+	SetTaskCompleted(content []byte, index int, completed bool) (string, error)
 }
 
 // service implements the Service interface.
@@ -62,7 +81,11 @@ type service struct {
 type Option func(*config)
 
 type config struct {
-	enableTags bool
+	enableTags        bool
+	enableMentions    bool
+	enableWikiLinks   bool
+	enableEmbeds      bool
+	enableFrontmatter bool
 }
 
 // WithTagExtension enables #tag parsing.
@@ -72,6 +95,35 @@ func WithTagExtension() Option {
 	}
 }
 
+// WithMentionExtension enables @username parsing.
+func WithMentionExtension() Option {
+	return func(c *config) {
+		c.enableMentions = true
+	}
+}
+
+// WithWikiLinkExtension enables [[target]] parsing.
+func WithWikiLinkExtension() Option {
+	return func(c *config) {
+		c.enableWikiLinks = true
+	}
+}
+
+// WithEmbedExtension enables ![[memos/{uid}]] parsing.
+// Embeds are rendered inline by RenderHTML when an EmbedResolver is given.
+func WithEmbedExtension() Option {
+	return func(c *config) {
+		c.enableEmbeds = true
+	}
+}
+
+// WithFrontmatterExtension enables parsing of a leading YAML frontmatter block.
+func WithFrontmatterExtension() Option {
+	return func(c *config) {
+		c.enableFrontmatter = true
+	}
+}
+
 // NewService creates a new markdown service with the given options.
 func NewService(opts ...Option) Service {
 	cfg := &config{}
@@ -87,6 +139,18 @@ func NewService(opts ...Option) Service {
 	if cfg.enableTags {
 		exts = append(exts, extensions.TagExtension)
 	}
+	if cfg.enableMentions {
+		exts = append(exts, extensions.MentionExtension)
+	}
+	if cfg.enableWikiLinks {
+		exts = append(exts, extensions.WikiLinkExtension)
+	}
+	if cfg.enableEmbeds {
+		exts = append(exts, extensions.EmbedExtension)
+	}
+	if cfg.enableFrontmatter {
+		exts = append(exts, extensions.FrontmatterExtension)
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(exts...),
@@ -192,9 +256,36 @@ func (s *service) RenderMarkdown(content []byte) (string, error) {
 }
 
 // RenderHTML renders markdown content to HTML using goldmark's built-in HTML renderer.
-func (s *service) RenderHTML(content []byte) (string, error) {
+func (s *service) RenderHTML(content []byte, opts ...RenderOption) (string, error) {
+	cfg := &renderConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var embeds *embedState
+	if cfg.embedResolver != nil {
+		embeds = &embedState{
+			ctx:       cfg.ctx,
+			resolver:  cfg.embedResolver,
+			remaining: MaxEmbedSize,
+		}
+	}
+	return s.renderHTML(content, embeds)
+}
+
+func (s *service) renderHTML(content []byte, embeds *embedState) (string, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return "", err
+	}
+	if embeds != nil {
+		if err := s.resolveEmbeds(root, embeds); err != nil {
+			return "", err
+		}
+	}
+
 	var buf bytes.Buffer
-	if err := s.md.Convert(content, &buf); err != nil {
+	if err := s.md.Renderer().Render(&buf, content, root); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -293,8 +384,13 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	}
 
 	data := &ExtractedData{
-		Tags:     []string{},
-		Property: &storepb.MemoPayload_Property{},
+		Tags:       []string{},
+		Mentions:   []string{},
+		WikiLinks:  []string{},
+		Properties: map[string]string{},
+		Tasks:      []*storepb.MemoPayload_Task{},
+		Links:      []string{},
+		Property:   &storepb.MemoPayload_Property{},
 	}
 
 	// Single walk to collect all data
@@ -308,8 +404,43 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 			data.Tags = append(data.Tags, string(tagNode.Tag))
 		}
 
+		// Extract mentions, keeping the first occurrence of each username
+		if mentionNode, ok := n.(*mast.MentionNode); ok {
+			if username := string(mentionNode.Username); !slices.Contains(data.Mentions, username) {
+				data.Mentions = append(data.Mentions, username)
+			}
+		}
+
+		// Extract wiki links, keeping the first occurrence of each target.
+		// Embedded memos are linked as well.
+		var linkTarget []byte
+		switch n := n.(type) {
+		case *mast.WikiLinkNode:
+			linkTarget = n.Target
+		case *mast.EmbedNode:
+			linkTarget = n.Target
+		default:
+			// Not a link to another memo
+		}
+		if target := string(linkTarget); target != "" && !slices.Contains(data.WikiLinks, target) {
+			data.WikiLinks = append(data.WikiLinks, target)
+		}
+
+		// Extract web links, keeping the first occurrence of each URL
+		if url := linkURL(n, content); url != "" && !slices.Contains(data.Links, url) {
+			data.Links = append(data.Links, url)
+		}
+
 		// Extract properties based on node kind
 		switch n.Kind() {
+		case mast.KindFrontmatter:
+			data.Properties = frontmatterProperties(n.Lines(), content)
+
+		case gast.KindHeading:
+			if data.Title == "" {
+				data.Title = plainText(n, content)
+			}
+
 		case gast.KindLink:
 			data.Property.HasLink = true
 
@@ -322,6 +453,7 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 				if !checkBox.IsChecked {
 					data.Property.HasIncompleteTasks = true
 				}
+				data.Tasks = append(data.Tasks, extractTask(checkBox, content))
 			}
 		default:
 			// No special handling for other node types
@@ -340,7 +472,32 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	return data, nil
 }
 
-// RenameTag renames all occurrences of oldTag to newTag in content.
+// linkURL returns the absolute http(s) URL of a link or autolink node, or empty for other nodes.
+func linkURL(n gast.Node, source []byte) string {
+	var url string
+	switch n := n.(type) {
+	case *gast.Link:
+		url = string(n.Destination)
+	case *gast.AutoLink:
+		if n.AutoLinkType != gast.AutoLinkURL {
+			return ""
+		}
+		url = string(n.URL(source))
+		// Linkify accepts www. links without a scheme.
+		if strings.HasPrefix(strings.ToLower(url), "www.") {
+			url = "http://" + url
+		}
+	default:
+		return ""
+	}
+	lower := strings.ToLower(url)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return ""
+	}
+	return url
+}
+
+// RenameTag renames all occurrences of oldTag and its nested tags to newTag in content.
 func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, error) {
 	root, err := s.parse(content)
 	if err != nil {
@@ -348,6 +505,7 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 	}
 
 	// Walk the AST to find and rename tag nodes
+	var removed []gast.Node
 	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
 		if !entering {
 			return gast.WalkContinue, nil
@@ -355,8 +513,12 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 
 		// Check for custom TagNode and rename if it matches
 		if tagNode, ok := n.(*mast.TagNode); ok {
-			if string(tagNode.Tag) == oldTag {
-				tagNode.Tag = []byte(newTag)
+			if renamed, ok := renameTag(string(tagNode.Tag), oldTag, newTag); ok {
+				if renamed == "" {
+					removed = append(removed, tagNode)
+				} else {
+					tagNode.Tag = []byte(renamed)
+				}
 			}
 		}
 
@@ -366,12 +528,156 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 	if err != nil {
 		return "", err
 	}
+	for _, node := range removed {
+		node.Parent().RemoveChild(node.Parent(), node)
+	}
 
 	// Render back to markdown using the already-parsed AST
 	mdRenderer := renderer.NewMarkdownRenderer()
 	return mdRenderer.Render(root, content), nil
 }
 
+// renameTag returns the new name of tag if it is oldTag or nested under it, e.g. oldTag/child.
+// Tags are matched case-insensitively; an empty newTag removes nested tags as well.
+func renameTag(tag, oldTag, newTag string) (string, bool) {
+	if strings.EqualFold(tag, oldTag) {
+		return newTag, true
+	}
+	if len(tag) > len(oldTag) && tag[len(oldTag)] == '/' && strings.EqualFold(tag[:len(oldTag)], oldTag) {
+		if newTag == "" {
+			return "", true
+		}
+		return newTag + tag[len(oldTag):], true
+	}
+	return "", false
+}
+
+// SetTaskCompleted checks or unchecks the task at the given index in content.
+func (s *service) SetTaskCompleted(content []byte, index int, completed bool) (string, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	// Walk the AST to find the task at the given index
+	found := false
+	count := 0
+	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+
+		if checkBox, ok := n.(*east.TaskCheckBox); ok {
+			if count == index {
+				checkBox.IsChecked = completed
+				found = true
+				return gast.WalkStop, nil
+			}
+			count++
+		}
+
+		return gast.WalkContinue, nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", errors.Errorf("task %d not found", index)
+	}
+
+	// Render back to markdown using the already-parsed AST
+	mdRenderer := renderer.NewMarkdownRenderer()
+	return mdRenderer.Render(root, content), nil
+}
+
+// dueDatePattern matches the due:YYYY-MM-DD syntax of tasks.
+var dueDatePattern = regexp.MustCompile(`(^|\s)due:(\d{4}-\d{2}-\d{2})(\s|$)`)
+
+// extractTask returns the task of a checkbox. The task content is the raw text of
+// the list item's first block, without the [ ] marker and due date.
+func extractTask(checkBox *east.TaskCheckBox, source []byte) *storepb.MemoPayload_Task {
+	task := &storepb.MemoPayload_Task{
+		Completed: checkBox.IsChecked,
+	}
+	parent := checkBox.Parent()
+	if parent == nil || parent.Lines().Len() == 0 {
+		return task
+	}
+
+	lines := parent.Lines()
+	task.Line = int32(bytes.Count(source[:lines.At(0).Start], []byte("\n")) + 1)
+	var buf strings.Builder
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		buf.Write(segment.Value(source))
+		buf.WriteByte(' ')
+	}
+	// The checkbox marker is always three characters, e.g. [ ] or [x].
+	text := strings.TrimSpace(buf.String())[3:]
+	if match := dueDatePattern.FindStringSubmatchIndex(text); match != nil {
+		dueDate := text[match[4]:match[5]]
+		if _, err := time.Parse(time.DateOnly, dueDate); err == nil {
+			task.DueDate = dueDate
+			text = text[:match[0]] + " " + text[match[1]:]
+		}
+	}
+	task.Content = strings.Join(strings.Fields(text), " ")
+	return task
+}
+
+// plainText returns the trimmed text of the node's descendants, ignoring markup.
+func plainText(node gast.Node, source []byte) string {
+	var buf strings.Builder
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *gast.Text:
+			buf.Write(n.Segment.Value(source))
+			if n.SoftLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *gast.String:
+			buf.Write(n.Value)
+		default:
+			// Only text nodes carry content
+		}
+		return gast.WalkContinue, nil
+	})
+	return strings.TrimSpace(buf.String())
+}
+
+// frontmatterProperties returns the scalar key/values of a YAML frontmatter block.
+// Frontmatter that is not a YAML mapping is ignored so that it never prevents saving a memo,
+// and nested values are skipped because properties are flat.
+func frontmatterProperties(lines *text.Segments, source []byte) map[string]string {
+	properties := map[string]string{}
+
+	var buf bytes.Buffer
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		buf.Write(segment.Value(source))
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(buf.Bytes(), &document); err != nil || len(document.Content) == 0 {
+		return properties
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return properties
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if key.Kind != yaml.ScalarNode || value.Kind != yaml.ScalarNode || value.Tag == "!!null" {
+			continue
+		}
+		properties[key.Value] = value.Value
+	}
+	return properties
+}
+
 // uniqueLowercase returns unique lowercase strings from input.
 func uniqueLowercase(strs []string) []string {
 	seen := make(map[string]bool)
@@ -315,6 +315,107 @@ func TestExtractTags(t *testing.T) {
 	}
 }
 
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no mentions",
+			content:  "Just plain text",
+			expected: []string{},
+		},
+		{
+			name:     "multiple mentions",
+			content:  "Thanks @alice and @bob-2!",
+			expected: []string{"alice", "bob-2"},
+		},
+		{
+			name:     "duplicate mentions",
+			content:  "@alice, @alice and @Alice",
+			expected: []string{"alice", "Alice"},
+		},
+		{
+			name:     "email addresses are not mentions",
+			content:  "Mail alice@example.com or @bob",
+			expected: []string{"bob"},
+		},
+		{
+			name:     "mentions in code are ignored",
+			content:  "`@alice` and\n\n```\n@bob\n```\n\n- [ ] ask @carol",
+			expected: []string{"carol"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension(), WithMentionExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.Mentions)
+
+			// Mentions are rendered back unchanged.
+			rendered, err := svc.RenderMarkdown([]byte(tt.content))
+			require.NoError(t, err)
+			for _, username := range tt.expected {
+				assert.Contains(t, rendered, "@"+username)
+			}
+		})
+	}
+}
+
+func TestExtractWikiLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expected      []string
+		expectedTitle string
+	}{
+		{
+			name:     "no links",
+			content:  "Just plain text with a [link](https://example.com)",
+			expected: []string{},
+		},
+		{
+			name:          "links by name and title",
+			content:       "# Reading list\n\nSee [[memos/abc123]] and [[Gardening]], then [[Gardening]] again.",
+			expected:      []string{"memos/abc123", "Gardening"},
+			expectedTitle: "Reading list",
+		},
+		{
+			name:          "title is the first heading without markup",
+			content:       "Intro\n\n## The *big* plan #work\n\n# Later heading",
+			expected:      []string{},
+			expectedTitle: "The big plan",
+		},
+		{
+			name:     "links in code are ignored",
+			content:  "`[[Gardening]]`",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension(), WithWikiLinkExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.WikiLinks)
+			assert.Equal(t, tt.expectedTitle, data.Title)
+
+			// Wiki links are rendered back unchanged.
+			rendered, err := svc.RenderMarkdown([]byte(tt.content))
+			require.NoError(t, err)
+			for _, target := range tt.expected {
+				assert.Contains(t, rendered, "[["+target+"]]")
+			}
+		})
+	}
+}
+
 func TestUniqueLowercase(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -446,3 +547,177 @@ func BenchmarkExtractProperties(b *testing.B) {
 		}
 	}
 }
+
+func TestExtractFrontmatterProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected map[string]string
+	}{
+		{
+			name:     "no frontmatter",
+			content:  "# Title\n\nstatus: done",
+			expected: map[string]string{},
+		},
+		{
+			name:     "scalar properties",
+			content:  "---\nstatus: done\npriority: 2\ndue: 2024-05-01\ntitle: \"Quoted: value\"\n---\n# Plan\n\nBody #work",
+			expected: map[string]string{"status": "done", "priority": "2", "due": "2024-05-01", "title": "Quoted: value"},
+		},
+		{
+			name:     "nested and empty values are skipped",
+			content:  "---\nstatus: todo\nempty:\ntags: [a, b]\nowner:\n  name: alice\n---\nBody",
+			expected: map[string]string{"status": "todo"},
+		},
+		{
+			name:     "invalid yaml is ignored",
+			content:  "---\nstatus: [unterminated\n---\nBody",
+			expected: map[string]string{},
+		},
+		{
+			name:     "must be the first line",
+			content:  "Intro\n\n---\nstatus: done\n---",
+			expected: map[string]string{},
+		},
+		{
+			name:     "unclosed frontmatter is a thematic break",
+			content:  "---\nstatus: done",
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension(), WithFrontmatterExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.Properties)
+		})
+	}
+
+	svc := NewService(WithTagExtension(), WithFrontmatterExtension())
+	content := "---\nstatus: done\n---\n# Plan\n\nBody #work"
+
+	// Frontmatter is metadata and does not show up in HTML or snippets.
+	html, err := svc.RenderHTML([]byte(content))
+	require.NoError(t, err)
+	assert.NotContains(t, html, "status")
+	assert.Contains(t, html, "<h1")
+	snippet, err := svc.GenerateSnippet([]byte(content), 100)
+	require.NoError(t, err)
+	assert.Equal(t, "Plan Body", snippet)
+
+	// Frontmatter survives rewriting the content.
+	data, err := svc.ExtractAll([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, "Plan", data.Title)
+	assert.Equal(t, []string{"work"}, data.Tags)
+	renamed, err := svc.RenameTag([]byte(content), "work", "job")
+	require.NoError(t, err)
+	assert.Equal(t, "---\nstatus: done\n---\n\n# Plan\n\nBody #job", renamed)
+}
+
+func TestExtractLinks(t *testing.T) {
+	svc := NewService()
+	content := "Read [the docs](https://usememos.com/docs) and https://github.com/usememos/memos.\n\n" +
+		"Also www.example.com, [again](https://usememos.com/docs), [relative](/memos/abc), mail@example.com and `https://code.example.com`."
+
+	data, err := svc.ExtractAll([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://usememos.com/docs",
+		"https://github.com/usememos/memos",
+		"http://www.example.com",
+	}, data.Links)
+}
+
+func TestExtractTasks(t *testing.T) {
+	svc := NewService(WithTagExtension())
+	content := "# Groceries\n\n- [ ] Buy **milk** #errand due:2024-05-01\n  before noon\n- [x] Bake bread due:2024-02-30\n  - [ ] Nested task\n\n> - [X] Quoted task"
+
+	data, err := svc.ExtractAll([]byte(content))
+	require.NoError(t, err)
+	require.Len(t, data.Tasks, 4)
+	assert.Equal(t, "Buy **milk** #errand before noon", data.Tasks[0].Content)
+	assert.False(t, data.Tasks[0].Completed)
+	assert.Equal(t, int32(3), data.Tasks[0].Line)
+	assert.Equal(t, "2024-05-01", data.Tasks[0].DueDate)
+	// Invalid dates are kept as text.
+	assert.Equal(t, "Bake bread due:2024-02-30", data.Tasks[1].Content)
+	assert.True(t, data.Tasks[1].Completed)
+	assert.Empty(t, data.Tasks[1].DueDate)
+	assert.Equal(t, "Nested task", data.Tasks[2].Content)
+	assert.Equal(t, int32(6), data.Tasks[2].Line)
+	assert.Equal(t, "Quoted task", data.Tasks[3].Content)
+	assert.Equal(t, int32(8), data.Tasks[3].Line)
+
+	data, err = svc.ExtractAll([]byte("- plain item\n- [link](https://example.com)"))
+	require.NoError(t, err)
+	assert.Empty(t, data.Tasks)
+}
+
+func TestSetTaskCompleted(t *testing.T) {
+	svc := NewService(WithTagExtension())
+	content := "- [ ] First #todo\n- [ ] Second"
+
+	updated, err := svc.SetTaskCompleted([]byte(content), 1, true)
+	require.NoError(t, err)
+	assert.Equal(t, "- [ ] First #todo\n- [x] Second", updated)
+
+	updated, err = svc.SetTaskCompleted([]byte(updated), 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, content, updated)
+
+	_, err = svc.SetTaskCompleted([]byte(content), 2, true)
+	require.Error(t, err)
+}
+
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		oldTag   string
+		newTag   string
+		expected string
+	}{
+		{
+			name:     "rename is case-insensitive",
+			content:  "Plan #Work and #work today",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "Plan #job and #job today",
+		},
+		{
+			name:     "nested tags are renamed",
+			content:  "#work/project-a #work #workshop",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job/project-a #job #workshop",
+		},
+		{
+			name:     "tags in code are kept",
+			content:  "#work `#work`",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job `#work`",
+		},
+		{
+			name:     "empty new tag removes the tags",
+			content:  "Plan #work/a and #other",
+			oldTag:   "work",
+			newTag:   "",
+			expected: "Plan  and #other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			renamed, err := svc.RenameTag([]byte(tt.content), tt.oldTag, tt.newTag)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, renamed)
+		})
+	}
+}
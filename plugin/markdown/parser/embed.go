@@ -0,0 +1,43 @@
+package parser
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+type embedParser struct{}
+
+// NewEmbedParser creates a new inline parser for ![[target]] syntax.
+func NewEmbedParser() parser.InlineParser {
+	return &embedParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*embedParser) Trigger() []byte {
+	return []byte{'!'}
+}
+
+// Parse parses ![[target]] syntax.
+// The target follows the same rules as wiki links.
+func (*embedParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+
+	// Must start with !
+	if len(line) == 0 || line[0] != '!' {
+		return nil
+	}
+	target, length := parseWikiLinkTarget(line[1:])
+	if target == nil {
+		return nil
+	}
+
+	// Advance reader past the closing brackets
+	block.Advance(1 + length)
+
+	return &mast.EmbedNode{
+		Target: target,
+	}
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestEmbedParser(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedTarget string
+		shouldParse    bool
+	}{
+		{
+			name:           "memo name",
+			input:          "![[memos/abc123]]",
+			expectedTarget: "memos/abc123",
+			shouldParse:    true,
+		},
+		{
+			name:           "followed by text",
+			input:          "![[ memos/abc123 ]] more",
+			expectedTarget: "memos/abc123",
+			shouldParse:    true,
+		},
+		{
+			name:        "image",
+			input:       "![alt](https://example.com/image.png)",
+			shouldParse: false,
+		},
+		{
+			name:        "wiki link without !",
+			input:       "[[memos/abc123]]",
+			shouldParse: false,
+		},
+		{
+			name:        "empty target",
+			input:       "![[]]",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewEmbedParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected embed to be parsed")
+				embedNode, ok := node.(*mast.EmbedNode)
+				require.True(t, ok, "Expected node to be *mast.EmbedNode")
+				assert.Equal(t, tt.expectedTarget, string(embedNode.Target))
+			} else {
+				assert.Nil(t, node, "Expected embed NOT to be parsed")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"bytes"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+// FrontmatterDelimiter is the line that opens and closes a frontmatter block.
+const FrontmatterDelimiter = "---"
+
+type frontmatterParser struct{}
+
+// NewFrontmatterParser creates a new block parser for YAML frontmatter.
+func NewFrontmatterParser() parser.BlockParser {
+	return &frontmatterParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*frontmatterParser) Trigger() []byte {
+	return []byte{'-'}
+}
+
+// Open parses the opening delimiter of a frontmatter block.
+// Frontmatter follows these rules:
+//   - The opening --- must be the very first line of the content
+//   - A closing --- line must follow; otherwise the line is a thematic break
+func (*frontmatterParser) Open(parent gast.Node, reader text.Reader, _ parser.Context) (gast.Node, parser.State) {
+	if parent.Kind() != gast.KindDocument || parent.HasChildren() {
+		return nil, parser.NoChildren
+	}
+	line, segment := reader.PeekLine()
+	if segment.Start != 0 || !isFrontmatterDelimiter(line) {
+		return nil, parser.NoChildren
+	}
+	if !hasFrontmatterEnd(reader.Source()[segment.Stop:]) {
+		return nil, parser.NoChildren
+	}
+
+	reader.AdvanceToEOL()
+	return &mast.FrontmatterNode{}, parser.NoChildren
+}
+
+// Continue appends YAML lines until the closing delimiter.
+func (*frontmatterParser) Continue(node gast.Node, reader text.Reader, _ parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if line == nil {
+		return parser.Close
+	}
+	reader.AdvanceToEOL()
+	if isFrontmatterDelimiter(line) {
+		return parser.Close
+	}
+	node.Lines().Append(segment)
+	return parser.Continue | parser.NoChildren
+}
+
+// Close does nothing; the lines are collected in Continue.
+func (*frontmatterParser) Close(gast.Node, text.Reader, parser.Context) {}
+
+// CanInterruptParagraph returns false because frontmatter only starts a document.
+func (*frontmatterParser) CanInterruptParagraph() bool {
+	return false
+}
+
+// CanAcceptIndentedLine returns false because the delimiter must not be indented.
+func (*frontmatterParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// isFrontmatterDelimiter reports whether the line is a --- delimiter, ignoring trailing whitespace.
+func isFrontmatterDelimiter(line []byte) bool {
+	return string(bytes.TrimRight(line, " \t\r\n")) == FrontmatterDelimiter
+}
+
+// hasFrontmatterEnd reports whether the source contains a closing delimiter line.
+func hasFrontmatterEnd(source []byte) bool {
+	for len(source) > 0 {
+		line := source
+		if i := bytes.IndexByte(source, '\n'); i >= 0 {
+			line, source = source[:i+1], source[i+1:]
+		} else {
+			source = nil
+		}
+		if isFrontmatterDelimiter(line) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	// MaxMentionLength defines the maximum length of a mentioned username.
+	MaxMentionLength = 32
+)
+
+type mentionParser struct{}
+
+// NewMentionParser creates a new inline parser for @username syntax.
+func NewMentionParser() parser.InlineParser {
+	return &mentionParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*mentionParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// isValidUsernameByte checks if a byte is valid in a username.
+func isValidUsernameByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-'
+}
+
+// isMentionBoundary checks if a mention may start right after the rune.
+// Mentions cannot be glued to a preceding word, which keeps email addresses
+// like user@example.com from being parsed as mentions.
+func isMentionBoundary(r rune) bool {
+	if r == '\n' || r == ' ' || r == '\t' {
+		return true
+	}
+	switch r {
+	case '(', '[', '{', '"', '\'', '*', '_', '~', ',', ';', ':':
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses @username syntax.
+// Mentions follow the username rules of memos:
+//   - Must start with @ at the beginning of a line or after whitespace or opening punctuation
+//   - Valid characters: ASCII letters, digits and hyphen (-), starting and ending with a letter or digit
+//   - Maximum length: 32 characters
+func (*mentionParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+
+	// Must start with @
+	if len(line) < 2 || line[0] != '@' {
+		return nil
+	}
+	if preceding := block.PrecendingCharacter(); !isMentionBoundary(preceding) {
+		return nil
+	}
+
+	pos := 1
+	for pos < len(line) && isValidUsernameByte(line[pos]) {
+		pos++
+	}
+	// A trailing hyphen is punctuation rather than part of the username.
+	for pos > 1 && line[pos-1] == '-' {
+		pos--
+	}
+
+	username := line[1:pos]
+	if len(username) == 0 || username[0] == '-' || len(username) > MaxMentionLength {
+		return nil
+	}
+	// Reject mentions glued to other word characters, e.g. @user@example.com.
+	if pos < len(line) && (line[pos] == '@' || line[pos] == '_') {
+		return nil
+	}
+
+	// Make a copy of the username
+	usernameCopy := make([]byte, len(username))
+	copy(usernameCopy, username)
+
+	// Advance reader
+	block.Advance(pos)
+
+	return &mast.MentionNode{
+		Username: usernameCopy,
+	}
+}
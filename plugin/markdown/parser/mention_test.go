@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestMentionParser(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedUsername string
+		shouldParse      bool
+	}{
+		{
+			name:             "basic mention",
+			input:            "@alice",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention with hyphen and digits",
+			input:            "@alice-2024",
+			expectedUsername: "alice-2024",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention followed by punctuation",
+			input:            "@alice, hello",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention followed by trailing hyphen",
+			input:            "@alice- hello",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:             "mixed case is preserved",
+			input:            "@Alice",
+			expectedUsername: "Alice",
+			shouldParse:      true,
+		},
+		{
+			name:        "lone @",
+			input:       "@",
+			shouldParse: false,
+		},
+		{
+			name:        "@ followed by space",
+			input:       "@ alice",
+			shouldParse: false,
+		},
+		{
+			name:        "starts with hyphen",
+			input:       "@-alice",
+			shouldParse: false,
+		},
+		{
+			name:        "too long",
+			input:       "@" + "a2345678901234567890123456789012x",
+			shouldParse: false,
+		},
+		{
+			name:        "glued to another @",
+			input:       "@alice@example.com",
+			shouldParse: false,
+		},
+		{
+			name:        "glued to underscore",
+			input:       "@alice_bob",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMentionParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected mention to be parsed")
+				mentionNode, ok := node.(*mast.MentionNode)
+				require.True(t, ok, "Expected node to be *mast.MentionNode")
+				assert.Equal(t, tt.expectedUsername, string(mentionNode.Username))
+			} else {
+				assert.Nil(t, node, "Expected mention NOT to be parsed")
+			}
+		})
+	}
+}
+
+func TestMentionParser_Trigger(t *testing.T) {
+	p := NewMentionParser()
+	triggers := p.Trigger()
+
+	assert.Equal(t, []byte{'@'}, triggers)
+}
+
+func TestMentionParser_PrecedingCharacter(t *testing.T) {
+	p := NewMentionParser()
+
+	// Mentions glued to a preceding word are not parsed, e.g. user@example.com.
+	reader := text.NewReader([]byte("user@example"))
+	reader.Advance(4)
+	assert.Nil(t, p.Parse(nil, reader, parser.NewContext()))
+
+	reader = text.NewReader([]byte("(@alice)"))
+	reader.Advance(1)
+	node := p.Parse(nil, reader, parser.NewContext())
+	require.NotNil(t, node)
+	mentionNode, ok := node.(*mast.MentionNode)
+	require.True(t, ok, "Expected node to be *mast.MentionNode")
+	assert.Equal(t, "alice", string(mentionNode.Username))
+}
+
+func TestMentionNode_Kind(t *testing.T) {
+	node := &mast.MentionNode{
+		Username: []byte("alice"),
+	}
+
+	assert.Equal(t, mast.KindMention, node.Kind())
+}
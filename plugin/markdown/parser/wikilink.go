@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	// MaxWikiLinkLength defines the maximum number of runes allowed in a wiki link target.
+	MaxWikiLinkLength = 200
+)
+
+type wikiLinkParser struct{}
+
+// NewWikiLinkParser creates a new inline parser for [[target]] syntax.
+func NewWikiLinkParser() parser.InlineParser {
+	return &wikiLinkParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*wikiLinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse parses [[target]] syntax.
+// Wiki links follow these rules:
+//   - Must start with [[ and end with ]] on the same line
+//   - The target is trimmed and must not be empty or contain brackets
+//   - Maximum target length: 200 runes
+func (*wikiLinkParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+
+	target, length := parseWikiLinkTarget(line)
+	if target == nil {
+		return nil
+	}
+
+	// Advance reader past the closing brackets
+	block.Advance(length)
+
+	return &mast.WikiLinkNode{
+		Target: target,
+	}
+}
+
+// parseWikiLinkTarget parses a [[target]] at the start of line.
+// It returns a copy of the trimmed target and the length of the link, or nil if there is no valid link.
+func parseWikiLinkTarget(line []byte) ([]byte, int) {
+	// Must start with [[
+	if len(line) < 4 || line[0] != '[' || line[1] != '[' {
+		return nil, 0
+	}
+
+	end := bytes.Index(line[2:], []byte("]]"))
+	if end < 0 {
+		return nil, 0
+	}
+	target := line[2 : 2+end]
+	if bytes.ContainsAny(target, "[]\n") {
+		return nil, 0
+	}
+	target = bytes.TrimSpace(target)
+	if len(target) == 0 || utf8.RuneCount(target) > MaxWikiLinkLength {
+		return nil, 0
+	}
+
+	// Make a copy of the target
+	targetCopy := make([]byte, len(target))
+	copy(targetCopy, target)
+	return targetCopy, 2 + end + 2
+}
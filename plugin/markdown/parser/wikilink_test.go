@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestWikiLinkParser(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedTarget string
+		shouldParse    bool
+	}{
+		{
+			name:           "memo name",
+			input:          "[[memos/abc123]]",
+			expectedTarget: "memos/abc123",
+			shouldParse:    true,
+		},
+		{
+			name:           "title with spaces",
+			input:          "[[Weekly review]] notes",
+			expectedTarget: "Weekly review",
+			shouldParse:    true,
+		},
+		{
+			name:           "surrounding whitespace is trimmed",
+			input:          "[[  Gardening ]]",
+			expectedTarget: "Gardening",
+			shouldParse:    true,
+		},
+		{
+			name:           "unicode title",
+			input:          "[[读书笔记]]",
+			expectedTarget: "读书笔记",
+			shouldParse:    true,
+		},
+		{
+			name:        "regular link",
+			input:       "[text](https://example.com)",
+			shouldParse: false,
+		},
+		{
+			name:        "empty target",
+			input:       "[[ ]]",
+			shouldParse: false,
+		},
+		{
+			name:        "unclosed",
+			input:       "[[Gardening",
+			shouldParse: false,
+		},
+		{
+			name:        "nested brackets",
+			input:       "[[a [b]]]",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewWikiLinkParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected wiki link to be parsed")
+				wikiLinkNode, ok := node.(*mast.WikiLinkNode)
+				require.True(t, ok, "Expected node to be *mast.WikiLinkNode")
+				assert.Equal(t, tt.expectedTarget, string(wikiLinkNode.Target))
+			} else {
+				assert.Nil(t, node, "Expected wiki link NOT to be parsed")
+			}
+		})
+	}
+}
+
+func TestWikiLinkParser_Trigger(t *testing.T) {
+	p := NewWikiLinkParser()
+	triggers := p.Trigger()
+
+	assert.Equal(t, []byte{'['}, triggers)
+}
+
+func TestWikiLinkNode_Kind(t *testing.T) {
+	node := &mast.WikiLinkNode{
+		Target: []byte("memos/abc123"),
+	}
+
+	assert.Equal(t, mast.KindWikiLink, node.Kind())
+}
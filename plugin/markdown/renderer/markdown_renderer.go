@@ -152,10 +152,36 @@ func (r *MarkdownRenderer) renderNode(node gast.Node, source []byte, depth int)
 		}
 
 	// Custom Memos nodes
+	case *mast.FrontmatterNode:
+		r.buf.WriteString("---\n")
+		lines := n.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			r.buf.Write(line.Value(source))
+		}
+		r.buf.WriteString("---")
+		if node.NextSibling() != nil {
+			r.buf.WriteString("\n\n")
+		}
+
 	case *mast.TagNode:
 		r.buf.WriteByte('#')
 		r.buf.Write(n.Tag)
 
+	case *mast.MentionNode:
+		r.buf.WriteByte('@')
+		r.buf.Write(n.Username)
+
+	case *mast.WikiLinkNode:
+		r.buf.WriteString("[[")
+		r.buf.Write(n.Target)
+		r.buf.WriteString("]]")
+
+	case *mast.EmbedNode:
+		r.buf.WriteString("![[")
+		r.buf.Write(n.Target)
+		r.buf.WriteString("]]")
+
 	default:
 		// For unknown nodes, try to render children
 		r.renderChildren(n, source, depth)
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -24,8 +25,40 @@ type Scheduler struct {
 type registeredJob struct {
 	job      *Job
 	cancelFn context.CancelFunc
+
+	stateMu      sync.Mutex
+	running      bool
+	lastRunTime  time.Time
+	lastDuration time.Duration
+	lastErr      error
+	nextRunTime  time.Time
+}
+
+// JobStatus is a snapshot of a registered job and its most recent execution.
+type JobStatus struct {
+	Name        string
+	Schedule    string
+	Description string
+
+	// Running reports whether the job is currently executing.
+	Running bool
+	// LastRunTime is the start time of the most recent execution (zero if never run).
+	LastRunTime time.Time
+	// LastDuration is how long the most recent execution took.
+	LastDuration time.Duration
+	// LastError is the error returned by the most recent execution, if any.
+	LastError error
+	// NextRunTime is the next scheduled execution (zero if the scheduler is not running).
+	NextRunTime time.Time
 }
 
+var (
+	// ErrJobNotFound is returned when no job is registered under the given name.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobRunning is returned when triggering a job that is already executing.
+	ErrJobRunning = errors.New("job is already running")
+)
+
 // Option configures a Scheduler.
 type Option func(*Scheduler)
 
@@ -121,12 +154,6 @@ func (s *Scheduler) Start() error {
 func (s *Scheduler) runJobWithSchedule(ctx context.Context, rj *registeredJob, schedule *Schedule) {
 	defer s.wg.Done()
 
-	// Apply middleware to handler
-	handler := rj.job.Handler
-	if s.middleware != nil {
-		handler = s.middleware(handler)
-	}
-
 	for {
 		// Calculate next run time
 		now := time.Now()
@@ -142,15 +169,16 @@ func (s *Scheduler) runJobWithSchedule(ctx context.Context, rj *registeredJob, s
 		next := schedule.Next(now)
 		duration := time.Until(next)
 
+		rj.stateMu.Lock()
+		rj.nextRunTime = next
+		rj.stateMu.Unlock()
+
 		timer := time.NewTimer(duration)
 
 		select {
 		case <-timer.C:
-			// Add job name to context and execute
-			jobCtx := withJobName(ctx, rj.job.Name)
-			if err := handler(jobCtx); err != nil {
-				// Error already handled by middleware (if any)
-				_ = err
+			if rj.tryStart() {
+				s.execute(ctx, rj)
 			}
 		case <-ctx.Done():
 			// Stop the timer to prevent it from firing. The timer will be garbage collected.
@@ -164,6 +192,111 @@ func (s *Scheduler) runJobWithSchedule(ctx context.Context, rj *registeredJob, s
 	}
 }
 
+// execute runs the job handler through the middleware chain and records the outcome.
+// The caller must have marked the job as running with tryStart.
+func (s *Scheduler) execute(ctx context.Context, rj *registeredJob) {
+	handler := rj.job.Handler
+	if s.middleware != nil {
+		handler = s.middleware(handler)
+	}
+
+	start := time.Now()
+	// Add job name to context and execute
+	err := handler(withJobName(ctx, rj.job.Name))
+
+	rj.stateMu.Lock()
+	rj.running = false
+	rj.lastRunTime = start
+	rj.lastDuration = time.Since(start)
+	rj.lastErr = err
+	rj.stateMu.Unlock()
+}
+
+// tryStart marks the job as running, returning false if it is already running.
+// Overlapping executions of the same job are skipped.
+func (rj *registeredJob) tryStart() bool {
+	rj.stateMu.Lock()
+	defer rj.stateMu.Unlock()
+	if rj.running {
+		return false
+	}
+	rj.running = true
+	return true
+}
+
+func (rj *registeredJob) status() *JobStatus {
+	rj.stateMu.Lock()
+	defer rj.stateMu.Unlock()
+	return &JobStatus{
+		Name:         rj.job.Name,
+		Schedule:     rj.job.Schedule,
+		Description:  rj.job.Description,
+		Running:      rj.running,
+		LastRunTime:  rj.lastRunTime,
+		LastDuration: rj.lastDuration,
+		LastError:    rj.lastErr,
+		NextRunTime:  rj.nextRunTime,
+	}
+}
+
+// Jobs returns the status of all registered jobs, sorted by name.
+func (s *Scheduler) Jobs() []*JobStatus {
+	s.jobsMu.RLock()
+	defer s.jobsMu.RUnlock()
+
+	list := make([]*JobStatus, 0, len(s.jobs))
+	for _, rj := range s.jobs {
+		list = append(list, rj.status())
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Job returns the status of the job with the given name.
+func (s *Scheduler) Job(name string) (*JobStatus, error) {
+	s.jobsMu.RLock()
+	defer s.jobsMu.RUnlock()
+
+	rj, ok := s.jobs[name]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return rj.status(), nil
+}
+
+// Trigger runs the job immediately in the background, outside of its schedule.
+// It returns ErrJobRunning if the job is already executing.
+func (s *Scheduler) Trigger(name string) error {
+	s.jobsMu.RLock()
+	rj, ok := s.jobs[name]
+	s.jobsMu.RUnlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+	if !rj.tryStart() {
+		return ErrJobRunning
+	}
+
+	// Triggered runs are canceled on Stop, like scheduled runs.
+	ctx, cancel := context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		go func() {
+			select {
+			case <-s.stopCh:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		s.execute(ctx, rj)
+	}()
+	return nil
+}
+
 // Stop gracefully shuts down the scheduler.
 // It waits for all running jobs to complete or until the context is canceled.
 func (s *Scheduler) Stop(ctx context.Context) error {
@@ -163,3 +163,85 @@ func TestSchedulerWithMiddleware(t *testing.T) {
 		t.Error("expected job completion log")
 	}
 }
+
+func TestSchedulerTrigger(t *testing.T) {
+	s := New()
+
+	release := make(chan struct{})
+	var runCount atomic.Int32
+	job := &Job{
+		Name:        "test-trigger",
+		Schedule:    "0 0 * * *", // Daily at midnight, so only manual runs happen
+		Description: "manually triggered job",
+		Handler: func(_ context.Context) error {
+			runCount.Add(1)
+			<-release
+			return fmt.Errorf("boom")
+		},
+	}
+	if err := s.Register(job); err != nil {
+		t.Fatalf("failed to register job: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("failed to start scheduler: %v", err)
+	}
+
+	if err := s.Trigger("missing"); err != ErrJobNotFound {
+		t.Fatalf("expected ErrJobNotFound, got %v", err)
+	}
+	if err := s.Trigger("test-trigger"); err != nil {
+		t.Fatalf("failed to trigger job: %v", err)
+	}
+	// A second trigger while the job is running is rejected.
+	if err := s.Trigger("test-trigger"); err != ErrJobRunning {
+		t.Fatalf("expected ErrJobRunning, got %v", err)
+	}
+
+	status, err := s.Job("test-trigger")
+	if err != nil {
+		t.Fatalf("failed to get job status: %v", err)
+	}
+	if !status.Running {
+		t.Error("expected job to be running")
+	}
+	close(release)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		status, _ = s.Job("test-trigger")
+		if (!status.Running && !status.NextRunTime.IsZero()) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	jobs := s.Jobs()
+	if len(jobs) != 1 {
+		t.Fatalf("expected 1 job, got %d", len(jobs))
+	}
+	status = jobs[0]
+	if status.Running {
+		t.Error("expected job to have finished")
+	}
+	if status.Description != "manually triggered job" {
+		t.Errorf("unexpected description %q", status.Description)
+	}
+	if status.LastRunTime.IsZero() {
+		t.Error("expected last run time to be set")
+	}
+	if status.LastError == nil || status.LastError.Error() != "boom" {
+		t.Errorf("expected last error %q, got %v", "boom", status.LastError)
+	}
+	if status.NextRunTime.IsZero() {
+		t.Error("expected next run time to be set")
+	}
+	if runCount.Load() != 1 {
+		t.Errorf("expected 1 run, got %d", runCount.Load())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Stop(ctx); err != nil {
+		t.Fatalf("failed to stop: %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// maxCBORDepth bounds the nesting of decoded CBOR items, authenticator data never nests deeply.
+const maxCBORDepth = 16
+
+// decodeCBOR decodes the first CBOR item (RFC 8949) of data and returns it together with the remaining bytes.
+// It supports the subset used by WebAuthn: integers, byte and text strings, arrays, maps and simple values.
+// Integers decode to int64, maps to map[any]any keyed by int64 or string.
+func decodeCBOR(data []byte) (any, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, errors.New("cbor: nested too deeply")
+	}
+	if len(data) == 0 {
+		return nil, nil, errors.New("cbor: unexpected end of data")
+	}
+	majorType := data[0] >> 5
+	additional := data[0] & 0x1f
+	data = data[1:]
+
+	if majorType == 7 {
+		switch additional {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		case 25, 26, 27:
+			size := 1 << (additional - 24)
+			if len(data) < size {
+				return nil, nil, errors.New("cbor: unexpected end of data")
+			}
+			var value float64
+			switch size {
+			case 2:
+				// Half-precision floats are not used by WebAuthn, skip their value.
+			case 4:
+				value = float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+			case 8:
+				value = math.Float64frombits(binary.BigEndian.Uint64(data))
+			}
+			return value, data[size:], nil
+		default:
+			return nil, nil, errors.Errorf("cbor: unsupported simple value %d", additional)
+		}
+	}
+
+	argument, data, err := decodeCBORArgument(additional, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch majorType {
+	case 0:
+		if argument > math.MaxInt64 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return int64(argument), data, nil
+	case 1:
+		if argument > math.MaxInt64 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(argument), data, nil
+	case 2, 3:
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: unexpected end of data")
+		}
+		value := data[:argument]
+		if majorType == 3 {
+			return string(value), data[argument:], nil
+		}
+		return append([]byte{}, value...), data[argument:], nil
+	case 4:
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: array longer than the data")
+		}
+		items := make([]any, 0, argument)
+		for i := uint64(0); i < argument; i++ {
+			var item any
+			item, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case 5:
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: map longer than the data")
+		}
+		items := make(map[any]any, argument)
+		for i := uint64(0); i < argument; i++ {
+			var key, value any
+			key, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errors.Errorf("cbor: unsupported map key type %T", key)
+			}
+			value, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[key] = value
+		}
+		return items, data, nil
+	case 6:
+		// Tags only annotate the following item.
+		return decodeCBORItem(data, depth+1)
+	default:
+		return nil, nil, errors.Errorf("cbor: unsupported major type %d", majorType)
+	}
+}
+
+func decodeCBORArgument(additional byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case additional < 24:
+		return uint64(additional), data, nil
+	case additional <= 27:
+		size := 1 << (additional - 24)
+		if len(data) < size {
+			return 0, nil, errors.New("cbor: unexpected end of data")
+		}
+		var argument uint64
+		for _, b := range data[:size] {
+			argument = argument<<8 | uint64(b)
+		}
+		return argument, data[size:], nil
+	default:
+		// Indefinite lengths are not allowed in the CTAP2 canonical encoding.
+		return 0, nil, errors.Errorf("cbor: unsupported additional information %d", additional)
+	}
+}
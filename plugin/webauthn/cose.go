@@ -0,0 +1,115 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// COSE algorithm identifiers of the supported credential public keys.
+// See https://www.iana.org/assignments/cose/cose.xhtml#algorithms.
+const (
+	AlgorithmES256 int64 = -7
+	AlgorithmEdDSA int64 = -8
+	AlgorithmRS256 int64 = -257
+)
+
+// SupportedAlgorithms lists the COSE algorithms accepted for new credentials, in order of preference.
+var SupportedAlgorithms = []int64{AlgorithmES256, AlgorithmEdDSA, AlgorithmRS256}
+
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+)
+
+// publicKey is a parsed COSE_Key (RFC 9052) of a credential.
+type publicKey struct {
+	algorithm int64
+	key       crypto.PublicKey
+}
+
+// parsePublicKey parses a CBOR encoded COSE_Key.
+func parsePublicKey(data []byte) (*publicKey, error) {
+	value, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("unexpected data after public key")
+	}
+	return parsePublicKeyMap(value)
+}
+
+func parsePublicKeyMap(value any) (*publicKey, error) {
+	coseKey, ok := value.(map[any]any)
+	if !ok {
+		return nil, errors.New("public key is not a map")
+	}
+	keyType, _ := coseKey[int64(1)].(int64)
+	algorithm, _ := coseKey[int64(3)].(int64)
+
+	switch {
+	case keyType == coseKeyTypeEC2 && algorithm == AlgorithmES256:
+		curve, _ := coseKey[int64(-1)].(int64)
+		x, _ := coseKey[int64(-2)].([]byte)
+		y, _ := coseKey[int64(-3)].([]byte)
+		if curve != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ES256 public key")
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("ES256 public key is not on the curve")
+		}
+		return &publicKey{algorithm: algorithm, key: key}, nil
+	case keyType == coseKeyTypeOKP && algorithm == AlgorithmEdDSA:
+		curve, _ := coseKey[int64(-1)].(int64)
+		x, _ := coseKey[int64(-2)].([]byte)
+		if curve != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid EdDSA public key")
+		}
+		return &publicKey{algorithm: algorithm, key: ed25519.PublicKey(x)}, nil
+	case keyType == coseKeyTypeRSA && algorithm == AlgorithmRS256:
+		n, _ := coseKey[int64(-1)].([]byte)
+		e, _ := coseKey[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RS256 public key")
+		}
+		exponent := new(big.Int).SetBytes(e)
+		return &publicKey{algorithm: algorithm, key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}}, nil
+	default:
+		return nil, errors.Errorf("unsupported public key type %d with algorithm %d", keyType, algorithm)
+	}
+}
+
+// verify checks the signature of the data.
+func (k *publicKey) verify(data, signature []byte) error {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return errors.New("invalid signature")
+		}
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("invalid signature")
+		}
+	default:
+		return errors.Errorf("unsupported public key %T", k.key)
+	}
+	return nil
+}
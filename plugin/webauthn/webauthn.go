@@ -0,0 +1,218 @@
+// Package webauthn verifies the registration and assertion ceremonies of WebAuthn credentials (passkeys).
+//
+// See https://www.w3.org/TR/webauthn-3/. Attestation statements are not verified: memos asks
+// for "none" attestation and does not restrict which authenticators may be registered.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ChallengeSize is the size of generated challenges in bytes.
+	ChallengeSize = 32
+
+	clientDataTypeCreate = "webauthn.create"
+	clientDataTypeGet    = "webauthn.get"
+
+	flagUserPresent         = 0x01
+	flagUserVerified        = 0x04
+	flagAttestedCredentials = 0x40
+
+	// authenticatorDataMinSize is the size of the RP ID hash, flags and sign counter.
+	authenticatorDataMinSize = 37
+)
+
+// RelyingParty is the website the credentials are scoped to.
+type RelyingParty struct {
+	// ID is the relying party identifier, the host name of the instance, e.g. "memos.example.com".
+	ID string
+	// Origin is the origin the ceremonies are run from, e.g. "https://memos.example.com".
+	Origin string
+}
+
+// Credential is a registered public key credential.
+type Credential struct {
+	// ID is the credential ID chosen by the authenticator.
+	ID []byte
+	// PublicKey is the CBOR encoded COSE_Key of the credential.
+	PublicKey []byte
+	// SignCount is the signature counter reported by the authenticator, zero if it does not keep one.
+	SignCount uint32
+	// UserVerified tells whether the user was verified, e.g. with a PIN or biometrics.
+	UserVerified bool
+}
+
+// Assertion is the result of a verified assertion.
+type Assertion struct {
+	// SignCount is the new signature counter to store for the credential.
+	SignCount uint32
+	// UserVerified tells whether the user was verified, e.g. with a PIN or biometrics.
+	UserVerified bool
+}
+
+// clientData is the collected client data of a ceremony.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the parsed authenticator data of a ceremony.
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// credentialID and credentialPublicKey are only set during registration.
+	credentialID        []byte
+	credentialPublicKey []byte
+}
+
+// NewChallenge generates a random challenge for a ceremony.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, ChallengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, errors.Wrap(err, "failed to generate challenge")
+	}
+	return challenge, nil
+}
+
+// VerifyRegistration verifies the response of navigator.credentials.create() to the challenge
+// and returns the new credential.
+func (rp *RelyingParty) VerifyRegistration(challenge, clientDataJSON, attestationObject []byte) (*Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	value, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode attestation object")
+	}
+	attestation, ok := value.(map[any]any)
+	if !ok {
+		return nil, errors.New("attestation object is not a map")
+	}
+	rawAuthenticatorData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("attestation object has no authenticator data")
+	}
+	authData, err := rp.parseAuthenticatorData(rawAuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if authData.credentialID == nil {
+		return nil, errors.New("authenticator data has no attested credential")
+	}
+	if _, err := parsePublicKey(authData.credentialPublicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:           authData.credentialID,
+		PublicKey:    authData.credentialPublicKey,
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of navigator.credentials.get() to the challenge
+// with the stored credential.
+func (rp *RelyingParty) VerifyAssertion(credential *Credential, challenge, clientDataJSON, rawAuthenticatorData, signature []byte) (*Assertion, error) {
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeGet, challenge); err != nil {
+		return nil, err
+	}
+	authData, err := rp.parseAuthenticatorData(rawAuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(append([]byte{}, rawAuthenticatorData...), clientDataHash[:]...)
+	if err := key.verify(signedData, signature); err != nil {
+		return nil, err
+	}
+
+	// A counter that does not increase means the authenticator may have been cloned.
+	if authData.signCount != 0 || credential.SignCount != 0 {
+		if authData.signCount <= credential.SignCount {
+			return nil, errors.New("signature counter did not increase")
+		}
+	}
+	return &Assertion{
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+	}, nil
+}
+
+func (rp *RelyingParty) verifyClientData(clientDataJSON []byte, clientDataType string, challenge []byte) error {
+	data := &clientData{}
+	if err := json.Unmarshal(clientDataJSON, data); err != nil {
+		return errors.Wrap(err, "failed to parse client data")
+	}
+	if data.Type != clientDataType {
+		return errors.Errorf("unexpected client data type %q", data.Type)
+	}
+	receivedChallenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode challenge")
+	}
+	if len(challenge) == 0 || subtle.ConstantTimeCompare(receivedChallenge, challenge) != 1 {
+		return errors.New("challenge mismatch")
+	}
+	if data.Origin != rp.Origin {
+		return errors.Errorf("unexpected origin %q", data.Origin)
+	}
+	return nil
+}
+
+func (rp *RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < authenticatorDataMinSize {
+		return nil, errors.New("authenticator data is too short")
+	}
+	authData := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return nil, errors.New("relying party ID mismatch")
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return nil, errors.New("user was not present")
+	}
+
+	if authData.flags&flagAttestedCredentials != 0 {
+		// AAGUID (16 bytes), credential ID length (2 bytes), credential ID and the COSE_Key.
+		rest := data[authenticatorDataMinSize:]
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data is too short")
+		}
+		credentialIDLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if credentialIDLength == 0 || len(rest) < credentialIDLength {
+			return nil, errors.New("invalid credential ID length")
+		}
+		authData.credentialID = append([]byte{}, rest[:credentialIDLength]...)
+		rest = rest[credentialIDLength:]
+		// The public key may be followed by extensions, decode it to find where it ends.
+		_, afterKey, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode credential public key")
+		}
+		authData.credentialPublicKey = append([]byte{}, rest[:len(rest)-len(afterKey)]...)
+	}
+	return authData, nil
+}
@@ -0,0 +1,106 @@
+package webauthn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/webauthn/webauthntest"
+)
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp := &RelyingParty{ID: "memos.example.com", Origin: "https://memos.example.com"}
+	authenticator, err := webauthntest.NewAuthenticator(rp.ID, rp.Origin)
+	require.NoError(t, err)
+
+	challenge, err := NewChallenge()
+	require.NoError(t, err)
+	clientDataJSON, attestationObject, err := authenticator.Register(challenge)
+	require.NoError(t, err)
+
+	otherChallenge, err := NewChallenge()
+	require.NoError(t, err)
+	_, err = rp.VerifyRegistration(otherChallenge, clientDataJSON, attestationObject)
+	assert.ErrorContains(t, err, "challenge mismatch")
+	_, err = (&RelyingParty{ID: rp.ID, Origin: "https://evil.example.com"}).VerifyRegistration(challenge, clientDataJSON, attestationObject)
+	assert.ErrorContains(t, err, "unexpected origin")
+	_, err = (&RelyingParty{ID: "example.com", Origin: rp.Origin}).VerifyRegistration(challenge, clientDataJSON, attestationObject)
+	assert.ErrorContains(t, err, "relying party ID mismatch")
+
+	credential, err := rp.VerifyRegistration(challenge, clientDataJSON, attestationObject)
+	require.NoError(t, err)
+	assert.Equal(t, authenticator.CredentialID, credential.ID)
+	assert.True(t, credential.UserVerified)
+
+	challenge, err = NewChallenge()
+	require.NoError(t, err)
+	clientDataJSON, authData, signature, err := authenticator.Assert(challenge)
+	require.NoError(t, err)
+	// A registration response is not accepted as an assertion.
+	registrationClientDataJSON, _, err := authenticator.Register(challenge)
+	require.NoError(t, err)
+	_, err = rp.VerifyAssertion(credential, challenge, registrationClientDataJSON, authData, signature)
+	assert.ErrorContains(t, err, "unexpected client data type")
+	_, err = rp.VerifyAssertion(credential, challenge, clientDataJSON, authData, append([]byte{}, signature[:len(signature)-1]...))
+	assert.ErrorContains(t, err, "invalid signature")
+
+	assertion, err := rp.VerifyAssertion(credential, challenge, clientDataJSON, authData, signature)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), assertion.SignCount)
+	assert.True(t, assertion.UserVerified)
+
+	// Replaying the assertion fails because the counter did not increase.
+	credential.SignCount = assertion.SignCount
+	_, err = rp.VerifyAssertion(credential, challenge, clientDataJSON, authData, signature)
+	assert.ErrorContains(t, err, "signature counter did not increase")
+}
+
+func TestAssertionWithoutCounter(t *testing.T) {
+	rp := &RelyingParty{ID: "localhost", Origin: "http://localhost:8081"}
+	authenticator, err := webauthntest.NewAuthenticator(rp.ID, rp.Origin)
+	require.NoError(t, err)
+	authenticator.ZeroCounter = true
+	authenticator.SkipUserVerification = true
+
+	challenge, err := NewChallenge()
+	require.NoError(t, err)
+	clientDataJSON, attestationObject, err := authenticator.Register(challenge)
+	require.NoError(t, err)
+	credential, err := rp.VerifyRegistration(challenge, clientDataJSON, attestationObject)
+	require.NoError(t, err)
+	assert.False(t, credential.UserVerified)
+
+	for i := 0; i < 2; i++ {
+		clientDataJSON, authData, signature, err := authenticator.Assert(challenge)
+		require.NoError(t, err)
+		assertion, err := rp.VerifyAssertion(credential, challenge, clientDataJSON, authData, signature)
+		require.NoError(t, err)
+		assert.Zero(t, assertion.SignCount)
+		assert.False(t, assertion.UserVerified)
+	}
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	// {1: 2, "a": [-1, h'0102'], "b": true}
+	data := []byte{0xa3, 0x01, 0x02, 0x61, 'a', 0x82, 0x20, 0x42, 0x01, 0x02, 0x61, 'b', 0xf5, 0xff}
+	value, rest, err := decodeCBOR(data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xff}, rest)
+	assert.Equal(t, map[any]any{
+		int64(1): int64(2),
+		"a":      []any{int64(-1), []byte{0x01, 0x02}},
+		"b":      true,
+	}, value)
+
+	for _, invalid := range [][]byte{
+		{},
+		{0x42, 0x01}, // Byte string longer than the data.
+		{0x9f},       // Indefinite length array.
+		{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, // Huge array.
+		{0xa1, 0x80, 0x01}, // Array as map key.
+	} {
+		_, _, err := decodeCBOR(invalid)
+		assert.Error(t, err, "data %x", invalid)
+	}
+}
@@ -0,0 +1,159 @@
+// Package webauthntest provides a software authenticator for testing WebAuthn ceremonies.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Authenticator is a software authenticator holding a single ES256 credential.
+type Authenticator struct {
+	// RPID and Origin are the relying party the authenticator answers for.
+	RPID   string
+	Origin string
+	// CredentialID is the ID of the credential.
+	CredentialID []byte
+	// SignCount is the signature counter, increased on every assertion unless ZeroCounter is set.
+	SignCount uint32
+	// ZeroCounter makes the authenticator report a zero counter like most synced passkeys do.
+	ZeroCounter bool
+	// SkipUserVerification leaves the user verified flag unset.
+	SkipUserVerification bool
+
+	key *ecdsa.PrivateKey
+}
+
+// NewAuthenticator creates an authenticator with a new credential.
+func NewAuthenticator(rpID, origin string) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		return nil, errors.Wrap(err, "failed to generate credential ID")
+	}
+	return &Authenticator{RPID: rpID, Origin: origin, CredentialID: credentialID, key: key}, nil
+}
+
+// Register answers navigator.credentials.create() and returns the client data JSON and the attestation object.
+func (a *Authenticator) Register(challenge []byte) ([]byte, []byte, error) {
+	clientDataJSON, err := a.clientDataJSON("webauthn.create", challenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attestedCredentialData := make([]byte, 16) // Zero AAGUID.
+	attestedCredentialData = binary.BigEndian.AppendUint16(attestedCredentialData, uint16(len(a.CredentialID)))
+	attestedCredentialData = append(attestedCredentialData, a.CredentialID...)
+	attestedCredentialData = append(attestedCredentialData, a.publicKey()...)
+	authData := append(a.authenticatorData(0x40, a.SignCount), attestedCredentialData...)
+
+	attestationObject := encodeMapHead(3)
+	attestationObject = append(attestationObject, encodeText("fmt")...)
+	attestationObject = append(attestationObject, encodeText("none")...)
+	attestationObject = append(attestationObject, encodeText("attStmt")...)
+	attestationObject = append(attestationObject, encodeMapHead(0)...)
+	attestationObject = append(attestationObject, encodeText("authData")...)
+	attestationObject = append(attestationObject, encodeBytes(authData)...)
+	return clientDataJSON, attestationObject, nil
+}
+
+// Assert answers navigator.credentials.get() and returns the client data JSON, the authenticator data and the signature.
+func (a *Authenticator) Assert(challenge []byte) ([]byte, []byte, []byte, error) {
+	clientDataJSON, err := a.clientDataJSON("webauthn.get", challenge)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !a.ZeroCounter {
+		a.SignCount++
+	}
+	authData := a.authenticatorData(0, a.SignCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to sign")
+	}
+	return clientDataJSON, authData, signature, nil
+}
+
+func (a *Authenticator) clientDataJSON(clientDataType string, challenge []byte) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        clientDataType,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authenticatorData(flags byte, signCount uint32) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	flags |= 0x01 // User present.
+	if !a.SkipUserVerification {
+		flags |= 0x04
+	}
+	authData := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(authData, signCount)
+}
+
+// publicKey returns the CBOR encoded COSE_Key of the credential.
+func (a *Authenticator) publicKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+
+	key := encodeMapHead(5)
+	key = append(key, encodeInt(1)...) // kty: EC2
+	key = append(key, encodeInt(2)...)
+	key = append(key, encodeInt(3)...) // alg: ES256
+	key = append(key, encodeInt(-7)...)
+	key = append(key, encodeInt(-1)...) // crv: P-256
+	key = append(key, encodeInt(1)...)
+	key = append(key, encodeInt(-2)...) // x
+	key = append(key, encodeBytes(x)...)
+	key = append(key, encodeInt(-3)...) // y
+	key = append(key, encodeBytes(y)...)
+	return key
+}
+
+func encodeHead(majorType byte, argument uint64) []byte {
+	switch {
+	case argument < 24:
+		return []byte{majorType<<5 | byte(argument)}
+	case argument <= 0xff:
+		return []byte{majorType<<5 | 24, byte(argument)}
+	case argument <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{majorType<<5 | 25}, uint16(argument))
+	default:
+		return binary.BigEndian.AppendUint32([]byte{majorType<<5 | 26}, uint32(argument))
+	}
+}
+
+func encodeInt(value int64) []byte {
+	if value < 0 {
+		return encodeHead(1, uint64(-1-value))
+	}
+	return encodeHead(0, uint64(value))
+}
+
+func encodeBytes(value []byte) []byte {
+	return append(encodeHead(2, uint64(len(value))), value...)
+}
+
+func encodeText(value string) []byte {
+	return append(encodeHead(3, uint64(len(value))), value...)
+}
+
+func encodeMapHead(size int) []byte {
+	return encodeHead(5, uint64(size))
+}
@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the format of the request body sent to a webhook endpoint.
+type Format string
+
+const (
+	// FormatMemos sends the WebhookRequestPayload as JSON.
+	FormatMemos Format = "MEMOS"
+	// FormatSlack sends a Slack incoming webhook message.
+	FormatSlack Format = "SLACK"
+	// FormatDiscord sends a Discord webhook message with an embed.
+	FormatDiscord Format = "DISCORD"
+	// FormatMattermost sends a Mattermost incoming webhook message.
+	FormatMattermost Format = "MATTERMOST"
+	// FormatTemplate renders a user-supplied text/template with TemplateData.
+	FormatTemplate Format = "TEMPLATE"
+)
+
+// maxTemplateOutputSize is the maximum size of a rendered template body.
+const maxTemplateOutputSize = 64 * 1024
+
+// TemplateData is the data available to payload templates and chat message builders.
+type TemplateData struct {
+	*WebhookRequestPayload
+	// Title is a short human readable description of the activity, e.g. "New memo".
+	Title string
+	// Snippet is a plain text excerpt of the content the activity is about.
+	// For comment activities it is the excerpt of the comment.
+	Snippet string
+	// Link is the URL of the memo on the instance, or empty if the instance URL is not configured.
+	Link string
+	// Tags are the tags of the memo.
+	Tags []string
+}
+
+var activityTitles = map[string]string{
+	ActivityTypeMemoCreated:           "New memo",
+	ActivityTypeMemoUpdated:           "Memo updated",
+	ActivityTypeMemoDeleted:           "Memo deleted",
+	ActivityTypeMemoVisibilityChanged: "Memo visibility changed",
+	ActivityTypeMemoCommentCreated:    "New comment",
+	ActivityTypeMemoReactionAdded:     "New reaction",
+	ActivityTypeMemoReactionRemoved:   "Reaction removed",
+	ActivityTypeMemoReminder:          "Memo reminder",
+	ActivityTypeAttachmentCreated:     "New attachment",
+	ActivityTypeUserCreated:           "New user",
+	ActivityTypeUserDeleted:           "User deleted",
+	ActivityTypeUserRoleChanged:       "User role changed",
+	ActivityTypeUserSignIn:            "User signed in",
+	ActivityTypeUserIDPSignIn:         "User signed in with an identity provider",
+}
+
+// ActivityTitle returns a short human readable description of the activity type.
+func ActivityTitle(activityType string) string {
+	if title, ok := activityTitles[activityType]; ok {
+		return title
+	}
+	return activityType
+}
+
+// ParseTemplate parses a payload template.
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := marshalJSON(v)
+			return string(b), err
+		},
+		"join": strings.Join,
+	}).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse webhook template")
+	}
+	return tmpl, nil
+}
+
+// Content types of the request bodies.
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeText = "text/plain; charset=utf-8"
+)
+
+// BuildBody renders the request body of the activity in the given format and returns it with its content type.
+// Templates may render any text, so their body is only sent as JSON if it is valid JSON.
+func BuildBody(format Format, templateText string, data *TemplateData) ([]byte, string, error) {
+	var body []byte
+	var err error
+	switch format {
+	case FormatMemos, "":
+		body, err = json.Marshal(data.WebhookRequestPayload)
+	case FormatSlack:
+		body, err = marshalJSON(buildSlackMessage(data))
+	case FormatDiscord:
+		body, err = marshalJSON(buildDiscordMessage(data))
+	case FormatMattermost:
+		body, err = marshalJSON(buildMattermostMessage(data))
+	case FormatTemplate:
+		tmpl, err := ParseTemplate(templateText)
+		if err != nil {
+			return nil, "", err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&limitedWriter{w: &buf, remaining: maxTemplateOutputSize}, data); err != nil {
+			return nil, "", errors.Wrap(err, "failed to render webhook template")
+		}
+		if !json.Valid(buf.Bytes()) {
+			return buf.Bytes(), ContentTypeText, nil
+		}
+		return buf.Bytes(), ContentTypeJSON, nil
+	default:
+		return nil, "", errors.Errorf("unsupported webhook format %q", format)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return body, ContentTypeJSON, nil
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// buildSlackMessage builds a message using Slack's mrkdwn, where links are written as <url|text>.
+func buildSlackMessage(data *TemplateData) *slackMessage {
+	lines := []string{fmt.Sprintf("*%s*", escapeSlack(data.Title))}
+	if data.Snippet != "" {
+		lines = append(lines, quoteLines(escapeSlack(data.Snippet)))
+	}
+	if tags := formatTags(data.Tags); tags != "" {
+		lines = append(lines, escapeSlack(tags))
+	}
+	if data.Link != "" {
+		lines = append(lines, fmt.Sprintf("<%s|View memo>", data.Link))
+	}
+	return &slackMessage{Text: strings.Join(lines, "\n")}
+}
+
+type mattermostMessage struct {
+	Text string `json:"text"`
+}
+
+// buildMattermostMessage builds a message using Markdown.
+func buildMattermostMessage(data *TemplateData) *mattermostMessage {
+	lines := []string{fmt.Sprintf("**%s**", data.Title)}
+	if data.Snippet != "" {
+		lines = append(lines, quoteLines(data.Snippet))
+	}
+	if tags := formatTags(data.Tags); tags != "" {
+		lines = append(lines, tags)
+	}
+	if data.Link != "" {
+		lines = append(lines, fmt.Sprintf("[View memo](%s)", data.Link))
+	}
+	return &mattermostMessage{Text: strings.Join(lines, "\n")}
+}
+
+type discordMessage struct {
+	Content string          `json:"content"`
+	Embeds  []*discordEmbed `json:"embeds,omitempty"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// buildDiscordMessage builds a message with a single embed linking to the memo.
+func buildDiscordMessage(data *TemplateData) *discordMessage {
+	embed := &discordEmbed{
+		Title:       data.Title,
+		Description: data.Snippet,
+		URL:         data.Link,
+	}
+	if tags := formatTags(data.Tags); tags != "" {
+		embed.Footer = &discordEmbedFooter{Text: tags}
+	}
+	return &discordMessage{
+		Content: data.Title,
+		Embeds:  []*discordEmbed{embed},
+	}
+}
+
+func formatTags(tags []string) string {
+	list := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		list = append(list, "#"+tag)
+	}
+	return strings.Join(list, " ")
+}
+
+func quoteLines(text string) string {
+	return "> " + strings.ReplaceAll(text, "\n", "\n> ")
+}
+
+// escapeSlack escapes the characters that Slack treats as control sequences.
+func escapeSlack(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// marshalJSON encodes v as JSON without escaping HTML characters, which would
+// otherwise turn Slack links such as <url|text> into \u003curl|text\u003e.
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// limitedWriter fails once more than remaining bytes have been written.
+type limitedWriter struct {
+	w         *bytes.Buffer
+	remaining int
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > l.remaining {
+		return 0, errors.Errorf("webhook template output exceeds %d bytes", maxTemplateOutputSize)
+	}
+	l.remaining -= len(p)
+	return l.w.Write(p)
+}
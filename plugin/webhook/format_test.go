@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func newTestTemplateData() *TemplateData {
+	return &TemplateData{
+		WebhookRequestPayload: &WebhookRequestPayload{
+			ActivityType: ActivityTypeMemoCreated,
+			Creator:      "users/1",
+			Memo:         &v1pb.Memo{Name: "memos/abc", Content: "Ship <it> & go #deploy"},
+		},
+		Title:   ActivityTitle(ActivityTypeMemoCreated),
+		Snippet: "Ship <it> & go\nnow",
+		Link:    "https://memos.example.com/memos/abc",
+		Tags:    []string{"deploy", "work"},
+	}
+}
+
+func TestBuildBody(t *testing.T) {
+	data := newTestTemplateData()
+
+	body, contentType, err := BuildBody(FormatMemos, "", data)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+	payload := &WebhookRequestPayload{}
+	require.NoError(t, json.Unmarshal(body, payload))
+	require.Equal(t, ActivityTypeMemoCreated, payload.ActivityType)
+
+	body, contentType, err = BuildBody(FormatSlack, "", data)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+	slack := &slackMessage{}
+	require.NoError(t, json.Unmarshal(body, slack))
+	require.Equal(t, "*New memo*\n> Ship &lt;it&gt; &amp; go\n> now\n#deploy #work\n<https://memos.example.com/memos/abc|View memo>", slack.Text)
+
+	body, contentType, err = BuildBody(FormatMattermost, "", data)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+	mattermost := &mattermostMessage{}
+	require.NoError(t, json.Unmarshal(body, mattermost))
+	require.Equal(t, "**New memo**\n> Ship <it> & go\n> now\n#deploy #work\n[View memo](https://memos.example.com/memos/abc)", mattermost.Text)
+
+	body, contentType, err = BuildBody(FormatDiscord, "", data)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+	discord := &discordMessage{}
+	require.NoError(t, json.Unmarshal(body, discord))
+	require.Equal(t, "New memo", discord.Content)
+	require.Len(t, discord.Embeds, 1)
+	require.Equal(t, data.Link, discord.Embeds[0].URL)
+	require.Equal(t, data.Snippet, discord.Embeds[0].Description)
+	require.Equal(t, "#deploy #work", discord.Embeds[0].Footer.Text)
+
+	body, contentType, err = BuildBody(FormatTemplate, `{{ .Title }}: {{ json .Memo.Content }} [{{ join .Tags "," }}] by {{ .Creator }}`, data)
+	require.NoError(t, err)
+	require.Equal(t, `New memo: "Ship <it> & go #deploy" [deploy,work] by users/1`, string(body))
+	require.Equal(t, ContentTypeText, contentType)
+
+	body, contentType, err = BuildBody(FormatTemplate, `{"text": {{ json .Title }}}`, data)
+	require.NoError(t, err)
+	require.Equal(t, `{"text": "New memo"}`, string(body))
+	require.Equal(t, ContentTypeJSON, contentType)
+
+	_, _, err = BuildBody("XML", "", data)
+	require.Error(t, err)
+}
+
+func TestBuildBodyTemplateErrors(t *testing.T) {
+	data := newTestTemplateData()
+
+	_, _, err := BuildBody(FormatTemplate, "{{ .Title", data)
+	require.Error(t, err)
+
+	_, _, err = BuildBody(FormatTemplate, "{{ .Unknown }}", data)
+	require.Error(t, err)
+
+	_, _, err = BuildBody(FormatTemplate, `{{ range $i := .Tags }}`+strings.Repeat("x", 40*1024)+`{{ end }}`, data)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exceeds")
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, "whsec_"))
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	signedAt := time.Unix(1700000000, 0)
+
+	header := Sign(secret, signedAt, body)
+	// The signature is HMAC-SHA256("1700000000." + body) keyed with the secret.
+	require.Equal(t, "t=1700000000,v1=e7fe52df57446c6c00e3b87f2bd7482fd9e67e6b4199369975b60d92421bd230", header)
+
+	require.NoError(t, VerifySignature(secret, header, body, 5*time.Minute, signedAt.Add(time.Minute)))
+	require.Error(t, VerifySignature("whsec_other", header, body, 5*time.Minute, signedAt))
+	require.Error(t, VerifySignature(secret, header, []byte(`{}`), 5*time.Minute, signedAt))
+	require.Error(t, VerifySignature(secret, header, body, 5*time.Minute, signedAt.Add(10*time.Minute)))
+	require.Error(t, VerifySignature(secret, "v1=abc", body, 5*time.Minute, signedAt))
+	require.Error(t, VerifySignature(secret, "", body, 5*time.Minute, signedAt))
+
+	// Any of several signatures may match, e.g. while a receiver rolls out a new scheme.
+	require.NoError(t, VerifySignature(secret, header+",v1=deadbeef", body, 5*time.Minute, signedAt))
+}
@@ -2,14 +2,17 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
-	"log/slog"
+	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/internal/netguard"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 )
 
@@ -18,6 +21,107 @@ var (
 	timeout = 30 * time.Second
 )
 
+// maxResponseBodySize is the maximum number of bytes read from the response body.
+const maxResponseBodySize = 64 * 1024
+
+// Client sends webhook requests.
+type Client struct {
+	httpClient *http.Client
+}
+
+var (
+	// guardedHTTPClient refuses to connect to the local machine and private networks.
+	guardedHTTPClient = newHTTPClient(netguard.NewDialer(10 * time.Second))
+	// unguardedHTTPClient connects to any address.
+	unguardedHTTPClient = newHTTPClient(&net.Dialer{Timeout: 10 * time.Second})
+)
+
+// NewClient returns a client for sending webhooks.
+// Unless allowInternalAddresses is set, webhooks to the local machine and private networks fail
+// with netguard.ErrInternalAddress, since the delivery log shows the response to the webhook owner,
+// which would let any user read from internal services.
+func NewClient(allowInternalAddresses bool) *Client {
+	if allowInternalAddresses {
+		return &Client{httpClient: unguardedHTTPClient}
+	}
+	return &Client{httpClient: guardedHTTPClient}
+}
+
+func newHTTPClient(dialer *net.Dialer) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// Connect directly, without a proxy, so that a guarded dialer checks the address of the actual server.
+			// Checking at connect time also covers redirects and hostnames that resolve to an internal address.
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return errors.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+			}
+			if len(via) >= 10 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+}
+
+// Activity types that webhooks can subscribe to.
+const (
+	ActivityTypeMemoCreated           = "memos.memo.created"
+	ActivityTypeMemoUpdated           = "memos.memo.updated"
+	ActivityTypeMemoDeleted           = "memos.memo.deleted"
+	ActivityTypeMemoVisibilityChanged = "memos.memo.visibility.changed"
+	ActivityTypeMemoCommentCreated    = "memos.memo.comment.created"
+	ActivityTypeMemoReactionAdded     = "memos.memo.reaction.added"
+	ActivityTypeMemoReactionRemoved   = "memos.memo.reaction.removed"
+	ActivityTypeMemoReminder          = "memos.memo.reminder"
+	ActivityTypeAttachmentCreated     = "memos.attachment.created"
+)
+
+// Activity types that only instance webhooks can subscribe to.
+const (
+	ActivityTypeUserCreated     = "memos.user.created"
+	ActivityTypeUserDeleted     = "memos.user.deleted"
+	ActivityTypeUserRoleChanged = "memos.user.role.changed"
+	ActivityTypeUserSignIn      = "memos.user.signin"
+	ActivityTypeUserIDPSignIn   = "memos.user.idp.signin"
+)
+
+// ActivityTypes lists all activity types in the order they are documented.
+var ActivityTypes = []string{
+	ActivityTypeMemoCreated,
+	ActivityTypeMemoUpdated,
+	ActivityTypeMemoDeleted,
+	ActivityTypeMemoVisibilityChanged,
+	ActivityTypeMemoCommentCreated,
+	ActivityTypeMemoReactionAdded,
+	ActivityTypeMemoReactionRemoved,
+	ActivityTypeMemoReminder,
+	ActivityTypeAttachmentCreated,
+}
+
+// DefaultActivityTypes are sent to webhooks that do not subscribe to specific activity types.
+var DefaultActivityTypes = []string{
+	ActivityTypeMemoCreated,
+	ActivityTypeMemoUpdated,
+	ActivityTypeMemoDeleted,
+}
+
+// InstanceActivityTypes lists the activity types instance webhooks can subscribe to.
+// Instance webhooks receive memos.memo.created for public memos only.
+// Instance webhooks that do not subscribe to specific activity types receive all of them.
+var InstanceActivityTypes = []string{
+	ActivityTypeUserCreated,
+	ActivityTypeUserDeleted,
+	ActivityTypeUserRoleChanged,
+	ActivityTypeUserSignIn,
+	ActivityTypeUserIDPSignIn,
+	ActivityTypeMemoCreated,
+}
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -27,64 +131,89 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The comment that was created, for comment activities.
+	Comment *v1pb.Memo `json:"comment,omitempty"`
+	// The reaction that was added or removed, for reaction activities.
+	Reaction *v1pb.Reaction `json:"reaction,omitempty"`
+	// The attachment that was created, for attachment activities.
+	Attachment *v1pb.Attachment `json:"attachment,omitempty"`
+	// The visibility of the memo before the change, for visibility activities.
+	PreviousVisibility string `json:"previousVisibility,omitempty"`
+	// The user the activity is about, for user activities.
+	User *v1pb.User `json:"user,omitempty"`
+	// The role of the user before the change, for role activities.
+	PreviousRole string `json:"previousRole,omitempty"`
+	// The resource name of the identity provider used to sign in. Format: identity-providers/{id}
+	IdentityProvider string `json:"identityProvider,omitempty"`
+}
+
+// IsValidActivityType reports whether webhooks can subscribe to the activity type.
+func IsValidActivityType(activityType string) bool {
+	return slices.Contains(ActivityTypes, activityType)
+}
+
+// IsValidInstanceActivityType reports whether instance webhooks can subscribe to the activity type.
+func IsValidInstanceActivityType(activityType string) bool {
+	return slices.Contains(InstanceActivityTypes, activityType)
+}
+
+// Response is the response returned by the webhook endpoint.
+type Response struct {
+	StatusCode int
+	Body       string
 }
 
 // Post posts the message to webhook endpoint.
-func Post(requestPayload *WebhookRequestPayload) error {
+func (c *Client) Post(requestPayload *WebhookRequestPayload) error {
 	body, err := json.Marshal(requestPayload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	_, err = c.Send(context.Background(), requestPayload.URL, body, ContentTypeJSON, "")
+	return err
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// Send posts the body with the given content type to the webhook endpoint.
+// If secret is not empty, the request is signed with it in the SignatureHeader.
+// The response is returned whenever the endpoint replied, even if the delivery is considered failed.
+func (c *Client) Send(ctx context.Context, url string, body []byte, contentType, secret string) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", url)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: timeout,
+	req.Header.Set("Content-Type", contentType)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, time.Now(), body))
 	}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", url)
 	}
 	defer resp.Body.Close()
 
-	b, err := io.ReadAll(resp.Body)
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to read webhook response from %s", url)
+	}
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Body:       string(b),
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+		return response, errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", url, resp.StatusCode, b)
 	}
 
-	response := &struct {
+	// Endpoints may report errors with a JSON body like {"code": 1, "message": "..."}.
+	// Bodies that are not JSON are accepted as long as the status code is successful.
+	result := &struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
-	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+	if err := json.Unmarshal(b, result); err == nil && result.Code != 0 {
+		return response, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", result.Code, result.Message)
 	}
 
-	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
-	}
-
-	return nil
-}
-
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
-			slog.Warn("Failed to dispatch webhook asynchronously",
-				slog.String("url", requestPayload.URL),
-				slog.String("activityType", requestPayload.ActivityType),
-				slog.Any("err", err))
-		}
-	}()
+	return response, nil
 }
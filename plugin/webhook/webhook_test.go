@@ -1 +1,71 @@
 package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/netguard"
+)
+
+func TestSend(t *testing.T) {
+	// The test servers listen on the local machine.
+	client := NewClient(true)
+	var header, contentType string
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(SignatureHeader)
+		contentType = r.Header.Get("Content-Type")
+		received, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	response, err := client.Send(context.Background(), server.URL, body, ContentTypeJSON, "whsec_test")
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeJSON, contentType)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "ok", response.Body)
+	require.Equal(t, body, received)
+	require.NoError(t, VerifySignature("whsec_test", header, received, time.Minute, time.Now()))
+
+	// Requests are not signed without a secret.
+	_, err = client.Send(context.Background(), server.URL, []byte("New memo"), ContentTypeText, "")
+	require.NoError(t, err)
+	require.Empty(t, header)
+	require.Equal(t, ContentTypeText, contentType)
+}
+
+func TestSendFailure(t *testing.T) {
+	// The test servers listen on the local machine.
+	client := NewClient(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"code":1,"message":"rejected"}`))
+	}))
+	defer server.Close()
+
+	response, err := client.Send(context.Background(), server.URL, []byte(`{}`), ContentTypeJSON, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rejected")
+	require.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestSendInternalAddress(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	response, err := NewClient(false).Send(context.Background(), server.URL, []byte(`{}`), ContentTypeJSON, "")
+	require.ErrorIs(t, err, netguard.ErrInternalAddress)
+	require.Nil(t, response)
+	require.False(t, called)
+}
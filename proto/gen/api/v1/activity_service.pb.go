@@ -31,6 +31,12 @@ const (
 	Activity_TYPE_UNSPECIFIED Activity_Type = 0
 	// Memo comment activity.
 	Activity_MEMO_COMMENT Activity_Type = 1
+	// Memo mention activity.
+	Activity_MEMO_MENTION Activity_Type = 2
+	// Memo reminder activity.
+	Activity_MEMO_REMINDER Activity_Type = 3
+	// Memo digest activity.
+	Activity_MEMO_DIGEST Activity_Type = 4
 )
 
 // Enum value maps for Activity_Type.
@@ -38,10 +44,16 @@ var (
 	Activity_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "MEMO_MENTION",
+		3: "MEMO_REMINDER",
+		4: "MEMO_DIGEST",
 	}
 	Activity_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"MEMO_MENTION":     2,
+		"MEMO_REMINDER":    3,
+		"MEMO_DIGEST":      4,
 	}
 )
 
@@ -226,6 +238,9 @@ type ActivityPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*ActivityPayload_MemoComment
+	//	*ActivityPayload_MemoMention
+	//	*ActivityPayload_MemoReminder
+	//	*ActivityPayload_MemoDigest
 	Payload       isActivityPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -277,6 +292,33 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoMention); ok {
+			return x.MemoMention
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoReminder); ok {
+			return x.MemoReminder
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoDigest() *ActivityMemoDigestPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoDigest); ok {
+			return x.MemoDigest
+		}
+	}
+	return nil
+}
+
 type isActivityPayload_Payload interface {
 	isActivityPayload_Payload()
 }
@@ -286,8 +328,29 @@ type ActivityPayload_MemoComment struct {
 	MemoComment *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3,oneof"`
 }
 
+type ActivityPayload_MemoMention struct {
+	// Memo mention activity payload.
+	MemoMention *ActivityMemoMentionPayload `protobuf:"bytes,2,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+}
+
+type ActivityPayload_MemoReminder struct {
+	// Memo reminder activity payload.
+	MemoReminder *ActivityMemoReminderPayload `protobuf:"bytes,3,opt,name=memo_reminder,json=memoReminder,proto3,oneof"`
+}
+
+type ActivityPayload_MemoDigest struct {
+	// Memo digest activity payload.
+	MemoDigest *ActivityMemoDigestPayload `protobuf:"bytes,4,opt,name=memo_digest,json=memoDigest,proto3,oneof"`
+}
+
 func (*ActivityPayload_MemoComment) isActivityPayload_Payload() {}
 
+func (*ActivityPayload_MemoMention) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoReminder) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoDigest) isActivityPayload_Payload() {}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -345,6 +408,166 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemo() string {
 	return ""
 }
 
+// ActivityMemoMentionPayload represents the payload of a memo mention activity.
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo that mentions the user.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+type ActivityMemoReminderPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo the reminder is for.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityMemoDigestPayload represents the payload of a daily memo digest activity.
+type ActivityMemoDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The day of the digest in the user's time zone, formatted as YYYY-MM-DD.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The names of the memos created on the same day in previous years.
+	// Format: memos/{memo}
+	OnThisDayMemos []string `protobuf:"bytes,2,rep,name=on_this_day_memos,json=onThisDayMemos,proto3" json:"on_this_day_memos,omitempty"`
+	// The names of the memos tagged #review that are due for review.
+	// Format: memos/{memo}
+	ReviewMemos   []string `protobuf:"bytes,3,rep,name=review_memos,json=reviewMemos,proto3" json:"review_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoDigestPayload) Reset() {
+	*x = ActivityMemoDigestPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoDigestPayload) ProtoMessage() {}
+
+func (x *ActivityMemoDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoDigestPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoDigestPayload) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ActivityMemoDigestPayload) GetOnThisDayMemos() []string {
+	if x != nil {
+		return x.OnThisDayMemos
+	}
+	return nil
+}
+
+func (x *ActivityMemoDigestPayload) GetReviewMemos() []string {
+	if x != nil {
+		return x.ReviewMemos
+	}
+	return nil
+}
+
 type ListActivitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of activities to return.
@@ -361,7 +584,7 @@ type ListActivitiesRequest struct {
 
 func (x *ListActivitiesRequest) Reset() {
 	*x = ListActivitiesRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +596,7 @@ func (x *ListActivitiesRequest) String() string {
 func (*ListActivitiesRequest) ProtoMessage() {}
 
 func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +609,7 @@ func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListActivitiesRequest) GetPageSize() int32 {
@@ -417,7 +640,7 @@ type ListActivitiesResponse struct {
 
 func (x *ListActivitiesResponse) Reset() {
 	*x = ListActivitiesResponse{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +652,7 @@ func (x *ListActivitiesResponse) String() string {
 func (*ListActivitiesResponse) ProtoMessage() {}
 
 func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +665,7 @@ func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListActivitiesResponse) GetActivities() []*Activity {
@@ -470,7 +693,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +705,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +718,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -509,7 +732,7 @@ var File_api_v1_activity_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x03\n" +
+	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x04\n" +
 	"\bActivity\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1d\n" +
 	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x124\n" +
@@ -517,23 +740,38 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\x0e2\x1c.memos.api.v1.Activity.LevelB\x03\xe0A\x03R\x05level\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12<\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\".\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\"d\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01\"=\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x02\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x03\x12\x0f\n" +
+	"\vMEMO_DIGEST\x10\x04\"=\n" +
 	"\x05Level\x12\x15\n" +
 	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04INFO\x10\x01\x12\b\n" +
 	"\x04WARN\x10\x02\x12\t\n" +
 	"\x05ERROR\x10\x03:M\xeaAJ\n" +
 	"\x15memos.api.v1/Activity\x12\x15activities/{activity}\x1a\x04name*\n" +
-	"activities2\bactivity\"k\n" +
+	"activities2\bactivity\"\xd8\x02\n" +
 	"\x0fActivityPayload\x12M\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoCommentB\t\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoComment\x12M\n" +
+	"\fmemo_mention\x18\x02 \x01(\v2(.memos.api.v1.ActivityMemoMentionPayloadH\x00R\vmemoMention\x12P\n" +
+	"\rmemo_reminder\x18\x03 \x01(\v2).memos.api.v1.ActivityMemoReminderPayloadH\x00R\fmemoReminder\x12J\n" +
+	"\vmemo_digest\x18\x04 \x01(\v2'.memos.api.v1.ActivityMemoDigestPayloadH\x00R\n" +
+	"memoDigestB\t\n" +
 	"\apayload\"S\n" +
 	"\x1aActivityMemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
-	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"S\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"0\n" +
+	"\x1aActivityMemoMentionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"1\n" +
+	"\x1bActivityMemoReminderPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"}\n" +
+	"\x19ActivityMemoDigestPayload\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12)\n" +
+	"\x11on_this_day_memos\x18\x02 \x03(\tR\x0eonThisDayMemos\x12!\n" +
+	"\freview_memos\x18\x03 \x03(\tR\vreviewMemos\"S\n" +
 	"\x15ListActivitiesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -564,34 +802,40 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_activity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(Activity_Type)(0),                 // 0: memos.api.v1.Activity.Type
-	(Activity_Level)(0),                // 1: memos.api.v1.Activity.Level
-	(*Activity)(nil),                   // 2: memos.api.v1.Activity
-	(*ActivityPayload)(nil),            // 3: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil), // 4: memos.api.v1.ActivityMemoCommentPayload
-	(*ListActivitiesRequest)(nil),      // 5: memos.api.v1.ListActivitiesRequest
-	(*ListActivitiesResponse)(nil),     // 6: memos.api.v1.ListActivitiesResponse
-	(*GetActivityRequest)(nil),         // 7: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(Activity_Type)(0),                  // 0: memos.api.v1.Activity.Type
+	(Activity_Level)(0),                 // 1: memos.api.v1.Activity.Level
+	(*Activity)(nil),                    // 2: memos.api.v1.Activity
+	(*ActivityPayload)(nil),             // 3: memos.api.v1.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),  // 4: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityMemoMentionPayload)(nil),  // 5: memos.api.v1.ActivityMemoMentionPayload
+	(*ActivityMemoReminderPayload)(nil), // 6: memos.api.v1.ActivityMemoReminderPayload
+	(*ActivityMemoDigestPayload)(nil),   // 7: memos.api.v1.ActivityMemoDigestPayload
+	(*ListActivitiesRequest)(nil),       // 8: memos.api.v1.ListActivitiesRequest
+	(*ListActivitiesResponse)(nil),      // 9: memos.api.v1.ListActivitiesResponse
+	(*GetActivityRequest)(nil),          // 10: memos.api.v1.GetActivityRequest
+	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
-	1, // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
-	8, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
-	3, // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
-	4, // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
-	2, // 5: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
-	5, // 6: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
-	7, // 7: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	6, // 8: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
-	2, // 9: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
+	1,  // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
+	11, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
+	4,  // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
+	5,  // 5: memos.api.v1.ActivityPayload.memo_mention:type_name -> memos.api.v1.ActivityMemoMentionPayload
+	6,  // 6: memos.api.v1.ActivityPayload.memo_reminder:type_name -> memos.api.v1.ActivityMemoReminderPayload
+	7,  // 7: memos.api.v1.ActivityPayload.memo_digest:type_name -> memos.api.v1.ActivityMemoDigestPayload
+	2,  // 8: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
+	8,  // 9: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
+	10, // 10: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	9,  // 11: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
+	2,  // 12: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -601,6 +845,9 @@ func file_api_v1_activity_service_proto_init() {
 	}
 	file_api_v1_activity_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*ActivityPayload_MemoComment)(nil),
+		(*ActivityPayload_MemoMention)(nil),
+		(*ActivityPayload_MemoReminder)(nil),
+		(*ActivityPayload_MemoDigest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -608,7 +855,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -41,6 +41,15 @@ const (
 	AuthServiceSignInProcedure = "/memos.api.v1.AuthService/SignIn"
 	// AuthServiceSignOutProcedure is the fully-qualified name of the AuthService's SignOut RPC.
 	AuthServiceSignOutProcedure = "/memos.api.v1.AuthService/SignOut"
+	// AuthServiceBeginPasskeyRegistrationProcedure is the fully-qualified name of the AuthService's
+	// BeginPasskeyRegistration RPC.
+	AuthServiceBeginPasskeyRegistrationProcedure = "/memos.api.v1.AuthService/BeginPasskeyRegistration"
+	// AuthServiceFinishPasskeyRegistrationProcedure is the fully-qualified name of the AuthService's
+	// FinishPasskeyRegistration RPC.
+	AuthServiceFinishPasskeyRegistrationProcedure = "/memos.api.v1.AuthService/FinishPasskeyRegistration"
+	// AuthServiceBeginPasskeySignInProcedure is the fully-qualified name of the AuthService's
+	// BeginPasskeySignIn RPC.
+	AuthServiceBeginPasskeySignInProcedure = "/memos.api.v1.AuthService/BeginPasskeySignIn"
 	// AuthServiceRefreshTokenProcedure is the fully-qualified name of the AuthService's RefreshToken
 	// RPC.
 	AuthServiceRefreshTokenProcedure = "/memos.api.v1.AuthService/RefreshToken"
@@ -54,11 +63,20 @@ type AuthServiceClient interface {
 	GetCurrentUser(context.Context, *connect.Request[v1.GetCurrentUserRequest]) (*connect.Response[v1.GetCurrentUserResponse], error)
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
-	// Supports password-based and SSO authentication methods.
+	// Supports password-based, passkey and SSO authentication methods.
+	// Users with two-factor authentication enabled complete a password sign-in in a second step.
 	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
 	SignOut(context.Context, *connect.Request[v1.SignOutRequest]) (*connect.Response[emptypb.Empty], error)
+	// BeginPasskeyRegistration starts registering a passkey for the current user.
+	// Returns the options for navigator.credentials.create() and a session token for FinishPasskeyRegistration.
+	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
+	// FinishPasskeyRegistration verifies the response of the authenticator and stores the passkey.
+	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.Passkey], error)
+	// BeginPasskeySignIn starts a sign-in with a passkey.
+	// Returns the options for navigator.credentials.get() and a session token for SignIn with passkey_credentials.
+	BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error)
 	// RefreshToken exchanges a valid refresh token for a new access token.
 	// The refresh token is read from the HttpOnly cookie.
 	// Returns a new short-lived access token.
@@ -94,6 +112,24 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("SignOut")),
 			connect.WithClientOptions(opts...),
 		),
+		beginPasskeyRegistration: connect.NewClient[v1.BeginPasskeyRegistrationRequest, v1.BeginPasskeyRegistrationResponse](
+			httpClient,
+			baseURL+AuthServiceBeginPasskeyRegistrationProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginPasskeyRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		finishPasskeyRegistration: connect.NewClient[v1.FinishPasskeyRegistrationRequest, v1.Passkey](
+			httpClient,
+			baseURL+AuthServiceFinishPasskeyRegistrationProcedure,
+			connect.WithSchema(authServiceMethods.ByName("FinishPasskeyRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		beginPasskeySignIn: connect.NewClient[v1.BeginPasskeySignInRequest, v1.BeginPasskeySignInResponse](
+			httpClient,
+			baseURL+AuthServiceBeginPasskeySignInProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginPasskeySignIn")),
+			connect.WithClientOptions(opts...),
+		),
 		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.RefreshTokenResponse](
 			httpClient,
 			baseURL+AuthServiceRefreshTokenProcedure,
@@ -105,10 +141,13 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	getCurrentUser *connect.Client[v1.GetCurrentUserRequest, v1.GetCurrentUserResponse]
-	signIn         *connect.Client[v1.SignInRequest, v1.SignInResponse]
-	signOut        *connect.Client[v1.SignOutRequest, emptypb.Empty]
-	refreshToken   *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	getCurrentUser            *connect.Client[v1.GetCurrentUserRequest, v1.GetCurrentUserResponse]
+	signIn                    *connect.Client[v1.SignInRequest, v1.SignInResponse]
+	signOut                   *connect.Client[v1.SignOutRequest, emptypb.Empty]
+	beginPasskeyRegistration  *connect.Client[v1.BeginPasskeyRegistrationRequest, v1.BeginPasskeyRegistrationResponse]
+	finishPasskeyRegistration *connect.Client[v1.FinishPasskeyRegistrationRequest, v1.Passkey]
+	beginPasskeySignIn        *connect.Client[v1.BeginPasskeySignInRequest, v1.BeginPasskeySignInResponse]
+	refreshToken              *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
 }
 
 // GetCurrentUser calls memos.api.v1.AuthService.GetCurrentUser.
@@ -126,6 +165,21 @@ func (c *authServiceClient) SignOut(ctx context.Context, req *connect.Request[v1
 	return c.signOut.CallUnary(ctx, req)
 }
 
+// BeginPasskeyRegistration calls memos.api.v1.AuthService.BeginPasskeyRegistration.
+func (c *authServiceClient) BeginPasskeyRegistration(ctx context.Context, req *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error) {
+	return c.beginPasskeyRegistration.CallUnary(ctx, req)
+}
+
+// FinishPasskeyRegistration calls memos.api.v1.AuthService.FinishPasskeyRegistration.
+func (c *authServiceClient) FinishPasskeyRegistration(ctx context.Context, req *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.Passkey], error) {
+	return c.finishPasskeyRegistration.CallUnary(ctx, req)
+}
+
+// BeginPasskeySignIn calls memos.api.v1.AuthService.BeginPasskeySignIn.
+func (c *authServiceClient) BeginPasskeySignIn(ctx context.Context, req *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error) {
+	return c.beginPasskeySignIn.CallUnary(ctx, req)
+}
+
 // RefreshToken calls memos.api.v1.AuthService.RefreshToken.
 func (c *authServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
 	return c.refreshToken.CallUnary(ctx, req)
@@ -139,11 +193,20 @@ type AuthServiceHandler interface {
 	GetCurrentUser(context.Context, *connect.Request[v1.GetCurrentUserRequest]) (*connect.Response[v1.GetCurrentUserResponse], error)
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
-	// Supports password-based and SSO authentication methods.
+	// Supports password-based, passkey and SSO authentication methods.
+	// Users with two-factor authentication enabled complete a password sign-in in a second step.
 	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
 	SignOut(context.Context, *connect.Request[v1.SignOutRequest]) (*connect.Response[emptypb.Empty], error)
+	// BeginPasskeyRegistration starts registering a passkey for the current user.
+	// Returns the options for navigator.credentials.create() and a session token for FinishPasskeyRegistration.
+	BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error)
+	// FinishPasskeyRegistration verifies the response of the authenticator and stores the passkey.
+	FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.Passkey], error)
+	// BeginPasskeySignIn starts a sign-in with a passkey.
+	// Returns the options for navigator.credentials.get() and a session token for SignIn with passkey_credentials.
+	BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error)
 	// RefreshToken exchanges a valid refresh token for a new access token.
 	// The refresh token is read from the HttpOnly cookie.
 	// Returns a new short-lived access token.
@@ -175,6 +238,24 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("SignOut")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceBeginPasskeyRegistrationHandler := connect.NewUnaryHandler(
+		AuthServiceBeginPasskeyRegistrationProcedure,
+		svc.BeginPasskeyRegistration,
+		connect.WithSchema(authServiceMethods.ByName("BeginPasskeyRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceFinishPasskeyRegistrationHandler := connect.NewUnaryHandler(
+		AuthServiceFinishPasskeyRegistrationProcedure,
+		svc.FinishPasskeyRegistration,
+		connect.WithSchema(authServiceMethods.ByName("FinishPasskeyRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginPasskeySignInHandler := connect.NewUnaryHandler(
+		AuthServiceBeginPasskeySignInProcedure,
+		svc.BeginPasskeySignIn,
+		connect.WithSchema(authServiceMethods.ByName("BeginPasskeySignIn")),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceRefreshTokenHandler := connect.NewUnaryHandler(
 		AuthServiceRefreshTokenProcedure,
 		svc.RefreshToken,
@@ -189,6 +270,12 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceSignInHandler.ServeHTTP(w, r)
 		case AuthServiceSignOutProcedure:
 			authServiceSignOutHandler.ServeHTTP(w, r)
+		case AuthServiceBeginPasskeyRegistrationProcedure:
+			authServiceBeginPasskeyRegistrationHandler.ServeHTTP(w, r)
+		case AuthServiceFinishPasskeyRegistrationProcedure:
+			authServiceFinishPasskeyRegistrationHandler.ServeHTTP(w, r)
+		case AuthServiceBeginPasskeySignInProcedure:
+			authServiceBeginPasskeySignInHandler.ServeHTTP(w, r)
 		case AuthServiceRefreshTokenProcedure:
 			authServiceRefreshTokenHandler.ServeHTTP(w, r)
 		default:
@@ -212,6 +299,18 @@ func (UnimplementedAuthServiceHandler) SignOut(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.SignOut is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) BeginPasskeyRegistration(context.Context, *connect.Request[v1.BeginPasskeyRegistrationRequest]) (*connect.Response[v1.BeginPasskeyRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.BeginPasskeyRegistration is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) FinishPasskeyRegistration(context.Context, *connect.Request[v1.FinishPasskeyRegistrationRequest]) (*connect.Response[v1.Passkey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.FinishPasskeyRegistration is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginPasskeySignIn(context.Context, *connect.Request[v1.BeginPasskeySignInRequest]) (*connect.Response[v1.BeginPasskeySignInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.BeginPasskeySignIn is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.RefreshToken is not implemented"))
 }
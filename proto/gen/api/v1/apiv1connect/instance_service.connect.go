@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -42,6 +43,24 @@ const (
 	// InstanceServiceUpdateInstanceSettingProcedure is the fully-qualified name of the
 	// InstanceService's UpdateInstanceSetting RPC.
 	InstanceServiceUpdateInstanceSettingProcedure = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	// InstanceServiceListInstanceJobsProcedure is the fully-qualified name of the InstanceService's
+	// ListInstanceJobs RPC.
+	InstanceServiceListInstanceJobsProcedure = "/memos.api.v1.InstanceService/ListInstanceJobs"
+	// InstanceServiceRunInstanceJobProcedure is the fully-qualified name of the InstanceService's
+	// RunInstanceJob RPC.
+	InstanceServiceRunInstanceJobProcedure = "/memos.api.v1.InstanceService/RunInstanceJob"
+	// InstanceServiceListInstanceWebhooksProcedure is the fully-qualified name of the InstanceService's
+	// ListInstanceWebhooks RPC.
+	InstanceServiceListInstanceWebhooksProcedure = "/memos.api.v1.InstanceService/ListInstanceWebhooks"
+	// InstanceServiceCreateInstanceWebhookProcedure is the fully-qualified name of the
+	// InstanceService's CreateInstanceWebhook RPC.
+	InstanceServiceCreateInstanceWebhookProcedure = "/memos.api.v1.InstanceService/CreateInstanceWebhook"
+	// InstanceServiceUpdateInstanceWebhookProcedure is the fully-qualified name of the
+	// InstanceService's UpdateInstanceWebhook RPC.
+	InstanceServiceUpdateInstanceWebhookProcedure = "/memos.api.v1.InstanceService/UpdateInstanceWebhook"
+	// InstanceServiceDeleteInstanceWebhookProcedure is the fully-qualified name of the
+	// InstanceService's DeleteInstanceWebhook RPC.
+	InstanceServiceDeleteInstanceWebhookProcedure = "/memos.api.v1.InstanceService/DeleteInstanceWebhook"
 )
 
 // InstanceServiceClient is a client for the memos.api.v1.InstanceService service.
@@ -52,6 +71,18 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(context.Context, *connect.Request[v1.ListInstanceJobsRequest]) (*connect.Response[v1.ListInstanceJobsResponse], error)
+	// Runs a background job immediately, outside of its schedule.
+	RunInstanceJob(context.Context, *connect.Request[v1.RunInstanceJobRequest]) (*connect.Response[v1.InstanceJob], error)
+	// Lists the instance webhooks. Only available to admins.
+	ListInstanceWebhooks(context.Context, *connect.Request[v1.ListInstanceWebhooksRequest]) (*connect.Response[v1.ListInstanceWebhooksResponse], error)
+	// Creates an instance webhook. Only available to admins.
+	CreateInstanceWebhook(context.Context, *connect.Request[v1.CreateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error)
+	// Updates an instance webhook. Only available to admins.
+	UpdateInstanceWebhook(context.Context, *connect.Request[v1.UpdateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error)
+	// Deletes an instance webhook. Only available to admins.
+	DeleteInstanceWebhook(context.Context, *connect.Request[v1.DeleteInstanceWebhookRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewInstanceServiceClient constructs a client for the memos.api.v1.InstanceService service. By
@@ -83,6 +114,42 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 			connect.WithClientOptions(opts...),
 		),
+		listInstanceJobs: connect.NewClient[v1.ListInstanceJobsRequest, v1.ListInstanceJobsResponse](
+			httpClient,
+			baseURL+InstanceServiceListInstanceJobsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListInstanceJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		runInstanceJob: connect.NewClient[v1.RunInstanceJobRequest, v1.InstanceJob](
+			httpClient,
+			baseURL+InstanceServiceRunInstanceJobProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("RunInstanceJob")),
+			connect.WithClientOptions(opts...),
+		),
+		listInstanceWebhooks: connect.NewClient[v1.ListInstanceWebhooksRequest, v1.ListInstanceWebhooksResponse](
+			httpClient,
+			baseURL+InstanceServiceListInstanceWebhooksProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListInstanceWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		createInstanceWebhook: connect.NewClient[v1.CreateInstanceWebhookRequest, v1.InstanceWebhook](
+			httpClient,
+			baseURL+InstanceServiceCreateInstanceWebhookProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateInstanceWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		updateInstanceWebhook: connect.NewClient[v1.UpdateInstanceWebhookRequest, v1.InstanceWebhook](
+			httpClient,
+			baseURL+InstanceServiceUpdateInstanceWebhookProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteInstanceWebhook: connect.NewClient[v1.DeleteInstanceWebhookRequest, emptypb.Empty](
+			httpClient,
+			baseURL+InstanceServiceDeleteInstanceWebhookProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DeleteInstanceWebhook")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -91,6 +158,12 @@ type instanceServiceClient struct {
 	getInstanceProfile    *connect.Client[v1.GetInstanceProfileRequest, v1.InstanceProfile]
 	getInstanceSetting    *connect.Client[v1.GetInstanceSettingRequest, v1.InstanceSetting]
 	updateInstanceSetting *connect.Client[v1.UpdateInstanceSettingRequest, v1.InstanceSetting]
+	listInstanceJobs      *connect.Client[v1.ListInstanceJobsRequest, v1.ListInstanceJobsResponse]
+	runInstanceJob        *connect.Client[v1.RunInstanceJobRequest, v1.InstanceJob]
+	listInstanceWebhooks  *connect.Client[v1.ListInstanceWebhooksRequest, v1.ListInstanceWebhooksResponse]
+	createInstanceWebhook *connect.Client[v1.CreateInstanceWebhookRequest, v1.InstanceWebhook]
+	updateInstanceWebhook *connect.Client[v1.UpdateInstanceWebhookRequest, v1.InstanceWebhook]
+	deleteInstanceWebhook *connect.Client[v1.DeleteInstanceWebhookRequest, emptypb.Empty]
 }
 
 // GetInstanceProfile calls memos.api.v1.InstanceService.GetInstanceProfile.
@@ -108,6 +181,36 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, req *
 	return c.updateInstanceSetting.CallUnary(ctx, req)
 }
 
+// ListInstanceJobs calls memos.api.v1.InstanceService.ListInstanceJobs.
+func (c *instanceServiceClient) ListInstanceJobs(ctx context.Context, req *connect.Request[v1.ListInstanceJobsRequest]) (*connect.Response[v1.ListInstanceJobsResponse], error) {
+	return c.listInstanceJobs.CallUnary(ctx, req)
+}
+
+// RunInstanceJob calls memos.api.v1.InstanceService.RunInstanceJob.
+func (c *instanceServiceClient) RunInstanceJob(ctx context.Context, req *connect.Request[v1.RunInstanceJobRequest]) (*connect.Response[v1.InstanceJob], error) {
+	return c.runInstanceJob.CallUnary(ctx, req)
+}
+
+// ListInstanceWebhooks calls memos.api.v1.InstanceService.ListInstanceWebhooks.
+func (c *instanceServiceClient) ListInstanceWebhooks(ctx context.Context, req *connect.Request[v1.ListInstanceWebhooksRequest]) (*connect.Response[v1.ListInstanceWebhooksResponse], error) {
+	return c.listInstanceWebhooks.CallUnary(ctx, req)
+}
+
+// CreateInstanceWebhook calls memos.api.v1.InstanceService.CreateInstanceWebhook.
+func (c *instanceServiceClient) CreateInstanceWebhook(ctx context.Context, req *connect.Request[v1.CreateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error) {
+	return c.createInstanceWebhook.CallUnary(ctx, req)
+}
+
+// UpdateInstanceWebhook calls memos.api.v1.InstanceService.UpdateInstanceWebhook.
+func (c *instanceServiceClient) UpdateInstanceWebhook(ctx context.Context, req *connect.Request[v1.UpdateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error) {
+	return c.updateInstanceWebhook.CallUnary(ctx, req)
+}
+
+// DeleteInstanceWebhook calls memos.api.v1.InstanceService.DeleteInstanceWebhook.
+func (c *instanceServiceClient) DeleteInstanceWebhook(ctx context.Context, req *connect.Request[v1.DeleteInstanceWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteInstanceWebhook.CallUnary(ctx, req)
+}
+
 // InstanceServiceHandler is an implementation of the memos.api.v1.InstanceService service.
 type InstanceServiceHandler interface {
 	// Gets the instance profile.
@@ -116,6 +219,18 @@ type InstanceServiceHandler interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(context.Context, *connect.Request[v1.ListInstanceJobsRequest]) (*connect.Response[v1.ListInstanceJobsResponse], error)
+	// Runs a background job immediately, outside of its schedule.
+	RunInstanceJob(context.Context, *connect.Request[v1.RunInstanceJobRequest]) (*connect.Response[v1.InstanceJob], error)
+	// Lists the instance webhooks. Only available to admins.
+	ListInstanceWebhooks(context.Context, *connect.Request[v1.ListInstanceWebhooksRequest]) (*connect.Response[v1.ListInstanceWebhooksResponse], error)
+	// Creates an instance webhook. Only available to admins.
+	CreateInstanceWebhook(context.Context, *connect.Request[v1.CreateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error)
+	// Updates an instance webhook. Only available to admins.
+	UpdateInstanceWebhook(context.Context, *connect.Request[v1.UpdateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error)
+	// Deletes an instance webhook. Only available to admins.
+	DeleteInstanceWebhook(context.Context, *connect.Request[v1.DeleteInstanceWebhookRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewInstanceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +258,42 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceListInstanceJobsHandler := connect.NewUnaryHandler(
+		InstanceServiceListInstanceJobsProcedure,
+		svc.ListInstanceJobs,
+		connect.WithSchema(instanceServiceMethods.ByName("ListInstanceJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceRunInstanceJobHandler := connect.NewUnaryHandler(
+		InstanceServiceRunInstanceJobProcedure,
+		svc.RunInstanceJob,
+		connect.WithSchema(instanceServiceMethods.ByName("RunInstanceJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListInstanceWebhooksHandler := connect.NewUnaryHandler(
+		InstanceServiceListInstanceWebhooksProcedure,
+		svc.ListInstanceWebhooks,
+		connect.WithSchema(instanceServiceMethods.ByName("ListInstanceWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateInstanceWebhookHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateInstanceWebhookProcedure,
+		svc.CreateInstanceWebhook,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateInstanceWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceUpdateInstanceWebhookHandler := connect.NewUnaryHandler(
+		InstanceServiceUpdateInstanceWebhookProcedure,
+		svc.UpdateInstanceWebhook,
+		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceDeleteInstanceWebhookHandler := connect.NewUnaryHandler(
+		InstanceServiceDeleteInstanceWebhookProcedure,
+		svc.DeleteInstanceWebhook,
+		connect.WithSchema(instanceServiceMethods.ByName("DeleteInstanceWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.InstanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InstanceServiceGetInstanceProfileProcedure:
@@ -151,6 +302,18 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceGetInstanceSettingHandler.ServeHTTP(w, r)
 		case InstanceServiceUpdateInstanceSettingProcedure:
 			instanceServiceUpdateInstanceSettingHandler.ServeHTTP(w, r)
+		case InstanceServiceListInstanceJobsProcedure:
+			instanceServiceListInstanceJobsHandler.ServeHTTP(w, r)
+		case InstanceServiceRunInstanceJobProcedure:
+			instanceServiceRunInstanceJobHandler.ServeHTTP(w, r)
+		case InstanceServiceListInstanceWebhooksProcedure:
+			instanceServiceListInstanceWebhooksHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateInstanceWebhookProcedure:
+			instanceServiceCreateInstanceWebhookHandler.ServeHTTP(w, r)
+		case InstanceServiceUpdateInstanceWebhookProcedure:
+			instanceServiceUpdateInstanceWebhookHandler.ServeHTTP(w, r)
+		case InstanceServiceDeleteInstanceWebhookProcedure:
+			instanceServiceDeleteInstanceWebhookHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +334,27 @@ func (UnimplementedInstanceServiceHandler) GetInstanceSetting(context.Context, *
 func (UnimplementedInstanceServiceHandler) UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.UpdateInstanceSetting is not implemented"))
 }
+
+func (UnimplementedInstanceServiceHandler) ListInstanceJobs(context.Context, *connect.Request[v1.ListInstanceJobsRequest]) (*connect.Response[v1.ListInstanceJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.ListInstanceJobs is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) RunInstanceJob(context.Context, *connect.Request[v1.RunInstanceJobRequest]) (*connect.Response[v1.InstanceJob], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.RunInstanceJob is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListInstanceWebhooks(context.Context, *connect.Request[v1.ListInstanceWebhooksRequest]) (*connect.Response[v1.ListInstanceWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.ListInstanceWebhooks is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateInstanceWebhook(context.Context, *connect.Request[v1.CreateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.CreateInstanceWebhook is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) UpdateInstanceWebhook(context.Context, *connect.Request[v1.UpdateInstanceWebhookRequest]) (*connect.Response[v1.InstanceWebhook], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.UpdateInstanceWebhook is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) DeleteInstanceWebhook(context.Context, *connect.Request[v1.DeleteInstanceWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.DeleteInstanceWebhook is not implemented"))
+}
@@ -71,6 +71,41 @@ const (
 	// MemoServiceDeleteMemoReactionProcedure is the fully-qualified name of the MemoService's
 	// DeleteMemoReaction RPC.
 	MemoServiceDeleteMemoReactionProcedure = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	// MemoServiceListMemoRevisionsProcedure is the fully-qualified name of the MemoService's
+	// ListMemoRevisions RPC.
+	MemoServiceListMemoRevisionsProcedure = "/memos.api.v1.MemoService/ListMemoRevisions"
+	// MemoServiceGetMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// GetMemoRevision RPC.
+	MemoServiceGetMemoRevisionProcedure = "/memos.api.v1.MemoService/GetMemoRevision"
+	// MemoServiceDiffMemoRevisionsProcedure is the fully-qualified name of the MemoService's
+	// DiffMemoRevisions RPC.
+	MemoServiceDiffMemoRevisionsProcedure = "/memos.api.v1.MemoService/DiffMemoRevisions"
+	// MemoServiceRestoreMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// RestoreMemoRevision RPC.
+	MemoServiceRestoreMemoRevisionProcedure = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	// MemoServiceListTasksProcedure is the fully-qualified name of the MemoService's ListTasks RPC.
+	MemoServiceListTasksProcedure = "/memos.api.v1.MemoService/ListTasks"
+	// MemoServiceSetTaskCompletedProcedure is the fully-qualified name of the MemoService's
+	// SetTaskCompleted RPC.
+	MemoServiceSetTaskCompletedProcedure = "/memos.api.v1.MemoService/SetTaskCompleted"
+	// MemoServiceArchiveMemoLinkProcedure is the fully-qualified name of the MemoService's
+	// ArchiveMemoLink RPC.
+	MemoServiceArchiveMemoLinkProcedure = "/memos.api.v1.MemoService/ArchiveMemoLink"
+	// MemoServiceCreateMemoReminderProcedure is the fully-qualified name of the MemoService's
+	// CreateMemoReminder RPC.
+	MemoServiceCreateMemoReminderProcedure = "/memos.api.v1.MemoService/CreateMemoReminder"
+	// MemoServiceListMemoRemindersProcedure is the fully-qualified name of the MemoService's
+	// ListMemoReminders RPC.
+	MemoServiceListMemoRemindersProcedure = "/memos.api.v1.MemoService/ListMemoReminders"
+	// MemoServiceUpdateMemoReminderProcedure is the fully-qualified name of the MemoService's
+	// UpdateMemoReminder RPC.
+	MemoServiceUpdateMemoReminderProcedure = "/memos.api.v1.MemoService/UpdateMemoReminder"
+	// MemoServiceDeleteMemoReminderProcedure is the fully-qualified name of the MemoService's
+	// DeleteMemoReminder RPC.
+	MemoServiceDeleteMemoReminderProcedure = "/memos.api.v1.MemoService/DeleteMemoReminder"
+	// MemoServiceGetMemoDigestProcedure is the fully-qualified name of the MemoService's GetMemoDigest
+	// RPC.
+	MemoServiceGetMemoDigestProcedure = "/memos.api.v1.MemoService/GetMemoDigest"
 )
 
 // MemoServiceClient is a client for the memos.api.v1.MemoService service.
@@ -103,6 +138,30 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoRevisions lists revisions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(context.Context, *connect.Request[v1.DiffMemoRevisionsRequest]) (*connect.Response[v1.DiffMemoRevisionsResponse], error)
+	// RestoreMemoRevision restores a memo to the content and visibility of a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
+	// ListTasks lists the task list items in the current user's memos.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// SetTaskCompleted checks or unchecks a task list item in a memo.
+	SetTaskCompleted(context.Context, *connect.Request[v1.SetTaskCompletedRequest]) (*connect.Response[v1.Task], error)
+	// ArchiveMemoLink saves a readable snapshot of a page linked from a memo as an attachment of the memo.
+	ArchiveMemoLink(context.Context, *connect.Request[v1.ArchiveMemoLinkRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateMemoReminder adds a reminder to a memo.
+	CreateMemoReminder(context.Context, *connect.Request[v1.CreateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error)
+	// ListMemoReminders lists the reminders of a memo, soonest first.
+	ListMemoReminders(context.Context, *connect.Request[v1.ListMemoRemindersRequest]) (*connect.Response[v1.ListMemoRemindersResponse], error)
+	// UpdateMemoReminder updates the time or recurrence of a reminder.
+	UpdateMemoReminder(context.Context, *connect.Request[v1.UpdateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error)
+	// DeleteMemoReminder deletes a reminder of a memo.
+	DeleteMemoReminder(context.Context, *connect.Request[v1.DeleteMemoReminderRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetMemoDigest returns the current user's memos to look back at on a day.
+	GetMemoDigest(context.Context, *connect.Request[v1.GetMemoDigestRequest]) (*connect.Response[v1.MemoDigest], error)
 }
 
 // NewMemoServiceClient constructs a client for the memos.api.v1.MemoService service. By default, it
@@ -200,6 +259,78 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 			connect.WithClientOptions(opts...),
 		),
+		listMemoRevisions: connect.NewClient[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoRevisionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoRevision: connect.NewClient[v1.GetMemoRevisionRequest, v1.MemoRevision](
+			httpClient,
+			baseURL+MemoServiceGetMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		diffMemoRevisions: connect.NewClient[v1.DiffMemoRevisionsRequest, v1.DiffMemoRevisionsResponse](
+			httpClient,
+			baseURL+MemoServiceDiffMemoRevisionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("DiffMemoRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreMemoRevision: connect.NewClient[v1.RestoreMemoRevisionRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRestoreMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		listTasks: connect.NewClient[v1.ListTasksRequest, v1.ListTasksResponse](
+			httpClient,
+			baseURL+MemoServiceListTasksProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		setTaskCompleted: connect.NewClient[v1.SetTaskCompletedRequest, v1.Task](
+			httpClient,
+			baseURL+MemoServiceSetTaskCompletedProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("SetTaskCompleted")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveMemoLink: connect.NewClient[v1.ArchiveMemoLinkRequest, v1.Attachment](
+			httpClient,
+			baseURL+MemoServiceArchiveMemoLinkProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ArchiveMemoLink")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoReminder: connect.NewClient[v1.CreateMemoReminderRequest, v1.MemoReminder](
+			httpClient,
+			baseURL+MemoServiceCreateMemoReminderProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CreateMemoReminder")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemoReminders: connect.NewClient[v1.ListMemoRemindersRequest, v1.ListMemoRemindersResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoRemindersProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoReminders")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemoReminder: connect.NewClient[v1.UpdateMemoReminderRequest, v1.MemoReminder](
+			httpClient,
+			baseURL+MemoServiceUpdateMemoReminderProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("UpdateMemoReminder")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMemoReminder: connect.NewClient[v1.DeleteMemoReminderRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoServiceDeleteMemoReminderProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReminder")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoDigest: connect.NewClient[v1.GetMemoDigestRequest, v1.MemoDigest](
+			httpClient,
+			baseURL+MemoServiceGetMemoDigestProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoDigest")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -219,6 +350,18 @@ type memoServiceClient struct {
 	listMemoReactions   *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
 	upsertMemoReaction  *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
 	deleteMemoReaction  *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	listMemoRevisions   *connect.Client[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse]
+	getMemoRevision     *connect.Client[v1.GetMemoRevisionRequest, v1.MemoRevision]
+	diffMemoRevisions   *connect.Client[v1.DiffMemoRevisionsRequest, v1.DiffMemoRevisionsResponse]
+	restoreMemoRevision *connect.Client[v1.RestoreMemoRevisionRequest, v1.Memo]
+	listTasks           *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	setTaskCompleted    *connect.Client[v1.SetTaskCompletedRequest, v1.Task]
+	archiveMemoLink     *connect.Client[v1.ArchiveMemoLinkRequest, v1.Attachment]
+	createMemoReminder  *connect.Client[v1.CreateMemoReminderRequest, v1.MemoReminder]
+	listMemoReminders   *connect.Client[v1.ListMemoRemindersRequest, v1.ListMemoRemindersResponse]
+	updateMemoReminder  *connect.Client[v1.UpdateMemoReminderRequest, v1.MemoReminder]
+	deleteMemoReminder  *connect.Client[v1.DeleteMemoReminderRequest, emptypb.Empty]
+	getMemoDigest       *connect.Client[v1.GetMemoDigestRequest, v1.MemoDigest]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -291,6 +434,66 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, req *connect
 	return c.deleteMemoReaction.CallUnary(ctx, req)
 }
 
+// ListMemoRevisions calls memos.api.v1.MemoService.ListMemoRevisions.
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, req *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return c.listMemoRevisions.CallUnary(ctx, req)
+}
+
+// GetMemoRevision calls memos.api.v1.MemoService.GetMemoRevision.
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, req *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return c.getMemoRevision.CallUnary(ctx, req)
+}
+
+// DiffMemoRevisions calls memos.api.v1.MemoService.DiffMemoRevisions.
+func (c *memoServiceClient) DiffMemoRevisions(ctx context.Context, req *connect.Request[v1.DiffMemoRevisionsRequest]) (*connect.Response[v1.DiffMemoRevisionsResponse], error) {
+	return c.diffMemoRevisions.CallUnary(ctx, req)
+}
+
+// RestoreMemoRevision calls memos.api.v1.MemoService.RestoreMemoRevision.
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return c.restoreMemoRevision.CallUnary(ctx, req)
+}
+
+// ListTasks calls memos.api.v1.MemoService.ListTasks.
+func (c *memoServiceClient) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return c.listTasks.CallUnary(ctx, req)
+}
+
+// SetTaskCompleted calls memos.api.v1.MemoService.SetTaskCompleted.
+func (c *memoServiceClient) SetTaskCompleted(ctx context.Context, req *connect.Request[v1.SetTaskCompletedRequest]) (*connect.Response[v1.Task], error) {
+	return c.setTaskCompleted.CallUnary(ctx, req)
+}
+
+// ArchiveMemoLink calls memos.api.v1.MemoService.ArchiveMemoLink.
+func (c *memoServiceClient) ArchiveMemoLink(ctx context.Context, req *connect.Request[v1.ArchiveMemoLinkRequest]) (*connect.Response[v1.Attachment], error) {
+	return c.archiveMemoLink.CallUnary(ctx, req)
+}
+
+// CreateMemoReminder calls memos.api.v1.MemoService.CreateMemoReminder.
+func (c *memoServiceClient) CreateMemoReminder(ctx context.Context, req *connect.Request[v1.CreateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error) {
+	return c.createMemoReminder.CallUnary(ctx, req)
+}
+
+// ListMemoReminders calls memos.api.v1.MemoService.ListMemoReminders.
+func (c *memoServiceClient) ListMemoReminders(ctx context.Context, req *connect.Request[v1.ListMemoRemindersRequest]) (*connect.Response[v1.ListMemoRemindersResponse], error) {
+	return c.listMemoReminders.CallUnary(ctx, req)
+}
+
+// UpdateMemoReminder calls memos.api.v1.MemoService.UpdateMemoReminder.
+func (c *memoServiceClient) UpdateMemoReminder(ctx context.Context, req *connect.Request[v1.UpdateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error) {
+	return c.updateMemoReminder.CallUnary(ctx, req)
+}
+
+// DeleteMemoReminder calls memos.api.v1.MemoService.DeleteMemoReminder.
+func (c *memoServiceClient) DeleteMemoReminder(ctx context.Context, req *connect.Request[v1.DeleteMemoReminderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteMemoReminder.CallUnary(ctx, req)
+}
+
+// GetMemoDigest calls memos.api.v1.MemoService.GetMemoDigest.
+func (c *memoServiceClient) GetMemoDigest(ctx context.Context, req *connect.Request[v1.GetMemoDigestRequest]) (*connect.Response[v1.MemoDigest], error) {
+	return c.getMemoDigest.CallUnary(ctx, req)
+}
+
 // MemoServiceHandler is an implementation of the memos.api.v1.MemoService service.
 type MemoServiceHandler interface {
 	// CreateMemo creates a memo.
@@ -321,6 +524,30 @@ type MemoServiceHandler interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoRevisions lists revisions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(context.Context, *connect.Request[v1.DiffMemoRevisionsRequest]) (*connect.Response[v1.DiffMemoRevisionsResponse], error)
+	// RestoreMemoRevision restores a memo to the content and visibility of a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
+	// ListTasks lists the task list items in the current user's memos.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// SetTaskCompleted checks or unchecks a task list item in a memo.
+	SetTaskCompleted(context.Context, *connect.Request[v1.SetTaskCompletedRequest]) (*connect.Response[v1.Task], error)
+	// ArchiveMemoLink saves a readable snapshot of a page linked from a memo as an attachment of the memo.
+	ArchiveMemoLink(context.Context, *connect.Request[v1.ArchiveMemoLinkRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateMemoReminder adds a reminder to a memo.
+	CreateMemoReminder(context.Context, *connect.Request[v1.CreateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error)
+	// ListMemoReminders lists the reminders of a memo, soonest first.
+	ListMemoReminders(context.Context, *connect.Request[v1.ListMemoRemindersRequest]) (*connect.Response[v1.ListMemoRemindersResponse], error)
+	// UpdateMemoReminder updates the time or recurrence of a reminder.
+	UpdateMemoReminder(context.Context, *connect.Request[v1.UpdateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error)
+	// DeleteMemoReminder deletes a reminder of a memo.
+	DeleteMemoReminder(context.Context, *connect.Request[v1.DeleteMemoReminderRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetMemoDigest returns the current user's memos to look back at on a day.
+	GetMemoDigest(context.Context, *connect.Request[v1.GetMemoDigestRequest]) (*connect.Response[v1.MemoDigest], error)
 }
 
 // NewMemoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -414,6 +641,78 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListMemoRevisionsHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoRevisionsProcedure,
+		svc.ListMemoRevisions,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoRevisionProcedure,
+		svc.GetMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceDiffMemoRevisionsHandler := connect.NewUnaryHandler(
+		MemoServiceDiffMemoRevisionsProcedure,
+		svc.DiffMemoRevisions,
+		connect.WithSchema(memoServiceMethods.ByName("DiffMemoRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRestoreMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceRestoreMemoRevisionProcedure,
+		svc.RestoreMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListTasksHandler := connect.NewUnaryHandler(
+		MemoServiceListTasksProcedure,
+		svc.ListTasks,
+		connect.WithSchema(memoServiceMethods.ByName("ListTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceSetTaskCompletedHandler := connect.NewUnaryHandler(
+		MemoServiceSetTaskCompletedProcedure,
+		svc.SetTaskCompleted,
+		connect.WithSchema(memoServiceMethods.ByName("SetTaskCompleted")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceArchiveMemoLinkHandler := connect.NewUnaryHandler(
+		MemoServiceArchiveMemoLinkProcedure,
+		svc.ArchiveMemoLink,
+		connect.WithSchema(memoServiceMethods.ByName("ArchiveMemoLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceCreateMemoReminderHandler := connect.NewUnaryHandler(
+		MemoServiceCreateMemoReminderProcedure,
+		svc.CreateMemoReminder,
+		connect.WithSchema(memoServiceMethods.ByName("CreateMemoReminder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemoRemindersHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoRemindersProcedure,
+		svc.ListMemoReminders,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoReminders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceUpdateMemoReminderHandler := connect.NewUnaryHandler(
+		MemoServiceUpdateMemoReminderProcedure,
+		svc.UpdateMemoReminder,
+		connect.WithSchema(memoServiceMethods.ByName("UpdateMemoReminder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceDeleteMemoReminderHandler := connect.NewUnaryHandler(
+		MemoServiceDeleteMemoReminderProcedure,
+		svc.DeleteMemoReminder,
+		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReminder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoDigestHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoDigestProcedure,
+		svc.GetMemoDigest,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoDigest")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.MemoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MemoServiceCreateMemoProcedure:
@@ -444,6 +743,30 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceUpsertMemoReactionHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoReactionProcedure:
 			memoServiceDeleteMemoReactionHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoRevisionsProcedure:
+			memoServiceListMemoRevisionsHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoRevisionProcedure:
+			memoServiceGetMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceDiffMemoRevisionsProcedure:
+			memoServiceDiffMemoRevisionsHandler.ServeHTTP(w, r)
+		case MemoServiceRestoreMemoRevisionProcedure:
+			memoServiceRestoreMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceListTasksProcedure:
+			memoServiceListTasksHandler.ServeHTTP(w, r)
+		case MemoServiceSetTaskCompletedProcedure:
+			memoServiceSetTaskCompletedHandler.ServeHTTP(w, r)
+		case MemoServiceArchiveMemoLinkProcedure:
+			memoServiceArchiveMemoLinkHandler.ServeHTTP(w, r)
+		case MemoServiceCreateMemoReminderProcedure:
+			memoServiceCreateMemoReminderHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoRemindersProcedure:
+			memoServiceListMemoRemindersHandler.ServeHTTP(w, r)
+		case MemoServiceUpdateMemoReminderProcedure:
+			memoServiceUpdateMemoReminderHandler.ServeHTTP(w, r)
+		case MemoServiceDeleteMemoReminderProcedure:
+			memoServiceDeleteMemoReminderHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoDigestProcedure:
+			memoServiceGetMemoDigestHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -508,3 +831,51 @@ func (UnimplementedMemoServiceHandler) UpsertMemoReaction(context.Context, *conn
 func (UnimplementedMemoServiceHandler) DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoReaction is not implemented"))
 }
+
+func (UnimplementedMemoServiceHandler) ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRevisions is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) DiffMemoRevisions(context.Context, *connect.Request[v1.DiffMemoRevisionsRequest]) (*connect.Response[v1.DiffMemoRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DiffMemoRevisions is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RestoreMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListTasks is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) SetTaskCompleted(context.Context, *connect.Request[v1.SetTaskCompletedRequest]) (*connect.Response[v1.Task], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SetTaskCompleted is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ArchiveMemoLink(context.Context, *connect.Request[v1.ArchiveMemoLinkRequest]) (*connect.Response[v1.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ArchiveMemoLink is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) CreateMemoReminder(context.Context, *connect.Request[v1.CreateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoReminder is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemoReminders(context.Context, *connect.Request[v1.ListMemoRemindersRequest]) (*connect.Response[v1.ListMemoRemindersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoReminders is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) UpdateMemoReminder(context.Context, *connect.Request[v1.UpdateMemoReminderRequest]) (*connect.Response[v1.MemoReminder], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.UpdateMemoReminder is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) DeleteMemoReminder(context.Context, *connect.Request[v1.DeleteMemoReminderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoReminder is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoDigest(context.Context, *connect.Request[v1.GetMemoDigestRequest]) (*connect.Response[v1.MemoDigest], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoDigest is not implemented"))
+}
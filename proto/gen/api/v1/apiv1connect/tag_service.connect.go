@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/tag_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "memos.api.v1.TagService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TagServiceListTagsProcedure is the fully-qualified name of the TagService's ListTags RPC.
+	TagServiceListTagsProcedure = "/memos.api.v1.TagService/ListTags"
+	// TagServiceUpdateTagProcedure is the fully-qualified name of the TagService's UpdateTag RPC.
+	TagServiceUpdateTagProcedure = "/memos.api.v1.TagService/UpdateTag"
+	// TagServiceRenameTagProcedure is the fully-qualified name of the TagService's RenameTag RPC.
+	TagServiceRenameTagProcedure = "/memos.api.v1.TagService/RenameTag"
+	// TagServiceMergeTagsProcedure is the fully-qualified name of the TagService's MergeTags RPC.
+	TagServiceMergeTagsProcedure = "/memos.api.v1.TagService/MergeTags"
+	// TagServiceDeleteTagProcedure is the fully-qualified name of the TagService's DeleteTag RPC.
+	TagServiceDeleteTagProcedure = "/memos.api.v1.TagService/DeleteTag"
+)
+
+// TagServiceClient is a client for the memos.api.v1.TagService service.
+type TagServiceClient interface {
+	// ListTags lists the tags of the current user as a tree of nested tags.
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// UpdateTag updates the color, description and pinned flag of a tag of the current user.
+	UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.Tag], error)
+	// RenameTag renames a tag and its nested tags in all memos of the current user.
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// MergeTags renames the source tags to the target tag in all memos of the current user.
+	MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error)
+	// DeleteTag removes a tag and its nested tags from all memos of the current user.
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+}
+
+// NewTagServiceClient constructs a client for the memos.api.v1.TagService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_api_v1_tag_service_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+TagServiceListTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTag: connect.NewClient[v1.UpdateTagRequest, v1.Tag](
+			httpClient,
+			baseURL+TagServiceUpdateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[v1.RenameTagRequest, v1.RenameTagResponse](
+			httpClient,
+			baseURL+TagServiceRenameTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeTags: connect.NewClient[v1.MergeTagsRequest, v1.MergeTagsResponse](
+			httpClient,
+			baseURL+TagServiceMergeTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("MergeTags")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+TagServiceDeleteTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	listTags  *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	updateTag *connect.Client[v1.UpdateTagRequest, v1.Tag]
+	renameTag *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
+	mergeTags *connect.Client[v1.MergeTagsRequest, v1.MergeTagsResponse]
+	deleteTag *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+}
+
+// ListTags calls memos.api.v1.TagService.ListTags.
+func (c *tagServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// UpdateTag calls memos.api.v1.TagService.UpdateTag.
+func (c *tagServiceClient) UpdateTag(ctx context.Context, req *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.Tag], error) {
+	return c.updateTag.CallUnary(ctx, req)
+}
+
+// RenameTag calls memos.api.v1.TagService.RenameTag.
+func (c *tagServiceClient) RenameTag(ctx context.Context, req *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return c.renameTag.CallUnary(ctx, req)
+}
+
+// MergeTags calls memos.api.v1.TagService.MergeTags.
+func (c *tagServiceClient) MergeTags(ctx context.Context, req *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error) {
+	return c.mergeTags.CallUnary(ctx, req)
+}
+
+// DeleteTag calls memos.api.v1.TagService.DeleteTag.
+func (c *tagServiceClient) DeleteTag(ctx context.Context, req *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return c.deleteTag.CallUnary(ctx, req)
+}
+
+// TagServiceHandler is an implementation of the memos.api.v1.TagService service.
+type TagServiceHandler interface {
+	// ListTags lists the tags of the current user as a tree of nested tags.
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// UpdateTag updates the color, description and pinned flag of a tag of the current user.
+	UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.Tag], error)
+	// RenameTag renames a tag and its nested tags in all memos of the current user.
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// MergeTags renames the source tags to the target tag in all memos of the current user.
+	MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error)
+	// DeleteTag removes a tag and its nested tags from all memos of the current user.
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_api_v1_tag_service_proto.Services().ByName("TagService").Methods()
+	tagServiceListTagsHandler := connect.NewUnaryHandler(
+		TagServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceUpdateTagHandler := connect.NewUnaryHandler(
+		TagServiceUpdateTagProcedure,
+		svc.UpdateTag,
+		connect.WithSchema(tagServiceMethods.ByName("UpdateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceRenameTagHandler := connect.NewUnaryHandler(
+		TagServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(tagServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceMergeTagsHandler := connect.NewUnaryHandler(
+		TagServiceMergeTagsProcedure,
+		svc.MergeTags,
+		connect.WithSchema(tagServiceMethods.ByName("MergeTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDeleteTagHandler := connect.NewUnaryHandler(
+		TagServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceListTagsProcedure:
+			tagServiceListTagsHandler.ServeHTTP(w, r)
+		case TagServiceUpdateTagProcedure:
+			tagServiceUpdateTagHandler.ServeHTTP(w, r)
+		case TagServiceRenameTagProcedure:
+			tagServiceRenameTagHandler.ServeHTTP(w, r)
+		case TagServiceMergeTagsProcedure:
+			tagServiceMergeTagsHandler.ServeHTTP(w, r)
+		case TagServiceDeleteTagProcedure:
+			tagServiceDeleteTagHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.TagService.ListTags is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) UpdateTag(context.Context, *connect.Request[v1.UpdateTagRequest]) (*connect.Response[v1.Tag], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.TagService.UpdateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.TagService.RenameTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.TagService.MergeTags is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.TagService.DeleteTag is not implemented"))
+}
@@ -68,6 +68,24 @@ const (
 	// UserServiceDeletePersonalAccessTokenProcedure is the fully-qualified name of the UserService's
 	// DeletePersonalAccessToken RPC.
 	UserServiceDeletePersonalAccessTokenProcedure = "/memos.api.v1.UserService/DeletePersonalAccessToken"
+	// UserServiceGetUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// GetUserTwoFactor RPC.
+	UserServiceGetUserTwoFactorProcedure = "/memos.api.v1.UserService/GetUserTwoFactor"
+	// UserServiceSetupUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// SetupUserTwoFactor RPC.
+	UserServiceSetupUserTwoFactorProcedure = "/memos.api.v1.UserService/SetupUserTwoFactor"
+	// UserServiceEnableUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// EnableUserTwoFactor RPC.
+	UserServiceEnableUserTwoFactorProcedure = "/memos.api.v1.UserService/EnableUserTwoFactor"
+	// UserServiceDisableUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// DisableUserTwoFactor RPC.
+	UserServiceDisableUserTwoFactorProcedure = "/memos.api.v1.UserService/DisableUserTwoFactor"
+	// UserServiceListUserPasskeysProcedure is the fully-qualified name of the UserService's
+	// ListUserPasskeys RPC.
+	UserServiceListUserPasskeysProcedure = "/memos.api.v1.UserService/ListUserPasskeys"
+	// UserServiceDeleteUserPasskeyProcedure is the fully-qualified name of the UserService's
+	// DeleteUserPasskey RPC.
+	UserServiceDeleteUserPasskeyProcedure = "/memos.api.v1.UserService/DeleteUserPasskey"
 	// UserServiceListUserWebhooksProcedure is the fully-qualified name of the UserService's
 	// ListUserWebhooks RPC.
 	UserServiceListUserWebhooksProcedure = "/memos.api.v1.UserService/ListUserWebhooks"
@@ -80,6 +98,12 @@ const (
 	// UserServiceDeleteUserWebhookProcedure is the fully-qualified name of the UserService's
 	// DeleteUserWebhook RPC.
 	UserServiceDeleteUserWebhookProcedure = "/memos.api.v1.UserService/DeleteUserWebhook"
+	// UserServiceListUserWebhookDeliveriesProcedure is the fully-qualified name of the UserService's
+	// ListUserWebhookDeliveries RPC.
+	UserServiceListUserWebhookDeliveriesProcedure = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	// UserServiceRedeliverUserWebhookDeliveryProcedure is the fully-qualified name of the UserService's
+	// RedeliverUserWebhookDelivery RPC.
+	UserServiceRedeliverUserWebhookDeliveryProcedure = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
 	// UserServiceListUserNotificationsProcedure is the fully-qualified name of the UserService's
 	// ListUserNotifications RPC.
 	UserServiceListUserNotificationsProcedure = "/memos.api.v1.UserService/ListUserNotifications"
@@ -124,6 +148,22 @@ type UserServiceClient interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user.
+	// The secret stays pending until it is confirmed with EnableUserTwoFactor.
+	SetupUserTwoFactor(context.Context, *connect.Request[v1.SetupUserTwoFactorRequest]) (*connect.Response[v1.SetupUserTwoFactorResponse], error)
+	// EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+	// The recovery codes are only returned once upon enabling.
+	EnableUserTwoFactor(context.Context, *connect.Request[v1.EnableUserTwoFactorRequest]) (*connect.Response[v1.EnableUserTwoFactorResponse], error)
+	// DisableUserTwoFactor disables two-factor authentication of a user.
+	// Users confirm with a TOTP or recovery code, admins can reset other users without a code.
+	DisableUserTwoFactor(context.Context, *connect.Request[v1.DisableUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPasskeys returns the passkeys of a user.
+	// Passkeys are registered with AuthService.BeginPasskeyRegistration and FinishPasskeyRegistration.
+	ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error)
+	// DeleteUserPasskey deletes a passkey of a user.
+	DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -132,6 +172,11 @@ type UserServiceClient interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserWebhookDeliveries lists recent deliveries of a webhook.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery queues the payload of a delivery to be sent again.
+	// A new delivery is created so the original attempt stays in the delivery log.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -229,6 +274,42 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 			connect.WithClientOptions(opts...),
 		),
+		getUserTwoFactor: connect.NewClient[v1.GetUserTwoFactorRequest, v1.UserTwoFactor](
+			httpClient,
+			baseURL+UserServiceGetUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		setupUserTwoFactor: connect.NewClient[v1.SetupUserTwoFactorRequest, v1.SetupUserTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceSetupUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetupUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		enableUserTwoFactor: connect.NewClient[v1.EnableUserTwoFactorRequest, v1.EnableUserTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceEnableUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnableUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		disableUserTwoFactor: connect.NewClient[v1.DisableUserTwoFactorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDisableUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserPasskeys: connect.NewClient[v1.ListUserPasskeysRequest, v1.ListUserPasskeysResponse](
+			httpClient,
+			baseURL+UserServiceListUserPasskeysProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserPasskeys")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserPasskey: connect.NewClient[v1.DeleteUserPasskeyRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserPasskeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserPasskey")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserWebhooks: connect.NewClient[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse](
 			httpClient,
 			baseURL+UserServiceListUserWebhooksProcedure,
@@ -253,6 +334,18 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listUserWebhookDeliveries: connect.NewClient[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+UserServiceListUserWebhookDeliveriesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverUserWebhookDelivery: connect.NewClient[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery](
+			httpClient,
+			baseURL+UserServiceRedeliverUserWebhookDeliveryProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserNotifications: connect.NewClient[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse](
 			httpClient,
 			baseURL+UserServiceListUserNotificationsProcedure,
@@ -276,26 +369,34 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	getUser                   *connect.Client[v1.GetUserRequest, v1.User]
-	createUser                *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser                *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser                *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	listAllUserStats          *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
-	getUserStats              *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
-	getUserSetting            *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
-	updateUserSetting         *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
-	listUserSettings          *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
-	listPersonalAccessTokens  *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
-	createPersonalAccessToken *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
-	deletePersonalAccessToken *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
-	listUserWebhooks          *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
-	createUserWebhook         *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
-	updateUserWebhook         *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
-	deleteUserWebhook         *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
-	listUserNotifications     *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
-	updateUserNotification    *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
-	deleteUserNotification    *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	listUsers                    *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser                      *connect.Client[v1.GetUserRequest, v1.User]
+	createUser                   *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                   *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                   *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	listAllUserStats             *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
+	getUserStats                 *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
+	getUserSetting               *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
+	updateUserSetting            *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
+	listUserSettings             *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
+	listPersonalAccessTokens     *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
+	createPersonalAccessToken    *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
+	deletePersonalAccessToken    *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
+	getUserTwoFactor             *connect.Client[v1.GetUserTwoFactorRequest, v1.UserTwoFactor]
+	setupUserTwoFactor           *connect.Client[v1.SetupUserTwoFactorRequest, v1.SetupUserTwoFactorResponse]
+	enableUserTwoFactor          *connect.Client[v1.EnableUserTwoFactorRequest, v1.EnableUserTwoFactorResponse]
+	disableUserTwoFactor         *connect.Client[v1.DisableUserTwoFactorRequest, emptypb.Empty]
+	listUserPasskeys             *connect.Client[v1.ListUserPasskeysRequest, v1.ListUserPasskeysResponse]
+	deleteUserPasskey            *connect.Client[v1.DeleteUserPasskeyRequest, emptypb.Empty]
+	listUserWebhooks             *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
+	createUserWebhook            *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
+	updateUserWebhook            *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
+	deleteUserWebhook            *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
+	listUserWebhookDeliveries    *connect.Client[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse]
+	redeliverUserWebhookDelivery *connect.Client[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery]
+	listUserNotifications        *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
+	updateUserNotification       *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
+	deleteUserNotification       *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
 }
 
 // ListUsers calls memos.api.v1.UserService.ListUsers.
@@ -363,6 +464,36 @@ func (c *userServiceClient) DeletePersonalAccessToken(ctx context.Context, req *
 	return c.deletePersonalAccessToken.CallUnary(ctx, req)
 }
 
+// GetUserTwoFactor calls memos.api.v1.UserService.GetUserTwoFactor.
+func (c *userServiceClient) GetUserTwoFactor(ctx context.Context, req *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error) {
+	return c.getUserTwoFactor.CallUnary(ctx, req)
+}
+
+// SetupUserTwoFactor calls memos.api.v1.UserService.SetupUserTwoFactor.
+func (c *userServiceClient) SetupUserTwoFactor(ctx context.Context, req *connect.Request[v1.SetupUserTwoFactorRequest]) (*connect.Response[v1.SetupUserTwoFactorResponse], error) {
+	return c.setupUserTwoFactor.CallUnary(ctx, req)
+}
+
+// EnableUserTwoFactor calls memos.api.v1.UserService.EnableUserTwoFactor.
+func (c *userServiceClient) EnableUserTwoFactor(ctx context.Context, req *connect.Request[v1.EnableUserTwoFactorRequest]) (*connect.Response[v1.EnableUserTwoFactorResponse], error) {
+	return c.enableUserTwoFactor.CallUnary(ctx, req)
+}
+
+// DisableUserTwoFactor calls memos.api.v1.UserService.DisableUserTwoFactor.
+func (c *userServiceClient) DisableUserTwoFactor(ctx context.Context, req *connect.Request[v1.DisableUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.disableUserTwoFactor.CallUnary(ctx, req)
+}
+
+// ListUserPasskeys calls memos.api.v1.UserService.ListUserPasskeys.
+func (c *userServiceClient) ListUserPasskeys(ctx context.Context, req *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error) {
+	return c.listUserPasskeys.CallUnary(ctx, req)
+}
+
+// DeleteUserPasskey calls memos.api.v1.UserService.DeleteUserPasskey.
+func (c *userServiceClient) DeleteUserPasskey(ctx context.Context, req *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserPasskey.CallUnary(ctx, req)
+}
+
 // ListUserWebhooks calls memos.api.v1.UserService.ListUserWebhooks.
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, req *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return c.listUserWebhooks.CallUnary(ctx, req)
@@ -383,6 +514,16 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, req *connect.
 	return c.deleteUserWebhook.CallUnary(ctx, req)
 }
 
+// ListUserWebhookDeliveries calls memos.api.v1.UserService.ListUserWebhookDeliveries.
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return c.listUserWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RedeliverUserWebhookDelivery calls memos.api.v1.UserService.RedeliverUserWebhookDelivery.
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return c.redeliverUserWebhookDelivery.CallUnary(ctx, req)
+}
+
 // ListUserNotifications calls memos.api.v1.UserService.ListUserNotifications.
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, req *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return c.listUserNotifications.CallUnary(ctx, req)
@@ -431,6 +572,22 @@ type UserServiceHandler interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user.
+	// The secret stays pending until it is confirmed with EnableUserTwoFactor.
+	SetupUserTwoFactor(context.Context, *connect.Request[v1.SetupUserTwoFactorRequest]) (*connect.Response[v1.SetupUserTwoFactorResponse], error)
+	// EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+	// The recovery codes are only returned once upon enabling.
+	EnableUserTwoFactor(context.Context, *connect.Request[v1.EnableUserTwoFactorRequest]) (*connect.Response[v1.EnableUserTwoFactorResponse], error)
+	// DisableUserTwoFactor disables two-factor authentication of a user.
+	// Users confirm with a TOTP or recovery code, admins can reset other users without a code.
+	DisableUserTwoFactor(context.Context, *connect.Request[v1.DisableUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPasskeys returns the passkeys of a user.
+	// Passkeys are registered with AuthService.BeginPasskeyRegistration and FinishPasskeyRegistration.
+	ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error)
+	// DeleteUserPasskey deletes a passkey of a user.
+	DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -439,6 +596,11 @@ type UserServiceHandler interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserWebhookDeliveries lists recent deliveries of a webhook.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery queues the payload of a delivery to be sent again.
+	// A new delivery is created so the original attempt stays in the delivery log.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -532,6 +694,42 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceGetUserTwoFactorProcedure,
+		svc.GetUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("GetUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetupUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceSetupUserTwoFactorProcedure,
+		svc.SetupUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("SetupUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnableUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceEnableUserTwoFactorProcedure,
+		svc.EnableUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("EnableUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceDisableUserTwoFactorProcedure,
+		svc.DisableUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("DisableUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserPasskeysHandler := connect.NewUnaryHandler(
+		UserServiceListUserPasskeysProcedure,
+		svc.ListUserPasskeys,
+		connect.WithSchema(userServiceMethods.ByName("ListUserPasskeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserPasskeyHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserPasskeyProcedure,
+		svc.DeleteUserPasskey,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserPasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserWebhooksHandler := connect.NewUnaryHandler(
 		UserServiceListUserWebhooksProcedure,
 		svc.ListUserWebhooks,
@@ -556,6 +754,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListUserWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		UserServiceListUserWebhookDeliveriesProcedure,
+		svc.ListUserWebhookDeliveries,
+		connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRedeliverUserWebhookDeliveryHandler := connect.NewUnaryHandler(
+		UserServiceRedeliverUserWebhookDeliveryProcedure,
+		svc.RedeliverUserWebhookDelivery,
+		connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserNotificationsHandler := connect.NewUnaryHandler(
 		UserServiceListUserNotificationsProcedure,
 		svc.ListUserNotifications,
@@ -602,6 +812,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceCreatePersonalAccessTokenHandler.ServeHTTP(w, r)
 		case UserServiceDeletePersonalAccessTokenProcedure:
 			userServiceDeletePersonalAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceGetUserTwoFactorProcedure:
+			userServiceGetUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceSetupUserTwoFactorProcedure:
+			userServiceSetupUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceEnableUserTwoFactorProcedure:
+			userServiceEnableUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceDisableUserTwoFactorProcedure:
+			userServiceDisableUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceListUserPasskeysProcedure:
+			userServiceListUserPasskeysHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserPasskeyProcedure:
+			userServiceDeleteUserPasskeyHandler.ServeHTTP(w, r)
 		case UserServiceListUserWebhooksProcedure:
 			userServiceListUserWebhooksHandler.ServeHTTP(w, r)
 		case UserServiceCreateUserWebhookProcedure:
@@ -610,6 +832,10 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserWebhookHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserWebhookProcedure:
 			userServiceDeleteUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceListUserWebhookDeliveriesProcedure:
+			userServiceListUserWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case UserServiceRedeliverUserWebhookDeliveryProcedure:
+			userServiceRedeliverUserWebhookDeliveryHandler.ServeHTTP(w, r)
 		case UserServiceListUserNotificationsProcedure:
 			userServiceListUserNotificationsHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserNotificationProcedure:
@@ -677,6 +903,30 @@ func (UnimplementedUserServiceHandler) DeletePersonalAccessToken(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeletePersonalAccessToken is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetupUserTwoFactor(context.Context, *connect.Request[v1.SetupUserTwoFactorRequest]) (*connect.Response[v1.SetupUserTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.SetupUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnableUserTwoFactor(context.Context, *connect.Request[v1.EnableUserTwoFactorRequest]) (*connect.Response[v1.EnableUserTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.EnableUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableUserTwoFactor(context.Context, *connect.Request[v1.DisableUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DisableUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserPasskeys is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserPasskey is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhooks is not implemented"))
 }
@@ -693,6 +943,14 @@ func (UnimplementedUserServiceHandler) DeleteUserWebhook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserWebhook is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RedeliverUserWebhookDelivery is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserNotifications is not implemented"))
 }
@@ -113,6 +113,8 @@ type SignInRequest struct {
 	//
 	//	*SignInRequest_PasswordCredentials_
 	//	*SignInRequest_SsoCredentials
+	//	*SignInRequest_TwoFactorCredentials_
+	//	*SignInRequest_PasskeyCredentials_
 	Credentials   isSignInRequest_Credentials `protobuf_oneof:"credentials"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -173,6 +175,24 @@ func (x *SignInRequest) GetSsoCredentials() *SignInRequest_SSOCredentials {
 	return nil
 }
 
+func (x *SignInRequest) GetTwoFactorCredentials() *SignInRequest_TwoFactorCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*SignInRequest_TwoFactorCredentials_); ok {
+			return x.TwoFactorCredentials
+		}
+	}
+	return nil
+}
+
+func (x *SignInRequest) GetPasskeyCredentials() *SignInRequest_PasskeyCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*SignInRequest_PasskeyCredentials_); ok {
+			return x.PasskeyCredentials
+		}
+	}
+	return nil
+}
+
 type isSignInRequest_Credentials interface {
 	isSignInRequest_Credentials()
 }
@@ -187,10 +207,24 @@ type SignInRequest_SsoCredentials struct {
 	SsoCredentials *SignInRequest_SSOCredentials `protobuf:"bytes,2,opt,name=sso_credentials,json=ssoCredentials,proto3,oneof"`
 }
 
+type SignInRequest_TwoFactorCredentials_ struct {
+	// Two-factor authentication, after a password sign-in returned a two-factor token.
+	TwoFactorCredentials *SignInRequest_TwoFactorCredentials `protobuf:"bytes,3,opt,name=two_factor_credentials,json=twoFactorCredentials,proto3,oneof"`
+}
+
+type SignInRequest_PasskeyCredentials_ struct {
+	// Passkey authentication, as the only credential or as the second factor of a password sign-in.
+	PasskeyCredentials *SignInRequest_PasskeyCredentials `protobuf:"bytes,4,opt,name=passkey_credentials,json=passkeyCredentials,proto3,oneof"`
+}
+
 func (*SignInRequest_PasswordCredentials_) isSignInRequest_Credentials() {}
 
 func (*SignInRequest_SsoCredentials) isSignInRequest_Credentials() {}
 
+func (*SignInRequest_TwoFactorCredentials_) isSignInRequest_Credentials() {}
+
+func (*SignInRequest_PasskeyCredentials_) isSignInRequest_Credentials() {}
+
 type SignInResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The authenticated user's information.
@@ -201,8 +235,12 @@ type SignInResponse struct {
 	// When the access token expires.
 	// Client should call RefreshToken before this time.
 	AccessTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Set instead of the access token when the user has two-factor authentication enabled.
+	// Sign in again with two_factor_credentials holding this token and a TOTP or recovery code.
+	// The token expires after five minutes.
+	TwoFactorToken string `protobuf:"bytes,4,opt,name=two_factor_token,json=twoFactorToken,proto3" json:"two_factor_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SignInResponse) Reset() {
@@ -256,6 +294,13 @@ func (x *SignInResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SignInResponse) GetTwoFactorToken() string {
+	if x != nil {
+		return x.TwoFactorToken
+	}
+	return ""
+}
+
 type SignOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -382,6 +427,479 @@ func (x *RefreshTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+type BeginPasskeyRegistrationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The options for navigator.credentials.create().
+	Options *PasskeyCreationOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// The session token to send with FinishPasskeyRegistration.
+	// The token expires after five minutes.
+	SessionToken  string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationResponse) Reset() {
+	*x = BeginPasskeyRegistrationResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetOptions() *PasskeyCreationOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BeginPasskeyRegistrationResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// PasskeyCreationOptions are the PublicKeyCredentialCreationOptions of a passkey registration.
+type PasskeyCreationOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge to sign.
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The relying party ID, the host name of the instance.
+	RpId string `protobuf:"bytes,2,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// The relying party name shown by the authenticator.
+	RpName string `protobuf:"bytes,3,opt,name=rp_name,json=rpName,proto3" json:"rp_name,omitempty"`
+	// The user handle stored with the credential.
+	UserId []byte `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The username of the user.
+	UserName string `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// The display name of the user.
+	UserDisplayName string `protobuf:"bytes,6,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// The accepted COSE algorithms, in order of preference.
+	Algorithms []int64 `protobuf:"varint,7,rep,packed,name=algorithms,proto3" json:"algorithms,omitempty"`
+	// The IDs of the credentials the user already registered.
+	ExcludeCredentialIds [][]byte `protobuf:"bytes,8,rep,name=exclude_credential_ids,json=excludeCredentialIds,proto3" json:"exclude_credential_ids,omitempty"`
+	// The time the user has to complete the ceremony in milliseconds.
+	TimeoutMs     uint32 `protobuf:"varint,9,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeyCreationOptions) Reset() {
+	*x = PasskeyCreationOptions{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyCreationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyCreationOptions) ProtoMessage() {}
+
+func (x *PasskeyCreationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyCreationOptions.ProtoReflect.Descriptor instead.
+func (*PasskeyCreationOptions) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PasskeyCreationOptions) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *PasskeyCreationOptions) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *PasskeyCreationOptions) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
+}
+
+func (x *PasskeyCreationOptions) GetUserId() []byte {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *PasskeyCreationOptions) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PasskeyCreationOptions) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *PasskeyCreationOptions) GetAlgorithms() []int64 {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *PasskeyCreationOptions) GetExcludeCredentialIds() [][]byte {
+	if x != nil {
+		return x.ExcludeCredentialIds
+	}
+	return nil
+}
+
+func (x *PasskeyCreationOptions) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type FinishPasskeyRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The session token returned by BeginPasskeyRegistration.
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// The client data JSON of the attestation.
+	ClientDataJson []byte `protobuf:"bytes,2,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	// The attestation object of the attestation.
+	AttestationObject []byte `protobuf:"bytes,3,opt,name=attestation_object,json=attestationObject,proto3" json:"attestation_object,omitempty"`
+	// Optional. A name to recognize the passkey by.
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Optional. The transports reported by getTransports(), e.g. "internal" or "usb".
+	Transports    []string `protobuf:"bytes,5,rep,name=transports,proto3" json:"transports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetClientDataJson() []byte {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetAttestationObject() []byte {
+	if x != nil {
+		return x.AttestationObject
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+type BeginPasskeySignInRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The two-factor token of a password sign-in, to use a passkey as the second factor.
+	// Without it the sign-in uses discoverable credentials and requires user verification.
+	TwoFactorToken string `protobuf:"bytes,1,opt,name=two_factor_token,json=twoFactorToken,proto3" json:"two_factor_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BeginPasskeySignInRequest) Reset() {
+	*x = BeginPasskeySignInRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignInRequest) ProtoMessage() {}
+
+func (x *BeginPasskeySignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeySignInRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeySignInRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BeginPasskeySignInRequest) GetTwoFactorToken() string {
+	if x != nil {
+		return x.TwoFactorToken
+	}
+	return ""
+}
+
+type BeginPasskeySignInResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The options for navigator.credentials.get().
+	Options *PasskeyRequestOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// The session token to send with SignIn in passkey_credentials.
+	// The token expires after five minutes.
+	SessionToken  string `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeySignInResponse) Reset() {
+	*x = BeginPasskeySignInResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeySignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeySignInResponse) ProtoMessage() {}
+
+func (x *BeginPasskeySignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeySignInResponse.ProtoReflect.Descriptor instead.
+func (*BeginPasskeySignInResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BeginPasskeySignInResponse) GetOptions() *PasskeyRequestOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BeginPasskeySignInResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// PasskeyRequestOptions are the PublicKeyCredentialRequestOptions of a passkey sign-in.
+type PasskeyRequestOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge to sign.
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The relying party ID, the host name of the instance.
+	RpId string `protobuf:"bytes,2,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// The IDs of the credentials that may be used, empty for discoverable credentials.
+	AllowCredentialIds [][]byte `protobuf:"bytes,3,rep,name=allow_credential_ids,json=allowCredentialIds,proto3" json:"allow_credential_ids,omitempty"`
+	// The user verification requirement, "required" or "preferred".
+	UserVerification string `protobuf:"bytes,4,opt,name=user_verification,json=userVerification,proto3" json:"user_verification,omitempty"`
+	// The time the user has to complete the ceremony in milliseconds.
+	TimeoutMs     uint32 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeyRequestOptions) Reset() {
+	*x = PasskeyRequestOptions{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyRequestOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyRequestOptions) ProtoMessage() {}
+
+func (x *PasskeyRequestOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyRequestOptions.ProtoReflect.Descriptor instead.
+func (*PasskeyRequestOptions) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PasskeyRequestOptions) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *PasskeyRequestOptions) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *PasskeyRequestOptions) GetAllowCredentialIds() [][]byte {
+	if x != nil {
+		return x.AllowCredentialIds
+	}
+	return nil
+}
+
+func (x *PasskeyRequestOptions) GetUserVerification() string {
+	if x != nil {
+		return x.UserVerification
+	}
+	return ""
+}
+
+func (x *PasskeyRequestOptions) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
 // Nested message for password-based authentication credentials.
 type SignInRequest_PasswordCredentials struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -395,7 +913,7 @@ type SignInRequest_PasswordCredentials struct {
 
 func (x *SignInRequest_PasswordCredentials) Reset() {
 	*x = SignInRequest_PasswordCredentials{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +925,7 @@ func (x *SignInRequest_PasswordCredentials) String() string {
 func (*SignInRequest_PasswordCredentials) ProtoMessage() {}
 
 func (x *SignInRequest_PasswordCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,14 +966,17 @@ type SignInRequest_SSOCredentials struct {
 	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
 	// The PKCE code verifier for enhanced security (RFC 7636).
 	// Optional - enables PKCE flow protection against authorization code interception.
-	CodeVerifier  string `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	CodeVerifier string `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	// The nonce sent in the authorization request.
+	// Required for OpenID Connect providers, the ID token must contain the same nonce.
+	Nonce         string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignInRequest_SSOCredentials) Reset() {
 	*x = SignInRequest_SSOCredentials{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	mi := &file_api_v1_auth_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +988,7 @@ func (x *SignInRequest_SSOCredentials) String() string {
 func (*SignInRequest_SSOCredentials) ProtoMessage() {}
 
 func (x *SignInRequest_SSOCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	mi := &file_api_v1_auth_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,6 +1032,160 @@ func (x *SignInRequest_SSOCredentials) GetCodeVerifier() string {
 	return ""
 }
 
+func (x *SignInRequest_SSOCredentials) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+// Nested message for the second step of a password sign-in with two-factor authentication.
+type SignInRequest_TwoFactorCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The two-factor token returned by the password sign-in.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// A current TOTP code or an unused recovery code.
+	// After 5 invalid codes, codes are rejected with RESOURCE_EXHAUSTED for 15 minutes.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInRequest_TwoFactorCredentials) Reset() {
+	*x = SignInRequest_TwoFactorCredentials{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInRequest_TwoFactorCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInRequest_TwoFactorCredentials) ProtoMessage() {}
+
+func (x *SignInRequest_TwoFactorCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInRequest_TwoFactorCredentials.ProtoReflect.Descriptor instead.
+func (*SignInRequest_TwoFactorCredentials) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *SignInRequest_TwoFactorCredentials) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SignInRequest_TwoFactorCredentials) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Nested message for the response of navigator.credentials.get() to a passkey sign-in.
+type SignInRequest_PasskeyCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The session token returned by BeginPasskeySignIn.
+	SessionToken string `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// The raw ID of the credential.
+	CredentialId []byte `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// The client data JSON of the assertion.
+	ClientDataJson []byte `protobuf:"bytes,3,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	// The authenticator data of the assertion.
+	AuthenticatorData []byte `protobuf:"bytes,4,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	// The signature of the assertion.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The user handle of the assertion, set by discoverable credentials.
+	UserHandle    []byte `protobuf:"bytes,6,opt,name=user_handle,json=userHandle,proto3" json:"user_handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInRequest_PasskeyCredentials) Reset() {
+	*x = SignInRequest_PasskeyCredentials{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInRequest_PasskeyCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInRequest_PasskeyCredentials) ProtoMessage() {}
+
+func (x *SignInRequest_PasskeyCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInRequest_PasskeyCredentials.ProtoReflect.Descriptor instead.
+func (*SignInRequest_PasskeyCredentials) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetCredentialId() []byte {
+	if x != nil {
+		return x.CredentialId
+	}
+	return nil
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetClientDataJson() []byte {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return nil
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetAuthenticatorData() []byte {
+	if x != nil {
+		return x.AuthenticatorData
+	}
+	return nil
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SignInRequest_PasskeyCredentials) GetUserHandle() []byte {
+	if x != nil {
+		return x.UserHandle
+	}
+	return nil
+}
+
 var File_api_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_service_proto_rawDesc = "" +
@@ -518,33 +1193,88 @@ const file_api_v1_auth_service_proto_rawDesc = "" +
 	"\x19api/v1/auth_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x17\n" +
 	"\x15GetCurrentUserRequest\"@\n" +
 	"\x16GetCurrentUserResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\"\xce\x03\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\"\x99\b\n" +
 	"\rSignInRequest\x12d\n" +
 	"\x14password_credentials\x18\x01 \x01(\v2/.memos.api.v1.SignInRequest.PasswordCredentialsH\x00R\x13passwordCredentials\x12U\n" +
-	"\x0fsso_credentials\x18\x02 \x01(\v2*.memos.api.v1.SignInRequest.SSOCredentialsH\x00R\x0essoCredentials\x1aW\n" +
+	"\x0fsso_credentials\x18\x02 \x01(\v2*.memos.api.v1.SignInRequest.SSOCredentialsH\x00R\x0essoCredentials\x12h\n" +
+	"\x16two_factor_credentials\x18\x03 \x01(\v20.memos.api.v1.SignInRequest.TwoFactorCredentialsH\x00R\x14twoFactorCredentials\x12a\n" +
+	"\x13passkey_credentials\x18\x04 \x01(\v2..memos.api.v1.SignInRequest.PasskeyCredentialsH\x00R\x12passkeyCredentials\x1aW\n" +
 	"\x13PasswordCredentials\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tB\x03\xe0A\x02R\busername\x12\x1f\n" +
-	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1a\x97\x01\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1a\xb2\x01\n" +
 	"\x0eSSOCredentials\x12\x1a\n" +
 	"\x06idp_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05idpId\x12\x17\n" +
 	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\x12&\n" +
 	"\fredirect_uri\x18\x03 \x01(\tB\x03\xe0A\x02R\vredirectUri\x12(\n" +
-	"\rcode_verifier\x18\x04 \x01(\tB\x03\xe0A\x01R\fcodeVerifierB\r\n" +
-	"\vcredentials\"\xae\x01\n" +
+	"\rcode_verifier\x18\x04 \x01(\tB\x03\xe0A\x01R\fcodeVerifier\x12\x19\n" +
+	"\x05nonce\x18\x05 \x01(\tB\x03\xe0A\x01R\x05nonce\x1aJ\n" +
+	"\x14TwoFactorCredentials\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\x1a\x94\x02\n" +
+	"\x12PasskeyCredentials\x12(\n" +
+	"\rsession_token\x18\x01 \x01(\tB\x03\xe0A\x02R\fsessionToken\x12(\n" +
+	"\rcredential_id\x18\x02 \x01(\fB\x03\xe0A\x02R\fcredentialId\x12-\n" +
+	"\x10client_data_json\x18\x03 \x01(\fB\x03\xe0A\x02R\x0eclientDataJson\x122\n" +
+	"\x12authenticator_data\x18\x04 \x01(\fB\x03\xe0A\x02R\x11authenticatorData\x12!\n" +
+	"\tsignature\x18\x05 \x01(\fB\x03\xe0A\x02R\tsignature\x12$\n" +
+	"\vuser_handle\x18\x06 \x01(\fB\x03\xe0A\x01R\n" +
+	"userHandleB\r\n" +
+	"\vcredentials\"\xd8\x01\n" +
 	"\x0eSignInResponse\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12Q\n" +
-	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\"\x10\n" +
+	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12(\n" +
+	"\x10two_factor_token\x18\x04 \x01(\tR\x0etwoFactorToken\"\x10\n" +
 	"\x0eSignOutRequest\"\x15\n" +
 	"\x13RefreshTokenRequest\"t\n" +
 	"\x14RefreshTokenResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x129\n" +
 	"\n" +
-	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\xbf\x03\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"!\n" +
+	"\x1fBeginPasskeyRegistrationRequest\"\x87\x01\n" +
+	" BeginPasskeyRegistrationResponse\x12>\n" +
+	"\aoptions\x18\x01 \x01(\v2$.memos.api.v1.PasskeyCreationOptionsR\aoptions\x12#\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\"\xbb\x02\n" +
+	"\x16PasskeyCreationOptions\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\fR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x02 \x01(\tR\x04rpId\x12\x17\n" +
+	"\arp_name\x18\x03 \x01(\tR\x06rpName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\fR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\x12*\n" +
+	"\x11user_display_name\x18\x06 \x01(\tR\x0fuserDisplayName\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\a \x03(\x03R\n" +
+	"algorithms\x124\n" +
+	"\x16exclude_credential_ids\x18\b \x03(\fR\x14excludeCredentialIds\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\t \x01(\rR\ttimeoutMs\"\xfc\x01\n" +
+	" FinishPasskeyRegistrationRequest\x12(\n" +
+	"\rsession_token\x18\x01 \x01(\tB\x03\xe0A\x02R\fsessionToken\x12-\n" +
+	"\x10client_data_json\x18\x02 \x01(\fB\x03\xe0A\x02R\x0eclientDataJson\x122\n" +
+	"\x12attestation_object\x18\x03 \x01(\fB\x03\xe0A\x02R\x11attestationObject\x12&\n" +
+	"\fdisplay_name\x18\x04 \x01(\tB\x03\xe0A\x01R\vdisplayName\x12#\n" +
+	"\n" +
+	"transports\x18\x05 \x03(\tB\x03\xe0A\x01R\n" +
+	"transports\"J\n" +
+	"\x19BeginPasskeySignInRequest\x12-\n" +
+	"\x10two_factor_token\x18\x01 \x01(\tB\x03\xe0A\x01R\x0etwoFactorToken\"\x80\x01\n" +
+	"\x1aBeginPasskeySignInResponse\x12=\n" +
+	"\aoptions\x18\x01 \x01(\v2#.memos.api.v1.PasskeyRequestOptionsR\aoptions\x12#\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\"\xc8\x01\n" +
+	"\x15PasskeyRequestOptions\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\fR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x02 \x01(\tR\x04rpId\x120\n" +
+	"\x14allow_credential_ids\x18\x03 \x03(\fR\x12allowCredentialIds\x12+\n" +
+	"\x11user_verification\x18\x04 \x01(\tR\x10userVerification\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\rR\ttimeoutMs2\xa1\a\n" +
 	"\vAuthService\x12t\n" +
 	"\x0eGetCurrentUser\x12#.memos.api.v1.GetCurrentUserRequest\x1a$.memos.api.v1.GetCurrentUserResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/auth/me\x12c\n" +
 	"\x06SignIn\x12\x1b.memos.api.v1.SignInRequest\x1a\x1c.memos.api.v1.SignInResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/signin\x12]\n" +
-	"\aSignOut\x12\x1c.memos.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signout\x12v\n" +
+	"\aSignOut\x12\x1c.memos.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signout\x12\xad\x01\n" +
+	"\x18BeginPasskeyRegistration\x12-.memos.api.v1.BeginPasskeyRegistrationRequest\x1a..memos.api.v1.BeginPasskeyRegistrationResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/auth/passkeys:beginRegistration\x12\x97\x01\n" +
+	"\x19FinishPasskeyRegistration\x12..memos.api.v1.FinishPasskeyRegistrationRequest\x1a\x15.memos.api.v1.Passkey\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/auth/passkeys:finishRegistration\x12\x95\x01\n" +
+	"\x12BeginPasskeySignIn\x12'.memos.api.v1.BeginPasskeySignInRequest\x1a(.memos.api.v1.BeginPasskeySignInResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/auth/passkeys:beginSignIn\x12v\n" +
 	"\fRefreshToken\x12!.memos.api.v1.RefreshTokenRequest\x1a\".memos.api.v1.RefreshTokenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/auth/refreshB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10AuthServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
@@ -560,41 +1290,61 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_api_v1_auth_service_proto_goTypes = []any{
-	(*GetCurrentUserRequest)(nil),             // 0: memos.api.v1.GetCurrentUserRequest
-	(*GetCurrentUserResponse)(nil),            // 1: memos.api.v1.GetCurrentUserResponse
-	(*SignInRequest)(nil),                     // 2: memos.api.v1.SignInRequest
-	(*SignInResponse)(nil),                    // 3: memos.api.v1.SignInResponse
-	(*SignOutRequest)(nil),                    // 4: memos.api.v1.SignOutRequest
-	(*RefreshTokenRequest)(nil),               // 5: memos.api.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),              // 6: memos.api.v1.RefreshTokenResponse
-	(*SignInRequest_PasswordCredentials)(nil), // 7: memos.api.v1.SignInRequest.PasswordCredentials
-	(*SignInRequest_SSOCredentials)(nil),      // 8: memos.api.v1.SignInRequest.SSOCredentials
-	(*User)(nil),                              // 9: memos.api.v1.User
-	(*timestamppb.Timestamp)(nil),             // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 11: google.protobuf.Empty
+	(*GetCurrentUserRequest)(nil),              // 0: memos.api.v1.GetCurrentUserRequest
+	(*GetCurrentUserResponse)(nil),             // 1: memos.api.v1.GetCurrentUserResponse
+	(*SignInRequest)(nil),                      // 2: memos.api.v1.SignInRequest
+	(*SignInResponse)(nil),                     // 3: memos.api.v1.SignInResponse
+	(*SignOutRequest)(nil),                     // 4: memos.api.v1.SignOutRequest
+	(*RefreshTokenRequest)(nil),                // 5: memos.api.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),               // 6: memos.api.v1.RefreshTokenResponse
+	(*BeginPasskeyRegistrationRequest)(nil),    // 7: memos.api.v1.BeginPasskeyRegistrationRequest
+	(*BeginPasskeyRegistrationResponse)(nil),   // 8: memos.api.v1.BeginPasskeyRegistrationResponse
+	(*PasskeyCreationOptions)(nil),             // 9: memos.api.v1.PasskeyCreationOptions
+	(*FinishPasskeyRegistrationRequest)(nil),   // 10: memos.api.v1.FinishPasskeyRegistrationRequest
+	(*BeginPasskeySignInRequest)(nil),          // 11: memos.api.v1.BeginPasskeySignInRequest
+	(*BeginPasskeySignInResponse)(nil),         // 12: memos.api.v1.BeginPasskeySignInResponse
+	(*PasskeyRequestOptions)(nil),              // 13: memos.api.v1.PasskeyRequestOptions
+	(*SignInRequest_PasswordCredentials)(nil),  // 14: memos.api.v1.SignInRequest.PasswordCredentials
+	(*SignInRequest_SSOCredentials)(nil),       // 15: memos.api.v1.SignInRequest.SSOCredentials
+	(*SignInRequest_TwoFactorCredentials)(nil), // 16: memos.api.v1.SignInRequest.TwoFactorCredentials
+	(*SignInRequest_PasskeyCredentials)(nil),   // 17: memos.api.v1.SignInRequest.PasskeyCredentials
+	(*User)(nil),                               // 18: memos.api.v1.User
+	(*timestamppb.Timestamp)(nil),              // 19: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 20: google.protobuf.Empty
+	(*Passkey)(nil),                            // 21: memos.api.v1.Passkey
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
-	9,  // 0: memos.api.v1.GetCurrentUserResponse.user:type_name -> memos.api.v1.User
-	7,  // 1: memos.api.v1.SignInRequest.password_credentials:type_name -> memos.api.v1.SignInRequest.PasswordCredentials
-	8,  // 2: memos.api.v1.SignInRequest.sso_credentials:type_name -> memos.api.v1.SignInRequest.SSOCredentials
-	9,  // 3: memos.api.v1.SignInResponse.user:type_name -> memos.api.v1.User
-	10, // 4: memos.api.v1.SignInResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
-	10, // 5: memos.api.v1.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: memos.api.v1.AuthService.GetCurrentUser:input_type -> memos.api.v1.GetCurrentUserRequest
-	2,  // 7: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
-	4,  // 8: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
-	5,  // 9: memos.api.v1.AuthService.RefreshToken:input_type -> memos.api.v1.RefreshTokenRequest
-	1,  // 10: memos.api.v1.AuthService.GetCurrentUser:output_type -> memos.api.v1.GetCurrentUserResponse
-	3,  // 11: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.SignInResponse
-	11, // 12: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	6,  // 13: memos.api.v1.AuthService.RefreshToken:output_type -> memos.api.v1.RefreshTokenResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	18, // 0: memos.api.v1.GetCurrentUserResponse.user:type_name -> memos.api.v1.User
+	14, // 1: memos.api.v1.SignInRequest.password_credentials:type_name -> memos.api.v1.SignInRequest.PasswordCredentials
+	15, // 2: memos.api.v1.SignInRequest.sso_credentials:type_name -> memos.api.v1.SignInRequest.SSOCredentials
+	16, // 3: memos.api.v1.SignInRequest.two_factor_credentials:type_name -> memos.api.v1.SignInRequest.TwoFactorCredentials
+	17, // 4: memos.api.v1.SignInRequest.passkey_credentials:type_name -> memos.api.v1.SignInRequest.PasskeyCredentials
+	18, // 5: memos.api.v1.SignInResponse.user:type_name -> memos.api.v1.User
+	19, // 6: memos.api.v1.SignInResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	19, // 7: memos.api.v1.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: memos.api.v1.BeginPasskeyRegistrationResponse.options:type_name -> memos.api.v1.PasskeyCreationOptions
+	13, // 9: memos.api.v1.BeginPasskeySignInResponse.options:type_name -> memos.api.v1.PasskeyRequestOptions
+	0,  // 10: memos.api.v1.AuthService.GetCurrentUser:input_type -> memos.api.v1.GetCurrentUserRequest
+	2,  // 11: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
+	4,  // 12: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
+	7,  // 13: memos.api.v1.AuthService.BeginPasskeyRegistration:input_type -> memos.api.v1.BeginPasskeyRegistrationRequest
+	10, // 14: memos.api.v1.AuthService.FinishPasskeyRegistration:input_type -> memos.api.v1.FinishPasskeyRegistrationRequest
+	11, // 15: memos.api.v1.AuthService.BeginPasskeySignIn:input_type -> memos.api.v1.BeginPasskeySignInRequest
+	5,  // 16: memos.api.v1.AuthService.RefreshToken:input_type -> memos.api.v1.RefreshTokenRequest
+	1,  // 17: memos.api.v1.AuthService.GetCurrentUser:output_type -> memos.api.v1.GetCurrentUserResponse
+	3,  // 18: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.SignInResponse
+	20, // 19: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	8,  // 20: memos.api.v1.AuthService.BeginPasskeyRegistration:output_type -> memos.api.v1.BeginPasskeyRegistrationResponse
+	21, // 21: memos.api.v1.AuthService.FinishPasskeyRegistration:output_type -> memos.api.v1.Passkey
+	12, // 22: memos.api.v1.AuthService.BeginPasskeySignIn:output_type -> memos.api.v1.BeginPasskeySignInResponse
+	6,  // 23: memos.api.v1.AuthService.RefreshToken:output_type -> memos.api.v1.RefreshTokenResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_service_proto_init() }
@@ -606,6 +1356,8 @@ func file_api_v1_auth_service_proto_init() {
 	file_api_v1_auth_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*SignInRequest_PasswordCredentials_)(nil),
 		(*SignInRequest_SsoCredentials)(nil),
+		(*SignInRequest_TwoFactorCredentials_)(nil),
+		(*SignInRequest_PasskeyCredentials_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -613,7 +1365,7 @@ func file_api_v1_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
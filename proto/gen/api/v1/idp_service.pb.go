@@ -30,6 +30,8 @@ const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	// OAuth2 identity provider.
 	IdentityProvider_OAUTH2 IdentityProvider_Type = 1
+	// OpenID Connect identity provider, configured from the discovery document of its issuer.
+	IdentityProvider_OIDC IdentityProvider_Type = 2
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -37,10 +39,12 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "OIDC",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"OIDC":             2,
 	}
 )
 
@@ -158,6 +162,7 @@ type IdentityProviderConfig struct {
 	// Types that are valid to be assigned to Config:
 	//
 	//	*IdentityProviderConfig_Oauth2Config
+	//	*IdentityProviderConfig_OidcConfig
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -209,6 +214,15 @@ func (x *IdentityProviderConfig) GetOauth2Config() *OAuth2Config {
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetOidcConfig() *OIDCConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_OidcConfig); ok {
+			return x.OidcConfig
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -217,8 +231,14 @@ type IdentityProviderConfig_Oauth2Config struct {
 	Oauth2Config *OAuth2Config `protobuf:"bytes,1,opt,name=oauth2_config,json=oauth2Config,proto3,oneof"`
 }
 
+type IdentityProviderConfig_OidcConfig struct {
+	OidcConfig *OIDCConfig `protobuf:"bytes,2,opt,name=oidc_config,json=oidcConfig,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2Config) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_OidcConfig) isIdentityProviderConfig_Config() {}
+
 type FieldMapping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
@@ -379,6 +399,100 @@ func (x *OAuth2Config) GetFieldMapping() *FieldMapping {
 	return nil
 }
 
+type OIDCConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The issuer URL, the discovery document is fetched from
+	// {issuer}/.well-known/openid-configuration.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Required. The client ID registered with the issuer.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret registered with the issuer.
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// Optional. The requested scopes, "openid" is always included.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Optional. The ID token claim used as the username.
+	// Defaults to "sub", the only claim that is unique and stable per issuer.
+	// Only use a claim such as "preferred_username" or "email" if the issuer does not let users change it,
+	// otherwise a user can sign in as another user by taking their name.
+	IdentifierClaim string `protobuf:"bytes,5,opt,name=identifier_claim,json=identifierClaim,proto3" json:"identifier_claim,omitempty"`
+	// Output only. The authorization endpoint of the issuer.
+	AuthUrl       string `protobuf:"bytes,6,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCConfig) Reset() {
+	*x = OIDCConfig{}
+	mi := &file_api_v1_idp_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConfig) ProtoMessage() {}
+
+func (x *OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_idp_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConfig.ProtoReflect.Descriptor instead.
+func (*OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OIDCConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCConfig) GetIdentifierClaim() string {
+	if x != nil {
+		return x.IdentifierClaim
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
 type ListIdentityProvidersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -387,7 +501,7 @@ type ListIdentityProvidersRequest struct {
 
 func (x *ListIdentityProvidersRequest) Reset() {
 	*x = ListIdentityProvidersRequest{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[4]
+	mi := &file_api_v1_idp_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +513,7 @@ func (x *ListIdentityProvidersRequest) String() string {
 func (*ListIdentityProvidersRequest) ProtoMessage() {}
 
 func (x *ListIdentityProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[4]
+	mi := &file_api_v1_idp_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +526,7 @@ func (x *ListIdentityProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIdentityProvidersRequest.ProtoReflect.Descriptor instead.
 func (*ListIdentityProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{5}
 }
 
 type ListIdentityProvidersResponse struct {
@@ -425,7 +539,7 @@ type ListIdentityProvidersResponse struct {
 
 func (x *ListIdentityProvidersResponse) Reset() {
 	*x = ListIdentityProvidersResponse{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[5]
+	mi := &file_api_v1_idp_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +551,7 @@ func (x *ListIdentityProvidersResponse) String() string {
 func (*ListIdentityProvidersResponse) ProtoMessage() {}
 
 func (x *ListIdentityProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[5]
+	mi := &file_api_v1_idp_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +564,7 @@ func (x *ListIdentityProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIdentityProvidersResponse.ProtoReflect.Descriptor instead.
 func (*ListIdentityProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListIdentityProvidersResponse) GetIdentityProviders() []*IdentityProvider {
@@ -471,7 +585,7 @@ type GetIdentityProviderRequest struct {
 
 func (x *GetIdentityProviderRequest) Reset() {
 	*x = GetIdentityProviderRequest{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[6]
+	mi := &file_api_v1_idp_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +597,7 @@ func (x *GetIdentityProviderRequest) String() string {
 func (*GetIdentityProviderRequest) ProtoMessage() {}
 
 func (x *GetIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[6]
+	mi := &file_api_v1_idp_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +610,7 @@ func (x *GetIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*GetIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetIdentityProviderRequest) GetName() string {
@@ -519,7 +633,7 @@ type CreateIdentityProviderRequest struct {
 
 func (x *CreateIdentityProviderRequest) Reset() {
 	*x = CreateIdentityProviderRequest{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[7]
+	mi := &file_api_v1_idp_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +645,7 @@ func (x *CreateIdentityProviderRequest) String() string {
 func (*CreateIdentityProviderRequest) ProtoMessage() {}
 
 func (x *CreateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[7]
+	mi := &file_api_v1_idp_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +658,7 @@ func (x *CreateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*CreateIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
@@ -574,7 +688,7 @@ type UpdateIdentityProviderRequest struct {
 
 func (x *UpdateIdentityProviderRequest) Reset() {
 	*x = UpdateIdentityProviderRequest{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[8]
+	mi := &file_api_v1_idp_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +700,7 @@ func (x *UpdateIdentityProviderRequest) String() string {
 func (*UpdateIdentityProviderRequest) ProtoMessage() {}
 
 func (x *UpdateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[8]
+	mi := &file_api_v1_idp_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +713,7 @@ func (x *UpdateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateIdentityProviderRequest) GetIdentityProvider() *IdentityProvider {
@@ -627,7 +741,7 @@ type DeleteIdentityProviderRequest struct {
 
 func (x *DeleteIdentityProviderRequest) Reset() {
 	*x = DeleteIdentityProviderRequest{}
-	mi := &file_api_v1_idp_service_proto_msgTypes[9]
+	mi := &file_api_v1_idp_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +753,7 @@ func (x *DeleteIdentityProviderRequest) String() string {
 func (*DeleteIdentityProviderRequest) ProtoMessage() {}
 
 func (x *DeleteIdentityProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_idp_service_proto_msgTypes[9]
+	mi := &file_api_v1_idp_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +766,7 @@ func (x *DeleteIdentityProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIdentityProviderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIdentityProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_idp_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteIdentityProviderRequest) GetName() string {
@@ -666,20 +780,23 @@ var File_api_v1_idp_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_idp_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18api/v1/idp_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x8c\x03\n" +
+	"\x18api/v1/idp_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\x96\x03\n" +
 	"\x10IdentityProvider\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12<\n" +
 	"\x04type\x18\x02 \x01(\x0e2#.memos.api.v1.IdentityProvider.TypeB\x03\xe0A\x02R\x04type\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tB\x03\xe0A\x02R\x05title\x120\n" +
 	"\x11identifier_filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x10identifierFilter\x12A\n" +
-	"\x06config\x18\x05 \x01(\v2$.memos.api.v1.IdentityProviderConfigB\x03\xe0A\x02R\x06config\"(\n" +
+	"\x06config\x18\x05 \x01(\v2$.memos.api.v1.IdentityProviderConfigB\x03\xe0A\x02R\x06config\"2\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01:g\xeaAd\n" +
-	"\x1dmemos.api.v1/IdentityProvider\x12\x18identity-providers/{idp}\x1a\x04name*\x11identityProviders2\x10identityProvider\"e\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04OIDC\x10\x02:g\xeaAd\n" +
+	"\x1dmemos.api.v1/IdentityProvider\x12\x18identity-providers/{idp}\x1a\x04name*\x11identityProviders2\x10identityProvider\"\xa2\x01\n" +
 	"\x16IdentityProviderConfig\x12A\n" +
-	"\roauth2_config\x18\x01 \x01(\v2\x1a.memos.api.v1.OAuth2ConfigH\x00R\foauth2ConfigB\b\n" +
+	"\roauth2_config\x18\x01 \x01(\v2\x1a.memos.api.v1.OAuth2ConfigH\x00R\foauth2Config\x12;\n" +
+	"\voidc_config\x18\x02 \x01(\v2\x18.memos.api.v1.OIDCConfigH\x00R\n" +
+	"oidcConfigB\b\n" +
 	"\x06config\"\x86\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
@@ -696,7 +813,15 @@ const file_api_v1_idp_service_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12?\n" +
-	"\rfield_mapping\x18\a \x01(\v2\x1a.memos.api.v1.FieldMappingR\ffieldMapping\"\x1e\n" +
+	"\rfield_mapping\x18\a \x01(\v2\x1a.memos.api.v1.FieldMappingR\ffieldMapping\"\xdd\x01\n" +
+	"\n" +
+	"OIDCConfig\x12\x1b\n" +
+	"\x06issuer\x18\x01 \x01(\tB\x03\xe0A\x02R\x06issuer\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tB\x03\xe0A\x02R\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12\x1b\n" +
+	"\x06scopes\x18\x04 \x03(\tB\x03\xe0A\x01R\x06scopes\x12.\n" +
+	"\x10identifier_claim\x18\x05 \x01(\tB\x03\xe0A\x01R\x0fidentifierClaim\x12\x1e\n" +
+	"\bauth_url\x18\x06 \x01(\tB\x03\xe0A\x03R\aauthUrl\"\x1e\n" +
 	"\x1cListIdentityProvidersRequest\"n\n" +
 	"\x1dListIdentityProvidersResponse\x12M\n" +
 	"\x12identity_providers\x18\x01 \x03(\v2\x1e.memos.api.v1.IdentityProviderR\x11identityProviders\"W\n" +
@@ -734,46 +859,48 @@ func file_api_v1_idp_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_idp_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_idp_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_idp_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_v1_idp_service_proto_goTypes = []any{
 	(IdentityProvider_Type)(0),            // 0: memos.api.v1.IdentityProvider.Type
 	(*IdentityProvider)(nil),              // 1: memos.api.v1.IdentityProvider
 	(*IdentityProviderConfig)(nil),        // 2: memos.api.v1.IdentityProviderConfig
 	(*FieldMapping)(nil),                  // 3: memos.api.v1.FieldMapping
 	(*OAuth2Config)(nil),                  // 4: memos.api.v1.OAuth2Config
-	(*ListIdentityProvidersRequest)(nil),  // 5: memos.api.v1.ListIdentityProvidersRequest
-	(*ListIdentityProvidersResponse)(nil), // 6: memos.api.v1.ListIdentityProvidersResponse
-	(*GetIdentityProviderRequest)(nil),    // 7: memos.api.v1.GetIdentityProviderRequest
-	(*CreateIdentityProviderRequest)(nil), // 8: memos.api.v1.CreateIdentityProviderRequest
-	(*UpdateIdentityProviderRequest)(nil), // 9: memos.api.v1.UpdateIdentityProviderRequest
-	(*DeleteIdentityProviderRequest)(nil), // 10: memos.api.v1.DeleteIdentityProviderRequest
-	(*fieldmaskpb.FieldMask)(nil),         // 11: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                 // 12: google.protobuf.Empty
+	(*OIDCConfig)(nil),                    // 5: memos.api.v1.OIDCConfig
+	(*ListIdentityProvidersRequest)(nil),  // 6: memos.api.v1.ListIdentityProvidersRequest
+	(*ListIdentityProvidersResponse)(nil), // 7: memos.api.v1.ListIdentityProvidersResponse
+	(*GetIdentityProviderRequest)(nil),    // 8: memos.api.v1.GetIdentityProviderRequest
+	(*CreateIdentityProviderRequest)(nil), // 9: memos.api.v1.CreateIdentityProviderRequest
+	(*UpdateIdentityProviderRequest)(nil), // 10: memos.api.v1.UpdateIdentityProviderRequest
+	(*DeleteIdentityProviderRequest)(nil), // 11: memos.api.v1.DeleteIdentityProviderRequest
+	(*fieldmaskpb.FieldMask)(nil),         // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 13: google.protobuf.Empty
 }
 var file_api_v1_idp_service_proto_depIdxs = []int32{
 	0,  // 0: memos.api.v1.IdentityProvider.type:type_name -> memos.api.v1.IdentityProvider.Type
 	2,  // 1: memos.api.v1.IdentityProvider.config:type_name -> memos.api.v1.IdentityProviderConfig
 	4,  // 2: memos.api.v1.IdentityProviderConfig.oauth2_config:type_name -> memos.api.v1.OAuth2Config
-	3,  // 3: memos.api.v1.OAuth2Config.field_mapping:type_name -> memos.api.v1.FieldMapping
-	1,  // 4: memos.api.v1.ListIdentityProvidersResponse.identity_providers:type_name -> memos.api.v1.IdentityProvider
-	1,  // 5: memos.api.v1.CreateIdentityProviderRequest.identity_provider:type_name -> memos.api.v1.IdentityProvider
-	1,  // 6: memos.api.v1.UpdateIdentityProviderRequest.identity_provider:type_name -> memos.api.v1.IdentityProvider
-	11, // 7: memos.api.v1.UpdateIdentityProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
-	5,  // 8: memos.api.v1.IdentityProviderService.ListIdentityProviders:input_type -> memos.api.v1.ListIdentityProvidersRequest
-	7,  // 9: memos.api.v1.IdentityProviderService.GetIdentityProvider:input_type -> memos.api.v1.GetIdentityProviderRequest
-	8,  // 10: memos.api.v1.IdentityProviderService.CreateIdentityProvider:input_type -> memos.api.v1.CreateIdentityProviderRequest
-	9,  // 11: memos.api.v1.IdentityProviderService.UpdateIdentityProvider:input_type -> memos.api.v1.UpdateIdentityProviderRequest
-	10, // 12: memos.api.v1.IdentityProviderService.DeleteIdentityProvider:input_type -> memos.api.v1.DeleteIdentityProviderRequest
-	6,  // 13: memos.api.v1.IdentityProviderService.ListIdentityProviders:output_type -> memos.api.v1.ListIdentityProvidersResponse
-	1,  // 14: memos.api.v1.IdentityProviderService.GetIdentityProvider:output_type -> memos.api.v1.IdentityProvider
-	1,  // 15: memos.api.v1.IdentityProviderService.CreateIdentityProvider:output_type -> memos.api.v1.IdentityProvider
-	1,  // 16: memos.api.v1.IdentityProviderService.UpdateIdentityProvider:output_type -> memos.api.v1.IdentityProvider
-	12, // 17: memos.api.v1.IdentityProviderService.DeleteIdentityProvider:output_type -> google.protobuf.Empty
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	5,  // 3: memos.api.v1.IdentityProviderConfig.oidc_config:type_name -> memos.api.v1.OIDCConfig
+	3,  // 4: memos.api.v1.OAuth2Config.field_mapping:type_name -> memos.api.v1.FieldMapping
+	1,  // 5: memos.api.v1.ListIdentityProvidersResponse.identity_providers:type_name -> memos.api.v1.IdentityProvider
+	1,  // 6: memos.api.v1.CreateIdentityProviderRequest.identity_provider:type_name -> memos.api.v1.IdentityProvider
+	1,  // 7: memos.api.v1.UpdateIdentityProviderRequest.identity_provider:type_name -> memos.api.v1.IdentityProvider
+	12, // 8: memos.api.v1.UpdateIdentityProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 9: memos.api.v1.IdentityProviderService.ListIdentityProviders:input_type -> memos.api.v1.ListIdentityProvidersRequest
+	8,  // 10: memos.api.v1.IdentityProviderService.GetIdentityProvider:input_type -> memos.api.v1.GetIdentityProviderRequest
+	9,  // 11: memos.api.v1.IdentityProviderService.CreateIdentityProvider:input_type -> memos.api.v1.CreateIdentityProviderRequest
+	10, // 12: memos.api.v1.IdentityProviderService.UpdateIdentityProvider:input_type -> memos.api.v1.UpdateIdentityProviderRequest
+	11, // 13: memos.api.v1.IdentityProviderService.DeleteIdentityProvider:input_type -> memos.api.v1.DeleteIdentityProviderRequest
+	7,  // 14: memos.api.v1.IdentityProviderService.ListIdentityProviders:output_type -> memos.api.v1.ListIdentityProvidersResponse
+	1,  // 15: memos.api.v1.IdentityProviderService.GetIdentityProvider:output_type -> memos.api.v1.IdentityProvider
+	1,  // 16: memos.api.v1.IdentityProviderService.CreateIdentityProvider:output_type -> memos.api.v1.IdentityProvider
+	1,  // 17: memos.api.v1.IdentityProviderService.UpdateIdentityProvider:output_type -> memos.api.v1.IdentityProvider
+	13, // 18: memos.api.v1.IdentityProviderService.DeleteIdentityProvider:output_type -> google.protobuf.Empty
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_idp_service_proto_init() }
@@ -783,6 +910,7 @@ func file_api_v1_idp_service_proto_init() {
 	}
 	file_api_v1_idp_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2Config)(nil),
+		(*IdentityProviderConfig_OidcConfig)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -790,7 +918,7 @@ func file_api_v1_idp_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_idp_service_proto_rawDesc), len(file_api_v1_idp_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -10,7 +10,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -34,6 +37,8 @@ const (
 	InstanceSetting_STORAGE InstanceSetting_Key = 2
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSetting_MEMO_RELATED InstanceSetting_Key = 3
+	// NOTIFICATION is the key for notification settings.
+	InstanceSetting_NOTIFICATION InstanceSetting_Key = 4
 )
 
 // Enum value maps for InstanceSetting_Key.
@@ -43,12 +48,14 @@ var (
 		1: "GENERAL",
 		2: "STORAGE",
 		3: "MEMO_RELATED",
+		4: "NOTIFICATION",
 	}
 	InstanceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
+		"NOTIFICATION":    4,
 	}
 )
 
@@ -257,6 +264,7 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting_
 	//	*InstanceSetting_StorageSetting_
 	//	*InstanceSetting_MemoRelatedSetting_
+	//	*InstanceSetting_NotificationSetting_
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -333,53 +341,551 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceSetting_MemoRelatedSe
 	return nil
 }
 
+func (x *InstanceSetting) GetNotificationSetting() *InstanceSetting_NotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_NotificationSetting_); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
 
-type InstanceSetting_GeneralSetting_ struct {
-	GeneralSetting *InstanceSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+type InstanceSetting_GeneralSetting_ struct {
+	GeneralSetting *InstanceSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type InstanceSetting_StorageSetting_ struct {
+	StorageSetting *InstanceSetting_StorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+}
+
+type InstanceSetting_MemoRelatedSetting_ struct {
+	MemoRelatedSetting *InstanceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+}
+
+type InstanceSetting_NotificationSetting_ struct {
+	NotificationSetting *InstanceSetting_NotificationSetting `protobuf:"bytes,5,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
+func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_NotificationSetting_) isInstanceSetting_Value() {}
+
+// Request message for GetInstanceSetting method.
+type GetInstanceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the instance setting.
+	// Format: instance/settings/{setting}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceSettingRequest) Reset() {
+	*x = GetInstanceSettingRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceSettingRequest) ProtoMessage() {}
+
+func (x *GetInstanceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInstanceSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for UpdateInstanceSetting method.
+type UpdateInstanceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The instance setting resource which replaces the resource on the server.
+	Setting *InstanceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInstanceSettingRequest) Reset() {
+	*x = UpdateInstanceSettingRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstanceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceSettingRequest) ProtoMessage() {}
+
+func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateInstanceSettingRequest) GetSetting() *InstanceSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// A background job run by the instance scheduler.
+type InstanceJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job.
+	// Format: instance/jobs/{job}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The cron expression defining when the job runs.
+	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// A human-readable description of what the job does.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the job is currently running.
+	Running bool `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	// The start time of the most recent run. Unset if the job has never run.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The duration of the most recent run.
+	LastRunDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=last_run_duration,json=lastRunDuration,proto3" json:"last_run_duration,omitempty"`
+	// The error of the most recent run. Empty if it succeeded.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The next scheduled run time.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceJob) Reset() {
+	*x = InstanceJob{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceJob) ProtoMessage() {}
+
+func (x *InstanceJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceJob.ProtoReflect.Descriptor instead.
+func (*InstanceJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InstanceJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *InstanceJob) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *InstanceJob) GetLastRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LastRunDuration
+	}
+	return nil
+}
+
+func (x *InstanceJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+// Request message for ListInstanceJobs method.
+type ListInstanceJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceJobsRequest) Reset() {
+	*x = ListInstanceJobsRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceJobsRequest) ProtoMessage() {}
+
+func (x *ListInstanceJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstanceJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Response message for ListInstanceJobs method.
+type ListInstanceJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of jobs, sorted by name.
+	Jobs          []*InstanceJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceJobsResponse) Reset() {
+	*x = ListInstanceJobsResponse{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceJobsResponse) ProtoMessage() {}
+
+func (x *ListInstanceJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstanceJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListInstanceJobsResponse) GetJobs() []*InstanceJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// Request message for RunInstanceJob method.
+type RunInstanceJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job to run.
+	// Format: instance/jobs/{job}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInstanceJobRequest) Reset() {
+	*x = RunInstanceJobRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInstanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInstanceJobRequest) ProtoMessage() {}
+
+func (x *RunInstanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInstanceJobRequest.ProtoReflect.Descriptor instead.
+func (*RunInstanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RunInstanceJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A webhook receiving activities across the whole instance.
+type InstanceWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the webhook.
+	// Format: instance/webhooks/{webhook}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL to send the webhook to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. Human-readable name for the webhook.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The secret used to sign webhook requests with HMAC-SHA256.
+	// Only returned when the webhook is created or its secret is rotated.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Optional. The activity types the webhook subscribes to.
+	// Supported types: memos.user.created, memos.user.deleted, memos.user.role.changed,
+	// memos.user.signin, memos.user.idp.signin and memos.memo.created, which is only sent for public memos.
+	// If empty, the webhook receives all of them.
+	ActivityTypes []string `protobuf:"bytes,5,rep,name=activity_types,json=activityTypes,proto3" json:"activity_types,omitempty"`
+	// Optional. The format of the request body.
+	PayloadFormat UserWebhook_PayloadFormat `protobuf:"varint,6,opt,name=payload_format,json=payloadFormat,proto3,enum=memos.api.v1.UserWebhook_PayloadFormat" json:"payload_format,omitempty"`
+	// Optional. A Go text/template rendering the request body when payload_format is TEMPLATE.
+	PayloadTemplate string `protobuf:"bytes,7,opt,name=payload_template,json=payloadTemplate,proto3" json:"payload_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceWebhook) Reset() {
+	*x = InstanceWebhook{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebhook) ProtoMessage() {}
+
+func (x *InstanceWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebhook.ProtoReflect.Descriptor instead.
+func (*InstanceWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InstanceWebhook) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *InstanceWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *InstanceWebhook) GetActivityTypes() []string {
+	if x != nil {
+		return x.ActivityTypes
+	}
+	return nil
+}
+
+func (x *InstanceWebhook) GetPayloadFormat() UserWebhook_PayloadFormat {
+	if x != nil {
+		return x.PayloadFormat
+	}
+	return UserWebhook_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
+func (x *InstanceWebhook) GetPayloadTemplate() string {
+	if x != nil {
+		return x.PayloadTemplate
+	}
+	return ""
 }
 
-type InstanceSetting_StorageSetting_ struct {
-	StorageSetting *InstanceSetting_StorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+// Request message for ListInstanceWebhooks method.
+type ListInstanceWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type InstanceSetting_MemoRelatedSetting_ struct {
-	MemoRelatedSetting *InstanceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+func (x *ListInstanceWebhooksRequest) Reset() {
+	*x = ListInstanceWebhooksRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
+func (x *ListInstanceWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
+func (*ListInstanceWebhooksRequest) ProtoMessage() {}
 
-func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
+func (x *ListInstanceWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-// Request message for GetInstanceSetting method.
-type GetInstanceSettingRequest struct {
+// Deprecated: Use ListInstanceWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListInstanceWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{10}
+}
+
+// Response message for ListInstanceWebhooks method.
+type ListInstanceWebhooksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the instance setting.
-	// Format: instance/settings/{setting}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The instance webhooks.
+	Webhooks      []*InstanceWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInstanceSettingRequest) Reset() {
-	*x = GetInstanceSettingRequest{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[3]
+func (x *ListInstanceWebhooksResponse) Reset() {
+	*x = ListInstanceWebhooksResponse{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInstanceSettingRequest) String() string {
+func (x *ListInstanceWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstanceSettingRequest) ProtoMessage() {}
+func (*ListInstanceWebhooksResponse) ProtoMessage() {}
 
-func (x *GetInstanceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[3]
+func (x *ListInstanceWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,44 +896,90 @@ func (x *GetInstanceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstanceSettingRequest.ProtoReflect.Descriptor instead.
-func (*GetInstanceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListInstanceWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListInstanceWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetInstanceSettingRequest) GetName() string {
+func (x *ListInstanceWebhooksResponse) GetWebhooks() []*InstanceWebhook {
 	if x != nil {
-		return x.Name
+		return x.Webhooks
 	}
-	return ""
+	return nil
 }
 
-// Request message for UpdateInstanceSetting method.
-type UpdateInstanceSettingRequest struct {
+// Request message for CreateInstanceWebhook method.
+type CreateInstanceWebhookRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The instance setting resource which replaces the resource on the server.
-	Setting *InstanceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// The list of fields to update.
+	// The webhook to create.
+	Webhook       *InstanceWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInstanceWebhookRequest) Reset() {
+	*x = CreateInstanceWebhookRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstanceWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstanceWebhookRequest) ProtoMessage() {}
+
+func (x *CreateInstanceWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstanceWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstanceWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateInstanceWebhookRequest) GetWebhook() *InstanceWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// Request message for UpdateInstanceWebhook method.
+type UpdateInstanceWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webhook to update.
+	Webhook *InstanceWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The list of fields to update. Include "secret" to rotate the signing secret.
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInstanceSettingRequest) Reset() {
-	*x = UpdateInstanceSettingRequest{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[4]
+func (x *UpdateInstanceWebhookRequest) Reset() {
+	*x = UpdateInstanceWebhookRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstanceSettingRequest) String() string {
+func (x *UpdateInstanceWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstanceSettingRequest) ProtoMessage() {}
+func (*UpdateInstanceWebhookRequest) ProtoMessage() {}
 
-func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[4]
+func (x *UpdateInstanceWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,25 +990,72 @@ func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstanceSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstanceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateInstanceWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateInstanceSettingRequest) GetSetting() *InstanceSetting {
+func (x *UpdateInstanceWebhookRequest) GetWebhook() *InstanceWebhook {
 	if x != nil {
-		return x.Setting
+		return x.Webhook
 	}
 	return nil
 }
 
-func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateInstanceWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
+// Request message for DeleteInstanceWebhook method.
+type DeleteInstanceWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the webhook to delete.
+	// Format: instance/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstanceWebhookRequest) Reset() {
+	*x = DeleteInstanceWebhookRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstanceWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInstanceWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteInstanceWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInstanceWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstanceWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteInstanceWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // General instance settings configuration.
 type InstanceSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -484,7 +1083,7 @@ type InstanceSetting_GeneralSetting struct {
 
 func (x *InstanceSetting_GeneralSetting) Reset() {
 	*x = InstanceSetting_GeneralSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +1095,7 @@ func (x *InstanceSetting_GeneralSetting) String() string {
 func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +1185,7 @@ type InstanceSetting_StorageSetting struct {
 
 func (x *InstanceSetting_StorageSetting) Reset() {
 	*x = InstanceSetting_StorageSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +1197,7 @@ func (x *InstanceSetting_StorageSetting) String() string {
 func (*InstanceSetting_StorageSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,14 +1253,17 @@ type InstanceSetting_MemoRelatedSetting struct {
 	// enable_double_click_edit enables editing on double click.
 	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
 	// reactions is the list of reactions.
-	Reactions     []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// revision_limit is the maximum number of revisions kept for each memo.
+	// Older revisions are removed when the limit is exceeded.
+	RevisionLimit int32 `protobuf:"varint,8,opt,name=revision_limit,json=revisionLimit,proto3" json:"revision_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InstanceSetting_MemoRelatedSetting) Reset() {
 	*x = InstanceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +1275,7 @@ func (x *InstanceSetting_MemoRelatedSetting) String() string {
 func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,6 +1326,59 @@ func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+func (x *InstanceSetting_MemoRelatedSetting) GetRevisionLimit() int32 {
+	if x != nil {
+		return x.RevisionLimit
+	}
+	return 0
+}
+
+// Notification settings for the instance.
+type InstanceSetting_NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email is the SMTP configuration for email notifications.
+	Email         *InstanceSetting_NotificationSetting_EmailSetting `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_NotificationSetting) Reset() {
+	*x = InstanceSetting_NotificationSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_NotificationSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_NotificationSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *InstanceSetting_NotificationSetting) GetEmail() *InstanceSetting_NotificationSetting_EmailSetting {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
 // Custom profile configuration for instance branding.
 type InstanceSetting_GeneralSetting_CustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -736,7 +1391,7 @@ type InstanceSetting_GeneralSetting_CustomProfile struct {
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
 	*x = InstanceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +1403,7 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
 func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +1456,7 @@ type InstanceSetting_StorageSetting_S3Config struct {
 
 func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
 	*x = InstanceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +1468,7 @@ func (x *InstanceSetting_StorageSetting_S3Config) String() string {
 func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,22 +1526,150 @@ func (x *InstanceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
 	return false
 }
 
+// SMTP configuration used to send notification emails.
+type InstanceSetting_NotificationSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables sending notification emails.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// smtp_host is the SMTP server hostname.
+	SmtpHost string `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the SMTP server port.
+	SmtpPort int32 `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the SMTP authentication username.
+	SmtpUsername string `protobuf:"bytes,4,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password is the SMTP authentication password.
+	SmtpPassword string `protobuf:"bytes,5,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// reply_to is the optional Reply-To address.
+	ReplyTo string `protobuf:"bytes,8,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	// use_tls enables STARTTLS.
+	UseTls bool `protobuf:"varint,9,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl enables implicit SSL/TLS.
+	UseSsl        bool `protobuf:"varint,10,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) Reset() {
+	*x = InstanceSetting_NotificationSetting_EmailSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_NotificationSetting_EmailSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_NotificationSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_NotificationSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceSetting_NotificationSetting_EmailSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
 var File_api_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"\x8c\x01\n" +
+	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
 	"\x0fInstanceProfile\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04demo\x18\x03 \x01(\bR\x04demo\x12!\n" +
 	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12(\n" +
 	"\x05admin\x18\a \x01(\v2\x12.memos.api.v1.UserR\x05admin\"\x1b\n" +
-	"\x19GetInstanceProfileRequest\"\x99\x0f\n" +
+	"\x19GetInstanceProfileRequest\"\xe0\x13\n" +
 	"\x0fInstanceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12W\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2,.memos.api.v1.InstanceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12W\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2,.memos.api.v1.InstanceSetting.StorageSettingH\x00R\x0estorageSetting\x12d\n" +
-	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x1a\xca\x04\n" +
+	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12f\n" +
+	"\x14notification_setting\x18\x05 \x01(\v21.memos.api.v1.InstanceSetting.NotificationSettingH\x00R\x13notificationSetting\x1a\xca\x04\n" +
 	"\x0eGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -916,18 +1699,35 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\x1a\x94\x02\n" +
+	"\x02S3\x10\x03\x1a\xbb\x02\n" +
 	"\x12MemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\"F\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x12%\n" +
+	"\x0erevision_limit\x18\b \x01(\x05R\rrevisionLimit\x1a\xa3\x03\n" +
+	"\x13NotificationSetting\x12T\n" +
+	"\x05email\x18\x01 \x01(\v2>.memos.api.v1.InstanceSetting.NotificationSetting.EmailSettingR\x05email\x1a\xb5\x02\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x04 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x05 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12\x19\n" +
+	"\breply_to\x18\b \x01(\tR\areplyTo\x12\x17\n" +
+	"\ause_tls\x18\t \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\n" +
+	" \x01(\bR\x06useSsl\"X\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x03:a\xeaA^\n" +
+	"\fMEMO_RELATED\x10\x03\x12\x10\n" +
+	"\fNOTIFICATION\x10\x04:a\xeaA^\n" +
 	"\x1cmemos.api.v1/InstanceSetting\x12\x1binstance/settings/{setting}*\x10instanceSettings2\x0finstanceSettingB\a\n" +
 	"\x05value\"U\n" +
 	"\x19GetInstanceSettingRequest\x128\n" +
@@ -936,11 +1736,56 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x1cUpdateInstanceSettingRequest\x12<\n" +
 	"\asetting\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xdb\x03\n" +
+	"updateMask\"\xd6\x03\n" +
+	"\vInstanceJob\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1f\n" +
+	"\bschedule\x18\x02 \x01(\tB\x03\xe0A\x03R\bschedule\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x03R\vdescription\x12\x1d\n" +
+	"\arunning\x18\x04 \x01(\bB\x03\xe0A\x03R\arunning\x12C\n" +
+	"\rlast_run_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12J\n" +
+	"\x11last_run_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x03R\x0flastRunDuration\x12\"\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tB\x03\xe0A\x03R\tlastError\x12C\n" +
+	"\rnext_run_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime:M\xeaAJ\n" +
+	"\x18memos.api.v1/InstanceJob\x12\x13instance/jobs/{job}*\finstanceJobs2\vinstanceJob\"\x19\n" +
+	"\x17ListInstanceJobsRequest\"I\n" +
+	"\x18ListInstanceJobsResponse\x12-\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x19.memos.api.v1.InstanceJobR\x04jobs\"M\n" +
+	"\x15RunInstanceJobRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/InstanceJobR\x04name\"\x9a\x03\n" +
+	"\x0fInstanceWebhook\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x02R\x03url\x12&\n" +
+	"\fdisplay_name\x18\x03 \x01(\tB\x03\xe0A\x01R\vdisplayName\x12\x1b\n" +
+	"\x06secret\x18\x04 \x01(\tB\x03\xe0A\x03R\x06secret\x12*\n" +
+	"\x0eactivity_types\x18\x05 \x03(\tB\x03\xe0A\x01R\ractivityTypes\x12S\n" +
+	"\x0epayload_format\x18\x06 \x01(\x0e2'.memos.api.v1.UserWebhook.PayloadFormatB\x03\xe0A\x01R\rpayloadFormat\x12.\n" +
+	"\x10payload_template\x18\a \x01(\tB\x03\xe0A\x01R\x0fpayloadTemplate:a\xeaA^\n" +
+	"\x1cmemos.api.v1/InstanceWebhook\x12\x1binstance/webhooks/{webhook}*\x10instanceWebhooks2\x0finstanceWebhook\"\x1d\n" +
+	"\x1bListInstanceWebhooksRequest\"Y\n" +
+	"\x1cListInstanceWebhooksResponse\x129\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1d.memos.api.v1.InstanceWebhookR\bwebhooks\"\\\n" +
+	"\x1cCreateInstanceWebhookRequest\x12<\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceWebhookB\x03\xe0A\x02R\awebhook\"\x9e\x01\n" +
+	"\x1cUpdateInstanceWebhookRequest\x12<\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceWebhookB\x03\xe0A\x02R\awebhook\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
+	"updateMask\"X\n" +
+	"\x1cDeleteInstanceWebhookRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cmemos.api.v1/InstanceWebhookR\x04name2\xde\n" +
+	"\n" +
 	"\x0fInstanceService\x12~\n" +
 	"\x12GetInstanceProfile\x12'.memos.api.v1.GetInstanceProfileRequest\x1a\x1d.memos.api.v1.InstanceProfile\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/instance/profile\x12\x8f\x01\n" +
 	"\x12GetInstanceSetting\x12'.memos.api.v1.GetInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=instance/settings/*}\x12\xb5\x01\n" +
-	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}B\xac\x01\n" +
+	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}\x12\x80\x01\n" +
+	"\x10ListInstanceJobs\x12%.memos.api.v1.ListInstanceJobsRequest\x1a&.memos.api.v1.ListInstanceJobsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/instance/jobs\x12\x86\x01\n" +
+	"\x0eRunInstanceJob\x12#.memos.api.v1.RunInstanceJobRequest\x1a\x19.memos.api.v1.InstanceJob\"4\xdaA\x04name\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/{name=instance/jobs/*}:run\x12\x90\x01\n" +
+	"\x14ListInstanceWebhooks\x12).memos.api.v1.ListInstanceWebhooksRequest\x1a*.memos.api.v1.ListInstanceWebhooksResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/instance/webhooks\x12\x98\x01\n" +
+	"\x15CreateInstanceWebhook\x12*.memos.api.v1.CreateInstanceWebhookRequest\x1a\x1d.memos.api.v1.InstanceWebhook\"4\xdaA\awebhook\x82\xd3\xe4\x93\x02$:\awebhook\"\x19/api/v1/instance/webhooks\x12\xb5\x01\n" +
+	"\x15UpdateInstanceWebhook\x12*.memos.api.v1.UpdateInstanceWebhookRequest\x1a\x1d.memos.api.v1.InstanceWebhook\"Q\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x025:\awebhook2*/api/v1/{webhook.name=instance/webhooks/*}\x12\x8e\x01\n" +
+	"\x15DeleteInstanceWebhook\x12*.memos.api.v1.DeleteInstanceWebhookRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=instance/webhooks/*}B\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14InstanceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -956,44 +1801,83 @@ func file_api_v1_instance_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_api_v1_instance_service_proto_goTypes = []any{
-	(InstanceSetting_Key)(0),                             // 0: memos.api.v1.InstanceSetting.Key
-	(InstanceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	(*InstanceProfile)(nil),                              // 2: memos.api.v1.InstanceProfile
-	(*GetInstanceProfileRequest)(nil),                    // 3: memos.api.v1.GetInstanceProfileRequest
-	(*InstanceSetting)(nil),                              // 4: memos.api.v1.InstanceSetting
-	(*GetInstanceSettingRequest)(nil),                    // 5: memos.api.v1.GetInstanceSettingRequest
-	(*UpdateInstanceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateInstanceSettingRequest
-	(*InstanceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.InstanceSetting.GeneralSetting
-	(*InstanceSetting_StorageSetting)(nil),               // 8: memos.api.v1.InstanceSetting.StorageSetting
-	(*InstanceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.InstanceSetting.MemoRelatedSetting
-	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 10: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 11: memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	(*User)(nil),                  // 12: memos.api.v1.User
-	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
+	(InstanceSetting_Key)(0),                                 // 0: memos.api.v1.InstanceSetting.Key
+	(InstanceSetting_StorageSetting_StorageType)(0),          // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	(*InstanceProfile)(nil),                                  // 2: memos.api.v1.InstanceProfile
+	(*GetInstanceProfileRequest)(nil),                        // 3: memos.api.v1.GetInstanceProfileRequest
+	(*InstanceSetting)(nil),                                  // 4: memos.api.v1.InstanceSetting
+	(*GetInstanceSettingRequest)(nil),                        // 5: memos.api.v1.GetInstanceSettingRequest
+	(*UpdateInstanceSettingRequest)(nil),                     // 6: memos.api.v1.UpdateInstanceSettingRequest
+	(*InstanceJob)(nil),                                      // 7: memos.api.v1.InstanceJob
+	(*ListInstanceJobsRequest)(nil),                          // 8: memos.api.v1.ListInstanceJobsRequest
+	(*ListInstanceJobsResponse)(nil),                         // 9: memos.api.v1.ListInstanceJobsResponse
+	(*RunInstanceJobRequest)(nil),                            // 10: memos.api.v1.RunInstanceJobRequest
+	(*InstanceWebhook)(nil),                                  // 11: memos.api.v1.InstanceWebhook
+	(*ListInstanceWebhooksRequest)(nil),                      // 12: memos.api.v1.ListInstanceWebhooksRequest
+	(*ListInstanceWebhooksResponse)(nil),                     // 13: memos.api.v1.ListInstanceWebhooksResponse
+	(*CreateInstanceWebhookRequest)(nil),                     // 14: memos.api.v1.CreateInstanceWebhookRequest
+	(*UpdateInstanceWebhookRequest)(nil),                     // 15: memos.api.v1.UpdateInstanceWebhookRequest
+	(*DeleteInstanceWebhookRequest)(nil),                     // 16: memos.api.v1.DeleteInstanceWebhookRequest
+	(*InstanceSetting_GeneralSetting)(nil),                   // 17: memos.api.v1.InstanceSetting.GeneralSetting
+	(*InstanceSetting_StorageSetting)(nil),                   // 18: memos.api.v1.InstanceSetting.StorageSetting
+	(*InstanceSetting_MemoRelatedSetting)(nil),               // 19: memos.api.v1.InstanceSetting.MemoRelatedSetting
+	(*InstanceSetting_NotificationSetting)(nil),              // 20: memos.api.v1.InstanceSetting.NotificationSetting
+	(*InstanceSetting_GeneralSetting_CustomProfile)(nil),     // 21: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	(*InstanceSetting_StorageSetting_S3Config)(nil),          // 22: memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	(*InstanceSetting_NotificationSetting_EmailSetting)(nil), // 23: memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting
+	(*User)(nil),                   // 24: memos.api.v1.User
+	(*fieldmaskpb.FieldMask)(nil),  // 25: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),  // 26: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 27: google.protobuf.Duration
+	(UserWebhook_PayloadFormat)(0), // 28: memos.api.v1.UserWebhook.PayloadFormat
+	(*emptypb.Empty)(nil),          // 29: google.protobuf.Empty
 }
 var file_api_v1_instance_service_proto_depIdxs = []int32{
-	12, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
-	7,  // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
-	8,  // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
-	9,  // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
-	4,  // 4: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
-	13, // 5: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 6: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	1,  // 7: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	11, // 8: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	3,  // 9: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
-	5,  // 10: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
-	6,  // 11: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
-	2,  // 12: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
-	4,  // 13: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	4,  // 14: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	24, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
+	17, // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
+	18, // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
+	19, // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
+	20, // 4: memos.api.v1.InstanceSetting.notification_setting:type_name -> memos.api.v1.InstanceSetting.NotificationSetting
+	4,  // 5: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
+	25, // 6: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26, // 7: memos.api.v1.InstanceJob.last_run_time:type_name -> google.protobuf.Timestamp
+	27, // 8: memos.api.v1.InstanceJob.last_run_duration:type_name -> google.protobuf.Duration
+	26, // 9: memos.api.v1.InstanceJob.next_run_time:type_name -> google.protobuf.Timestamp
+	7,  // 10: memos.api.v1.ListInstanceJobsResponse.jobs:type_name -> memos.api.v1.InstanceJob
+	28, // 11: memos.api.v1.InstanceWebhook.payload_format:type_name -> memos.api.v1.UserWebhook.PayloadFormat
+	11, // 12: memos.api.v1.ListInstanceWebhooksResponse.webhooks:type_name -> memos.api.v1.InstanceWebhook
+	11, // 13: memos.api.v1.CreateInstanceWebhookRequest.webhook:type_name -> memos.api.v1.InstanceWebhook
+	11, // 14: memos.api.v1.UpdateInstanceWebhookRequest.webhook:type_name -> memos.api.v1.InstanceWebhook
+	25, // 15: memos.api.v1.UpdateInstanceWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21, // 16: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	1,  // 17: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	22, // 18: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	23, // 19: memos.api.v1.InstanceSetting.NotificationSetting.email:type_name -> memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting
+	3,  // 20: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
+	5,  // 21: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
+	6,  // 22: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
+	8,  // 23: memos.api.v1.InstanceService.ListInstanceJobs:input_type -> memos.api.v1.ListInstanceJobsRequest
+	10, // 24: memos.api.v1.InstanceService.RunInstanceJob:input_type -> memos.api.v1.RunInstanceJobRequest
+	12, // 25: memos.api.v1.InstanceService.ListInstanceWebhooks:input_type -> memos.api.v1.ListInstanceWebhooksRequest
+	14, // 26: memos.api.v1.InstanceService.CreateInstanceWebhook:input_type -> memos.api.v1.CreateInstanceWebhookRequest
+	15, // 27: memos.api.v1.InstanceService.UpdateInstanceWebhook:input_type -> memos.api.v1.UpdateInstanceWebhookRequest
+	16, // 28: memos.api.v1.InstanceService.DeleteInstanceWebhook:input_type -> memos.api.v1.DeleteInstanceWebhookRequest
+	2,  // 29: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
+	4,  // 30: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	4,  // 31: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	9,  // 32: memos.api.v1.InstanceService.ListInstanceJobs:output_type -> memos.api.v1.ListInstanceJobsResponse
+	7,  // 33: memos.api.v1.InstanceService.RunInstanceJob:output_type -> memos.api.v1.InstanceJob
+	13, // 34: memos.api.v1.InstanceService.ListInstanceWebhooks:output_type -> memos.api.v1.ListInstanceWebhooksResponse
+	11, // 35: memos.api.v1.InstanceService.CreateInstanceWebhook:output_type -> memos.api.v1.InstanceWebhook
+	11, // 36: memos.api.v1.InstanceService.UpdateInstanceWebhook:output_type -> memos.api.v1.InstanceWebhook
+	29, // 37: memos.api.v1.InstanceService.DeleteInstanceWebhook:output_type -> google.protobuf.Empty
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_instance_service_proto_init() }
@@ -1006,6 +1890,7 @@ func file_api_v1_instance_service_proto_init() {
 		(*InstanceSetting_GeneralSetting_)(nil),
 		(*InstanceSetting_StorageSetting_)(nil),
 		(*InstanceSetting_MemoRelatedSetting_)(nil),
+		(*InstanceSetting_NotificationSetting_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1013,7 +1898,7 @@ func file_api_v1_instance_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_instance_service_proto_rawDesc), len(file_api_v1_instance_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   10,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,6 +23,12 @@ const (
 	InstanceService_GetInstanceProfile_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceProfile"
 	InstanceService_GetInstanceSetting_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceSetting"
 	InstanceService_UpdateInstanceSetting_FullMethodName = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	InstanceService_ListInstanceJobs_FullMethodName      = "/memos.api.v1.InstanceService/ListInstanceJobs"
+	InstanceService_RunInstanceJob_FullMethodName        = "/memos.api.v1.InstanceService/RunInstanceJob"
+	InstanceService_ListInstanceWebhooks_FullMethodName  = "/memos.api.v1.InstanceService/ListInstanceWebhooks"
+	InstanceService_CreateInstanceWebhook_FullMethodName = "/memos.api.v1.InstanceService/CreateInstanceWebhook"
+	InstanceService_UpdateInstanceWebhook_FullMethodName = "/memos.api.v1.InstanceService/UpdateInstanceWebhook"
+	InstanceService_DeleteInstanceWebhook_FullMethodName = "/memos.api.v1.InstanceService/DeleteInstanceWebhook"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -34,6 +41,18 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(ctx context.Context, in *GetInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(ctx context.Context, in *UpdateInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(ctx context.Context, in *ListInstanceJobsRequest, opts ...grpc.CallOption) (*ListInstanceJobsResponse, error)
+	// Runs a background job immediately, outside of its schedule.
+	RunInstanceJob(ctx context.Context, in *RunInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error)
+	// Lists the instance webhooks. Only available to admins.
+	ListInstanceWebhooks(ctx context.Context, in *ListInstanceWebhooksRequest, opts ...grpc.CallOption) (*ListInstanceWebhooksResponse, error)
+	// Creates an instance webhook. Only available to admins.
+	CreateInstanceWebhook(ctx context.Context, in *CreateInstanceWebhookRequest, opts ...grpc.CallOption) (*InstanceWebhook, error)
+	// Updates an instance webhook. Only available to admins.
+	UpdateInstanceWebhook(ctx context.Context, in *UpdateInstanceWebhookRequest, opts ...grpc.CallOption) (*InstanceWebhook, error)
+	// Deletes an instance webhook. Only available to admins.
+	DeleteInstanceWebhook(ctx context.Context, in *DeleteInstanceWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type instanceServiceClient struct {
@@ -74,6 +93,66 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *instanceServiceClient) ListInstanceJobs(ctx context.Context, in *ListInstanceJobsRequest, opts ...grpc.CallOption) (*ListInstanceJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstanceJobsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListInstanceJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RunInstanceJob(ctx context.Context, in *RunInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceJob)
+	err := c.cc.Invoke(ctx, InstanceService_RunInstanceJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ListInstanceWebhooks(ctx context.Context, in *ListInstanceWebhooksRequest, opts ...grpc.CallOption) (*ListInstanceWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstanceWebhooksResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListInstanceWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) CreateInstanceWebhook(ctx context.Context, in *CreateInstanceWebhookRequest, opts ...grpc.CallOption) (*InstanceWebhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceWebhook)
+	err := c.cc.Invoke(ctx, InstanceService_CreateInstanceWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) UpdateInstanceWebhook(ctx context.Context, in *UpdateInstanceWebhookRequest, opts ...grpc.CallOption) (*InstanceWebhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceWebhook)
+	err := c.cc.Invoke(ctx, InstanceService_UpdateInstanceWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) DeleteInstanceWebhook(ctx context.Context, in *DeleteInstanceWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstanceService_DeleteInstanceWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -84,6 +163,18 @@ type InstanceServiceServer interface {
 	GetInstanceSetting(context.Context, *GetInstanceSettingRequest) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(context.Context, *ListInstanceJobsRequest) (*ListInstanceJobsResponse, error)
+	// Runs a background job immediately, outside of its schedule.
+	RunInstanceJob(context.Context, *RunInstanceJobRequest) (*InstanceJob, error)
+	// Lists the instance webhooks. Only available to admins.
+	ListInstanceWebhooks(context.Context, *ListInstanceWebhooksRequest) (*ListInstanceWebhooksResponse, error)
+	// Creates an instance webhook. Only available to admins.
+	CreateInstanceWebhook(context.Context, *CreateInstanceWebhookRequest) (*InstanceWebhook, error)
+	// Updates an instance webhook. Only available to admins.
+	UpdateInstanceWebhook(context.Context, *UpdateInstanceWebhookRequest) (*InstanceWebhook, error)
+	// Deletes an instance webhook. Only available to admins.
+	DeleteInstanceWebhook(context.Context, *DeleteInstanceWebhookRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -103,6 +194,24 @@ func (UnimplementedInstanceServiceServer) GetInstanceSetting(context.Context, *G
 func (UnimplementedInstanceServiceServer) UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceSetting not implemented")
 }
+func (UnimplementedInstanceServiceServer) ListInstanceJobs(context.Context, *ListInstanceJobsRequest) (*ListInstanceJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInstanceJobs not implemented")
+}
+func (UnimplementedInstanceServiceServer) RunInstanceJob(context.Context, *RunInstanceJobRequest) (*InstanceJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInstanceJob not implemented")
+}
+func (UnimplementedInstanceServiceServer) ListInstanceWebhooks(context.Context, *ListInstanceWebhooksRequest) (*ListInstanceWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInstanceWebhooks not implemented")
+}
+func (UnimplementedInstanceServiceServer) CreateInstanceWebhook(context.Context, *CreateInstanceWebhookRequest) (*InstanceWebhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInstanceWebhook not implemented")
+}
+func (UnimplementedInstanceServiceServer) UpdateInstanceWebhook(context.Context, *UpdateInstanceWebhookRequest) (*InstanceWebhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceWebhook not implemented")
+}
+func (UnimplementedInstanceServiceServer) DeleteInstanceWebhook(context.Context, *DeleteInstanceWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteInstanceWebhook not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -178,6 +287,114 @@ func _InstanceService_UpdateInstanceSetting_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_ListInstanceJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstanceJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListInstanceJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListInstanceJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListInstanceJobs(ctx, req.(*ListInstanceJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RunInstanceJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RunInstanceJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RunInstanceJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RunInstanceJob(ctx, req.(*RunInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ListInstanceWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstanceWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListInstanceWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListInstanceWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListInstanceWebhooks(ctx, req.(*ListInstanceWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_CreateInstanceWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstanceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).CreateInstanceWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_CreateInstanceWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).CreateInstanceWebhook(ctx, req.(*CreateInstanceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_UpdateInstanceWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstanceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).UpdateInstanceWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_UpdateInstanceWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).UpdateInstanceWebhook(ctx, req.(*UpdateInstanceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_DeleteInstanceWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstanceWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).DeleteInstanceWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_DeleteInstanceWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).DeleteInstanceWebhook(ctx, req.(*DeleteInstanceWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +414,30 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstanceSetting",
 			Handler:    _InstanceService_UpdateInstanceSetting_Handler,
 		},
+		{
+			MethodName: "ListInstanceJobs",
+			Handler:    _InstanceService_ListInstanceJobs_Handler,
+		},
+		{
+			MethodName: "RunInstanceJob",
+			Handler:    _InstanceService_RunInstanceJob_Handler,
+		},
+		{
+			MethodName: "ListInstanceWebhooks",
+			Handler:    _InstanceService_ListInstanceWebhooks_Handler,
+		},
+		{
+			MethodName: "CreateInstanceWebhook",
+			Handler:    _InstanceService_CreateInstanceWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateInstanceWebhook",
+			Handler:    _InstanceService_UpdateInstanceWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteInstanceWebhook",
+			Handler:    _InstanceService_DeleteInstanceWebhook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/instance_service.proto",
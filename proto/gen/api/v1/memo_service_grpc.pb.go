@@ -34,6 +34,18 @@ const (
 	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
 	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
 	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoRevisions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName     = "/memos.api.v1.MemoService/GetMemoRevision"
+	MemoService_DiffMemoRevisions_FullMethodName   = "/memos.api.v1.MemoService/DiffMemoRevisions"
+	MemoService_RestoreMemoRevision_FullMethodName = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_ListTasks_FullMethodName           = "/memos.api.v1.MemoService/ListTasks"
+	MemoService_SetTaskCompleted_FullMethodName    = "/memos.api.v1.MemoService/SetTaskCompleted"
+	MemoService_ArchiveMemoLink_FullMethodName     = "/memos.api.v1.MemoService/ArchiveMemoLink"
+	MemoService_CreateMemoReminder_FullMethodName  = "/memos.api.v1.MemoService/CreateMemoReminder"
+	MemoService_ListMemoReminders_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReminders"
+	MemoService_UpdateMemoReminder_FullMethodName  = "/memos.api.v1.MemoService/UpdateMemoReminder"
+	MemoService_DeleteMemoReminder_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReminder"
+	MemoService_GetMemoDigest_FullMethodName       = "/memos.api.v1.MemoService/GetMemoDigest"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -68,6 +80,30 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions of a memo, newest first.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(ctx context.Context, in *DiffMemoRevisionsRequest, opts ...grpc.CallOption) (*DiffMemoRevisionsResponse, error)
+	// RestoreMemoRevision restores a memo to the content and visibility of a revision.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// ListTasks lists the task list items in the current user's memos.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// SetTaskCompleted checks or unchecks a task list item in a memo.
+	SetTaskCompleted(ctx context.Context, in *SetTaskCompletedRequest, opts ...grpc.CallOption) (*Task, error)
+	// ArchiveMemoLink saves a readable snapshot of a page linked from a memo as an attachment of the memo.
+	ArchiveMemoLink(ctx context.Context, in *ArchiveMemoLinkRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// CreateMemoReminder adds a reminder to a memo.
+	CreateMemoReminder(ctx context.Context, in *CreateMemoReminderRequest, opts ...grpc.CallOption) (*MemoReminder, error)
+	// ListMemoReminders lists the reminders of a memo, soonest first.
+	ListMemoReminders(ctx context.Context, in *ListMemoRemindersRequest, opts ...grpc.CallOption) (*ListMemoRemindersResponse, error)
+	// UpdateMemoReminder updates the time or recurrence of a reminder.
+	UpdateMemoReminder(ctx context.Context, in *UpdateMemoReminderRequest, opts ...grpc.CallOption) (*MemoReminder, error)
+	// DeleteMemoReminder deletes a reminder of a memo.
+	DeleteMemoReminder(ctx context.Context, in *DeleteMemoReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetMemoDigest returns the current user's memos to look back at on a day.
+	GetMemoDigest(ctx context.Context, in *GetMemoDigestRequest, opts ...grpc.CallOption) (*MemoDigest, error)
 }
 
 type memoServiceClient struct {
@@ -218,6 +254,126 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRevision)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DiffMemoRevisions(ctx context.Context, in *DiffMemoRevisionsRequest, opts ...grpc.CallOption) (*DiffMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_DiffMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetTaskCompleted(ctx context.Context, in *SetTaskCompletedRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, MemoService_SetTaskCompleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ArchiveMemoLink(ctx context.Context, in *ArchiveMemoLinkRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, MemoService_ArchiveMemoLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoReminder(ctx context.Context, in *CreateMemoReminderRequest, opts ...grpc.CallOption) (*MemoReminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoReminder)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoReminders(ctx context.Context, in *ListMemoRemindersRequest, opts ...grpc.CallOption) (*ListMemoRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRemindersResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UpdateMemoReminder(ctx context.Context, in *UpdateMemoReminderRequest, opts ...grpc.CallOption) (*MemoReminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoReminder)
+	err := c.cc.Invoke(ctx, MemoService_UpdateMemoReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoReminder(ctx context.Context, in *DeleteMemoReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoDigest(ctx context.Context, in *GetMemoDigestRequest, opts ...grpc.CallOption) (*MemoDigest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoDigest)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
@@ -250,6 +406,30 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions of a memo, newest first.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error)
+	// DiffMemoRevisions returns a unified diff between two revisions of a memo.
+	DiffMemoRevisions(context.Context, *DiffMemoRevisionsRequest) (*DiffMemoRevisionsResponse, error)
+	// RestoreMemoRevision restores a memo to the content and visibility of a revision.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// ListTasks lists the task list items in the current user's memos.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// SetTaskCompleted checks or unchecks a task list item in a memo.
+	SetTaskCompleted(context.Context, *SetTaskCompletedRequest) (*Task, error)
+	// ArchiveMemoLink saves a readable snapshot of a page linked from a memo as an attachment of the memo.
+	ArchiveMemoLink(context.Context, *ArchiveMemoLinkRequest) (*Attachment, error)
+	// CreateMemoReminder adds a reminder to a memo.
+	CreateMemoReminder(context.Context, *CreateMemoReminderRequest) (*MemoReminder, error)
+	// ListMemoReminders lists the reminders of a memo, soonest first.
+	ListMemoReminders(context.Context, *ListMemoRemindersRequest) (*ListMemoRemindersResponse, error)
+	// UpdateMemoReminder updates the time or recurrence of a reminder.
+	UpdateMemoReminder(context.Context, *UpdateMemoReminderRequest) (*MemoReminder, error)
+	// DeleteMemoReminder deletes a reminder of a memo.
+	DeleteMemoReminder(context.Context, *DeleteMemoReminderRequest) (*emptypb.Empty, error)
+	// GetMemoDigest returns the current user's memos to look back at on a day.
+	GetMemoDigest(context.Context, *GetMemoDigestRequest) (*MemoDigest, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -302,6 +482,42 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) DiffMemoRevisions(context.Context, *DiffMemoRevisionsRequest) (*DiffMemoRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedMemoServiceServer) SetTaskCompleted(context.Context, *SetTaskCompletedRequest) (*Task, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaskCompleted not implemented")
+}
+func (UnimplementedMemoServiceServer) ArchiveMemoLink(context.Context, *ArchiveMemoLinkRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveMemoLink not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoReminder(context.Context, *CreateMemoReminderRequest) (*MemoReminder, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoReminders(context.Context, *ListMemoRemindersRequest) (*ListMemoRemindersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoReminders not implemented")
+}
+func (UnimplementedMemoServiceServer) UpdateMemoReminder(context.Context, *UpdateMemoReminderRequest) (*MemoReminder, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoReminder(context.Context, *DeleteMemoReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemoReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoDigest(context.Context, *GetMemoDigestRequest) (*MemoDigest, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoDigest not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -575,6 +791,222 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DiffMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DiffMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DiffMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DiffMemoRevisions(ctx, req.(*DiffMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetTaskCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskCompletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetTaskCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetTaskCompleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetTaskCompleted(ctx, req.(*SetTaskCompletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ArchiveMemoLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMemoLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ArchiveMemoLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ArchiveMemoLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ArchiveMemoLink(ctx, req.(*ArchiveMemoLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoReminder(ctx, req.(*CreateMemoReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoReminders(ctx, req.(*ListMemoRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemoReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemoReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemoReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemoReminder(ctx, req.(*UpdateMemoReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoReminder(ctx, req.(*DeleteMemoReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoDigest(ctx, req.(*GetMemoDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -638,6 +1070,54 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "DiffMemoRevisions",
+			Handler:    _MemoService_DiffMemoRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _MemoService_ListTasks_Handler,
+		},
+		{
+			MethodName: "SetTaskCompleted",
+			Handler:    _MemoService_SetTaskCompleted_Handler,
+		},
+		{
+			MethodName: "ArchiveMemoLink",
+			Handler:    _MemoService_ArchiveMemoLink_Handler,
+		},
+		{
+			MethodName: "CreateMemoReminder",
+			Handler:    _MemoService_CreateMemoReminder_Handler,
+		},
+		{
+			MethodName: "ListMemoReminders",
+			Handler:    _MemoService_ListMemoReminders_Handler,
+		},
+		{
+			MethodName: "UpdateMemoReminder",
+			Handler:    _MemoService_UpdateMemoReminder_Handler,
+		},
+		{
+			MethodName: "DeleteMemoReminder",
+			Handler:    _MemoService_DeleteMemoReminder_Handler,
+		},
+		{
+			MethodName: "GetMemoDigest",
+			Handler:    _MemoService_GetMemoDigest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/memo_template_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo template.
+	// Format: users/{user}/templates/{template}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the memo template.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The content of the memos created from the template.
+	// The placeholders {{date}}, {{time}}, {{weekday}}, {{week}}, {{month}} and {{year}}
+	// are replaced with the time the memo is created at in the time zone of the user,
+	// e.g. `2026-10-16`, `09:30`, `Friday`, `2026-W42`, `2026-10` and `2026`.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memos created from the template.
+	// Defaults to private.
+	Visibility Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// A cron expression to create memos from the template on, e.g. `0 9 * * 1-5`.
+	// Supports the standard five fields, descriptors such as `@weekly` and a `CRON_TZ=` prefix.
+	// Without `CRON_TZ=`, the expression is evaluated in the time zone of the user.
+	// Leave empty to only create memos from the template manually.
+	Cron string `protobuf:"bytes,5,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Output only. The time the next memo is created from the template.
+	// Unset without a cron expression.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoTemplate) Reset() {
+	*x = MemoTemplate{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoTemplate) ProtoMessage() {}
+
+func (x *MemoTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoTemplate.ProtoReflect.Descriptor instead.
+func (*MemoTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *MemoTemplate) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+type ListMemoTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where memo templates are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTemplatesRequest) Reset() {
+	*x = ListMemoTemplatesRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTemplatesRequest) ProtoMessage() {}
+
+func (x *ListMemoTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMemoTemplatesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memo templates.
+	Templates     []*MemoTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTemplatesResponse) Reset() {
+	*x = ListMemoTemplatesResponse{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTemplatesResponse) ProtoMessage() {}
+
+func (x *ListMemoTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMemoTemplatesResponse) GetTemplates() []*MemoTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetMemoTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo template to retrieve.
+	// Format: users/{user}/templates/{template}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoTemplateRequest) Reset() {
+	*x = GetMemoTemplateRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoTemplateRequest) ProtoMessage() {}
+
+func (x *GetMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMemoTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateMemoTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this memo template will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The memo template to create.
+	Template      *MemoTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoTemplateRequest) Reset() {
+	*x = CreateMemoTemplateRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoTemplateRequest) ProtoMessage() {}
+
+func (x *CreateMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMemoTemplateRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoTemplateRequest) GetTemplate() *MemoTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateMemoTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo template resource which replaces the resource on the server.
+	Template *MemoTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoTemplateRequest) Reset() {
+	*x = UpdateMemoTemplateRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMemoTemplateRequest) GetTemplate() *MemoTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *UpdateMemoTemplateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMemoTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo template to delete.
+	// Format: users/{user}/templates/{template}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTemplateRequest) Reset() {
+	*x = DeleteMemoTemplateRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteMemoTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateMemoFromTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo template to create the memo from.
+	// Format: users/{user}/templates/{template}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoFromTemplateRequest) Reset() {
+	*x = CreateMemoFromTemplateRequest{}
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateMemoFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_template_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_template_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateMemoFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_memo_template_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_memo_template_service_proto_rawDesc = "" +
+	"\n" +
+	"\"api/v1/memo_template_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
+	"\fMemoTemplate\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tB\x03\xe0A\x02R\acontent\x12=\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\x12\x17\n" +
+	"\x04cron\x18\x05 \x01(\tB\x03\xe0A\x01R\x04cron\x12C\n" +
+	"\rnext_run_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime:V\xeaAS\n" +
+	"\x19memos.api.v1/MemoTemplate\x12!users/{user}/templates/{template}*\ttemplates2\btemplate\"U\n" +
+	"\x18ListMemoTemplatesRequest\x129\n" +
+	"\x06parent\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\x12\x19memos.api.v1/MemoTemplateR\x06parent\"U\n" +
+	"\x19ListMemoTemplatesResponse\x128\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoTemplateR\ttemplates\"O\n" +
+	"\x16GetMemoTemplateRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoTemplateR\x04name\"\x93\x01\n" +
+	"\x19CreateMemoTemplateRequest\x129\n" +
+	"\x06parent\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\x12\x19memos.api.v1/MemoTemplateR\x06parent\x12;\n" +
+	"\btemplate\x18\x02 \x01(\v2\x1a.memos.api.v1.MemoTemplateB\x03\xe0A\x02R\btemplate\"\x9a\x01\n" +
+	"\x19UpdateMemoTemplateRequest\x12;\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1a.memos.api.v1.MemoTemplateB\x03\xe0A\x02R\btemplate\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"R\n" +
+	"\x19DeleteMemoTemplateRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoTemplateR\x04name\"V\n" +
+	"\x1dCreateMemoFromTemplateRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoTemplateR\x04name2\xb8\a\n" +
+	"\x13MemoTemplateService\x12\x99\x01\n" +
+	"\x11ListMemoTemplates\x12&.memos.api.v1.ListMemoTemplatesRequest\x1a'.memos.api.v1.ListMemoTemplatesResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/templates\x12\x86\x01\n" +
+	"\x0fGetMemoTemplate\x12$.memos.api.v1.GetMemoTemplateRequest\x1a\x1a.memos.api.v1.MemoTemplate\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=users/*/templates/*}\x12\xa1\x01\n" +
+	"\x12CreateMemoTemplate\x12'.memos.api.v1.CreateMemoTemplateRequest\x1a\x1a.memos.api.v1.MemoTemplate\"F\xdaA\x0fparent,template\x82\xd3\xe4\x93\x02.:\btemplate\"\"/api/v1/{parent=users/*}/templates\x12\xaf\x01\n" +
+	"\x12UpdateMemoTemplate\x12'.memos.api.v1.UpdateMemoTemplateRequest\x1a\x1a.memos.api.v1.MemoTemplate\"T\xdaA\x14template,update_mask\x82\xd3\xe4\x93\x027:\btemplate2+/api/v1/{template.name=users/*/templates/*}\x12\x88\x01\n" +
+	"\x12DeleteMemoTemplate\x12'.memos.api.v1.DeleteMemoTemplateRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/templates/*}\x12\x9a\x01\n" +
+	"\x16CreateMemoFromTemplate\x12+.memos.api.v1.CreateMemoFromTemplateRequest\x1a\x12.memos.api.v1.Memo\"?\xdaA\x04name\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{name=users/*/templates/*}:createMemoB\xb0\x01\n" +
+	"\x10com.memos.api.v1B\x18MemoTemplateServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_memo_template_service_proto_rawDescOnce sync.Once
+	file_api_v1_memo_template_service_proto_rawDescData []byte
+)
+
+func file_api_v1_memo_template_service_proto_rawDescGZIP() []byte {
+	file_api_v1_memo_template_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_memo_template_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_memo_template_service_proto_rawDesc), len(file_api_v1_memo_template_service_proto_rawDesc)))
+	})
+	return file_api_v1_memo_template_service_proto_rawDescData
+}
+
+var file_api_v1_memo_template_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_memo_template_service_proto_goTypes = []any{
+	(*MemoTemplate)(nil),                  // 0: memos.api.v1.MemoTemplate
+	(*ListMemoTemplatesRequest)(nil),      // 1: memos.api.v1.ListMemoTemplatesRequest
+	(*ListMemoTemplatesResponse)(nil),     // 2: memos.api.v1.ListMemoTemplatesResponse
+	(*GetMemoTemplateRequest)(nil),        // 3: memos.api.v1.GetMemoTemplateRequest
+	(*CreateMemoTemplateRequest)(nil),     // 4: memos.api.v1.CreateMemoTemplateRequest
+	(*UpdateMemoTemplateRequest)(nil),     // 5: memos.api.v1.UpdateMemoTemplateRequest
+	(*DeleteMemoTemplateRequest)(nil),     // 6: memos.api.v1.DeleteMemoTemplateRequest
+	(*CreateMemoFromTemplateRequest)(nil), // 7: memos.api.v1.CreateMemoFromTemplateRequest
+	(Visibility)(0),                       // 8: memos.api.v1.Visibility
+	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 11: google.protobuf.Empty
+	(*Memo)(nil),                          // 12: memos.api.v1.Memo
+}
+var file_api_v1_memo_template_service_proto_depIdxs = []int32{
+	8,  // 0: memos.api.v1.MemoTemplate.visibility:type_name -> memos.api.v1.Visibility
+	9,  // 1: memos.api.v1.MemoTemplate.next_run_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: memos.api.v1.ListMemoTemplatesResponse.templates:type_name -> memos.api.v1.MemoTemplate
+	0,  // 3: memos.api.v1.CreateMemoTemplateRequest.template:type_name -> memos.api.v1.MemoTemplate
+	0,  // 4: memos.api.v1.UpdateMemoTemplateRequest.template:type_name -> memos.api.v1.MemoTemplate
+	10, // 5: memos.api.v1.UpdateMemoTemplateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: memos.api.v1.MemoTemplateService.ListMemoTemplates:input_type -> memos.api.v1.ListMemoTemplatesRequest
+	3,  // 7: memos.api.v1.MemoTemplateService.GetMemoTemplate:input_type -> memos.api.v1.GetMemoTemplateRequest
+	4,  // 8: memos.api.v1.MemoTemplateService.CreateMemoTemplate:input_type -> memos.api.v1.CreateMemoTemplateRequest
+	5,  // 9: memos.api.v1.MemoTemplateService.UpdateMemoTemplate:input_type -> memos.api.v1.UpdateMemoTemplateRequest
+	6,  // 10: memos.api.v1.MemoTemplateService.DeleteMemoTemplate:input_type -> memos.api.v1.DeleteMemoTemplateRequest
+	7,  // 11: memos.api.v1.MemoTemplateService.CreateMemoFromTemplate:input_type -> memos.api.v1.CreateMemoFromTemplateRequest
+	2,  // 12: memos.api.v1.MemoTemplateService.ListMemoTemplates:output_type -> memos.api.v1.ListMemoTemplatesResponse
+	0,  // 13: memos.api.v1.MemoTemplateService.GetMemoTemplate:output_type -> memos.api.v1.MemoTemplate
+	0,  // 14: memos.api.v1.MemoTemplateService.CreateMemoTemplate:output_type -> memos.api.v1.MemoTemplate
+	0,  // 15: memos.api.v1.MemoTemplateService.UpdateMemoTemplate:output_type -> memos.api.v1.MemoTemplate
+	11, // 16: memos.api.v1.MemoTemplateService.DeleteMemoTemplate:output_type -> google.protobuf.Empty
+	12, // 17: memos.api.v1.MemoTemplateService.CreateMemoFromTemplate:output_type -> memos.api.v1.Memo
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_memo_template_service_proto_init() }
+func file_api_v1_memo_template_service_proto_init() {
+	if File_api_v1_memo_template_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_template_service_proto_rawDesc), len(file_api_v1_memo_template_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_memo_template_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_memo_template_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_memo_template_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_memo_template_service_proto = out.File
+	file_api_v1_memo_template_service_proto_goTypes = nil
+	file_api_v1_memo_template_service_proto_depIdxs = nil
+}
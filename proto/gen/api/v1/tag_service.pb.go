@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/tag_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag path without the leading #, e.g. `work/project-a`.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Output only. The number of memos using exactly this tag.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// Output only. The number of memos using this tag or any of its nested tags.
+	TotalMemoCount int32 `protobuf:"varint,3,opt,name=total_memo_count,json=totalMemoCount,proto3" json:"total_memo_count,omitempty"`
+	// Output only. The last update time of the memos using this tag or any of its nested tags.
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// Optional. The display color of the tag in #RRGGBB format.
+	Color string `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	// Optional. The description of the tag.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional. Whether the tag is pinned.
+	Pinned bool `protobuf:"varint,7,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Output only. The nested tags, e.g. `work/project-a` is a child of `work`.
+	Children      []*Tag `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Tag) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetTotalMemoCount() int32 {
+	if x != nil {
+		return x.TotalMemoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tag) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Tag) GetChildren() []*Tag {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The top-level tags. Pinned tags come first, then tags are ordered by name.
+	Tags          []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag to update.
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Required. The list of fields to update: color, description or pinned.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTagRequest) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag to rename, without the leading #.
+	// Nested tags are renamed as well, e.g. `work/project` becomes `job/project`.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Required. The new name of the tag, without the leading #.
+	NewTag string `protobuf:"bytes,2,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// Optional. If true, the affected memos are counted but not updated.
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RenameTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that were updated, or would be updated if validate_only is set.
+	AffectedMemoCount int32 `protobuf:"varint,1,opt,name=affected_memo_count,json=affectedMemoCount,proto3" json:"affected_memo_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RenameTagResponse) GetAffectedMemoCount() int32 {
+	if x != nil {
+		return x.AffectedMemoCount
+	}
+	return 0
+}
+
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tags to merge, without the leading #.
+	SourceTags []string `protobuf:"bytes,1,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	// Required. The tag to merge into, without the leading #.
+	TargetTag string `protobuf:"bytes,2,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	// Optional. If true, the affected memos are counted but not updated.
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type MergeTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that were updated, or would be updated if validate_only is set.
+	AffectedMemoCount int32 `protobuf:"varint,1,opt,name=affected_memo_count,json=affectedMemoCount,proto3" json:"affected_memo_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MergeTagsResponse) GetAffectedMemoCount() int32 {
+	if x != nil {
+		return x.AffectedMemoCount
+	}
+	return 0
+}
+
+type DeleteTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag to delete, without the leading #.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. If true, the affected memos are counted but not updated.
+	ValidateOnly  bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeleteTagRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type DeleteTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that were updated, or would be updated if validate_only is set.
+	AffectedMemoCount int32 `protobuf:"varint,1,opt,name=affected_memo_count,json=affectedMemoCount,proto3" json:"affected_memo_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteTagResponse) GetAffectedMemoCount() int32 {
+	if x != nil {
+		return x.AffectedMemoCount
+	}
+	return 0
+}
+
+var File_api_v1_tag_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_tag_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/v1/tag_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x02\n" +
+	"\x03Tag\x12\x15\n" +
+	"\x03tag\x18\x01 \x01(\tB\x03\xe0A\x02R\x03tag\x12\"\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05B\x03\xe0A\x03R\tmemoCount\x12-\n" +
+	"\x10total_memo_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\x0etotalMemoCount\x12E\n" +
+	"\x0elast_used_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tB\x03\xe0A\x01R\x05color\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tB\x03\xe0A\x01R\vdescription\x12\x1b\n" +
+	"\x06pinned\x18\a \x01(\bB\x03\xe0A\x01R\x06pinned\x122\n" +
+	"\bchildren\x18\b \x03(\v2\x11.memos.api.v1.TagB\x03\xe0A\x03R\bchildren\"\x11\n" +
+	"\x0fListTagsRequest\"9\n" +
+	"\x10ListTagsResponse\x12%\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"~\n" +
+	"\x10UpdateTagRequest\x12(\n" +
+	"\x03tag\x18\x01 \x01(\v2\x11.memos.api.v1.TagB\x03\xe0A\x02R\x03tag\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"q\n" +
+	"\x10RenameTagRequest\x12\x15\n" +
+	"\x03tag\x18\x01 \x01(\tB\x03\xe0A\x02R\x03tag\x12\x1c\n" +
+	"\anew_tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x06newTag\x12(\n" +
+	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"C\n" +
+	"\x11RenameTagResponse\x12.\n" +
+	"\x13affected_memo_count\x18\x01 \x01(\x05R\x11affectedMemoCount\"\x86\x01\n" +
+	"\x10MergeTagsRequest\x12$\n" +
+	"\vsource_tags\x18\x01 \x03(\tB\x03\xe0A\x02R\n" +
+	"sourceTags\x12\"\n" +
+	"\n" +
+	"target_tag\x18\x02 \x01(\tB\x03\xe0A\x02R\ttargetTag\x12(\n" +
+	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"C\n" +
+	"\x11MergeTagsResponse\x12.\n" +
+	"\x13affected_memo_count\x18\x01 \x01(\x05R\x11affectedMemoCount\"S\n" +
+	"\x10DeleteTagRequest\x12\x15\n" +
+	"\x03tag\x18\x01 \x01(\tB\x03\xe0A\x02R\x03tag\x12(\n" +
+	"\rvalidate_only\x18\x02 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"C\n" +
+	"\x11DeleteTagResponse\x12.\n" +
+	"\x13affected_memo_count\x18\x01 \x01(\x05R\x11affectedMemoCount2\xa3\x04\n" +
+	"\n" +
+	"TagService\x12_\n" +
+	"\bListTags\x12\x1d.memos.api.v1.ListTagsRequest\x1a\x1e.memos.api.v1.ListTagsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/tags\x12k\n" +
+	"\tUpdateTag\x12\x1e.memos.api.v1.UpdateTagRequest\x1a\x11.memos.api.v1.Tag\"+\xdaA\x0ftag,update_mask\x82\xd3\xe4\x93\x02\x13:\x03tag2\f/api/v1/tags\x12l\n" +
+	"\tRenameTag\x12\x1e.memos.api.v1.RenameTagRequest\x1a\x1f.memos.api.v1.RenameTagResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/tags:rename\x12k\n" +
+	"\tMergeTags\x12\x1e.memos.api.v1.MergeTagsRequest\x1a\x1f.memos.api.v1.MergeTagsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/tags:merge\x12l\n" +
+	"\tDeleteTag\x12\x1e.memos.api.v1.DeleteTagRequest\x1a\x1f.memos.api.v1.DeleteTagResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/tags:deleteB\xa7\x01\n" +
+	"\x10com.memos.api.v1B\x0fTagServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_tag_service_proto_rawDescOnce sync.Once
+	file_api_v1_tag_service_proto_rawDescData []byte
+)
+
+func file_api_v1_tag_service_proto_rawDescGZIP() []byte {
+	file_api_v1_tag_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_tag_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)))
+	})
+	return file_api_v1_tag_service_proto_rawDescData
+}
+
+var file_api_v1_tag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_tag_service_proto_goTypes = []any{
+	(*Tag)(nil),                   // 0: memos.api.v1.Tag
+	(*ListTagsRequest)(nil),       // 1: memos.api.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),      // 2: memos.api.v1.ListTagsResponse
+	(*UpdateTagRequest)(nil),      // 3: memos.api.v1.UpdateTagRequest
+	(*RenameTagRequest)(nil),      // 4: memos.api.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),     // 5: memos.api.v1.RenameTagResponse
+	(*MergeTagsRequest)(nil),      // 6: memos.api.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),     // 7: memos.api.v1.MergeTagsResponse
+	(*DeleteTagRequest)(nil),      // 8: memos.api.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),     // 9: memos.api.v1.DeleteTagResponse
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 11: google.protobuf.FieldMask
+}
+var file_api_v1_tag_service_proto_depIdxs = []int32{
+	10, // 0: memos.api.v1.Tag.last_used_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: memos.api.v1.Tag.children:type_name -> memos.api.v1.Tag
+	0,  // 2: memos.api.v1.ListTagsResponse.tags:type_name -> memos.api.v1.Tag
+	0,  // 3: memos.api.v1.UpdateTagRequest.tag:type_name -> memos.api.v1.Tag
+	11, // 4: memos.api.v1.UpdateTagRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 5: memos.api.v1.TagService.ListTags:input_type -> memos.api.v1.ListTagsRequest
+	3,  // 6: memos.api.v1.TagService.UpdateTag:input_type -> memos.api.v1.UpdateTagRequest
+	4,  // 7: memos.api.v1.TagService.RenameTag:input_type -> memos.api.v1.RenameTagRequest
+	6,  // 8: memos.api.v1.TagService.MergeTags:input_type -> memos.api.v1.MergeTagsRequest
+	8,  // 9: memos.api.v1.TagService.DeleteTag:input_type -> memos.api.v1.DeleteTagRequest
+	2,  // 10: memos.api.v1.TagService.ListTags:output_type -> memos.api.v1.ListTagsResponse
+	0,  // 11: memos.api.v1.TagService.UpdateTag:output_type -> memos.api.v1.Tag
+	5,  // 12: memos.api.v1.TagService.RenameTag:output_type -> memos.api.v1.RenameTagResponse
+	7,  // 13: memos.api.v1.TagService.MergeTags:output_type -> memos.api.v1.MergeTagsResponse
+	9,  // 14: memos.api.v1.TagService.DeleteTag:output_type -> memos.api.v1.DeleteTagResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_tag_service_proto_init() }
+func file_api_v1_tag_service_proto_init() {
+	if File_api_v1_tag_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_tag_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_tag_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_tag_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_tag_service_proto = out.File
+	file_api_v1_tag_service_proto_goTypes = nil
+	file_api_v1_tag_service_proto_depIdxs = nil
+}
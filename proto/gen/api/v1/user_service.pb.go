@@ -86,6 +86,8 @@ const (
 	UserSetting_GENERAL UserSetting_Key = 1
 	// WEBHOOKS is the key for user webhooks.
 	UserSetting_WEBHOOKS UserSetting_Key = 4
+	// NOTIFICATION is the key for user notification preferences.
+	UserSetting_NOTIFICATION UserSetting_Key = 5
 )
 
 // Enum value maps for UserSetting_Key.
@@ -94,11 +96,13 @@ var (
 		0: "KEY_UNSPECIFIED",
 		1: "GENERAL",
 		4: "WEBHOOKS",
+		5: "NOTIFICATION",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"WEBHOOKS":        4,
+		"NOTIFICATION":    5,
 	}
 )
 
@@ -129,6 +133,125 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 0}
 }
 
+type UserWebhook_PayloadFormat int32
+
+const (
+	// Defaults to MEMOS.
+	UserWebhook_PAYLOAD_FORMAT_UNSPECIFIED UserWebhook_PayloadFormat = 0
+	// The Memos JSON payload with the activity type, creator and memo.
+	UserWebhook_MEMOS UserWebhook_PayloadFormat = 1
+	// A Slack incoming webhook message.
+	UserWebhook_SLACK UserWebhook_PayloadFormat = 2
+	// A Discord webhook message.
+	UserWebhook_DISCORD UserWebhook_PayloadFormat = 3
+	// A Mattermost incoming webhook message.
+	UserWebhook_MATTERMOST UserWebhook_PayloadFormat = 4
+	// A body rendered from payload_template.
+	UserWebhook_TEMPLATE UserWebhook_PayloadFormat = 5
+)
+
+// Enum value maps for UserWebhook_PayloadFormat.
+var (
+	UserWebhook_PayloadFormat_name = map[int32]string{
+		0: "PAYLOAD_FORMAT_UNSPECIFIED",
+		1: "MEMOS",
+		2: "SLACK",
+		3: "DISCORD",
+		4: "MATTERMOST",
+		5: "TEMPLATE",
+	}
+	UserWebhook_PayloadFormat_value = map[string]int32{
+		"PAYLOAD_FORMAT_UNSPECIFIED": 0,
+		"MEMOS":                      1,
+		"SLACK":                      2,
+		"DISCORD":                    3,
+		"MATTERMOST":                 4,
+		"TEMPLATE":                   5,
+	}
+)
+
+func (x UserWebhook_PayloadFormat) Enum() *UserWebhook_PayloadFormat {
+	p := new(UserWebhook_PayloadFormat)
+	*p = x
+	return p
+}
+
+func (x UserWebhook_PayloadFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhook_PayloadFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (UserWebhook_PayloadFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[2]
+}
+
+func (x UserWebhook_PayloadFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhook_PayloadFormat.Descriptor instead.
+func (UserWebhook_PayloadFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type UserWebhookDelivery_State int32
+
+const (
+	UserWebhookDelivery_STATE_UNSPECIFIED UserWebhookDelivery_State = 0
+	// The delivery is waiting for its next attempt.
+	UserWebhookDelivery_PENDING UserWebhookDelivery_State = 1
+	// The endpoint accepted the delivery.
+	UserWebhookDelivery_SUCCEEDED UserWebhookDelivery_State = 2
+	// The delivery failed after the maximum number of attempts.
+	UserWebhookDelivery_FAILED UserWebhookDelivery_State = 3
+)
+
+// Enum value maps for UserWebhookDelivery_State.
+var (
+	UserWebhookDelivery_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	UserWebhookDelivery_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x UserWebhookDelivery_State) Enum() *UserWebhookDelivery_State {
+	p := new(UserWebhookDelivery_State)
+	*p = x
+	return p
+}
+
+func (x UserWebhookDelivery_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhookDelivery_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserWebhookDelivery_State) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[3]
+}
+
+func (x UserWebhookDelivery_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhookDelivery_State.Descriptor instead.
+func (UserWebhookDelivery_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39, 0}
+}
+
 type UserNotification_Status int32
 
 const (
@@ -162,11 +285,11 @@ func (x UserNotification_Status) String() string {
 }
 
 func (UserNotification_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[4].Descriptor()
 }
 
 func (UserNotification_Status) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[2]
+	return &file_api_v1_user_service_proto_enumTypes[4]
 }
 
 func (x UserNotification_Status) Number() protoreflect.EnumNumber {
@@ -175,7 +298,7 @@ func (x UserNotification_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Status.Descriptor instead.
 func (UserNotification_Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type UserNotification_Type int32
@@ -183,6 +306,9 @@ type UserNotification_Type int32
 const (
 	UserNotification_TYPE_UNSPECIFIED UserNotification_Type = 0
 	UserNotification_MEMO_COMMENT     UserNotification_Type = 1
+	UserNotification_MENTION          UserNotification_Type = 2
+	UserNotification_REMINDER         UserNotification_Type = 3
+	UserNotification_DIGEST           UserNotification_Type = 4
 )
 
 // Enum value maps for UserNotification_Type.
@@ -190,10 +316,16 @@ var (
 	UserNotification_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "MENTION",
+		3: "REMINDER",
+		4: "DIGEST",
 	}
 	UserNotification_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"MENTION":          2,
+		"REMINDER":         3,
+		"DIGEST":           4,
 	}
 )
 
@@ -208,11 +340,11 @@ func (x UserNotification_Type) String() string {
 }
 
 func (UserNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[5].Descriptor()
 }
 
 func (UserNotification_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[3]
+	return &file_api_v1_user_service_proto_enumTypes[5]
 }
 
 func (x UserNotification_Type) Number() protoreflect.EnumNumber {
@@ -221,7 +353,7 @@ func (x UserNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Type.Descriptor instead.
 func (UserNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 1}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43, 1}
 }
 
 type User struct {
@@ -509,7 +641,6 @@ type GetUserRequest struct {
 	// Supports both numeric IDs and username strings:
 	//   - users/{id}       (e.g., users/101)
 	//   - users/{username} (e.g., users/steven)
-	//
 	// Format: users/{id_or_username}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Optional. The fields to return in the response.
@@ -986,6 +1117,7 @@ type UserSetting struct {
 	//
 	//	*UserSetting_GeneralSetting_
 	//	*UserSetting_WebhooksSetting_
+	//	*UserSetting_NotificationSetting_
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1053,6 +1185,15 @@ func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
 	return nil
 }
 
+func (x *UserSetting) GetNotificationSetting() *UserSetting_NotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NotificationSetting_); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -1065,10 +1206,16 @@ type UserSetting_WebhooksSetting_ struct {
 	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
 }
 
+type UserSetting_NotificationSetting_ struct {
+	NotificationSetting *UserSetting_NotificationSetting `protobuf:"bytes,6,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
 func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
 
 func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
 
+func (*UserSetting_NotificationSetting_) isUserSetting_Value() {}
+
 type GetUserSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user setting.
@@ -1679,38 +1826,36 @@ func (x *DeletePersonalAccessTokenRequest) GetName() string {
 	return ""
 }
 
-// UserWebhook represents a webhook owned by a user.
-type UserWebhook struct {
+// UserTwoFactor is the two-factor authentication status of a user.
+type UserTwoFactor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook.
-	// Format: users/{user}/webhooks/{webhook}
+	// The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The URL to send the webhook to.
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	// Optional. Human-readable name for the webhook.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// The creation time of the webhook.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The last update time of the webhook.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UserWebhook) Reset() {
-	*x = UserWebhook{}
+	// Output only. Whether sign-in with a password requires a TOTP or recovery code.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Output only. When two-factor authentication was enabled.
+	EnableTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enable_time,json=enableTime,proto3" json:"enable_time,omitempty"`
+	// Output only. The number of unused recovery codes.
+	RemainingRecoveryCodes int32 `protobuf:"varint,4,opt,name=remaining_recovery_codes,json=remainingRecoveryCodes,proto3" json:"remaining_recovery_codes,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserTwoFactor) Reset() {
+	*x = UserTwoFactor{}
 	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserWebhook) String() string {
+func (x *UserTwoFactor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserWebhook) ProtoMessage() {}
+func (*UserTwoFactor) ProtoMessage() {}
 
-func (x *UserWebhook) ProtoReflect() protoreflect.Message {
+func (x *UserTwoFactor) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,69 +1867,62 @@ func (x *UserWebhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
-func (*UserWebhook) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserTwoFactor.ProtoReflect.Descriptor instead.
+func (*UserTwoFactor) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UserWebhook) GetName() string {
+func (x *UserTwoFactor) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserWebhook) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *UserWebhook) GetDisplayName() string {
+func (x *UserTwoFactor) GetEnabled() bool {
 	if x != nil {
-		return x.DisplayName
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserTwoFactor) GetEnableTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreateTime
+		return x.EnableTime
 	}
 	return nil
 }
 
-func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+func (x *UserTwoFactor) GetRemainingRecoveryCodes() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.RemainingRecoveryCodes
 	}
-	return nil
+	return 0
 }
 
-type ListUserWebhooksRequest struct {
+type GetUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksRequest) Reset() {
-	*x = ListUserWebhooksRequest{}
+func (x *GetUserTwoFactorRequest) Reset() {
+	*x = GetUserTwoFactorRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksRequest) String() string {
+func (x *GetUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksRequest) ProtoMessage() {}
+func (*GetUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1796,40 +1934,41 @@ func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListUserWebhooksRequest) GetParent() string {
+func (x *GetUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-type ListUserWebhooksResponse struct {
+type SetupUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksResponse) Reset() {
-	*x = ListUserWebhooksResponse{}
+func (x *SetupUserTwoFactorRequest) Reset() {
+	*x = SetupUserTwoFactorRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksResponse) String() string {
+func (x *SetupUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksResponse) ProtoMessage() {}
+func (*SetupUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
+func (x *SetupUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1841,43 +1980,42 @@ func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*SetupUserTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+func (x *SetupUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.Webhooks
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateUserWebhookRequest struct {
+type SetupUserTwoFactorResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The webhook to create.
-	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The base32 encoded TOTP secret, for manual entry in an authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth:// URI of the secret, usually shown as a QR code.
+	OtpauthUri    string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserWebhookRequest) Reset() {
-	*x = CreateUserWebhookRequest{}
+func (x *SetupUserTwoFactorResponse) Reset() {
+	*x = SetupUserTwoFactorResponse{}
 	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserWebhookRequest) String() string {
+func (x *SetupUserTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserWebhookRequest) ProtoMessage() {}
+func (*SetupUserTwoFactorResponse) ProtoMessage() {}
 
-func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+func (x *SetupUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,49 +2027,50 @@ func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetupUserTwoFactorResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateUserWebhookRequest) GetParent() string {
+func (x *SetupUserTwoFactorResponse) GetSecret() string {
 	if x != nil {
-		return x.Parent
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+func (x *SetupUserTwoFactorResponse) GetOtpauthUri() string {
 	if x != nil {
-		return x.Webhook
+		return x.OtpauthUri
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserWebhookRequest struct {
+type EnableUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The webhook to update.
-	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. A current TOTP code generated from the pending secret.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserWebhookRequest) Reset() {
-	*x = UpdateUserWebhookRequest{}
+func (x *EnableUserTwoFactorRequest) Reset() {
+	*x = EnableUserTwoFactorRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserWebhookRequest) String() string {
+func (x *EnableUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserWebhookRequest) ProtoMessage() {}
+func (*EnableUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+func (x *EnableUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1943,48 +2082,50 @@ func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableUserTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+func (x *EnableUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.Webhook
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *EnableUserTwoFactorRequest) GetCode() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type DeleteUserWebhookRequest struct {
+type EnableUserTwoFactorResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook to delete.
-	// Format: users/{user}/webhooks/{webhook}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The two-factor authentication status.
+	TwoFactor *UserTwoFactor `protobuf:"bytes,1,opt,name=two_factor,json=twoFactor,proto3" json:"two_factor,omitempty"`
+	// One-time recovery codes to sign in without the authenticator app.
+	// This is the only time the recovery codes will be visible.
+	RecoveryCodes []string `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserWebhookRequest) Reset() {
-	*x = DeleteUserWebhookRequest{}
+func (x *EnableUserTwoFactorResponse) Reset() {
+	*x = EnableUserTwoFactorResponse{}
 	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserWebhookRequest) String() string {
+func (x *EnableUserTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserWebhookRequest) ProtoMessage() {}
+func (*EnableUserTwoFactorResponse) ProtoMessage() {}
 
-func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
+func (x *EnableUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1996,52 +2137,51 @@ func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnableUserTwoFactorResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteUserWebhookRequest) GetName() string {
+func (x *EnableUserTwoFactorResponse) GetTwoFactor() *UserTwoFactor {
 	if x != nil {
-		return x.Name
+		return x.TwoFactor
 	}
-	return ""
+	return nil
 }
 
-type UserNotification struct {
+func (x *EnableUserTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the notification.
-	// Format: users/{user}/notifications/{notification}
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The sender of the notification.
-	// Format: users/{user}
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// The status of the notification.
-	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
-	// The creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The type of the notification.
-	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
-	// The activity ID associated with this notification.
-	ActivityId    *int32 `protobuf:"varint,6,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// A current TOTP code or an unused recovery code.
+	// Required unless an admin resets the two-factor authentication of another user.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserNotification) Reset() {
-	*x = UserNotification{}
+func (x *DisableUserTwoFactorRequest) Reset() {
+	*x = DisableUserTwoFactorRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification) String() string {
+func (x *DisableUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotification) ProtoMessage() {}
+func (*DisableUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *UserNotification) ProtoReflect() protoreflect.Message {
+func (x *DisableUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2053,48 +2193,1025 @@ func (x *UserNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
-func (*UserNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableUserTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UserNotification) GetName() string {
+func (x *DisableUserTwoFactorRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserNotification) GetSender() string {
+func (x *DisableUserTwoFactorRequest) GetCode() string {
 	if x != nil {
-		return x.Sender
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserNotification) GetStatus() UserNotification_Status {
-	if x != nil {
-		return x.Status
-	}
-	return UserNotification_STATUS_UNSPECIFIED
+type Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the passkey.
+	// Format: users/{user}/passkeys/{passkey}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name to recognize the passkey by.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Output only. The transports of the passkey, e.g. "internal" or "usb".
+	Transports []string `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	// Output only. The registration timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last sign-in timestamp.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification) GetType() UserNotification_Type {
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return UserNotification_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *UserNotification) GetActivityId() int32 {
-	if x != nil && x.ActivityId != nil {
+// Deprecated: Use Passkey.ProtoReflect.Descriptor instead.
+func (*Passkey) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Passkey) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *Passkey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Passkey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type ListUserPasskeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose passkeys will be listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysRequest) Reset() {
+	*x = ListUserPasskeysRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysRequest) ProtoMessage() {}
+
+func (x *ListUserPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserPasskeysRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserPasskeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of passkeys.
+	Passkeys      []*Passkey `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysResponse) Reset() {
+	*x = ListUserPasskeysResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysResponse) ProtoMessage() {}
+
+func (x *ListUserPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListUserPasskeysResponse) GetPasskeys() []*Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+type DeleteUserPasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the passkey to delete.
+	// Format: users/{user}/passkeys/{passkey}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPasskeyRequest) Reset() {
+	*x = DeleteUserPasskeyRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPasskeyRequest) ProtoMessage() {}
+
+func (x *DeleteUserPasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteUserPasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UserWebhook represents a webhook owned by a user.
+type UserWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL to send the webhook to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. Human-readable name for the webhook.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The creation time of the webhook.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last update time of the webhook.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The secret used to sign webhook requests with HMAC-SHA256.
+	// Only returned when the webhook is created or its secret is rotated.
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Optional. The activity types the webhook subscribes to, e.g. "memos.memo.created".
+	// Supported types: memos.memo.created, memos.memo.updated, memos.memo.deleted,
+	// memos.memo.visibility.changed, memos.memo.comment.created, memos.memo.reaction.added,
+	// memos.memo.reaction.removed, memos.memo.reminder and memos.attachment.created.
+	// If empty, the webhook receives memos.memo.created, memos.memo.updated and memos.memo.deleted.
+	ActivityTypes []string `protobuf:"bytes,7,rep,name=activity_types,json=activityTypes,proto3" json:"activity_types,omitempty"`
+	// Optional. A CEL expression using the memo filter syntax, e.g. `tag in ["deploy"]`.
+	// The webhook only fires for activities whose memo matches the filter.
+	// Activities without a memo, such as attachments not linked to a memo, never match a filter.
+	Filter string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The format of the request body.
+	PayloadFormat UserWebhook_PayloadFormat `protobuf:"varint,9,opt,name=payload_format,json=payloadFormat,proto3,enum=memos.api.v1.UserWebhook_PayloadFormat" json:"payload_format,omitempty"`
+	// Optional. A Go text/template rendering the request body when payload_format is TEMPLATE.
+	// The template receives the payload fields (.ActivityType, .Creator, .Memo, ...) along with
+	// .Title, .Snippet, .Link and .Tags, and may use the `json` and `join` functions.
+	// The body is sent as application/json if it is valid JSON and as text/plain otherwise.
+	PayloadTemplate string `protobuf:"bytes,10,opt,name=payload_template,json=payloadTemplate,proto3" json:"payload_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserWebhook) Reset() {
+	*x = UserWebhook{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhook) ProtoMessage() {}
+
+func (x *UserWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
+func (*UserWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetActivityTypes() []string {
+	if x != nil {
+		return x.ActivityTypes
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetPayloadFormat() UserWebhook_PayloadFormat {
+	if x != nil {
+		return x.PayloadFormat
+	}
+	return UserWebhook_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
+func (x *UserWebhook) GetPayloadTemplate() string {
+	if x != nil {
+		return x.PayloadTemplate
+	}
+	return ""
+}
+
+type ListUserWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksRequest) Reset() {
+	*x = ListUserWebhooksRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksRequest) ProtoMessage() {}
+
+func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUserWebhooksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksResponse) Reset() {
+	*x = ListUserWebhooksResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksResponse) ProtoMessage() {}
+
+func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type CreateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The webhook to create.
+	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserWebhookRequest) Reset() {
+	*x = CreateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserWebhookRequest) ProtoMessage() {}
+
+func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateUserWebhookRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webhook to update.
+	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The list of fields to update.
+	// Include "secret" to rotate the signing secret; the new secret is returned in the response.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserWebhookRequest) Reset() {
+	*x = UpdateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to delete.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWebhookRequest) Reset() {
+	*x = DeleteUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UserWebhookDelivery is a single request sent to a webhook, including its retries.
+type UserWebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of activity that triggered the delivery, e.g. "memos.memo.created".
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The URL the delivery is sent to.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The JSON request body.
+	Payload string                    `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	State   UserWebhookDelivery_State `protobuf:"varint,5,opt,name=state,proto3,enum=memos.api.v1.UserWebhookDelivery_State" json:"state,omitempty"`
+	// The number of attempts made so far.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The HTTP status code of the latest attempt, or 0 if the endpoint did not respond.
+	StatusCode int32 `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The response body of the latest attempt, truncated.
+	ResponseBody string `protobuf:"bytes,8,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	// The error of the latest attempt, if it failed.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// The creation time of the delivery.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the latest attempt.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The time of the next attempt. Only set for pending deliveries.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserWebhookDelivery) Reset() {
+	*x = UserWebhookDelivery{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhookDelivery) ProtoMessage() {}
+
+func (x *UserWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*UserWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UserWebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetState() UserWebhookDelivery_State {
+	if x != nil {
+		return x.State
+	}
+	return UserWebhookDelivery_STATE_UNSPECIFIED
+}
+
+func (x *UserWebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *UserWebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UserWebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
+type ListUserWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of deliveries to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListUserWebhookDeliveries` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesRequest) Reset() {
+	*x = ListUserWebhookDeliveriesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of deliveries, ordered from newest to oldest.
+	Deliveries []*UserWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesResponse) Reset() {
+	*x = ListUserWebhookDeliveriesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetDeliveries() []*UserWebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RedeliverUserWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery to redeliver.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) Reset() {
+	*x = RedeliverUserWebhookDeliveryRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverUserWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RedeliverUserWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverUserWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverUserWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserNotification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the notification.
+	// Format: users/{user}/notifications/{notification}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The sender of the notification.
+	// Format: users/{user}
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The status of the notification.
+	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
+	// The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The type of the notification.
+	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
+	// The activity ID associated with this notification.
+	ActivityId    *int32 `protobuf:"varint,6,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotification) Reset() {
+	*x = UserNotification{}
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotification) ProtoMessage() {}
+
+func (x *UserNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
+func (*UserNotification) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UserNotification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserNotification) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *UserNotification) GetStatus() UserNotification_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UserNotification_STATUS_UNSPECIFIED
+}
+
+func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserNotification) GetType() UserNotification_Type {
+	if x != nil {
+		return x.Type
+	}
+	return UserNotification_TYPE_UNSPECIFIED
+}
+
+func (x *UserNotification) GetActivityId() int32 {
+	if x != nil && x.ActivityId != nil {
 		return *x.ActivityId
 	}
 	return 0
@@ -2114,7 +3231,7 @@ type ListUserNotificationsRequest struct {
 
 func (x *ListUserNotificationsRequest) Reset() {
 	*x = ListUserNotificationsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2126,7 +3243,7 @@ func (x *ListUserNotificationsRequest) String() string {
 func (*ListUserNotificationsRequest) ProtoMessage() {}
 
 func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +3256,7 @@ func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListUserNotificationsRequest) GetParent() string {
@@ -2180,7 +3297,7 @@ type ListUserNotificationsResponse struct {
 
 func (x *ListUserNotificationsResponse) Reset() {
 	*x = ListUserNotificationsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2192,7 +3309,7 @@ func (x *ListUserNotificationsResponse) String() string {
 func (*ListUserNotificationsResponse) ProtoMessage() {}
 
 func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,7 +3322,7 @@ func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
@@ -2232,7 +3349,7 @@ type UpdateUserNotificationRequest struct {
 
 func (x *UpdateUserNotificationRequest) Reset() {
 	*x = UpdateUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2244,7 +3361,7 @@ func (x *UpdateUserNotificationRequest) String() string {
 func (*UpdateUserNotificationRequest) ProtoMessage() {}
 
 func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,7 +3374,7 @@ func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
@@ -2284,7 +3401,7 @@ type DeleteUserNotificationRequest struct {
 
 func (x *DeleteUserNotificationRequest) Reset() {
 	*x = DeleteUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2296,7 +3413,7 @@ func (x *DeleteUserNotificationRequest) String() string {
 func (*DeleteUserNotificationRequest) ProtoMessage() {}
 
 func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,7 +3426,7 @@ func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DeleteUserNotificationRequest) GetName() string {
@@ -2332,7 +3449,7 @@ type UserStats_MemoTypeStats struct {
 
 func (x *UserStats_MemoTypeStats) Reset() {
 	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +3461,7 @@ func (x *UserStats_MemoTypeStats) String() string {
 func (*UserStats_MemoTypeStats) ProtoMessage() {}
 
 func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,14 +3515,17 @@ type UserSetting_GeneralSetting struct {
 	// The preferred theme of the user.
 	// This references a CSS file in the web/public/themes/ directory.
 	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The time zone of the user as an IANA name, e.g. "Europe/Berlin".
+	// If not set, the time zone of the server is used.
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserSetting_GeneralSetting) Reset() {
 	*x = UserSetting_GeneralSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +3537,7 @@ func (x *UserSetting_GeneralSetting) String() string {
 func (*UserSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,6 +3574,13 @@ func (x *UserSetting_GeneralSetting) GetTheme() string {
 	return ""
 }
 
+func (x *UserSetting_GeneralSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 // User webhooks configuration.
 type UserSetting_WebhooksSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2465,7 +3592,7 @@ type UserSetting_WebhooksSetting struct {
 
 func (x *UserSetting_WebhooksSetting) Reset() {
 	*x = UserSetting_WebhooksSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2477,7 +3604,7 @@ func (x *UserSetting_WebhooksSetting) String() string {
 func (*UserSetting_WebhooksSetting) ProtoMessage() {}
 
 func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2500,6 +3627,64 @@ func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
 	return nil
 }
 
+// User notification preferences.
+type UserSetting_NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to receive inbox notifications by email.
+	// Requires email notifications to be configured on the instance.
+	EmailEnabled bool `protobuf:"varint,1,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	// Whether to receive a daily digest of the memos created on the same day in previous years
+	// and the memos tagged #review that are due for review.
+	// The digest is delivered to the inbox, and by email if email_enabled is set.
+	DigestEnabled bool `protobuf:"varint,2,opt,name=digest_enabled,json=digestEnabled,proto3" json:"digest_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_NotificationSetting) Reset() {
+	*x = UserSetting_NotificationSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_NotificationSetting) ProtoMessage() {}
+
+func (x *UserSetting_NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_NotificationSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+func (x *UserSetting_NotificationSetting) GetDigestEnabled() bool {
+	if x != nil {
+		return x.DigestEnabled
+	}
+	return false
+}
+
 var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
@@ -2581,21 +3766,27 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11memos.api.v1/UserR\x04name\"\x19\n" +
 	"\x17ListAllUserStatsRequest\"I\n" +
 	"\x18ListAllUserStatsResponse\x12-\n" +
-	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xb0\x04\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xb5\x06\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12S\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2(.memos.api.v1.UserSetting.GeneralSettingH\x00R\x0egeneralSetting\x12V\n" +
-	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x1av\n" +
+	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x12b\n" +
+	"\x14notification_setting\x18\x06 \x01(\v2-.memos.api.v1.UserSetting.NotificationSettingH\x00R\x13notificationSetting\x1a\x97\x01\n" +
 	"\x0eGeneralSetting\x12\x1b\n" +
 	"\x06locale\x18\x01 \x01(\tB\x03\xe0A\x01R\x06locale\x12,\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x1aH\n" +
+	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x12\x1f\n" +
+	"\btimezone\x18\x05 \x01(\tB\x03\xe0A\x01R\btimezone\x1aH\n" +
 	"\x0fWebhooksSetting\x125\n" +
-	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\"5\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1ak\n" +
+	"\x13NotificationSetting\x12(\n" +
+	"\remail_enabled\x18\x01 \x01(\bB\x03\xe0A\x01R\femailEnabled\x12*\n" +
+	"\x0edigest_enabled\x18\x02 \x01(\bB\x03\xe0A\x01R\rdigestEnabled\"G\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
-	"\bWEBHOOKS\x10\x04:Y\xeaAV\n" +
+	"\bWEBHOOKS\x10\x04\x12\x10\n" +
+	"\fNOTIFICATION\x10\x05:Y\xeaAV\n" +
 	"\x18memos.api.v1/UserSetting\x12\x1fusers/{user}/settings/{setting}*\fuserSettings2\vuserSettingB\a\n" +
 	"\x05value\"M\n" +
 	"\x15GetUserSettingRequest\x124\n" +
@@ -2647,7 +3838,54 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"`\n" +
 	" DeletePersonalAccessTokenRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" memos.api.v1/PersonalAccessTokenR\x04name\"\xda\x01\n" +
+	" memos.api.v1/PersonalAccessTokenR\x04name\"\x8c\x02\n" +
+	"\rUserTwoFactor\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x12@\n" +
+	"\venable_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"enableTime\x12=\n" +
+	"\x18remaining_recovery_codes\x18\x04 \x01(\x05B\x03\xe0A\x03R\x16remainingRecoveryCodes:B\xeaA?\n" +
+	"\x1amemos.api.v1/UserTwoFactor\x12\x16users/{user}/twoFactor2\ttwoFactor\"Q\n" +
+	"\x17GetUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"S\n" +
+	"\x19SetupUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"U\n" +
+	"\x1aSetupUserTwoFactorResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"m\n" +
+	"\x1aEnableUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\"\x80\x01\n" +
+	"\x1bEnableUserTwoFactorResponse\x12:\n" +
+	"\n" +
+	"two_factor\x18\x01 \x01(\v2\x1b.memos.api.v1.UserTwoFactorR\ttwoFactor\x12%\n" +
+	"\x0erecovery_codes\x18\x02 \x03(\tR\rrecoveryCodes\"n\n" +
+	"\x1bDisableUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x01R\x04code\"\xc2\x02\n" +
+	"\aPasskey\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12#\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tB\x03\xe0A\x03R\n" +
+	"transports\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\x0elast_used_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime:M\xeaAJ\n" +
+	"\x14memos.api.v1/Passkey\x12\x1fusers/{user}/passkeys/{passkey}*\bpasskeys2\apasskey\"L\n" +
+	"\x17ListUserPasskeysRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"M\n" +
+	"\x18ListUserPasskeysResponse\x121\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x15.memos.api.v1.PasskeyR\bpasskeys\"L\n" +
+	"\x18DeleteUserPasskeyRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14memos.api.v1/PasskeyR\x04name\"\xb7\x04\n" +
 	"\vUserWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
@@ -2655,7 +3893,21 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"6\n" +
+	"updateTime\x12\x1b\n" +
+	"\x06secret\x18\x06 \x01(\tB\x03\xe0A\x03R\x06secret\x12*\n" +
+	"\x0eactivity_types\x18\a \x03(\tB\x03\xe0A\x01R\ractivityTypes\x12\x1b\n" +
+	"\x06filter\x18\b \x01(\tB\x03\xe0A\x01R\x06filter\x12S\n" +
+	"\x0epayload_format\x18\t \x01(\x0e2'.memos.api.v1.UserWebhook.PayloadFormatB\x03\xe0A\x01R\rpayloadFormat\x12.\n" +
+	"\x10payload_template\x18\n" +
+	" \x01(\tB\x03\xe0A\x01R\x0fpayloadTemplate\"p\n" +
+	"\rPayloadFormat\x12\x1e\n" +
+	"\x1aPAYLOAD_FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05MEMOS\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\x12\x0e\n" +
+	"\n" +
+	"MATTERMOST\x10\x04\x12\f\n" +
+	"\bTEMPLATE\x10\x05\"6\n" +
 	"\x17ListUserWebhooksRequest\x12\x1b\n" +
 	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"Q\n" +
 	"\x18ListUserWebhooksResponse\x125\n" +
@@ -2668,7 +3920,42 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"3\n" +
 	"\x18DeleteUserWebhookRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xbe\x04\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xf7\x04\n" +
+	"\x13UserWebhookDelivery\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12(\n" +
+	"\ractivity_type\x18\x02 \x01(\tB\x03\xe0A\x03R\factivityType\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tB\x03\xe0A\x03R\x03url\x12\x1d\n" +
+	"\apayload\x18\x04 \x01(\tB\x03\xe0A\x03R\apayload\x12B\n" +
+	"\x05state\x18\x05 \x01(\x0e2'.memos.api.v1.UserWebhookDelivery.StateB\x03\xe0A\x03R\x05state\x12\x1f\n" +
+	"\battempts\x18\x06 \x01(\x05B\x03\xe0A\x03R\battempts\x12$\n" +
+	"\vstatus_code\x18\a \x01(\x05B\x03\xe0A\x03R\n" +
+	"statusCode\x12(\n" +
+	"\rresponse_body\x18\b \x01(\tB\x03\xe0A\x03R\fresponseBody\x12\x19\n" +
+	"\x05error\x18\t \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12K\n" +
+	"\x11next_attempt_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0fnextAttemptTime\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\x85\x01\n" +
+	" ListUserWebhookDeliveriesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8e\x01\n" +
+	"!ListUserWebhookDeliveriesResponse\x12A\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2!.memos.api.v1.UserWebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\">\n" +
+	"#RedeliverUserWebhookDeliveryRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xe5\x04\n" +
 	"\x10UserNotification\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x121\n" +
 	"\x06sender\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -2683,10 +3970,14 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\".\n" +
+	"\bARCHIVED\x10\x02\"U\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01:p\xeaAm\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\v\n" +
+	"\aMENTION\x10\x02\x12\f\n" +
+	"\bREMINDER\x10\x03\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\x04:p\xeaAm\n" +
 	"\x1dmemos.api.v1/UserNotification\x12)users/{user}/notifications/{notification}\x1a\x04name*\rnotifications2\fnotificationB\x0e\n" +
 	"\f_activity_id\"\xb4\x01\n" +
 	"\x1cListUserNotificationsRequest\x121\n" +
@@ -2705,7 +3996,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"updateMask\"Z\n" +
 	"\x1dDeleteUserNotificationRequest\x129\n" +
 	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
-	"\x1dmemos.api.v1/UserNotificationR\x04name2\x83\x17\n" +
+	"\x1dmemos.api.v1/UserNotificationR\x04name2\x99!\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12e\n" +
@@ -2722,11 +4013,19 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x10ListUserSettings\x12%.memos.api.v1.ListUserSettingsRequest\x1a&.memos.api.v1.ListUserSettingsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/settings\x12\xb9\x01\n" +
 	"\x18ListPersonalAccessTokens\x12-.memos.api.v1.ListPersonalAccessTokensRequest\x1a..memos.api.v1.ListPersonalAccessTokensResponse\">\xdaA\x06parent\x82\xd3\xe4\x93\x02/\x12-/api/v1/{parent=users/*}/personalAccessTokens\x12\xb6\x01\n" +
 	"\x19CreatePersonalAccessToken\x12..memos.api.v1.CreatePersonalAccessTokenRequest\x1a/.memos.api.v1.CreatePersonalAccessTokenResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{parent=users/*}/personalAccessTokens\x12\xa1\x01\n" +
-	"\x19DeletePersonalAccessToken\x12..memos.api.v1.DeletePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=users/*/personalAccessTokens/*}\x12\x95\x01\n" +
+	"\x19DeletePersonalAccessToken\x12..memos.api.v1.DeletePersonalAccessTokenRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=users/*/personalAccessTokens/*}\x12\x87\x01\n" +
+	"\x10GetUserTwoFactor\x12%.memos.api.v1.GetUserTwoFactorRequest\x1a\x1b.memos.api.v1.UserTwoFactor\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/twoFactor}\x12\xa1\x01\n" +
+	"\x12SetupUserTwoFactor\x12'.memos.api.v1.SetupUserTwoFactorRequest\x1a(.memos.api.v1.SetupUserTwoFactorResponse\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{name=users/*/twoFactor}:setup\x12\xaa\x01\n" +
+	"\x13EnableUserTwoFactor\x12(.memos.api.v1.EnableUserTwoFactorRequest\x1a).memos.api.v1.EnableUserTwoFactorResponse\">\xdaA\tname,code\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/twoFactor}:enable\x12\x95\x01\n" +
+	"\x14DisableUserTwoFactor\x12).memos.api.v1.DisableUserTwoFactorRequest\x1a\x16.google.protobuf.Empty\":\xdaA\x04name\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=users/*/twoFactor}:disable\x12\x95\x01\n" +
+	"\x10ListUserPasskeys\x12%.memos.api.v1.ListUserPasskeysRequest\x1a&.memos.api.v1.ListUserPasskeysResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/passkeys\x12\x85\x01\n" +
+	"\x11DeleteUserPasskey\x12&.memos.api.v1.DeleteUserPasskeyRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/passkeys/*}\x12\x95\x01\n" +
 	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x9b\x01\n" +
 	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"C\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02,:\awebhook\"!/api/v1/{parent=users/*}/webhooks\x12\xa8\x01\n" +
 	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"P\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x024:\awebhook2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\x85\x01\n" +
-	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xa9\x01\n" +
+	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xbd\x01\n" +
+	"\x19ListUserWebhookDeliveries\x12..memos.api.v1.ListUserWebhookDeliveriesRequest\x1a/.memos.api.v1.ListUserWebhookDeliveriesResponse\"?\xdaA\x06parent\x82\xd3\xe4\x93\x020\x12./api/v1/{parent=users/*/webhooks/*}/deliveries\x12\xc0\x01\n" +
+	"\x1cRedeliverUserWebhookDelivery\x121.memos.api.v1.RedeliverUserWebhookDeliveryRequest\x1a!.memos.api.v1.UserWebhookDelivery\"J\xdaA\x04name\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/{name=users/*/webhooks/*/deliveries/*}:redeliver\x12\xa9\x01\n" +
 	"\x15ListUserNotifications\x12*.memos.api.v1.ListUserNotificationsRequest\x1a+.memos.api.v1.ListUserNotificationsResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/notifications\x12\xcb\x01\n" +
 	"\x16UpdateUserNotification\x12+.memos.api.v1.UpdateUserNotificationRequest\x1a\x1e.memos.api.v1.UserNotification\"d\xdaA\x18notification,update_mask\x82\xd3\xe4\x93\x02C:\fnotification23/api/v1/{notification.name=users/*/notifications/*}\x12\x94\x01\n" +
 	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}B\xa8\x01\n" +
@@ -2744,137 +4043,183 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                            // 0: memos.api.v1.User.Role
-	(UserSetting_Key)(0),                      // 1: memos.api.v1.UserSetting.Key
-	(UserNotification_Status)(0),              // 2: memos.api.v1.UserNotification.Status
-	(UserNotification_Type)(0),                // 3: memos.api.v1.UserNotification.Type
-	(*User)(nil),                              // 4: memos.api.v1.User
-	(*ListUsersRequest)(nil),                  // 5: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                 // 6: memos.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                    // 7: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),                 // 8: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),                 // 9: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                 // 10: memos.api.v1.DeleteUserRequest
-	(*UserStats)(nil),                         // 11: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),               // 12: memos.api.v1.GetUserStatsRequest
-	(*ListAllUserStatsRequest)(nil),           // 13: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),          // 14: memos.api.v1.ListAllUserStatsResponse
-	(*UserSetting)(nil),                       // 15: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),             // 16: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),          // 17: memos.api.v1.UpdateUserSettingRequest
-	(*ListUserSettingsRequest)(nil),           // 18: memos.api.v1.ListUserSettingsRequest
-	(*ListUserSettingsResponse)(nil),          // 19: memos.api.v1.ListUserSettingsResponse
-	(*PersonalAccessToken)(nil),               // 20: memos.api.v1.PersonalAccessToken
-	(*ListPersonalAccessTokensRequest)(nil),   // 21: memos.api.v1.ListPersonalAccessTokensRequest
-	(*ListPersonalAccessTokensResponse)(nil),  // 22: memos.api.v1.ListPersonalAccessTokensResponse
-	(*CreatePersonalAccessTokenRequest)(nil),  // 23: memos.api.v1.CreatePersonalAccessTokenRequest
-	(*CreatePersonalAccessTokenResponse)(nil), // 24: memos.api.v1.CreatePersonalAccessTokenResponse
-	(*DeletePersonalAccessTokenRequest)(nil),  // 25: memos.api.v1.DeletePersonalAccessTokenRequest
-	(*UserWebhook)(nil),                       // 26: memos.api.v1.UserWebhook
-	(*ListUserWebhooksRequest)(nil),           // 27: memos.api.v1.ListUserWebhooksRequest
-	(*ListUserWebhooksResponse)(nil),          // 28: memos.api.v1.ListUserWebhooksResponse
-	(*CreateUserWebhookRequest)(nil),          // 29: memos.api.v1.CreateUserWebhookRequest
-	(*UpdateUserWebhookRequest)(nil),          // 30: memos.api.v1.UpdateUserWebhookRequest
-	(*DeleteUserWebhookRequest)(nil),          // 31: memos.api.v1.DeleteUserWebhookRequest
-	(*UserNotification)(nil),                  // 32: memos.api.v1.UserNotification
-	(*ListUserNotificationsRequest)(nil),      // 33: memos.api.v1.ListUserNotificationsRequest
-	(*ListUserNotificationsResponse)(nil),     // 34: memos.api.v1.ListUserNotificationsResponse
-	(*UpdateUserNotificationRequest)(nil),     // 35: memos.api.v1.UpdateUserNotificationRequest
-	(*DeleteUserNotificationRequest)(nil),     // 36: memos.api.v1.DeleteUserNotificationRequest
-	nil,                                       // 37: memos.api.v1.UserStats.TagCountEntry
-	(*UserStats_MemoTypeStats)(nil),           // 38: memos.api.v1.UserStats.MemoTypeStats
-	(*UserSetting_GeneralSetting)(nil),        // 39: memos.api.v1.UserSetting.GeneralSetting
-	(*UserSetting_WebhooksSetting)(nil),       // 40: memos.api.v1.UserSetting.WebhooksSetting
-	(State)(0),                                // 41: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),             // 42: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),             // 43: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                     // 44: google.protobuf.Empty
+	(User_Role)(0),                              // 0: memos.api.v1.User.Role
+	(UserSetting_Key)(0),                        // 1: memos.api.v1.UserSetting.Key
+	(UserWebhook_PayloadFormat)(0),              // 2: memos.api.v1.UserWebhook.PayloadFormat
+	(UserWebhookDelivery_State)(0),              // 3: memos.api.v1.UserWebhookDelivery.State
+	(UserNotification_Status)(0),                // 4: memos.api.v1.UserNotification.Status
+	(UserNotification_Type)(0),                  // 5: memos.api.v1.UserNotification.Type
+	(*User)(nil),                                // 6: memos.api.v1.User
+	(*ListUsersRequest)(nil),                    // 7: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 8: memos.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                      // 9: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                   // 10: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                   // 11: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                   // 12: memos.api.v1.DeleteUserRequest
+	(*UserStats)(nil),                           // 13: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                 // 14: memos.api.v1.GetUserStatsRequest
+	(*ListAllUserStatsRequest)(nil),             // 15: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),            // 16: memos.api.v1.ListAllUserStatsResponse
+	(*UserSetting)(nil),                         // 17: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),               // 18: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),            // 19: memos.api.v1.UpdateUserSettingRequest
+	(*ListUserSettingsRequest)(nil),             // 20: memos.api.v1.ListUserSettingsRequest
+	(*ListUserSettingsResponse)(nil),            // 21: memos.api.v1.ListUserSettingsResponse
+	(*PersonalAccessToken)(nil),                 // 22: memos.api.v1.PersonalAccessToken
+	(*ListPersonalAccessTokensRequest)(nil),     // 23: memos.api.v1.ListPersonalAccessTokensRequest
+	(*ListPersonalAccessTokensResponse)(nil),    // 24: memos.api.v1.ListPersonalAccessTokensResponse
+	(*CreatePersonalAccessTokenRequest)(nil),    // 25: memos.api.v1.CreatePersonalAccessTokenRequest
+	(*CreatePersonalAccessTokenResponse)(nil),   // 26: memos.api.v1.CreatePersonalAccessTokenResponse
+	(*DeletePersonalAccessTokenRequest)(nil),    // 27: memos.api.v1.DeletePersonalAccessTokenRequest
+	(*UserTwoFactor)(nil),                       // 28: memos.api.v1.UserTwoFactor
+	(*GetUserTwoFactorRequest)(nil),             // 29: memos.api.v1.GetUserTwoFactorRequest
+	(*SetupUserTwoFactorRequest)(nil),           // 30: memos.api.v1.SetupUserTwoFactorRequest
+	(*SetupUserTwoFactorResponse)(nil),          // 31: memos.api.v1.SetupUserTwoFactorResponse
+	(*EnableUserTwoFactorRequest)(nil),          // 32: memos.api.v1.EnableUserTwoFactorRequest
+	(*EnableUserTwoFactorResponse)(nil),         // 33: memos.api.v1.EnableUserTwoFactorResponse
+	(*DisableUserTwoFactorRequest)(nil),         // 34: memos.api.v1.DisableUserTwoFactorRequest
+	(*Passkey)(nil),                             // 35: memos.api.v1.Passkey
+	(*ListUserPasskeysRequest)(nil),             // 36: memos.api.v1.ListUserPasskeysRequest
+	(*ListUserPasskeysResponse)(nil),            // 37: memos.api.v1.ListUserPasskeysResponse
+	(*DeleteUserPasskeyRequest)(nil),            // 38: memos.api.v1.DeleteUserPasskeyRequest
+	(*UserWebhook)(nil),                         // 39: memos.api.v1.UserWebhook
+	(*ListUserWebhooksRequest)(nil),             // 40: memos.api.v1.ListUserWebhooksRequest
+	(*ListUserWebhooksResponse)(nil),            // 41: memos.api.v1.ListUserWebhooksResponse
+	(*CreateUserWebhookRequest)(nil),            // 42: memos.api.v1.CreateUserWebhookRequest
+	(*UpdateUserWebhookRequest)(nil),            // 43: memos.api.v1.UpdateUserWebhookRequest
+	(*DeleteUserWebhookRequest)(nil),            // 44: memos.api.v1.DeleteUserWebhookRequest
+	(*UserWebhookDelivery)(nil),                 // 45: memos.api.v1.UserWebhookDelivery
+	(*ListUserWebhookDeliveriesRequest)(nil),    // 46: memos.api.v1.ListUserWebhookDeliveriesRequest
+	(*ListUserWebhookDeliveriesResponse)(nil),   // 47: memos.api.v1.ListUserWebhookDeliveriesResponse
+	(*RedeliverUserWebhookDeliveryRequest)(nil), // 48: memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	(*UserNotification)(nil),                    // 49: memos.api.v1.UserNotification
+	(*ListUserNotificationsRequest)(nil),        // 50: memos.api.v1.ListUserNotificationsRequest
+	(*ListUserNotificationsResponse)(nil),       // 51: memos.api.v1.ListUserNotificationsResponse
+	(*UpdateUserNotificationRequest)(nil),       // 52: memos.api.v1.UpdateUserNotificationRequest
+	(*DeleteUserNotificationRequest)(nil),       // 53: memos.api.v1.DeleteUserNotificationRequest
+	nil,                                         // 54: memos.api.v1.UserStats.TagCountEntry
+	(*UserStats_MemoTypeStats)(nil),             // 55: memos.api.v1.UserStats.MemoTypeStats
+	(*UserSetting_GeneralSetting)(nil),          // 56: memos.api.v1.UserSetting.GeneralSetting
+	(*UserSetting_WebhooksSetting)(nil),         // 57: memos.api.v1.UserSetting.WebhooksSetting
+	(*UserSetting_NotificationSetting)(nil),     // 58: memos.api.v1.UserSetting.NotificationSetting
+	(State)(0),                                  // 59: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil),               // 60: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 61: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 62: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	41, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	42, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	42, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	4,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	43, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	4,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	4,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	43, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	42, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
-	38, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	37, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	11, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
-	39, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
-	40, // 14: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
-	15, // 15: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	43, // 16: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	15, // 17: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
-	42, // 18: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	42, // 19: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	42, // 20: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	20, // 21: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
-	20, // 22: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
-	42, // 23: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
-	42, // 24: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
-	26, // 25: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
-	26, // 26: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	26, // 27: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	43, // 28: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 29: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
-	42, // 30: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
-	3,  // 31: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
-	32, // 32: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
-	32, // 33: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
-	43, // 34: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	26, // 35: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
-	5,  // 36: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	7,  // 37: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	8,  // 38: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	9,  // 39: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	10, // 40: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	13, // 41: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	12, // 42: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	16, // 43: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	17, // 44: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	18, // 45: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
-	21, // 46: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
-	23, // 47: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
-	25, // 48: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
-	27, // 49: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
-	29, // 50: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
-	30, // 51: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
-	31, // 52: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
-	33, // 53: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
-	35, // 54: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
-	36, // 55: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
-	6,  // 56: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	4,  // 57: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	4,  // 58: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	4,  // 59: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	44, // 60: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	14, // 61: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	11, // 62: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	15, // 63: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	15, // 64: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	19, // 65: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
-	22, // 66: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
-	24, // 67: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
-	44, // 68: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
-	28, // 69: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
-	26, // 70: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	26, // 71: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	44, // 72: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
-	34, // 73: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
-	32, // 74: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
-	44, // 75: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	59, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	60, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	60, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	6,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	61, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	6,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	6,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	61, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	60, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
+	55, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	54, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	13, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
+	56, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
+	57, // 14: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
+	58, // 15: memos.api.v1.UserSetting.notification_setting:type_name -> memos.api.v1.UserSetting.NotificationSetting
+	17, // 16: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	61, // 17: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17, // 18: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
+	60, // 19: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	60, // 20: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	60, // 21: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	22, // 22: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
+	22, // 23: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
+	60, // 24: memos.api.v1.UserTwoFactor.enable_time:type_name -> google.protobuf.Timestamp
+	28, // 25: memos.api.v1.EnableUserTwoFactorResponse.two_factor:type_name -> memos.api.v1.UserTwoFactor
+	60, // 26: memos.api.v1.Passkey.create_time:type_name -> google.protobuf.Timestamp
+	60, // 27: memos.api.v1.Passkey.last_used_time:type_name -> google.protobuf.Timestamp
+	35, // 28: memos.api.v1.ListUserPasskeysResponse.passkeys:type_name -> memos.api.v1.Passkey
+	60, // 29: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
+	60, // 30: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 31: memos.api.v1.UserWebhook.payload_format:type_name -> memos.api.v1.UserWebhook.PayloadFormat
+	39, // 32: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
+	39, // 33: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	39, // 34: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	61, // 35: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 36: memos.api.v1.UserWebhookDelivery.state:type_name -> memos.api.v1.UserWebhookDelivery.State
+	60, // 37: memos.api.v1.UserWebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	60, // 38: memos.api.v1.UserWebhookDelivery.update_time:type_name -> google.protobuf.Timestamp
+	60, // 39: memos.api.v1.UserWebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	45, // 40: memos.api.v1.ListUserWebhookDeliveriesResponse.deliveries:type_name -> memos.api.v1.UserWebhookDelivery
+	4,  // 41: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
+	60, // 42: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
+	5,  // 43: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
+	49, // 44: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
+	49, // 45: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
+	61, // 46: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	39, // 47: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
+	7,  // 48: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	9,  // 49: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	10, // 50: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	11, // 51: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	12, // 52: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	15, // 53: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	14, // 54: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	18, // 55: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	19, // 56: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	20, // 57: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
+	23, // 58: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
+	25, // 59: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
+	27, // 60: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
+	29, // 61: memos.api.v1.UserService.GetUserTwoFactor:input_type -> memos.api.v1.GetUserTwoFactorRequest
+	30, // 62: memos.api.v1.UserService.SetupUserTwoFactor:input_type -> memos.api.v1.SetupUserTwoFactorRequest
+	32, // 63: memos.api.v1.UserService.EnableUserTwoFactor:input_type -> memos.api.v1.EnableUserTwoFactorRequest
+	34, // 64: memos.api.v1.UserService.DisableUserTwoFactor:input_type -> memos.api.v1.DisableUserTwoFactorRequest
+	36, // 65: memos.api.v1.UserService.ListUserPasskeys:input_type -> memos.api.v1.ListUserPasskeysRequest
+	38, // 66: memos.api.v1.UserService.DeleteUserPasskey:input_type -> memos.api.v1.DeleteUserPasskeyRequest
+	40, // 67: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
+	42, // 68: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
+	43, // 69: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
+	44, // 70: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
+	46, // 71: memos.api.v1.UserService.ListUserWebhookDeliveries:input_type -> memos.api.v1.ListUserWebhookDeliveriesRequest
+	48, // 72: memos.api.v1.UserService.RedeliverUserWebhookDelivery:input_type -> memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	50, // 73: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
+	52, // 74: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
+	53, // 75: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
+	8,  // 76: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	6,  // 77: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	6,  // 78: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	6,  // 79: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	62, // 80: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	16, // 81: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	13, // 82: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	17, // 83: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	17, // 84: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	21, // 85: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
+	24, // 86: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
+	26, // 87: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
+	62, // 88: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
+	28, // 89: memos.api.v1.UserService.GetUserTwoFactor:output_type -> memos.api.v1.UserTwoFactor
+	31, // 90: memos.api.v1.UserService.SetupUserTwoFactor:output_type -> memos.api.v1.SetupUserTwoFactorResponse
+	33, // 91: memos.api.v1.UserService.EnableUserTwoFactor:output_type -> memos.api.v1.EnableUserTwoFactorResponse
+	62, // 92: memos.api.v1.UserService.DisableUserTwoFactor:output_type -> google.protobuf.Empty
+	37, // 93: memos.api.v1.UserService.ListUserPasskeys:output_type -> memos.api.v1.ListUserPasskeysResponse
+	62, // 94: memos.api.v1.UserService.DeleteUserPasskey:output_type -> google.protobuf.Empty
+	41, // 95: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
+	39, // 96: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	39, // 97: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	62, // 98: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
+	47, // 99: memos.api.v1.UserService.ListUserWebhookDeliveries:output_type -> memos.api.v1.ListUserWebhookDeliveriesResponse
+	45, // 100: memos.api.v1.UserService.RedeliverUserWebhookDelivery:output_type -> memos.api.v1.UserWebhookDelivery
+	51, // 101: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
+	49, // 102: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
+	62, // 103: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
+	76, // [76:104] is the sub-list for method output_type
+	48, // [48:76] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -2886,15 +4231,16 @@ func file_api_v1_user_service_proto_init() {
 	file_api_v1_user_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*UserSetting_GeneralSetting_)(nil),
 		(*UserSetting_WebhooksSetting_)(nil),
+		(*UserSetting_NotificationSetting_)(nil),
 	}
-	file_api_v1_user_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   37,
+			NumEnums:      6,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,26 +20,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName                 = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName                   = "/memos.api.v1.UserService/GetUser"
-	UserService_CreateUser_FullMethodName                = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName                = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName                = "/memos.api.v1.UserService/DeleteUser"
-	UserService_ListAllUserStats_FullMethodName          = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName              = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName            = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName         = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserSettings_FullMethodName          = "/memos.api.v1.UserService/ListUserSettings"
-	UserService_ListPersonalAccessTokens_FullMethodName  = "/memos.api.v1.UserService/ListPersonalAccessTokens"
-	UserService_CreatePersonalAccessToken_FullMethodName = "/memos.api.v1.UserService/CreatePersonalAccessToken"
-	UserService_DeletePersonalAccessToken_FullMethodName = "/memos.api.v1.UserService/DeletePersonalAccessToken"
-	UserService_ListUserWebhooks_FullMethodName          = "/memos.api.v1.UserService/ListUserWebhooks"
-	UserService_CreateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/CreateUserWebhook"
-	UserService_UpdateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/UpdateUserWebhook"
-	UserService_DeleteUserWebhook_FullMethodName         = "/memos.api.v1.UserService/DeleteUserWebhook"
-	UserService_ListUserNotifications_FullMethodName     = "/memos.api.v1.UserService/ListUserNotifications"
-	UserService_UpdateUserNotification_FullMethodName    = "/memos.api.v1.UserService/UpdateUserNotification"
-	UserService_DeleteUserNotification_FullMethodName    = "/memos.api.v1.UserService/DeleteUserNotification"
+	UserService_ListUsers_FullMethodName                    = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                      = "/memos.api.v1.UserService/GetUser"
+	UserService_CreateUser_FullMethodName                   = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                   = "/memos.api.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                   = "/memos.api.v1.UserService/DeleteUser"
+	UserService_ListAllUserStats_FullMethodName             = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName                 = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserSetting_FullMethodName               = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName            = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserSettings_FullMethodName             = "/memos.api.v1.UserService/ListUserSettings"
+	UserService_ListPersonalAccessTokens_FullMethodName     = "/memos.api.v1.UserService/ListPersonalAccessTokens"
+	UserService_CreatePersonalAccessToken_FullMethodName    = "/memos.api.v1.UserService/CreatePersonalAccessToken"
+	UserService_DeletePersonalAccessToken_FullMethodName    = "/memos.api.v1.UserService/DeletePersonalAccessToken"
+	UserService_GetUserTwoFactor_FullMethodName             = "/memos.api.v1.UserService/GetUserTwoFactor"
+	UserService_SetupUserTwoFactor_FullMethodName           = "/memos.api.v1.UserService/SetupUserTwoFactor"
+	UserService_EnableUserTwoFactor_FullMethodName          = "/memos.api.v1.UserService/EnableUserTwoFactor"
+	UserService_DisableUserTwoFactor_FullMethodName         = "/memos.api.v1.UserService/DisableUserTwoFactor"
+	UserService_ListUserPasskeys_FullMethodName             = "/memos.api.v1.UserService/ListUserPasskeys"
+	UserService_DeleteUserPasskey_FullMethodName            = "/memos.api.v1.UserService/DeleteUserPasskey"
+	UserService_ListUserWebhooks_FullMethodName             = "/memos.api.v1.UserService/ListUserWebhooks"
+	UserService_CreateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/CreateUserWebhook"
+	UserService_UpdateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/UpdateUserWebhook"
+	UserService_DeleteUserWebhook_FullMethodName            = "/memos.api.v1.UserService/DeleteUserWebhook"
+	UserService_ListUserWebhookDeliveries_FullMethodName    = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	UserService_RedeliverUserWebhookDelivery_FullMethodName = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
+	UserService_ListUserNotifications_FullMethodName        = "/memos.api.v1.UserService/ListUserNotifications"
+	UserService_UpdateUserNotification_FullMethodName       = "/memos.api.v1.UserService/UpdateUserNotification"
+	UserService_DeleteUserNotification_FullMethodName       = "/memos.api.v1.UserService/DeleteUserNotification"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -77,6 +85,22 @@ type UserServiceClient interface {
 	CreatePersonalAccessToken(ctx context.Context, in *CreatePersonalAccessTokenRequest, opts ...grpc.CallOption) (*CreatePersonalAccessTokenResponse, error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(ctx context.Context, in *DeletePersonalAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user.
+	// The secret stays pending until it is confirmed with EnableUserTwoFactor.
+	SetupUserTwoFactor(ctx context.Context, in *SetupUserTwoFactorRequest, opts ...grpc.CallOption) (*SetupUserTwoFactorResponse, error)
+	// EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+	// The recovery codes are only returned once upon enabling.
+	EnableUserTwoFactor(ctx context.Context, in *EnableUserTwoFactorRequest, opts ...grpc.CallOption) (*EnableUserTwoFactorResponse, error)
+	// DisableUserTwoFactor disables two-factor authentication of a user.
+	// Users confirm with a TOTP or recovery code, admins can reset other users without a code.
+	DisableUserTwoFactor(ctx context.Context, in *DisableUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys of a user.
+	// Passkeys are registered with AuthService.BeginPasskeyRegistration and FinishPasskeyRegistration.
+	ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error)
+	// DeleteUserPasskey deletes a passkey of a user.
+	DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(ctx context.Context, in *ListUserWebhooksRequest, opts ...grpc.CallOption) (*ListUserWebhooksResponse, error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -85,6 +109,11 @@ type UserServiceClient interface {
 	UpdateUserWebhook(ctx context.Context, in *UpdateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(ctx context.Context, in *DeleteUserWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserWebhookDeliveries lists recent deliveries of a webhook.
+	ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery queues the payload of a delivery to be sent again.
+	// A new delivery is created so the original attempt stays in the delivery log.
+	RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
@@ -231,6 +260,66 @@ func (c *userServiceClient) DeletePersonalAccessToken(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserTwoFactor)
+	err := c.cc.Invoke(ctx, UserService_GetUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetupUserTwoFactor(ctx context.Context, in *SetupUserTwoFactorRequest, opts ...grpc.CallOption) (*SetupUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_SetupUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EnableUserTwoFactor(ctx context.Context, in *EnableUserTwoFactorRequest, opts ...grpc.CallOption) (*EnableUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_EnableUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableUserTwoFactor(ctx context.Context, in *DisableUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DisableUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPasskeysResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, in *ListUserWebhooksRequest, opts ...grpc.CallOption) (*ListUserWebhooksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserWebhooksResponse)
@@ -271,6 +360,26 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhookDelivery)
+	err := c.cc.Invoke(ctx, UserService_RedeliverUserWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserNotificationsResponse)
@@ -336,6 +445,22 @@ type UserServiceServer interface {
 	CreatePersonalAccessToken(context.Context, *CreatePersonalAccessTokenRequest) (*CreatePersonalAccessTokenResponse, error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *DeletePersonalAccessTokenRequest) (*emptypb.Empty, error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user.
+	// The secret stays pending until it is confirmed with EnableUserTwoFactor.
+	SetupUserTwoFactor(context.Context, *SetupUserTwoFactorRequest) (*SetupUserTwoFactorResponse, error)
+	// EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+	// The recovery codes are only returned once upon enabling.
+	EnableUserTwoFactor(context.Context, *EnableUserTwoFactorRequest) (*EnableUserTwoFactorResponse, error)
+	// DisableUserTwoFactor disables two-factor authentication of a user.
+	// Users confirm with a TOTP or recovery code, admins can reset other users without a code.
+	DisableUserTwoFactor(context.Context, *DisableUserTwoFactorRequest) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys of a user.
+	// Passkeys are registered with AuthService.BeginPasskeyRegistration and FinishPasskeyRegistration.
+	ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error)
+	// DeleteUserPasskey deletes a passkey of a user.
+	DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -344,6 +469,11 @@ type UserServiceServer interface {
 	UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error)
+	// ListUserWebhookDeliveries lists recent deliveries of a webhook.
+	ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery queues the payload of a delivery to be sent again.
+	// A new delivery is created so the original attempt stays in the delivery log.
+	RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
@@ -399,6 +529,24 @@ func (UnimplementedUserServiceServer) CreatePersonalAccessToken(context.Context,
 func (UnimplementedUserServiceServer) DeletePersonalAccessToken(context.Context, *DeletePersonalAccessTokenRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePersonalAccessToken not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) SetupUserTwoFactor(context.Context, *SetupUserTwoFactorRequest) (*SetupUserTwoFactorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetupUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) EnableUserTwoFactor(context.Context, *EnableUserTwoFactorRequest) (*EnableUserTwoFactorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnableUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) DisableUserTwoFactor(context.Context, *DisableUserTwoFactorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserPasskeys not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserPasskey not implemented")
+}
 func (UnimplementedUserServiceServer) ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUserWebhooks not implemented")
 }
@@ -411,6 +559,12 @@ func (UnimplementedUserServiceServer) UpdateUserWebhook(context.Context, *Update
 func (UnimplementedUserServiceServer) DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUserWebhook not implemented")
 }
+func (UnimplementedUserServiceServer) ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserWebhookDeliveries not implemented")
+}
+func (UnimplementedUserServiceServer) RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeliverUserWebhookDelivery not implemented")
+}
 func (UnimplementedUserServiceServer) ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUserNotifications not implemented")
 }
@@ -675,6 +829,114 @@ func _UserService_DeletePersonalAccessToken_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, req.(*GetUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetupUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetupUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetupUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetupUserTwoFactor(ctx, req.(*SetupUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnableUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnableUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnableUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnableUserTwoFactor(ctx, req.(*EnableUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableUserTwoFactor(ctx, req.(*DisableUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPasskeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserPasskeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, req.(*ListUserPasskeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, req.(*DeleteUserPasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserWebhooksRequest)
 	if err := dec(in); err != nil {
@@ -747,6 +1009,42 @@ func _UserService_DeleteUserWebhook_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListUserWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, req.(*ListUserWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeliverUserWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverUserWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RedeliverUserWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, req.(*RedeliverUserWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -860,6 +1158,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePersonalAccessToken",
 			Handler:    _UserService_DeletePersonalAccessToken_Handler,
 		},
+		{
+			MethodName: "GetUserTwoFactor",
+			Handler:    _UserService_GetUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "SetupUserTwoFactor",
+			Handler:    _UserService_SetupUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnableUserTwoFactor",
+			Handler:    _UserService_EnableUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableUserTwoFactor",
+			Handler:    _UserService_DisableUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "ListUserPasskeys",
+			Handler:    _UserService_ListUserPasskeys_Handler,
+		},
+		{
+			MethodName: "DeleteUserPasskey",
+			Handler:    _UserService_DeleteUserPasskey_Handler,
+		},
 		{
 			MethodName: "ListUserWebhooks",
 			Handler:    _UserService_ListUserWebhooks_Handler,
@@ -876,6 +1198,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserWebhook",
 			Handler:    _UserService_DeleteUserWebhook_Handler,
 		},
+		{
+			MethodName: "ListUserWebhookDeliveries",
+			Handler:    _UserService_ListUserWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverUserWebhookDelivery",
+			Handler:    _UserService_RedeliverUserWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "ListUserNotifications",
 			Handler:    _UserService_ListUserNotifications_Handler,
@@ -73,16 +73,168 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
+type ActivityMemoMentionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_store_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityMemoReminderPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityMemoDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The day of the digest in the user's time zone, formatted as YYYY-MM-DD.
+	Date             string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	OnThisDayMemoIds []int32 `protobuf:"varint,2,rep,packed,name=on_this_day_memo_ids,json=onThisDayMemoIds,proto3" json:"on_this_day_memo_ids,omitempty"`
+	ReviewMemoIds    []int32 `protobuf:"varint,3,rep,packed,name=review_memo_ids,json=reviewMemoIds,proto3" json:"review_memo_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActivityMemoDigestPayload) Reset() {
+	*x = ActivityMemoDigestPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoDigestPayload) ProtoMessage() {}
+
+func (x *ActivityMemoDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoDigestPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoDigestPayload) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ActivityMemoDigestPayload) GetOnThisDayMemoIds() []int32 {
+	if x != nil {
+		return x.OnThisDayMemoIds
+	}
+	return nil
+}
+
+func (x *ActivityMemoDigestPayload) GetReviewMemoIds() []int32 {
+	if x != nil {
+		return x.ReviewMemoIds
+	}
+	return nil
+}
+
 type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	MemoComment   *ActivityMemoCommentPayload  `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	MemoMention   *ActivityMemoMentionPayload  `protobuf:"bytes,2,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
+	MemoReminder  *ActivityMemoReminderPayload `protobuf:"bytes,3,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	MemoDigest    *ActivityMemoDigestPayload   `protobuf:"bytes,4,opt,name=memo_digest,json=memoDigest,proto3" json:"memo_digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +246,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +259,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{1}
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -117,6 +269,27 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoDigest() *ActivityMemoDigestPayload {
+	if x != nil {
+		return x.MemoDigest
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
@@ -124,9 +297,21 @@ const file_store_activity_proto_rawDesc = "" +
 	"\x14store/activity.proto\x12\vmemos.store\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"]\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"5\n" +
+	"\x1aActivityMemoMentionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"6\n" +
+	"\x1bActivityMemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"\x87\x01\n" +
+	"\x19ActivityMemoDigestPayload\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12.\n" +
+	"\x14on_this_day_memo_ids\x18\x02 \x03(\x05R\x10onThisDayMemoIds\x12&\n" +
+	"\x0freview_memo_ids\x18\x03 \x03(\x05R\rreviewMemoIds\"\xc1\x02\n" +
 	"\x0fActivityPayload\x12J\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoCommentB\x98\x01\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12J\n" +
+	"\fmemo_mention\x18\x02 \x01(\v2'.memos.store.ActivityMemoMentionPayloadR\vmemoMention\x12M\n" +
+	"\rmemo_reminder\x18\x03 \x01(\v2(.memos.store.ActivityMemoReminderPayloadR\fmemoReminder\x12G\n" +
+	"\vmemo_digest\x18\x04 \x01(\v2&.memos.store.ActivityMemoDigestPayloadR\n" +
+	"memoDigestB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -141,18 +326,24 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil), // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityPayload)(nil),            // 1: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),  // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityMemoMentionPayload)(nil),  // 1: memos.store.ActivityMemoMentionPayload
+	(*ActivityMemoReminderPayload)(nil), // 2: memos.store.ActivityMemoReminderPayload
+	(*ActivityMemoDigestPayload)(nil),   // 3: memos.store.ActivityMemoDigestPayload
+	(*ActivityPayload)(nil),             // 4: memos.store.ActivityPayload
 }
 var file_store_activity_proto_depIdxs = []int32{
 	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: memos.store.ActivityPayload.memo_mention:type_name -> memos.store.ActivityMemoMentionPayload
+	2, // 2: memos.store.ActivityPayload.memo_reminder:type_name -> memos.store.ActivityMemoReminderPayload
+	3, // 3: memos.store.ActivityPayload.memo_digest:type_name -> memos.store.ActivityMemoDigestPayload
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -166,7 +357,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,7 @@ type IdentityProvider_Type int32
 const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	IdentityProvider_OAUTH2           IdentityProvider_Type = 1
+	IdentityProvider_OIDC             IdentityProvider_Type = 2
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -33,10 +34,12 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "OIDC",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"OIDC":             2,
 	}
 )
 
@@ -148,6 +151,7 @@ type IdentityProviderConfig struct {
 	// Types that are valid to be assigned to Config:
 	//
 	//	*IdentityProviderConfig_Oauth2Config
+	//	*IdentityProviderConfig_OidcConfig
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +203,15 @@ func (x *IdentityProviderConfig) GetOauth2Config() *OAuth2Config {
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetOidcConfig() *OIDCConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_OidcConfig); ok {
+			return x.OidcConfig
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -207,8 +220,14 @@ type IdentityProviderConfig_Oauth2Config struct {
 	Oauth2Config *OAuth2Config `protobuf:"bytes,1,opt,name=oauth2_config,json=oauth2Config,proto3,oneof"`
 }
 
+type IdentityProviderConfig_OidcConfig struct {
+	OidcConfig *OIDCConfig `protobuf:"bytes,2,opt,name=oidc_config,json=oidcConfig,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2Config) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_OidcConfig) isIdentityProviderConfig_Config() {}
+
 type FieldMapping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
@@ -369,23 +388,112 @@ func (x *OAuth2Config) GetFieldMapping() *FieldMapping {
 	return nil
 }
 
+type OIDCConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Issuer       string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes       []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The ID token claim used as the username, defaults to "sub".
+	IdentifierClaim string `protobuf:"bytes,5,opt,name=identifier_claim,json=identifierClaim,proto3" json:"identifier_claim,omitempty"`
+	// The authorization endpoint from the discovery document, refreshed whenever the config is saved.
+	AuthUrl       string `protobuf:"bytes,6,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCConfig) Reset() {
+	*x = OIDCConfig{}
+	mi := &file_store_idp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConfig) ProtoMessage() {}
+
+func (x *OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConfig.ProtoReflect.Descriptor instead.
+func (*OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OIDCConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OIDCConfig) GetIdentifierClaim() string {
+	if x != nil {
+		return x.IdentifierClaim
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
 var File_store_idp_proto protoreflect.FileDescriptor
 
 const file_store_idp_proto_rawDesc = "" +
 	"\n" +
-	"\x0fstore/idp.proto\x12\vmemos.store\"\x82\x02\n" +
+	"\x0fstore/idp.proto\x12\vmemos.store\"\x8c\x02\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
 	"\x04type\x18\x03 \x01(\x0e2\".memos.store.IdentityProvider.TypeR\x04type\x12+\n" +
 	"\x11identifier_filter\x18\x04 \x01(\tR\x10identifierFilter\x12;\n" +
-	"\x06config\x18\x05 \x01(\v2#.memos.store.IdentityProviderConfigR\x06config\"(\n" +
+	"\x06config\x18\x05 \x01(\v2#.memos.store.IdentityProviderConfigR\x06config\"2\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01\"d\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04OIDC\x10\x02\"\xa0\x01\n" +
 	"\x16IdentityProviderConfig\x12@\n" +
-	"\roauth2_config\x18\x01 \x01(\v2\x19.memos.store.OAuth2ConfigH\x00R\foauth2ConfigB\b\n" +
+	"\roauth2_config\x18\x01 \x01(\v2\x19.memos.store.OAuth2ConfigH\x00R\foauth2Config\x12:\n" +
+	"\voidc_config\x18\x02 \x01(\v2\x17.memos.store.OIDCConfigH\x00R\n" +
+	"oidcConfigB\b\n" +
 	"\x06config\"\x86\x01\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
@@ -402,7 +510,15 @@ const file_store_idp_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12>\n" +
-	"\rfield_mapping\x18\a \x01(\v2\x19.memos.store.FieldMappingR\ffieldMappingB\x93\x01\n" +
+	"\rfield_mapping\x18\a \x01(\v2\x19.memos.store.FieldMappingR\ffieldMapping\"\xc4\x01\n" +
+	"\n" +
+	"OIDCConfig\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12)\n" +
+	"\x10identifier_claim\x18\x05 \x01(\tR\x0fidentifierClaim\x12\x19\n" +
+	"\bauth_url\x18\x06 \x01(\tR\aauthUrlB\x93\x01\n" +
 	"\x0fcom.memos.storeB\bIdpProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -418,24 +534,26 @@ func file_store_idp_proto_rawDescGZIP() []byte {
 }
 
 var file_store_idp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_idp_proto_goTypes = []any{
 	(IdentityProvider_Type)(0),     // 0: memos.store.IdentityProvider.Type
 	(*IdentityProvider)(nil),       // 1: memos.store.IdentityProvider
 	(*IdentityProviderConfig)(nil), // 2: memos.store.IdentityProviderConfig
 	(*FieldMapping)(nil),           // 3: memos.store.FieldMapping
 	(*OAuth2Config)(nil),           // 4: memos.store.OAuth2Config
+	(*OIDCConfig)(nil),             // 5: memos.store.OIDCConfig
 }
 var file_store_idp_proto_depIdxs = []int32{
 	0, // 0: memos.store.IdentityProvider.type:type_name -> memos.store.IdentityProvider.Type
 	2, // 1: memos.store.IdentityProvider.config:type_name -> memos.store.IdentityProviderConfig
 	4, // 2: memos.store.IdentityProviderConfig.oauth2_config:type_name -> memos.store.OAuth2Config
-	3, // 3: memos.store.OAuth2Config.field_mapping:type_name -> memos.store.FieldMapping
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 3: memos.store.IdentityProviderConfig.oidc_config:type_name -> memos.store.OIDCConfig
+	3, // 4: memos.store.OAuth2Config.field_mapping:type_name -> memos.store.FieldMapping
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_store_idp_proto_init() }
@@ -445,6 +563,7 @@ func file_store_idp_proto_init() {
 	}
 	file_store_idp_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2Config)(nil),
+		(*IdentityProviderConfig_OidcConfig)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -452,7 +571,7 @@ func file_store_idp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_idp_proto_rawDesc), len(file_store_idp_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
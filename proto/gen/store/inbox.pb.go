@@ -27,6 +27,12 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	// Memo comment notification.
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
+	// Memo mention notification.
+	InboxMessage_MENTION InboxMessage_Type = 3
+	// Memo reminder notification.
+	InboxMessage_REMINDER InboxMessage_Type = 4
+	// Daily memo digest notification.
+	InboxMessage_DIGEST InboxMessage_Type = 5
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -34,10 +40,16 @@ var (
 	InboxMessage_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		3: "MENTION",
+		4: "REMINDER",
+		5: "DIGEST",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"MENTION":          3,
+		"REMINDER":         4,
+		"DIGEST":           5,
 	}
 )
 
@@ -126,14 +138,18 @@ var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xa8\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xd5\x01\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\".\n" +
+	"activityId\x88\x01\x01\"[\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01B\x0e\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\v\n" +
+	"\aMENTION\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\x05\"\x04\b\x02\x10\x02B\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
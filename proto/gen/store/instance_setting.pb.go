@@ -33,6 +33,10 @@ const (
 	InstanceSettingKey_STORAGE InstanceSettingKey = 3
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSettingKey_MEMO_RELATED InstanceSettingKey = 4
+	// NOTIFICATION is the key for notification settings.
+	InstanceSettingKey_NOTIFICATION InstanceSettingKey = 5
+	// WEBHOOKS is the key for instance webhooks.
+	InstanceSettingKey_WEBHOOKS InstanceSettingKey = 6
 )
 
 // Enum value maps for InstanceSettingKey.
@@ -43,6 +47,8 @@ var (
 		2: "GENERAL",
 		3: "STORAGE",
 		4: "MEMO_RELATED",
+		5: "NOTIFICATION",
+		6: "WEBHOOKS",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -50,6 +56,8 @@ var (
 		"GENERAL":                          2,
 		"STORAGE":                          3,
 		"MEMO_RELATED":                     4,
+		"NOTIFICATION":                     5,
+		"WEBHOOKS":                         6,
 	}
 )
 
@@ -144,6 +152,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting
 	//	*InstanceSetting_StorageSetting
 	//	*InstanceSetting_MemoRelatedSetting
+	//	*InstanceSetting_NotificationSetting
+	//	*InstanceSetting_WebhooksSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +239,24 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceMemoRelatedSetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetNotificationSetting() *InstanceNotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_NotificationSetting); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetWebhooksSetting() *InstanceWebhooksSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_WebhooksSetting); ok {
+			return x.WebhooksSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -249,6 +277,14 @@ type InstanceSetting_MemoRelatedSetting struct {
 	MemoRelatedSetting *InstanceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_NotificationSetting struct {
+	NotificationSetting *InstanceNotificationSetting `protobuf:"bytes,6,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
+type InstanceSetting_WebhooksSetting struct {
+	WebhooksSetting *InstanceWebhooksSetting `protobuf:"bytes,7,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -257,6 +293,10 @@ func (*InstanceSetting_StorageSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_NotificationSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_WebhooksSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -650,7 +690,9 @@ type InstanceMemoRelatedSetting struct {
 	// enable_double_click_edit enables editing on double click.
 	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
 	// reactions is the list of reactions.
-	Reactions     []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// revision_limit is the maximum number of revisions kept for each memo.
+	RevisionLimit int32 `protobuf:"varint,8,opt,name=revision_limit,json=revisionLimit,proto3" json:"revision_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -720,17 +762,243 @@ func (x *InstanceMemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+func (x *InstanceMemoRelatedSetting) GetRevisionLimit() int32 {
+	if x != nil {
+		return x.RevisionLimit
+	}
+	return 0
+}
+
+type InstanceNotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email is the SMTP configuration for email notifications.
+	Email         *InstanceNotificationSetting_EmailSetting `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceNotificationSetting) Reset() {
+	*x = InstanceNotificationSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceNotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceNotificationSetting) ProtoMessage() {}
+
+func (x *InstanceNotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceNotificationSetting.ProtoReflect.Descriptor instead.
+func (*InstanceNotificationSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InstanceNotificationSetting) GetEmail() *InstanceNotificationSetting_EmailSetting {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type InstanceWebhooksSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Webhooks receiving instance activities, such as user sign-ins.
+	// Instance webhooks do not use the filter field.
+	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceWebhooksSetting) Reset() {
+	*x = InstanceWebhooksSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebhooksSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebhooksSetting) ProtoMessage() {}
+
+func (x *InstanceWebhooksSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebhooksSetting.ProtoReflect.Descriptor instead.
+func (*InstanceWebhooksSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstanceWebhooksSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type InstanceNotificationSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables sending notification emails.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// smtp_host is the SMTP server hostname.
+	SmtpHost string `protobuf:"bytes,2,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the SMTP server port.
+	SmtpPort int32 `protobuf:"varint,3,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the SMTP authentication username.
+	SmtpUsername string `protobuf:"bytes,4,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password is the SMTP authentication password.
+	SmtpPassword string `protobuf:"bytes,5,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// reply_to is the optional Reply-To address.
+	ReplyTo string `protobuf:"bytes,8,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	// use_tls enables STARTTLS.
+	UseTls bool `protobuf:"varint,9,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl enables implicit SSL/TLS.
+	UseSsl        bool `protobuf:"varint,10,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) Reset() {
+	*x = InstanceNotificationSetting_EmailSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceNotificationSetting_EmailSetting) ProtoMessage() {}
+
+func (x *InstanceNotificationSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceNotificationSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceNotificationSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceNotificationSetting_EmailSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
 var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\x94\x03\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\x1a\x18store/user_setting.proto\"\xc6\x04\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2#.memos.store.InstanceGeneralSettingH\x00R\x0egeneralSetting\x12N\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2#.memos.store.InstanceStorageSettingH\x00R\x0estorageSetting\x12[\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSettingB\a\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12]\n" +
+	"\x14notification_setting\x18\x06 \x01(\v2(.memos.store.InstanceNotificationSettingH\x00R\x13notificationSetting\x12Q\n" +
+	"\x10webhooks_setting\x18\a \x01(\v2$.memos.store.InstanceWebhooksSettingH\x00R\x0fwebhooksSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
@@ -765,19 +1033,39 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\x9c\x02\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xc3\x02\n" +
 	"\x1aInstanceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions*q\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x12%\n" +
+	"\x0erevision_limit\x18\b \x01(\x05R\rrevisionLimit\"\xa2\x03\n" +
+	"\x1bInstanceNotificationSetting\x12K\n" +
+	"\x05email\x18\x01 \x01(\v25.memos.store.InstanceNotificationSetting.EmailSettingR\x05email\x1a\xb5\x02\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tsmtp_host\x18\x02 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x03 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x04 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x05 \x01(\tR\fsmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12\x19\n" +
+	"\breply_to\x18\b \x01(\tR\areplyTo\x12\x17\n" +
+	"\ause_tls\x18\t \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\n" +
+	" \x01(\bR\x06useSsl\"_\n" +
+	"\x17InstanceWebhooksSetting\x12D\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks*\x91\x01\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04B\x9f\x01\n" +
+	"\fMEMO_RELATED\x10\x04\x12\x10\n" +
+	"\fNOTIFICATION\x10\x05\x12\f\n" +
+	"\bWEBHOOKS\x10\x06B\x9f\x01\n" +
 	"\x0fcom.memos.storeB\x14InstanceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -793,32 +1081,40 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_instance_setting_proto_goTypes = []any{
-	(InstanceSettingKey)(0),                 // 0: memos.store.InstanceSettingKey
-	(InstanceStorageSetting_StorageType)(0), // 1: memos.store.InstanceStorageSetting.StorageType
-	(*InstanceSetting)(nil),                 // 2: memos.store.InstanceSetting
-	(*InstanceBasicSetting)(nil),            // 3: memos.store.InstanceBasicSetting
-	(*InstanceGeneralSetting)(nil),          // 4: memos.store.InstanceGeneralSetting
-	(*InstanceCustomProfile)(nil),           // 5: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),          // 6: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                 // 7: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),      // 8: memos.store.InstanceMemoRelatedSetting
+	(InstanceSettingKey)(0),                          // 0: memos.store.InstanceSettingKey
+	(InstanceStorageSetting_StorageType)(0),          // 1: memos.store.InstanceStorageSetting.StorageType
+	(*InstanceSetting)(nil),                          // 2: memos.store.InstanceSetting
+	(*InstanceBasicSetting)(nil),                     // 3: memos.store.InstanceBasicSetting
+	(*InstanceGeneralSetting)(nil),                   // 4: memos.store.InstanceGeneralSetting
+	(*InstanceCustomProfile)(nil),                    // 5: memos.store.InstanceCustomProfile
+	(*InstanceStorageSetting)(nil),                   // 6: memos.store.InstanceStorageSetting
+	(*StorageS3Config)(nil),                          // 7: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),               // 8: memos.store.InstanceMemoRelatedSetting
+	(*InstanceNotificationSetting)(nil),              // 9: memos.store.InstanceNotificationSetting
+	(*InstanceWebhooksSetting)(nil),                  // 10: memos.store.InstanceWebhooksSetting
+	(*InstanceNotificationSetting_EmailSetting)(nil), // 11: memos.store.InstanceNotificationSetting.EmailSetting
+	(*WebhooksUserSetting_Webhook)(nil),              // 12: memos.store.WebhooksUserSetting.Webhook
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
-	3, // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
-	4, // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	6, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	8, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	5, // 5: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	1, // 6: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	7, // 7: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
+	3,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
+	4,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
+	6,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	8,  // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	9,  // 5: memos.store.InstanceSetting.notification_setting:type_name -> memos.store.InstanceNotificationSetting
+	10, // 6: memos.store.InstanceSetting.webhooks_setting:type_name -> memos.store.InstanceWebhooksSetting
+	5,  // 7: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	1,  // 8: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	7,  // 9: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	11, // 10: memos.store.InstanceNotificationSetting.email:type_name -> memos.store.InstanceNotificationSetting.EmailSetting
+	12, // 11: memos.store.InstanceWebhooksSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -826,11 +1122,14 @@ func file_store_instance_setting_proto_init() {
 	if File_store_instance_setting_proto != nil {
 		return
 	}
+	file_store_user_setting_proto_init()
 	file_store_instance_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*InstanceSetting_BasicSetting)(nil),
 		(*InstanceSetting_GeneralSetting)(nil),
 		(*InstanceSetting_StorageSetting)(nil),
 		(*InstanceSetting_MemoRelatedSetting)(nil),
+		(*InstanceSetting_NotificationSetting)(nil),
+		(*InstanceSetting_WebhooksSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -838,7 +1137,7 @@ func file_store_instance_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
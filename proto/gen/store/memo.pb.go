@@ -22,10 +22,20 @@ const (
 )
 
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The usernames mentioned in the memo content.
+	Mentions []string `protobuf:"bytes,4,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// The scalar key/values of the leading YAML frontmatter block.
+	Properties map[string]string `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The task list items in the memo content, in document order.
+	Tasks []*MemoPayload_Task `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// The distinct http(s) URLs linked from the memo content, in document order.
+	Links []string `protobuf:"bytes,7,rep,name=links,proto3" json:"links,omitempty"`
+	// The pending publication of a scheduled memo, or unset once the memo is published.
+	Schedule      *MemoPayload_Schedule `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +91,41 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetTasks() []*MemoPayload_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetSchedule() *MemoPayload_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -94,7 +139,7 @@ type MemoPayload_Property struct {
 
 func (x *MemoPayload_Property) Reset() {
 	*x = MemoPayload_Property{}
-	mi := &file_store_memo_proto_msgTypes[1]
+	mi := &file_store_memo_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +151,7 @@ func (x *MemoPayload_Property) String() string {
 func (*MemoPayload_Property) ProtoMessage() {}
 
 func (x *MemoPayload_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[1]
+	mi := &file_store_memo_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +164,7 @@ func (x *MemoPayload_Property) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoPayload_Property.ProtoReflect.Descriptor instead.
 func (*MemoPayload_Property) Descriptor() ([]byte, []int) {
-	return file_store_memo_proto_rawDescGZIP(), []int{0, 0}
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
 }
 
 func (x *MemoPayload_Property) GetHasLink() bool {
@@ -150,6 +195,131 @@ func (x *MemoPayload_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+type MemoPayload_Task struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The raw markdown text of the task, without the checkbox and due date.
+	Content   string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Completed bool   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The 1-based line number of the task in the memo content.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The due date written as due:YYYY-MM-DD, or empty.
+	DueDate       string `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Task) Reset() {
+	*x = MemoPayload_Task{}
+	mi := &file_store_memo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Task) ProtoMessage() {}
+
+func (x *MemoPayload_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Task.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Task) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_Task) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoPayload_Task) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *MemoPayload_Task) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *MemoPayload_Task) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+type MemoPayload_Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unix timestamp at which the memo is published.
+	PublishTs int64 `protobuf:"varint,1,opt,name=publish_ts,json=publishTs,proto3" json:"publish_ts,omitempty"`
+	// The visibility the memo gets when it is published. The memo is private until then.
+	Visibility    string `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Schedule) Reset() {
+	*x = MemoPayload_Schedule{}
+	mi := &file_store_memo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Schedule) ProtoMessage() {}
+
+func (x *MemoPayload_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Schedule.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Schedule) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *MemoPayload_Schedule) GetPublishTs() int64 {
+	if x != nil {
+		return x.PublishTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Schedule) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -161,7 +331,7 @@ type MemoPayload_Location struct {
 
 func (x *MemoPayload_Location) Reset() {
 	*x = MemoPayload_Location{}
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +343,7 @@ func (x *MemoPayload_Location) String() string {
 func (*MemoPayload_Location) ProtoMessage() {}
 
 func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +356,7 @@ func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoPayload_Location.ProtoReflect.Descriptor instead.
 func (*MemoPayload_Location) Descriptor() ([]byte, []int) {
-	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 4}
 }
 
 func (x *MemoPayload_Location) GetPlaceholder() string {
@@ -214,16 +384,37 @@ var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xa0\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\"\x89\a\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\x96\x01\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bmentions\x18\x04 \x03(\tR\bmentions\x12H\n" +
+	"\n" +
+	"properties\x18\x05 \x03(\v2(.memos.store.MemoPayload.PropertiesEntryR\n" +
+	"properties\x123\n" +
+	"\x05tasks\x18\x06 \x03(\v2\x1d.memos.store.MemoPayload.TaskR\x05tasks\x12\x14\n" +
+	"\x05links\x18\a \x03(\tR\x05links\x12=\n" +
+	"\bschedule\x18\b \x01(\v2!.memos.store.MemoPayload.ScheduleR\bschedule\x1a=\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x96\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1af\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1am\n" +
+	"\x04Task\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x1aI\n" +
+	"\bSchedule\x12\x1d\n" +
+	"\n" +
+	"publish_ts\x18\x01 \x01(\x03R\tpublishTs\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\x1af\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
@@ -242,20 +433,26 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_memo_proto_goTypes = []any{
 	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	nil,                          // 1: memos.store.MemoPayload.PropertiesEntry
+	(*MemoPayload_Property)(nil), // 2: memos.store.MemoPayload.Property
+	(*MemoPayload_Task)(nil),     // 3: memos.store.MemoPayload.Task
+	(*MemoPayload_Schedule)(nil), // 4: memos.store.MemoPayload.Schedule
+	(*MemoPayload_Location)(nil), // 5: memos.store.MemoPayload.Location
 }
 var file_store_memo_proto_depIdxs = []int32{
-	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
-	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
+	5, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
+	1, // 2: memos.store.MemoPayload.properties:type_name -> memos.store.MemoPayload.PropertiesEntry
+	3, // 3: memos.store.MemoPayload.tasks:type_name -> memos.store.MemoPayload.Task
+	4, // 4: memos.store.MemoPayload.schedule:type_name -> memos.store.MemoPayload.Schedule
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -269,7 +466,7 @@ func file_store_memo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -36,17 +36,32 @@ const (
 	UserSetting_REFRESH_TOKENS UserSetting_Key = 6
 	// Personal access tokens for the user.
 	UserSetting_PERSONAL_ACCESS_TOKENS UserSetting_Key = 7
+	// The notification preferences of the user.
+	UserSetting_NOTIFICATION UserSetting_Key = 8
+	// The tag metadata of the user.
+	UserSetting_TAGS UserSetting_Key = 9
+	// The memo templates of the user.
+	UserSetting_TEMPLATES UserSetting_Key = 10
+	// The two-factor authentication of the user.
+	UserSetting_TWO_FACTOR UserSetting_Key = 11
+	// The passkeys of the user.
+	UserSetting_PASSKEYS UserSetting_Key = 12
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		4: "SHORTCUTS",
-		5: "WEBHOOKS",
-		6: "REFRESH_TOKENS",
-		7: "PERSONAL_ACCESS_TOKENS",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		4:  "SHORTCUTS",
+		5:  "WEBHOOKS",
+		6:  "REFRESH_TOKENS",
+		7:  "PERSONAL_ACCESS_TOKENS",
+		8:  "NOTIFICATION",
+		9:  "TAGS",
+		10: "TEMPLATES",
+		11: "TWO_FACTOR",
+		12: "PASSKEYS",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED":        0,
@@ -55,6 +70,11 @@ var (
 		"WEBHOOKS":               5,
 		"REFRESH_TOKENS":         6,
 		"PERSONAL_ACCESS_TOKENS": 7,
+		"NOTIFICATION":           8,
+		"TAGS":                   9,
+		"TEMPLATES":              10,
+		"TWO_FACTOR":             11,
+		"PASSKEYS":               12,
 	}
 )
 
@@ -85,6 +105,64 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type WebhooksUserSetting_PayloadFormat int32
+
+const (
+	WebhooksUserSetting_PAYLOAD_FORMAT_UNSPECIFIED WebhooksUserSetting_PayloadFormat = 0
+	WebhooksUserSetting_MEMOS                      WebhooksUserSetting_PayloadFormat = 1
+	WebhooksUserSetting_SLACK                      WebhooksUserSetting_PayloadFormat = 2
+	WebhooksUserSetting_DISCORD                    WebhooksUserSetting_PayloadFormat = 3
+	WebhooksUserSetting_MATTERMOST                 WebhooksUserSetting_PayloadFormat = 4
+	WebhooksUserSetting_TEMPLATE                   WebhooksUserSetting_PayloadFormat = 5
+)
+
+// Enum value maps for WebhooksUserSetting_PayloadFormat.
+var (
+	WebhooksUserSetting_PayloadFormat_name = map[int32]string{
+		0: "PAYLOAD_FORMAT_UNSPECIFIED",
+		1: "MEMOS",
+		2: "SLACK",
+		3: "DISCORD",
+		4: "MATTERMOST",
+		5: "TEMPLATE",
+	}
+	WebhooksUserSetting_PayloadFormat_value = map[string]int32{
+		"PAYLOAD_FORMAT_UNSPECIFIED": 0,
+		"MEMOS":                      1,
+		"SLACK":                      2,
+		"DISCORD":                    3,
+		"MATTERMOST":                 4,
+		"TEMPLATE":                   5,
+	}
+)
+
+func (x WebhooksUserSetting_PayloadFormat) Enum() *WebhooksUserSetting_PayloadFormat {
+	p := new(WebhooksUserSetting_PayloadFormat)
+	*p = x
+	return p
+}
+
+func (x WebhooksUserSetting_PayloadFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhooksUserSetting_PayloadFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhooksUserSetting_PayloadFormat) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x WebhooksUserSetting_PayloadFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhooksUserSetting_PayloadFormat.Descriptor instead.
+func (WebhooksUserSetting_PayloadFormat) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +174,11 @@ type UserSetting struct {
 	//	*UserSetting_Webhooks
 	//	*UserSetting_RefreshTokens
 	//	*UserSetting_PersonalAccessTokens
+	//	*UserSetting_Notification
+	//	*UserSetting_Tags
+	//	*UserSetting_Templates
+	//	*UserSetting_TwoFactor
+	//	*UserSetting_Passkeys
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +280,51 @@ func (x *UserSetting) GetPersonalAccessTokens() *PersonalAccessTokensUserSetting
 	return nil
 }
 
+func (x *UserSetting) GetNotification() *NotificationUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Notification); ok {
+			return x.Notification
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTags() *TagsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Tags); ok {
+			return x.Tags
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTemplates() *TemplatesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Templates); ok {
+			return x.Templates
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTwoFactor() *TwoFactorUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TwoFactor); ok {
+			return x.TwoFactor
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetPasskeys() *PasskeysUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Passkeys); ok {
+			return x.Passkeys
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +349,26 @@ type UserSetting_PersonalAccessTokens struct {
 	PersonalAccessTokens *PersonalAccessTokensUserSetting `protobuf:"bytes,9,opt,name=personal_access_tokens,json=personalAccessTokens,proto3,oneof"`
 }
 
+type UserSetting_Notification struct {
+	Notification *NotificationUserSetting `protobuf:"bytes,10,opt,name=notification,proto3,oneof"`
+}
+
+type UserSetting_Tags struct {
+	Tags *TagsUserSetting `protobuf:"bytes,11,opt,name=tags,proto3,oneof"`
+}
+
+type UserSetting_Templates struct {
+	Templates *TemplatesUserSetting `protobuf:"bytes,12,opt,name=templates,proto3,oneof"`
+}
+
+type UserSetting_TwoFactor struct {
+	TwoFactor *TwoFactorUserSetting `protobuf:"bytes,13,opt,name=two_factor,json=twoFactor,proto3,oneof"`
+}
+
+type UserSetting_Passkeys struct {
+	Passkeys *PasskeysUserSetting `protobuf:"bytes,14,opt,name=passkeys,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Shortcuts) isUserSetting_Value() {}
@@ -231,6 +379,16 @@ func (*UserSetting_RefreshTokens) isUserSetting_Value() {}
 
 func (*UserSetting_PersonalAccessTokens) isUserSetting_Value() {}
 
+func (*UserSetting_Notification) isUserSetting_Value() {}
+
+func (*UserSetting_Tags) isUserSetting_Value() {}
+
+func (*UserSetting_Templates) isUserSetting_Value() {}
+
+func (*UserSetting_TwoFactor) isUserSetting_Value() {}
+
+func (*UserSetting_Passkeys) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -239,7 +397,9 @@ type GeneralUserSetting struct {
 	MemoVisibility string `protobuf:"bytes,2,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The user's theme preference.
 	// This references a CSS file in the web/public/themes/ directory.
-	Theme         string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The user's time zone as an IANA name, e.g. "Europe/Berlin".
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -295,6 +455,77 @@ func (x *GeneralUserSetting) GetTheme() string {
 	return ""
 }
 
+func (x *GeneralUserSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type NotificationUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email_enabled enables email notifications for inbox events.
+	EmailEnabled bool `protobuf:"varint,1,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	// digest_enabled enables the daily digest of memos to look back at.
+	DigestEnabled bool `protobuf:"varint,2,opt,name=digest_enabled,json=digestEnabled,proto3" json:"digest_enabled,omitempty"`
+	// last_digest_date is the date, in the user's time zone, of the last digest checked for sending.
+	// Format: YYYY-MM-DD.
+	LastDigestDate string `protobuf:"bytes,3,opt,name=last_digest_date,json=lastDigestDate,proto3" json:"last_digest_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationUserSetting) Reset() {
+	*x = NotificationUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationUserSetting) ProtoMessage() {}
+
+func (x *NotificationUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationUserSetting) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetDigestEnabled() bool {
+	if x != nil {
+		return x.DigestEnabled
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetLastDigestDate() string {
+	if x != nil {
+		return x.LastDigestDate
+	}
+	return ""
+}
+
 type RefreshTokensUserSetting struct {
 	state         protoimpl.MessageState                   `protogen:"open.v1"`
 	RefreshTokens []*RefreshTokensUserSetting_RefreshToken `protobuf:"bytes,1,rep,name=refresh_tokens,json=refreshTokens,proto3" json:"refresh_tokens,omitempty"`
@@ -304,7 +535,7 @@ type RefreshTokensUserSetting struct {
 
 func (x *RefreshTokensUserSetting) Reset() {
 	*x = RefreshTokensUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +547,7 @@ func (x *RefreshTokensUserSetting) String() string {
 func (*RefreshTokensUserSetting) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[2]
+	mi := &file_store_user_setting_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +560,7 @@ func (x *RefreshTokensUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokensUserSetting.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RefreshTokensUserSetting) GetRefreshTokens() []*RefreshTokensUserSetting_RefreshToken {
@@ -348,7 +579,7 @@ type PersonalAccessTokensUserSetting struct {
 
 func (x *PersonalAccessTokensUserSetting) Reset() {
 	*x = PersonalAccessTokensUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +591,7 @@ func (x *PersonalAccessTokensUserSetting) String() string {
 func (*PersonalAccessTokensUserSetting) ProtoMessage() {}
 
 func (x *PersonalAccessTokensUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[3]
+	mi := &file_store_user_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +604,7 @@ func (x *PersonalAccessTokensUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalAccessTokensUserSetting.ProtoReflect.Descriptor instead.
 func (*PersonalAccessTokensUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PersonalAccessTokensUserSetting) GetTokens() []*PersonalAccessTokensUserSetting_PersonalAccessToken {
@@ -392,7 +623,7 @@ type ShortcutsUserSetting struct {
 
 func (x *ShortcutsUserSetting) Reset() {
 	*x = ShortcutsUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +635,7 @@ func (x *ShortcutsUserSetting) String() string {
 func (*ShortcutsUserSetting) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +648,7 @@ func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortcutsUserSetting.ProtoReflect.Descriptor instead.
 func (*ShortcutsUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
@@ -427,6 +658,238 @@ func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
 	return nil
 }
 
+type TagsUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tag metadata keyed by the lowercase tag path, e.g. "work/project-a"
+	Tags          map[string]*TagsUserSetting_Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsUserSetting) Reset() {
+	*x = TagsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsUserSetting) ProtoMessage() {}
+
+func (x *TagsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsUserSetting.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TagsUserSetting) GetTags() map[string]*TagsUserSetting_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TemplatesUserSetting struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Templates     []*TemplatesUserSetting_Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesUserSetting) Reset() {
+	*x = TemplatesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesUserSetting) ProtoMessage() {}
+
+func (x *TemplatesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesUserSetting.ProtoReflect.Descriptor instead.
+func (*TemplatesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TemplatesUserSetting) GetTemplates() []*TemplatesUserSetting_Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type TwoFactorUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32 encoded TOTP secret, pending until two-factor authentication is enabled
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether sign-in requires a second factor
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// When two-factor authentication was enabled
+	EnabledAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enabled_at,json=enabledAt,proto3" json:"enabled_at,omitempty"`
+	// SHA-256 hashes of the unused recovery codes
+	RecoveryCodeHashes []string `protobuf:"bytes,4,rep,name=recovery_code_hashes,json=recoveryCodeHashes,proto3" json:"recovery_code_hashes,omitempty"`
+	// Time step of the last accepted TOTP code, to reject replayed codes
+	LastUsedStep int64 `protobuf:"varint,5,opt,name=last_used_step,json=lastUsedStep,proto3" json:"last_used_step,omitempty"`
+	// Number of invalid codes entered since the last accepted code or lockout
+	FailedAttempts int32 `protobuf:"varint,6,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// Until when codes are rejected after too many invalid codes
+	LockedUntil   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorUserSetting) Reset() {
+	*x = TwoFactorUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorUserSetting) ProtoMessage() {}
+
+func (x *TwoFactorUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorUserSetting.ProtoReflect.Descriptor instead.
+func (*TwoFactorUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TwoFactorUserSetting) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TwoFactorUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TwoFactorUserSetting) GetEnabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnabledAt
+	}
+	return nil
+}
+
+func (x *TwoFactorUserSetting) GetRecoveryCodeHashes() []string {
+	if x != nil {
+		return x.RecoveryCodeHashes
+	}
+	return nil
+}
+
+func (x *TwoFactorUserSetting) GetLastUsedStep() int64 {
+	if x != nil {
+		return x.LastUsedStep
+	}
+	return 0
+}
+
+func (x *TwoFactorUserSetting) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *TwoFactorUserSetting) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+type PasskeysUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Passkeys      []*PasskeysUserSetting_Passkey `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeysUserSetting) Reset() {
+	*x = PasskeysUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeysUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeysUserSetting) ProtoMessage() {}
+
+func (x *PasskeysUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeysUserSetting.ProtoReflect.Descriptor instead.
+func (*PasskeysUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PasskeysUserSetting) GetPasskeys() []*PasskeysUserSetting_Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
 type WebhooksUserSetting struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
@@ -436,7 +899,7 @@ type WebhooksUserSetting struct {
 
 func (x *WebhooksUserSetting) Reset() {
 	*x = WebhooksUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[5]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +911,7 @@ func (x *WebhooksUserSetting) String() string {
 func (*WebhooksUserSetting) ProtoMessage() {}
 
 func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[5]
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +924,7 @@ func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
 func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
@@ -489,7 +952,7 @@ type RefreshTokensUserSetting_RefreshToken struct {
 
 func (x *RefreshTokensUserSetting_RefreshToken) Reset() {
 	*x = RefreshTokensUserSetting_RefreshToken{}
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +964,7 @@ func (x *RefreshTokensUserSetting_RefreshToken) String() string {
 func (*RefreshTokensUserSetting_RefreshToken) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_RefreshToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[6]
+	mi := &file_store_user_setting_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +977,7 @@ func (x *RefreshTokensUserSetting_RefreshToken) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use RefreshTokensUserSetting_RefreshToken.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting_RefreshToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *RefreshTokensUserSetting_RefreshToken) GetTokenId() string {
@@ -570,7 +1033,7 @@ type RefreshTokensUserSetting_ClientInfo struct {
 
 func (x *RefreshTokensUserSetting_ClientInfo) Reset() {
 	*x = RefreshTokensUserSetting_ClientInfo{}
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +1045,7 @@ func (x *RefreshTokensUserSetting_ClientInfo) String() string {
 func (*RefreshTokensUserSetting_ClientInfo) ProtoMessage() {}
 
 func (x *RefreshTokensUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[7]
+	mi := &file_store_user_setting_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +1058,7 @@ func (x *RefreshTokensUserSetting_ClientInfo) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RefreshTokensUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
 func (*RefreshTokensUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *RefreshTokensUserSetting_ClientInfo) GetUserAgent() string {
@@ -653,7 +1116,7 @@ type PersonalAccessTokensUserSetting_PersonalAccessToken struct {
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) Reset() {
 	*x = PersonalAccessTokensUserSetting_PersonalAccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +1128,7 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) String() string {
 func (*PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoMessage() {}
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +1141,7 @@ func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) ProtoReflect() pro
 
 // Deprecated: Use PersonalAccessTokensUserSetting_PersonalAccessToken.ProtoReflect.Descriptor instead.
 func (*PersonalAccessTokensUserSetting_PersonalAccessToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *PersonalAccessTokensUserSetting_PersonalAccessToken) GetTokenId() string {
@@ -734,7 +1197,7 @@ type ShortcutsUserSetting_Shortcut struct {
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -746,7 +1209,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1222,7 @@ func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
 func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *ShortcutsUserSetting_Shortcut) GetId() string {
@@ -783,6 +1246,265 @@ func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
 	return ""
 }
 
+type TagsUserSetting_Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Display color in #RRGGBB format
+	Color string `protobuf:"bytes,1,opt,name=color,proto3" json:"color,omitempty"`
+	// Free-form description of the tag
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the tag is pinned to the top of the tag list
+	Pinned        bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsUserSetting_Tag) Reset() {
+	*x = TagsUserSetting_Tag{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsUserSetting_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsUserSetting_Tag) ProtoMessage() {}
+
+func (x *TagsUserSetting_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsUserSetting_Tag.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting_Tag) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *TagsUserSetting_Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type TemplatesUserSetting_Template struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Memo content with placeholders such as {{date}} and {{week}}
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Visibility of the memos created from the template
+	Visibility string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Cron expression to create memos from the template on, empty for manual use only
+	Cron string `protobuf:"bytes,5,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Unix timestamp of the next scheduled memo, zero without a cron expression
+	NextRunTs     int64 `protobuf:"varint,6,opt,name=next_run_ts,json=nextRunTs,proto3" json:"next_run_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplatesUserSetting_Template) Reset() {
+	*x = TemplatesUserSetting_Template{}
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplatesUserSetting_Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesUserSetting_Template) ProtoMessage() {}
+
+func (x *TemplatesUserSetting_Template) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesUserSetting_Template.ProtoReflect.Descriptor instead.
+func (*TemplatesUserSetting_Template) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *TemplatesUserSetting_Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *TemplatesUserSetting_Template) GetNextRunTs() int64 {
+	if x != nil {
+		return x.NextRunTs
+	}
+	return 0
+}
+
+type PasskeysUserSetting_Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the passkey
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Credential ID chosen by the authenticator
+	CredentialId []byte `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// CBOR encoded COSE_Key of the credential
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Signature counter of the authenticator, zero if it does not keep one
+	SignCount uint32 `protobuf:"varint,4,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	// User-provided name of the passkey
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Transports reported by the browser, e.g. "internal" or "usb"
+	Transports []string `protobuf:"bytes,6,rep,name=transports,proto3" json:"transports,omitempty"`
+	// When the passkey was registered
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the passkey was last used to sign in
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeysUserSetting_Passkey) Reset() {
+	*x = PasskeysUserSetting_Passkey{}
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeysUserSetting_Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeysUserSetting_Passkey) ProtoMessage() {}
+
+func (x *PasskeysUserSetting_Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeysUserSetting_Passkey.ProtoReflect.Descriptor instead.
+func (*PasskeysUserSetting_Passkey) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *PasskeysUserSetting_Passkey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PasskeysUserSetting_Passkey) GetCredentialId() []byte {
+	if x != nil {
+		return x.CredentialId
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *PasskeysUserSetting_Passkey) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PasskeysUserSetting_Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
 type WebhooksUserSetting_Webhook struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier for the webhook
@@ -790,14 +1512,24 @@ type WebhooksUserSetting_Webhook struct {
 	// Descriptive title for the webhook
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The webhook URL endpoint
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret used to sign requests with HMAC-SHA256
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Activity types the webhook subscribes to, empty for the default memo activities
+	ActivityTypes []string `protobuf:"bytes,5,rep,name=activity_types,json=activityTypes,proto3" json:"activity_types,omitempty"`
+	// CEL expression that the related memo must match
+	Filter string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Format of the request body
+	PayloadFormat WebhooksUserSetting_PayloadFormat `protobuf:"varint,7,opt,name=payload_format,json=payloadFormat,proto3,enum=memos.store.WebhooksUserSetting_PayloadFormat" json:"payload_format,omitempty"`
+	// Go text/template used to render the request body for the TEMPLATE format
+	PayloadTemplate string `protobuf:"bytes,8,opt,name=payload_template,json=payloadTemplate,proto3" json:"payload_template,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *WebhooksUserSetting_Webhook) Reset() {
 	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1541,7 @@ func (x *WebhooksUserSetting_Webhook) String() string {
 func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
 
 func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1554,7 @@ func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
 func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *WebhooksUserSetting_Webhook) GetId() string {
@@ -846,11 +1578,46 @@ func (x *WebhooksUserSetting_Webhook) GetUrl() string {
 	return ""
 }
 
+func (x *WebhooksUserSetting_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetActivityTypes() []string {
+	if x != nil {
+		return x.ActivityTypes
+	}
+	return nil
+}
+
+func (x *WebhooksUserSetting_Webhook) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetPayloadFormat() WebhooksUserSetting_PayloadFormat {
+	if x != nil {
+		return x.PayloadFormat
+	}
+	return WebhooksUserSetting_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
+func (x *WebhooksUserSetting_Webhook) GetPayloadTemplate() string {
+	if x != nil {
+		return x.PayloadTemplate
+	}
+	return ""
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\a\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -858,19 +1625,38 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
 	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x12N\n" +
 	"\x0erefresh_tokens\x18\b \x01(\v2%.memos.store.RefreshTokensUserSettingH\x00R\rrefreshTokens\x12d\n" +
-	"\x16personal_access_tokens\x18\t \x01(\v2,.memos.store.PersonalAccessTokensUserSettingH\x00R\x14personalAccessTokens\"t\n" +
+	"\x16personal_access_tokens\x18\t \x01(\v2,.memos.store.PersonalAccessTokensUserSettingH\x00R\x14personalAccessTokens\x12J\n" +
+	"\fnotification\x18\n" +
+	" \x01(\v2$.memos.store.NotificationUserSettingH\x00R\fnotification\x122\n" +
+	"\x04tags\x18\v \x01(\v2\x1c.memos.store.TagsUserSettingH\x00R\x04tags\x12A\n" +
+	"\ttemplates\x18\f \x01(\v2!.memos.store.TemplatesUserSettingH\x00R\ttemplates\x12B\n" +
+	"\n" +
+	"two_factor\x18\r \x01(\v2!.memos.store.TwoFactorUserSettingH\x00R\ttwoFactor\x12>\n" +
+	"\bpasskeys\x18\x0e \x01(\v2 .memos.store.PasskeysUserSettingH\x00R\bpasskeys\"\xbd\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
 	"\bWEBHOOKS\x10\x05\x12\x12\n" +
 	"\x0eREFRESH_TOKENS\x10\x06\x12\x1a\n" +
-	"\x16PERSONAL_ACCESS_TOKENS\x10\aB\a\n" +
-	"\x05value\"k\n" +
+	"\x16PERSONAL_ACCESS_TOKENS\x10\a\x12\x10\n" +
+	"\fNOTIFICATION\x10\b\x12\b\n" +
+	"\x04TAGS\x10\t\x12\r\n" +
+	"\tTEMPLATES\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"TWO_FACTOR\x10\v\x12\f\n" +
+	"\bPASSKEYS\x10\fB\a\n" +
+	"\x05value\"\x87\x01\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
 	"\x0fmemo_visibility\x18\x02 \x01(\tR\x0ememoVisibility\x12\x14\n" +
-	"\x05theme\x18\x03 \x01(\tR\x05theme\"\xa4\x04\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x8f\x01\n" +
+	"\x17NotificationUserSetting\x12#\n" +
+	"\remail_enabled\x18\x01 \x01(\bR\femailEnabled\x12%\n" +
+	"\x0edigest_enabled\x18\x02 \x01(\bR\rdigestEnabled\x12(\n" +
+	"\x10last_digest_date\x18\x03 \x01(\tR\x0elastDigestDate\"\xa4\x04\n" +
 	"\x18RefreshTokensUserSetting\x12Y\n" +
 	"\x0erefresh_tokens\x18\x01 \x03(\v22.memos.store.RefreshTokensUserSetting.RefreshTokenR\rrefreshTokens\x1a\x94\x02\n" +
 	"\fRefreshToken\x12\x19\n" +
@@ -910,13 +1696,72 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\xff\x01\n" +
+	"\x0fTagsUserSetting\x12:\n" +
+	"\x04tags\x18\x01 \x03(\v2&.memos.store.TagsUserSetting.TagsEntryR\x04tags\x1aU\n" +
+	"\x03Tag\x12\x14\n" +
+	"\x05color\x18\x01 \x01(\tR\x05color\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\x1aY\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .memos.store.TagsUserSetting.TagR\x05value:\x028\x01\"\x81\x02\n" +
+	"\x14TemplatesUserSetting\x12H\n" +
+	"\ttemplates\x18\x01 \x03(\v2*.memos.store.TemplatesUserSetting.TemplateR\ttemplates\x1a\x9e\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12\x12\n" +
+	"\x04cron\x18\x05 \x01(\tR\x04cron\x12\x1e\n" +
+	"\vnext_run_ts\x18\x06 \x01(\x03R\tnextRunTs\"\xc3\x02\n" +
+	"\x14TwoFactorUserSetting\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"enabled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tenabledAt\x120\n" +
+	"\x14recovery_code_hashes\x18\x04 \x03(\tR\x12recoveryCodeHashes\x12$\n" +
+	"\x0elast_used_step\x18\x05 \x01(\x03R\flastUsedStep\x12'\n" +
+	"\x0ffailed_attempts\x18\x06 \x01(\x05R\x0efailedAttempts\x12=\n" +
+	"\flocked_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\"\x96\x03\n" +
+	"\x13PasskeysUserSetting\x12D\n" +
+	"\bpasskeys\x18\x01 \x03(\v2(.memos.store.PasskeysUserSetting.PasskeyR\bpasskeys\x1a\xb8\x02\n" +
+	"\aPasskey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\fR\fcredentialId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x1d\n" +
+	"\n" +
+	"sign_count\x18\x04 \x01(\rR\tsignCount\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x06 \x03(\tR\n" +
+	"transports\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xea\x03\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
-	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1a\x9a\x02\n" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12%\n" +
+	"\x0eactivity_types\x18\x05 \x03(\tR\ractivityTypes\x12\x16\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\x12U\n" +
+	"\x0epayload_format\x18\a \x01(\x0e2..memos.store.WebhooksUserSetting.PayloadFormatR\rpayloadFormat\x12)\n" +
+	"\x10payload_template\x18\b \x01(\tR\x0fpayloadTemplate\"p\n" +
+	"\rPayloadFormat\x12\x1e\n" +
+	"\x1aPAYLOAD_FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05MEMOS\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\x12\x0e\n" +
+	"\n" +
+	"MATTERMOST\x10\x04\x12\f\n" +
+	"\bTEMPLATE\x10\x05B\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -931,45 +1776,69 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_store_user_setting_proto_goTypes = []any{
 	(UserSetting_Key)(0),                                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                                  // 2: memos.store.GeneralUserSetting
-	(*RefreshTokensUserSetting)(nil),                            // 3: memos.store.RefreshTokensUserSetting
-	(*PersonalAccessTokensUserSetting)(nil),                     // 4: memos.store.PersonalAccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                                 // 6: memos.store.WebhooksUserSetting
-	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 7: memos.store.RefreshTokensUserSetting.RefreshToken
-	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 8: memos.store.RefreshTokensUserSetting.ClientInfo
-	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 9: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),                       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),                         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),                               // 12: google.protobuf.Timestamp
+	(WebhooksUserSetting_PayloadFormat)(0),                      // 1: memos.store.WebhooksUserSetting.PayloadFormat
+	(*UserSetting)(nil),                                         // 2: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                                  // 3: memos.store.GeneralUserSetting
+	(*NotificationUserSetting)(nil),                             // 4: memos.store.NotificationUserSetting
+	(*RefreshTokensUserSetting)(nil),                            // 5: memos.store.RefreshTokensUserSetting
+	(*PersonalAccessTokensUserSetting)(nil),                     // 6: memos.store.PersonalAccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                                // 7: memos.store.ShortcutsUserSetting
+	(*TagsUserSetting)(nil),                                     // 8: memos.store.TagsUserSetting
+	(*TemplatesUserSetting)(nil),                                // 9: memos.store.TemplatesUserSetting
+	(*TwoFactorUserSetting)(nil),                                // 10: memos.store.TwoFactorUserSetting
+	(*PasskeysUserSetting)(nil),                                 // 11: memos.store.PasskeysUserSetting
+	(*WebhooksUserSetting)(nil),                                 // 12: memos.store.WebhooksUserSetting
+	(*RefreshTokensUserSetting_RefreshToken)(nil),               // 13: memos.store.RefreshTokensUserSetting.RefreshToken
+	(*RefreshTokensUserSetting_ClientInfo)(nil),                 // 14: memos.store.RefreshTokensUserSetting.ClientInfo
+	(*PersonalAccessTokensUserSetting_PersonalAccessToken)(nil), // 15: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),                       // 16: memos.store.ShortcutsUserSetting.Shortcut
+	(*TagsUserSetting_Tag)(nil),                                 // 17: memos.store.TagsUserSetting.Tag
+	nil,                                                         // 18: memos.store.TagsUserSetting.TagsEntry
+	(*TemplatesUserSetting_Template)(nil),                       // 19: memos.store.TemplatesUserSetting.Template
+	(*PasskeysUserSetting_Passkey)(nil),                         // 20: memos.store.PasskeysUserSetting.Passkey
+	(*WebhooksUserSetting_Webhook)(nil),                         // 21: memos.store.WebhooksUserSetting.Webhook
+	(*timestamppb.Timestamp)(nil),                               // 22: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	5,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	3,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
-	4,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
-	7,  // 6: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
-	9,  // 7: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
-	12, // 13: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 14: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	12, // 15: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	3,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	7,  // 2: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	12, // 3: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	5,  // 4: memos.store.UserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting
+	6,  // 5: memos.store.UserSetting.personal_access_tokens:type_name -> memos.store.PersonalAccessTokensUserSetting
+	4,  // 6: memos.store.UserSetting.notification:type_name -> memos.store.NotificationUserSetting
+	8,  // 7: memos.store.UserSetting.tags:type_name -> memos.store.TagsUserSetting
+	9,  // 8: memos.store.UserSetting.templates:type_name -> memos.store.TemplatesUserSetting
+	10, // 9: memos.store.UserSetting.two_factor:type_name -> memos.store.TwoFactorUserSetting
+	11, // 10: memos.store.UserSetting.passkeys:type_name -> memos.store.PasskeysUserSetting
+	13, // 11: memos.store.RefreshTokensUserSetting.refresh_tokens:type_name -> memos.store.RefreshTokensUserSetting.RefreshToken
+	15, // 12: memos.store.PersonalAccessTokensUserSetting.tokens:type_name -> memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken
+	16, // 13: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	18, // 14: memos.store.TagsUserSetting.tags:type_name -> memos.store.TagsUserSetting.TagsEntry
+	19, // 15: memos.store.TemplatesUserSetting.templates:type_name -> memos.store.TemplatesUserSetting.Template
+	22, // 16: memos.store.TwoFactorUserSetting.enabled_at:type_name -> google.protobuf.Timestamp
+	22, // 17: memos.store.TwoFactorUserSetting.locked_until:type_name -> google.protobuf.Timestamp
+	20, // 18: memos.store.PasskeysUserSetting.passkeys:type_name -> memos.store.PasskeysUserSetting.Passkey
+	21, // 19: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	22, // 20: memos.store.RefreshTokensUserSetting.RefreshToken.expires_at:type_name -> google.protobuf.Timestamp
+	22, // 21: memos.store.RefreshTokensUserSetting.RefreshToken.created_at:type_name -> google.protobuf.Timestamp
+	14, // 22: memos.store.RefreshTokensUserSetting.RefreshToken.client_info:type_name -> memos.store.RefreshTokensUserSetting.ClientInfo
+	22, // 23: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	22, // 24: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	22, // 25: memos.store.PersonalAccessTokensUserSetting.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	17, // 26: memos.store.TagsUserSetting.TagsEntry.value:type_name -> memos.store.TagsUserSetting.Tag
+	22, // 27: memos.store.PasskeysUserSetting.Passkey.created_at:type_name -> google.protobuf.Timestamp
+	22, // 28: memos.store.PasskeysUserSetting.Passkey.last_used_at:type_name -> google.protobuf.Timestamp
+	1,  // 29: memos.store.WebhooksUserSetting.Webhook.payload_format:type_name -> memos.store.WebhooksUserSetting.PayloadFormat
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -983,14 +1852,19 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_Webhooks)(nil),
 		(*UserSetting_RefreshTokens)(nil),
 		(*UserSetting_PersonalAccessTokens)(nil),
+		(*UserSetting_Notification)(nil),
+		(*UserSetting_Tags)(nil),
+		(*UserSetting_Templates)(nil),
+		(*UserSetting_TwoFactor)(nil),
+		(*UserSetting_Passkeys)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,6 +12,7 @@ package auth
 
 import (
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"time"
@@ -50,6 +51,22 @@ const (
 
 	// PersonalAccessTokenPrefix is the prefix for PAT tokens.
 	PersonalAccessTokenPrefix = "memos_pat_"
+
+	// TwoFactorTokenAudienceName is the audience claim for two-factor tokens.
+	TwoFactorTokenAudienceName = "user.two-factor-token"
+
+	// TwoFactorTokenDuration is the lifetime of two-factor tokens (5 minutes).
+	TwoFactorTokenDuration = 5 * time.Minute
+
+	// PasskeySessionTokenAudienceName is the audience claim for passkey session tokens.
+	PasskeySessionTokenAudienceName = "user.passkey-session"
+
+	// PasskeySessionTokenDuration is the lifetime of passkey session tokens (5 minutes).
+	PasskeySessionTokenDuration = 5 * time.Minute
+
+	// PasskeyRegistrationSession and PasskeySignInSession are the types of passkey session tokens.
+	PasskeyRegistrationSession = "passkey_registration"
+	PasskeySignInSession       = "passkey_sign_in"
 )
 
 // ClaimsMessage represents the claims structure in a JWT token.
@@ -84,6 +101,22 @@ type RefreshTokenClaims struct {
 	jwt.RegisteredClaims
 }
 
+// TwoFactorTokenClaims contains claims for the short-lived tokens issued after a password sign-in
+// of a user with two-factor authentication, to be exchanged together with a TOTP or recovery code.
+type TwoFactorTokenClaims struct {
+	Type string `json:"type"` // "two_factor"
+	jwt.RegisteredClaims
+}
+
+// PasskeySessionTokenClaims contains claims for the short-lived tokens that carry the challenge
+// of a passkey ceremony between its begin and finish requests.
+// The subject is the user the ceremony is bound to, empty for sign-ins with discoverable credentials.
+type PasskeySessionTokenClaims struct {
+	Type      string `json:"type"`      // PasskeyRegistrationSession or PasskeySignInSession
+	Challenge string `json:"challenge"` // Base64url encoded challenge
+	jwt.RegisteredClaims
+}
+
 // GenerateAccessToken generates a JWT access token for a user.
 //
 // Parameters:
@@ -186,6 +219,62 @@ func GenerateRefreshToken(userID int32, tokenID string, secret []byte) (string,
 	return tokenString, expiresAt, nil
 }
 
+// GenerateTwoFactorToken generates a short-lived token for the second step of a sign-in.
+func GenerateTwoFactorToken(userID int32, secret []byte) (string, time.Time, error) {
+	expiresAt := time.Now().Add(TwoFactorTokenDuration)
+
+	claims := &TwoFactorTokenClaims{
+		Type: "two_factor",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{TwoFactorTokenAudienceName},
+			Subject:   fmt.Sprint(userID),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = KeyID
+
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+// GeneratePasskeySessionToken generates a short-lived token for a passkey ceremony.
+// Pass zero as userID for a sign-in with discoverable credentials.
+func GeneratePasskeySessionToken(userID int32, sessionType string, challenge []byte, secret []byte) (string, time.Time, error) {
+	expiresAt := time.Now().Add(PasskeySessionTokenDuration)
+
+	claims := &PasskeySessionTokenClaims{
+		Type:      sessionType,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{PasskeySessionTokenAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	if userID != 0 {
+		claims.Subject = fmt.Sprint(userID)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = KeyID
+
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
 // GeneratePersonalAccessToken generates a random PAT string.
 func GeneratePersonalAccessToken() string {
 	randomStr, err := util.RandomString(32)
@@ -247,3 +336,35 @@ func ParseRefreshToken(tokenString string, secret []byte) (*RefreshTokenClaims,
 	}
 	return claims, nil
 }
+
+// ParseTwoFactorToken parses and validates a two-factor token.
+func ParseTwoFactorToken(tokenString string, secret []byte) (*TwoFactorTokenClaims, error) {
+	claims := &TwoFactorTokenClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, verifyJWTKeyFunc(secret),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(TwoFactorTokenAudienceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != "two_factor" {
+		return nil, errors.New("invalid token type: expected two-factor token")
+	}
+	return claims, nil
+}
+
+// ParsePasskeySessionToken parses and validates a passkey session token of the given type.
+func ParsePasskeySessionToken(tokenString, sessionType string, secret []byte) (*PasskeySessionTokenClaims, error) {
+	claims := &PasskeySessionTokenClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, verifyJWTKeyFunc(secret),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(PasskeySessionTokenAudienceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != sessionType {
+		return nil, errors.Errorf("invalid token type: expected %s token", sessionType)
+	}
+	return claims, nil
+}
@@ -147,6 +147,70 @@ func TestParseRefreshToken(t *testing.T) {
 	})
 }
 
+func TestParseTwoFactorToken(t *testing.T) {
+	secret := []byte("test-secret")
+
+	t.Run("parses valid two-factor token", func(t *testing.T) {
+		token, expiresAt, err := GenerateTwoFactorToken(1, secret)
+		require.NoError(t, err)
+		assert.True(t, expiresAt.Before(time.Now().Add(TwoFactorTokenDuration+time.Minute)))
+
+		claims, err := ParseTwoFactorToken(token, secret)
+		require.NoError(t, err)
+		assert.Equal(t, "1", claims.Subject)
+		assert.Equal(t, "two_factor", claims.Type)
+	})
+
+	t.Run("is not an access token", func(t *testing.T) {
+		token, _, err := GenerateTwoFactorToken(1, secret)
+		require.NoError(t, err)
+
+		_, err = ParseAccessTokenV2(token, secret)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid audience")
+	})
+
+	t.Run("fails with access token", func(t *testing.T) {
+		accessToken, _, err := GenerateAccessTokenV2(1, "testuser", "USER", "ACTIVE", secret)
+		require.NoError(t, err)
+
+		_, err = ParseTwoFactorToken(accessToken, secret)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid audience")
+	})
+}
+
+func TestParsePasskeySessionToken(t *testing.T) {
+	secret := []byte("test-secret")
+
+	t.Run("parses valid passkey session token", func(t *testing.T) {
+		token, _, err := GeneratePasskeySessionToken(1, PasskeyRegistrationSession, []byte{0x01, 0x02}, secret)
+		require.NoError(t, err)
+
+		claims, err := ParsePasskeySessionToken(token, PasskeyRegistrationSession, secret)
+		require.NoError(t, err)
+		assert.Equal(t, "1", claims.Subject)
+		assert.Equal(t, "AQI", claims.Challenge)
+	})
+
+	t.Run("has no subject for discoverable sign-ins", func(t *testing.T) {
+		token, _, err := GeneratePasskeySessionToken(0, PasskeySignInSession, []byte{0x01}, secret)
+		require.NoError(t, err)
+
+		claims, err := ParsePasskeySessionToken(token, PasskeySignInSession, secret)
+		require.NoError(t, err)
+		assert.Empty(t, claims.Subject)
+	})
+
+	t.Run("fails with another session type", func(t *testing.T) {
+		token, _, err := GeneratePasskeySessionToken(1, PasskeyRegistrationSession, []byte{0x01}, secret)
+		require.NoError(t, err)
+
+		_, err = ParsePasskeySessionToken(token, PasskeySignInSession, secret)
+		assert.Error(t, err)
+	})
+}
+
 func TestGeneratePersonalAccessToken(t *testing.T) {
 	t.Run("generates token with correct prefix", func(t *testing.T) {
 		token := GeneratePersonalAccessToken()
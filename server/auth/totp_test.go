@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTOTPCode(t *testing.T) {
+	// Test vectors of RFC 6238 appendix B for HMAC-SHA1, truncated to six digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, test := range tests {
+		code, err := GenerateTOTPCode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.code, code, "time %d", test.unix)
+	}
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := GenerateTOTPCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok := ValidateTOTPCode(secret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/30, step)
+
+	// Codes of the previous and next time step are accepted for clock drift.
+	_, ok = ValidateTOTPCode(secret, code, now.Add(TOTPPeriod))
+	assert.True(t, ok)
+	_, ok = ValidateTOTPCode(secret, code, now.Add(-TOTPPeriod))
+	assert.True(t, ok)
+	_, ok = ValidateTOTPCode(secret, code, now.Add(3*TOTPPeriod))
+	assert.False(t, ok)
+
+	_, ok = ValidateTOTPCode(secret, "12345", now)
+	assert.False(t, ok)
+	_, ok = ValidateTOTPCode("not base32!", code, now)
+	assert.False(t, ok)
+}
+
+func TestBuildTOTPURI(t *testing.T) {
+	uri, err := url.Parse(BuildTOTPURI("steven", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/Memos:steven", uri.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	assert.Equal(t, "Memos", uri.Query().Get("issuer"))
+}
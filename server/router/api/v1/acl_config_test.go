@@ -12,6 +12,7 @@ func TestPublicMethodsArePublic(t *testing.T) {
 		// Auth Service
 		"/memos.api.v1.AuthService/SignIn",
 		"/memos.api.v1.AuthService/RefreshToken",
+		"/memos.api.v1.AuthService/BeginPasskeySignIn",
 		// Instance Service
 		"/memos.api.v1.InstanceService/GetInstanceProfile",
 		"/memos.api.v1.InstanceService/GetInstanceSetting",
@@ -42,16 +43,30 @@ func TestProtectedMethodsRequireAuth(t *testing.T) {
 		// Auth Service - logout and get current user require auth
 		"/memos.api.v1.AuthService/SignOut",
 		"/memos.api.v1.AuthService/GetCurrentUser",
+		"/memos.api.v1.AuthService/BeginPasskeyRegistration",
+		"/memos.api.v1.AuthService/FinishPasskeyRegistration",
 		// Instance Service - admin operations
 		"/memos.api.v1.InstanceService/UpdateInstanceSetting",
 		// User Service - modification operations
 		"/memos.api.v1.UserService/ListUsers",
 		"/memos.api.v1.UserService/UpdateUser",
 		"/memos.api.v1.UserService/DeleteUser",
+		"/memos.api.v1.UserService/GetUserTwoFactor",
+		"/memos.api.v1.UserService/SetupUserTwoFactor",
+		"/memos.api.v1.UserService/EnableUserTwoFactor",
+		"/memos.api.v1.UserService/DisableUserTwoFactor",
+		"/memos.api.v1.UserService/ListUserPasskeys",
+		"/memos.api.v1.UserService/DeleteUserPasskey",
 		// Memo Service - write operations
 		"/memos.api.v1.MemoService/CreateMemo",
 		"/memos.api.v1.MemoService/UpdateMemo",
 		"/memos.api.v1.MemoService/DeleteMemo",
+		"/memos.api.v1.MemoService/ArchiveMemoLink",
+		"/memos.api.v1.MemoService/CreateMemoReminder",
+		"/memos.api.v1.MemoService/ListMemoReminders",
+		"/memos.api.v1.MemoService/UpdateMemoReminder",
+		"/memos.api.v1.MemoService/DeleteMemoReminder",
+		"/memos.api.v1.MemoService/GetMemoDigest",
 		// Attachment Service - write operations
 		"/memos.api.v1.AttachmentService/CreateAttachment",
 		"/memos.api.v1.AttachmentService/DeleteAttachment",
@@ -60,6 +75,19 @@ func TestProtectedMethodsRequireAuth(t *testing.T) {
 		"/memos.api.v1.ShortcutService/ListShortcuts",
 		"/memos.api.v1.ShortcutService/UpdateShortcut",
 		"/memos.api.v1.ShortcutService/DeleteShortcut",
+		// Memo Template Service
+		"/memos.api.v1.MemoTemplateService/ListMemoTemplates",
+		"/memos.api.v1.MemoTemplateService/GetMemoTemplate",
+		"/memos.api.v1.MemoTemplateService/CreateMemoTemplate",
+		"/memos.api.v1.MemoTemplateService/UpdateMemoTemplate",
+		"/memos.api.v1.MemoTemplateService/DeleteMemoTemplate",
+		"/memos.api.v1.MemoTemplateService/CreateMemoFromTemplate",
+		// Tag Service
+		"/memos.api.v1.TagService/ListTags",
+		"/memos.api.v1.TagService/UpdateTag",
+		"/memos.api.v1.TagService/RenameTag",
+		"/memos.api.v1.TagService/MergeTags",
+		"/memos.api.v1.TagService/DeleteTag",
 		// Activity Service
 		"/memos.api.v1.ActivityService/GetActivity",
 	}
@@ -78,6 +78,12 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Type {
 	case store.ActivityTypeMemoComment:
 		activityType = v1pb.Activity_MEMO_COMMENT
+	case store.ActivityTypeMemoMention:
+		activityType = v1pb.Activity_MEMO_MENTION
+	case store.ActivityTypeMemoReminder:
+		activityType = v1pb.Activity_MEMO_REMINDER
+	case store.ActivityTypeMemoDigest:
+		activityType = v1pb.Activity_MEMO_DIGEST
 	default:
 		activityType = v1pb.Activity_TYPE_UNSPECIFIED
 	}
@@ -134,5 +140,75 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			},
 		}
 	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
+	if payload.MemoReminder != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReminder.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReminder{
+			MemoReminder: &v1pb.ActivityMemoReminderPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
+	if payload.MemoDigest != nil {
+		memoIDs := append(append([]int32{}, payload.MemoDigest.OnThisDayMemoIds...), payload.MemoDigest.ReviewMemoIds...)
+		memoNames := make(map[int32]string, len(memoIDs))
+		if len(memoIDs) > 0 {
+			memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+				IDList:         memoIDs,
+				ExcludeContent: true,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+			}
+			for _, memo := range memos {
+				memoNames[memo.ID] = fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+			}
+		}
+		// Memos deleted since the digest was sent are left out.
+		namesOf := func(ids []int32) []string {
+			names := []string{}
+			for _, id := range ids {
+				if name, ok := memoNames[id]; ok {
+					names = append(names, name)
+				}
+			}
+			return names
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoDigest{
+			MemoDigest: &v1pb.ActivityMemoDigestPayload{
+				Date:           payload.MemoDigest.Date,
+				OnThisDayMemos: namesOf(payload.MemoDigest.OnThisDayMemoIds),
+				ReviewMemos:    namesOf(payload.MemoDigest.ReviewMemoIds),
+			},
+		}
+	}
 	return v2Payload, nil
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/usememos/memos/internal/immich"
 	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -229,6 +230,7 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		}
 	}
 
+	var relatedMemo *store.Memo
 	if request.Attachment.Memo != nil {
 		memoUID, err := ExtractMemoUIDFromName(*request.Attachment.Memo)
 		if err != nil {
@@ -242,13 +244,38 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 			return nil, status.Errorf(codes.NotFound, "memo not found: %s", *request.Attachment.Memo)
 		}
 		create.MemoID = &memo.ID
+		relatedMemo = memo
 	}
 	attachment, err := s.Store.CreateAttachment(ctx, create)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
 
-	return convertAttachmentFromStore(attachment), nil
+	attachmentMessage := convertAttachmentFromStore(attachment)
+	s.dispatchAttachmentCreatedWebhook(ctx, user.ID, relatedMemo, attachmentMessage)
+	return attachmentMessage, nil
+}
+
+// dispatchAttachmentCreatedWebhook dispatches webhook to the attachment creator when an attachment is created.
+func (s *APIV1Service) dispatchAttachmentCreatedWebhook(ctx context.Context, creatorID int32, memo *store.Memo, attachment *v1pb.Attachment) {
+	payload := &webhook.WebhookRequestPayload{
+		ActivityType: webhook.ActivityTypeAttachmentCreated,
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, creatorID),
+		Attachment:   attachment,
+	}
+	var memoMessage *v1pb.Memo
+	if memo != nil {
+		converted, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+		if err != nil {
+			slog.Warn("Failed to convert memo for attachment webhook", slog.Any("err", err))
+			return
+		}
+		memoMessage = converted
+		payload.Memo = memoMessage
+	}
+	if err := s.dispatchWebhook(ctx, creatorID, memoMessage, payload); err != nil {
+		slog.Warn("Failed to dispatch attachment created webhook", slog.Any("err", err))
+	}
 }
 
 func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAttachmentsRequest) (*v1pb.ListAttachmentsResponse, error) {
@@ -19,6 +19,8 @@ import (
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/idp"
 	"github.com/usememos/memos/plugin/idp/oauth2"
+	"github.com/usememos/memos/plugin/idp/oidc"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -55,14 +57,23 @@ func (s *APIV1Service) GetCurrentUser(ctx context.Context, _ *v1pb.GetCurrentUse
 // SignIn authenticates a user with credentials and returns tokens.
 // On success, returns an access token and sets a refresh token cookie.
 //
-// Supports two authentication methods:
+// Supports three authentication methods:
 // 1. Password-based authentication (username + password).
-// 2. SSO authentication (OAuth2 authorization code).
+// 2. Passkey authentication (WebAuthn assertion), see BeginPasskeySignIn.
+// 3. SSO authentication (OAuth2 or OpenID Connect authorization code).
+//
+// Users with two-factor authentication enabled get a short-lived two-factor token from the
+// password sign-in instead of an access token, and complete it with the token and a TOTP
+// or recovery code, or with one of their passkeys.
 //
 // Authentication: Not required (public endpoint).
 // Returns: User info, access token, and token expiry.
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.SignInResponse, error) {
 	var existingUser *store.User
+	// requireTwoFactor is set when the password was right but a second factor is still needed.
+	requireTwoFactor := false
+	// signInActivity is the webhook payload of a successful sign-in.
+	signInActivity := &webhook.WebhookRequestPayload{ActivityType: webhook.ActivityTypeUserSignIn}
 
 	// Authentication Method 1: Password-based authentication
 	if passwordCredentials := request.GetPasswordCredentials(); passwordCredentials != nil {
@@ -87,9 +98,61 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 		if instanceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
 			return nil, status.Errorf(codes.PermissionDenied, "password signin is not allowed")
 		}
+		twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication, error: %v", err)
+		}
+		// Passkeys are a way to sign in without the password, registering one does not enable two-factor
+		// authentication. Without it the password is enough, with it a passkey may replace the code.
+		requireTwoFactor = twoFactor.Enabled
+		existingUser = user
+	} else if twoFactorCredentials := request.GetTwoFactorCredentials(); twoFactorCredentials != nil {
+		// Second step of a password sign-in with two-factor authentication.
+		claims, err := auth.ParseTwoFactorToken(twoFactorCredentials.Token, []byte(s.Secret))
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor token: %v", err)
+		}
+		userID, err := util.ConvertStringToInt32(claims.Subject)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in two-factor token")
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not found")
+		}
+		twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication, error: %v", err)
+		}
+		// Two-factor authentication may have been reset since the password sign-in.
+		if !twoFactor.Enabled {
+			return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+		}
+		ok, err := s.verifyTwoFactorCode(ctx, user.ID, twoFactor, twoFactorCredentials.Code)
+		if errors.Is(err, errTwoFactorLocked) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to verify two-factor code, error: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, invalidTwoFactorCodeError)
+		}
+		existingUser = user
+	} else if passkeyCredentials := request.GetPasskeyCredentials(); passkeyCredentials != nil {
+		// Passkey authentication, on its own or as the second step of a password sign-in.
+		user, err := s.verifyPasskeySignIn(ctx, passkeyCredentials)
+		if err != nil {
+			return nil, err
+		}
 		existingUser = user
 	} else if ssoCredentials := request.GetSsoCredentials(); ssoCredentials != nil {
-		// Authentication Method 2: SSO (OAuth2) authentication
+		// Authentication Method 2: SSO (OAuth2 or OpenID Connect) authentication
 		identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
 			ID: &ssoCredentials.IdpId,
 		})
@@ -115,6 +178,25 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get user info, error: %v", err)
 			}
+		} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+			if ssoCredentials.Nonce == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "nonce is required")
+			}
+			oidcIdentityProvider, err := oidc.NewIdentityProvider(identityProvider.Config.GetOidcConfig())
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to create oidc identity provider, error: %v", err)
+			}
+			idToken, err := oidcIdentityProvider.ExchangeToken(ctx, ssoCredentials.RedirectUri, ssoCredentials.Code, ssoCredentials.CodeVerifier)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to exchange token, error: %v", err)
+			}
+			userInfo, err = oidcIdentityProvider.UserInfo(ctx, idToken, ssoCredentials.Nonce)
+			if err != nil {
+				return nil, status.Errorf(codes.Unauthenticated, "failed to verify id token, error: %v", err)
+			}
+		}
+		if userInfo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type %s", identityProvider.Type)
 		}
 
 		identifierFilter := identityProvider.IdentifierFilter
@@ -166,8 +248,13 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
 			}
+			s.dispatchUserActivityWebhook(ctx, nil, user, &webhook.WebhookRequestPayload{ActivityType: webhook.ActivityTypeUserCreated})
 		}
 		existingUser = user
+		signInActivity = &webhook.WebhookRequestPayload{
+			ActivityType:     webhook.ActivityTypeUserIDPSignIn,
+			IdentityProvider: fmt.Sprintf("%s%d", IdentityProviderNamePrefix, identityProvider.Id),
+		}
 	}
 
 	if existingUser == nil {
@@ -176,11 +263,21 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 	if existingUser.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", existingUser.Username)
 	}
+	if requireTwoFactor {
+		twoFactorToken, _, err := auth.GenerateTwoFactorToken(existingUser.ID, []byte(s.Secret))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate two-factor token: %v", err)
+		}
+		return &v1pb.SignInResponse{
+			TwoFactorToken: twoFactorToken,
+		}, nil
+	}
 
 	accessToken, accessExpiresAt, err := s.doSignIn(ctx, existingUser)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	s.dispatchUserActivityWebhook(ctx, nil, existingUser, signInActivity)
 
 	return &v1pb.SignInResponse{
 		User:                 convertUserFromStore(existingUser),
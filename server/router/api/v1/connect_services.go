@@ -39,6 +39,54 @@ func (s *ConnectServiceHandler) UpdateInstanceSetting(ctx context.Context, req *
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListInstanceJobs(ctx context.Context, req *connect.Request[v1pb.ListInstanceJobsRequest]) (*connect.Response[v1pb.ListInstanceJobsResponse], error) {
+	resp, err := s.APIV1Service.ListInstanceJobs(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RunInstanceJob(ctx context.Context, req *connect.Request[v1pb.RunInstanceJobRequest]) (*connect.Response[v1pb.InstanceJob], error) {
+	resp, err := s.APIV1Service.RunInstanceJob(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListInstanceWebhooks(ctx context.Context, req *connect.Request[v1pb.ListInstanceWebhooksRequest]) (*connect.Response[v1pb.ListInstanceWebhooksResponse], error) {
+	resp, err := s.APIV1Service.ListInstanceWebhooks(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateInstanceWebhook(ctx context.Context, req *connect.Request[v1pb.CreateInstanceWebhookRequest]) (*connect.Response[v1pb.InstanceWebhook], error) {
+	resp, err := s.APIV1Service.CreateInstanceWebhook(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateInstanceWebhook(ctx context.Context, req *connect.Request[v1pb.UpdateInstanceWebhookRequest]) (*connect.Response[v1pb.InstanceWebhook], error) {
+	resp, err := s.APIV1Service.UpdateInstanceWebhook(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteInstanceWebhook(ctx context.Context, req *connect.Request[v1pb.DeleteInstanceWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteInstanceWebhook(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AuthService
 //
 // Auth service methods need special handling for response headers (cookies).
@@ -69,6 +117,30 @@ func (s *ConnectServiceHandler) RefreshToken(ctx context.Context, req *connect.R
 	})
 }
 
+func (s *ConnectServiceHandler) BeginPasskeyRegistration(ctx context.Context, req *connect.Request[v1pb.BeginPasskeyRegistrationRequest]) (*connect.Response[v1pb.BeginPasskeyRegistrationResponse], error) {
+	resp, err := s.APIV1Service.BeginPasskeyRegistration(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) FinishPasskeyRegistration(ctx context.Context, req *connect.Request[v1pb.FinishPasskeyRegistrationRequest]) (*connect.Response[v1pb.Passkey], error) {
+	resp, err := s.APIV1Service.FinishPasskeyRegistration(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) BeginPasskeySignIn(ctx context.Context, req *connect.Request[v1pb.BeginPasskeySignInRequest]) (*connect.Response[v1pb.BeginPasskeySignInResponse], error) {
+	resp, err := s.APIV1Service.BeginPasskeySignIn(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // UserService
 
 func (s *ConnectServiceHandler) ListUsers(ctx context.Context, req *connect.Request[v1pb.ListUsersRequest]) (*connect.Response[v1pb.ListUsersResponse], error) {
@@ -175,6 +247,54 @@ func (s *ConnectServiceHandler) DeletePersonalAccessToken(ctx context.Context, r
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) GetUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.GetUserTwoFactorRequest]) (*connect.Response[v1pb.UserTwoFactor], error) {
+	resp, err := s.APIV1Service.GetUserTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SetupUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.SetupUserTwoFactorRequest]) (*connect.Response[v1pb.SetupUserTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.SetupUserTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) EnableUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.EnableUserTwoFactorRequest]) (*connect.Response[v1pb.EnableUserTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.EnableUserTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DisableUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.DisableUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DisableUserTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListUserPasskeys(ctx context.Context, req *connect.Request[v1pb.ListUserPasskeysRequest]) (*connect.Response[v1pb.ListUserPasskeysResponse], error) {
+	resp, err := s.APIV1Service.ListUserPasskeys(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteUserPasskey(ctx context.Context, req *connect.Request[v1pb.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserPasskey(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserWebhooks(ctx context.Context, req *connect.Request[v1pb.ListUserWebhooksRequest]) (*connect.Response[v1pb.ListUserWebhooksResponse], error) {
 	resp, err := s.APIV1Service.ListUserWebhooks(ctx, req.Msg)
 	if err != nil {
@@ -207,6 +327,22 @@ func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListUserWebhookDeliveriesResponse], error) {
+	resp, err := s.APIV1Service.ListUserWebhookDeliveries(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1pb.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1pb.UserWebhookDelivery], error) {
+	resp, err := s.APIV1Service.RedeliverUserWebhookDelivery(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
 	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
 	if err != nil {
@@ -345,6 +481,102 @@ func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *con
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListMemoRevisions(ctx context.Context, req *connect.Request[v1pb.ListMemoRevisionsRequest]) (*connect.Response[v1pb.ListMemoRevisionsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoRevision(ctx context.Context, req *connect.Request[v1pb.GetMemoRevisionRequest]) (*connect.Response[v1pb.MemoRevision], error) {
+	resp, err := s.APIV1Service.GetMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DiffMemoRevisions(ctx context.Context, req *connect.Request[v1pb.DiffMemoRevisionsRequest]) (*connect.Response[v1pb.DiffMemoRevisionsResponse], error) {
+	resp, err := s.APIV1Service.DiffMemoRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1pb.RestoreMemoRevisionRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RestoreMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListTasks(ctx context.Context, req *connect.Request[v1pb.ListTasksRequest]) (*connect.Response[v1pb.ListTasksResponse], error) {
+	resp, err := s.APIV1Service.ListTasks(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SetTaskCompleted(ctx context.Context, req *connect.Request[v1pb.SetTaskCompletedRequest]) (*connect.Response[v1pb.Task], error) {
+	resp, err := s.APIV1Service.SetTaskCompleted(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ArchiveMemoLink(ctx context.Context, req *connect.Request[v1pb.ArchiveMemoLinkRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.ArchiveMemoLink(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoReminder(ctx context.Context, req *connect.Request[v1pb.CreateMemoReminderRequest]) (*connect.Response[v1pb.MemoReminder], error) {
+	resp, err := s.APIV1Service.CreateMemoReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoReminders(ctx context.Context, req *connect.Request[v1pb.ListMemoRemindersRequest]) (*connect.Response[v1pb.ListMemoRemindersResponse], error) {
+	resp, err := s.APIV1Service.ListMemoReminders(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoReminder(ctx context.Context, req *connect.Request[v1pb.UpdateMemoReminderRequest]) (*connect.Response[v1pb.MemoReminder], error) {
+	resp, err := s.APIV1Service.UpdateMemoReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoReminder(ctx context.Context, req *connect.Request[v1pb.DeleteMemoReminderRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoDigest(ctx context.Context, req *connect.Request[v1pb.GetMemoDigestRequest]) (*connect.Response[v1pb.MemoDigest], error) {
+	resp, err := s.APIV1Service.GetMemoDigest(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AttachmentService
 
 func (s *ConnectServiceHandler) CreateAttachment(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
@@ -429,6 +661,98 @@ func (s *ConnectServiceHandler) DeleteShortcut(ctx context.Context, req *connect
 	return connect.NewResponse(resp), nil
 }
 
+// MemoTemplateService
+
+func (s *ConnectServiceHandler) ListMemoTemplates(ctx context.Context, req *connect.Request[v1pb.ListMemoTemplatesRequest]) (*connect.Response[v1pb.ListMemoTemplatesResponse], error) {
+	resp, err := s.APIV1Service.ListMemoTemplates(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoTemplate(ctx context.Context, req *connect.Request[v1pb.GetMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.GetMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoTemplate(ctx context.Context, req *connect.Request[v1pb.CreateMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.CreateMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoTemplate(ctx context.Context, req *connect.Request[v1pb.UpdateMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.UpdateMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoTemplate(ctx context.Context, req *connect.Request[v1pb.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoFromTemplate(ctx context.Context, req *connect.Request[v1pb.CreateMemoFromTemplateRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CreateMemoFromTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// TagService
+
+func (s *ConnectServiceHandler) ListTags(ctx context.Context, req *connect.Request[v1pb.ListTagsRequest]) (*connect.Response[v1pb.ListTagsResponse], error) {
+	resp, err := s.APIV1Service.ListTags(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateTag(ctx context.Context, req *connect.Request[v1pb.UpdateTagRequest]) (*connect.Response[v1pb.Tag], error) {
+	resp, err := s.APIV1Service.UpdateTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RenameTag(ctx context.Context, req *connect.Request[v1pb.RenameTagRequest]) (*connect.Response[v1pb.RenameTagResponse], error) {
+	resp, err := s.APIV1Service.RenameTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) MergeTags(ctx context.Context, req *connect.Request[v1pb.MergeTagsRequest]) (*connect.Response[v1pb.MergeTagsResponse], error) {
+	resp, err := s.APIV1Service.MergeTags(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteTag(ctx context.Context, req *connect.Request[v1pb.DeleteTagRequest]) (*connect.Response[v1pb.DeleteTagResponse], error) {
+	resp, err := s.APIV1Service.DeleteTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // ActivityService
 
 func (s *ConnectServiceHandler) ListActivities(ctx context.Context, req *connect.Request[v1pb.ListActivitiesRequest]) (*connect.Response[v1pb.ListActivitiesResponse], error) {
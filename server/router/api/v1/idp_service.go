@@ -3,11 +3,14 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/usememos/memos/plugin/idp/oidc"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -25,7 +28,11 @@ func (s *APIV1Service) CreateIdentityProvider(ctx context.Context, request *v1pb
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	identityProvider, err := s.Store.CreateIdentityProvider(ctx, convertIdentityProviderToStore(request.IdentityProvider))
+	identityProviderCreate := convertIdentityProviderToStore(request.IdentityProvider)
+	if err := resolveIdentityProviderConfig(ctx, identityProviderCreate.Type, identityProviderCreate.Config); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid identity provider config: %v", err)
+	}
+	identityProvider, err := s.Store.CreateIdentityProvider(ctx, identityProviderCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create identity provider, error: %+v", err)
 	}
@@ -114,6 +121,9 @@ func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb
 			update.IdentifierFilter = &request.IdentityProvider.IdentifierFilter
 		case "config":
 			update.Config = convertIdentityProviderConfigToStore(request.IdentityProvider.Type, request.IdentityProvider.Config)
+			if err := resolveIdentityProviderConfig(ctx, update.Type, update.Config); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid identity provider config: %v", err)
+			}
 		default:
 			// Ignore unsupported fields
 		}
@@ -185,6 +195,20 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 				},
 			},
 		}
+	} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+		oidcConfig := identityProvider.Config.GetOidcConfig()
+		temp.Config = &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_OidcConfig{
+				OidcConfig: &v1pb.OIDCConfig{
+					Issuer:          oidcConfig.GetIssuer(),
+					ClientId:        oidcConfig.GetClientId(),
+					ClientSecret:    oidcConfig.GetClientSecret(),
+					Scopes:          oidcConfig.GetScopes(),
+					IdentifierClaim: oidcConfig.GetIdentifierClaim(),
+					AuthUrl:         oidcConfig.GetAuthUrl(),
+				},
+			},
+		}
 	}
 	return temp
 }
@@ -223,7 +247,43 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 				},
 			},
 		}
+	} else if identityProviderType == v1pb.IdentityProvider_OIDC {
+		oidcConfig := config.GetOidcConfig()
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_OidcConfig{
+				OidcConfig: &storepb.OIDCConfig{
+					Issuer:          strings.TrimSpace(oidcConfig.GetIssuer()),
+					ClientId:        oidcConfig.GetClientId(),
+					ClientSecret:    oidcConfig.GetClientSecret(),
+					Scopes:          oidcConfig.GetScopes(),
+					IdentifierClaim: oidcConfig.GetIdentifierClaim(),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// resolveIdentityProviderConfig checks the issuer of an OpenID Connect provider with its discovery document
+// and fills in the authorization endpoint and scopes the web client needs to start a sign-in.
+func resolveIdentityProviderConfig(ctx context.Context, identityProviderType storepb.IdentityProvider_Type, config *storepb.IdentityProviderConfig) error {
+	if identityProviderType != storepb.IdentityProvider_OIDC {
+		return nil
+	}
+	oidcConfig := config.GetOidcConfig()
+	if oidcConfig == nil {
+		return errors.New("oidc config is required")
+	}
+	oidcIdentityProvider, err := oidc.NewIdentityProvider(oidcConfig)
+	if err != nil {
+		return err
+	}
+	metadata, err := oidcIdentityProvider.Discover(ctx)
+	if err != nil {
+		return err
 	}
+	oidcConfig.AuthUrl = metadata.AuthorizationEndpoint
+	oidcConfig.Scopes = oidcIdentityProvider.Scopes()
 	return nil
 }
 
@@ -231,6 +291,8 @@ func redactIdentityProviderResponse(identityProvider *v1pb.IdentityProvider, use
 	if userRole != store.RoleAdmin {
 		if identityProvider.Type == v1pb.IdentityProvider_OAUTH2 {
 			identityProvider.Config.GetOauth2Config().ClientSecret = ""
+		} else if identityProvider.Type == v1pb.IdentityProvider_OIDC && identityProvider.Config.GetOidcConfig() != nil {
+			identityProvider.Config.GetOidcConfig().ClientSecret = ""
 		}
 	}
 
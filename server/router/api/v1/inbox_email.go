@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// dispatchInboxEmail sends an email for the inbox message to its receiver when email
+// notifications are enabled on the instance and by the receiver. Delivery is asynchronous
+// and failures are only logged, as the inbox message has already been created.
+func (s *APIV1Service) dispatchInboxEmail(ctx context.Context, inbox *store.Inbox) {
+	if err := s.sendInboxEmail(ctx, inbox); err != nil {
+		slog.Warn("Failed to send inbox email", slog.Int("inbox", int(inbox.ID)), slog.Any("err", err))
+	}
+}
+
+func (s *APIV1Service) sendInboxEmail(ctx context.Context, inbox *store.Inbox) error {
+	notificationSetting, err := s.Store.GetInstanceNotificationSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance notification setting")
+	}
+	emailSetting := notificationSetting.GetEmail()
+	if !emailSetting.GetEnabled() {
+		return nil
+	}
+
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &inbox.ReceiverID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user notification setting")
+	}
+	if !userSetting.GetNotification().GetEmailEnabled() {
+		return nil
+	}
+	receiver, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.ReceiverID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get receiver")
+	}
+	if receiver == nil || receiver.Email == "" {
+		return nil
+	}
+
+	message, err := s.buildInboxEmailMessage(ctx, inbox)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return nil
+	}
+	message.To = []string{receiver.Email}
+	message.ReplyTo = emailSetting.ReplyTo
+	email.SendAsync(convertEmailConfigFromStore(emailSetting), message)
+	return nil
+}
+
+// buildInboxEmailMessage renders the email for an inbox message.
+// It returns nil if the message type has no email representation.
+func (s *APIV1Service) buildInboxEmailMessage(ctx context.Context, inbox *store.Inbox) (*email.Message, error) {
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	if sender == nil {
+		return nil, nil
+	}
+	senderName := sender.Nickname
+	if senderName == "" {
+		senderName = sender.Username
+	}
+
+	if inbox.Message.ActivityId == nil {
+		return nil, nil
+	}
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil {
+		return nil, nil
+	}
+
+	switch inbox.Message.GetType() {
+	case storepb.InboxMessage_MEMO_COMMENT:
+		payload := activity.Payload.GetMemoComment()
+		if payload == nil {
+			return nil, nil
+		}
+		comment, snippet, err := s.getInboxEmailMemo(ctx, payload.MemoId)
+		if err != nil || comment == nil {
+			return nil, err
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &payload.RelatedMemoId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if relatedMemo == nil {
+			return nil, nil
+		}
+		return s.newMemoEmailMessage(
+			fmt.Sprintf("%s commented on your memo", senderName),
+			fmt.Sprintf("%s commented on your memo:", senderName),
+			snippet,
+			relatedMemo,
+		), nil
+	case storepb.InboxMessage_MENTION:
+		payload := activity.Payload.GetMemoMention()
+		if payload == nil {
+			return nil, nil
+		}
+		memo, snippet, err := s.getInboxEmailMemo(ctx, payload.MemoId)
+		if err != nil || memo == nil {
+			return nil, err
+		}
+		return s.newMemoEmailMessage(
+			fmt.Sprintf("%s mentioned you in a memo", senderName),
+			fmt.Sprintf("%s mentioned you in a memo:", senderName),
+			snippet,
+			memo,
+		), nil
+	case storepb.InboxMessage_REMINDER:
+		payload := activity.Payload.GetMemoReminder()
+		if payload == nil {
+			return nil, nil
+		}
+		memo, snippet, err := s.getInboxEmailMemo(ctx, payload.MemoId)
+		if err != nil || memo == nil {
+			return nil, err
+		}
+		return s.newMemoEmailMessage("Reminder of your memo", "You asked to be reminded of this memo:", snippet, memo), nil
+	case storepb.InboxMessage_DIGEST:
+		payload := activity.Payload.GetMemoDigest()
+		if payload == nil {
+			return nil, nil
+		}
+		return s.buildMemoDigestEmailMessage(ctx, inbox.ReceiverID, payload)
+	default:
+		return nil, nil
+	}
+}
+
+// getInboxEmailMemo returns the memo of an inbox activity and the snippet of its content.
+// The memo is nil if it no longer exists.
+func (s *APIV1Service) getInboxEmailMemo(ctx context.Context, memoID int32) (*store.Memo, string, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, "", nil
+	}
+	snippet, err := s.getMemoContentSnippet(memo.Content)
+	if err != nil {
+		return nil, "", err
+	}
+	return memo, snippet, nil
+}
+
+// newMemoEmailMessage renders an email quoting the snippet, followed by a link to the memo.
+func (s *APIV1Service) newMemoEmailMessage(subject, intro, snippet string, memo *store.Memo) *email.Message {
+	var body strings.Builder
+	fmt.Fprintf(&body, "%s\n\n%s\n", intro, snippet)
+	if link := s.getMemoLink(memo); link != "" {
+		fmt.Fprintf(&body, "\nView the memo: %s\n", link)
+	}
+	return &email.Message{
+		Subject: subject,
+		Body:    body.String(),
+	}
+}
+
+// buildMemoDigestEmailMessage renders the digest email in the locale of the receiver.
+func (s *APIV1Service) buildMemoDigestEmailMessage(ctx context.Context, receiverID int32, payload *storepb.ActivityMemoDigestPayload) (*email.Message, error) {
+	location, locale, err := s.getUserTimezoneAndLocale(ctx, receiverID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get receiver setting")
+	}
+	texts := getMemoDigestTexts(locale)
+
+	var body strings.Builder
+	writeSection := func(title string, memoIDs []int32, withYear bool) error {
+		if len(memoIDs) == 0 {
+			return nil
+		}
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			return nil
+		}
+		fmt.Fprintf(&body, "%s\n", title)
+		for _, memo := range memos {
+			snippet, err := s.getMemoContentSnippet(memo.Content)
+			if err != nil {
+				return err
+			}
+			body.WriteString("\n")
+			if withYear {
+				fmt.Fprintf(&body, "%d: ", time.Unix(memo.CreatedTs, 0).In(location).Year())
+			}
+			fmt.Fprintf(&body, "%s\n", snippet)
+			if link := s.getMemoLink(memo); link != "" {
+				fmt.Fprintf(&body, "%s\n", link)
+			}
+		}
+		body.WriteString("\n")
+		return nil
+	}
+	if err := writeSection(texts.OnThisDay, payload.OnThisDayMemoIds, true); err != nil {
+		return nil, err
+	}
+	if err := writeSection(texts.Review, payload.ReviewMemoIds, false); err != nil {
+		return nil, err
+	}
+	if body.Len() == 0 {
+		return nil, nil
+	}
+	return &email.Message{
+		Subject: fmt.Sprintf(texts.Subject, payload.Date),
+		Body:    body.String(),
+	}, nil
+}
+
+// getMemoLink returns the absolute URL of the memo, or empty if the instance URL is not configured.
+func (s *APIV1Service) getMemoLink(memo *store.Memo) string {
+	if s.Profile == nil || s.Profile.InstanceURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s%s", strings.TrimSuffix(s.Profile.InstanceURL, "/"), MemoNamePrefix, memo.UID)
+}
+
+func convertEmailConfigFromStore(setting *storepb.InstanceNotificationSetting_EmailSetting) *email.Config {
+	return &email.Config{
+		SMTPHost:     setting.SmtpHost,
+		SMTPPort:     int(setting.SmtpPort),
+		SMTPUsername: setting.SmtpUsername,
+		SMTPPassword: setting.SmtpPassword,
+		FromEmail:    setting.FromEmail,
+		FromName:     setting.FromName,
+		UseTLS:       setting.UseTls,
+		UseSSL:       setting.UseSsl,
+	}
+}
@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListInstanceJobs(ctx context.Context, _ *v1pb.ListInstanceJobsRequest) (*v1pb.ListInstanceJobsResponse, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ListInstanceJobsResponse{
+		Jobs: []*v1pb.InstanceJob{},
+	}
+	if s.Scheduler == nil {
+		return response, nil
+	}
+	for _, job := range s.Scheduler.Jobs() {
+		response.Jobs = append(response.Jobs, convertInstanceJobFromScheduler(job))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RunInstanceJob(ctx context.Context, request *v1pb.RunInstanceJobRequest) (*v1pb.InstanceJob, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	jobName, err := ExtractInstanceJobNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	if s.Scheduler == nil {
+		return nil, status.Errorf(codes.NotFound, "job not found")
+	}
+	if err := s.Scheduler.Trigger(jobName); err != nil {
+		if errors.Is(err, scheduler.ErrJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "job not found")
+		}
+		if errors.Is(err, scheduler.ErrJobRunning) {
+			return nil, status.Errorf(codes.FailedPrecondition, "job is already running")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to run job: %v", err)
+	}
+
+	job, err := s.Scheduler.Job(jobName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+	return convertInstanceJobFromScheduler(job), nil
+}
+
+// checkInstanceAdminPermission ensures only admins can manage instance resources, such as background jobs and webhooks.
+func (s *APIV1Service) checkInstanceAdminPermission(ctx context.Context) error {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func convertInstanceJobFromScheduler(job *scheduler.JobStatus) *v1pb.InstanceJob {
+	instanceJob := &v1pb.InstanceJob{
+		Name:        fmt.Sprintf("%s%s", InstanceJobNamePrefix, job.Name),
+		Schedule:    job.Schedule,
+		Description: job.Description,
+		Running:     job.Running,
+	}
+	if !job.LastRunTime.IsZero() {
+		instanceJob.LastRunTime = timestamppb.New(job.LastRunTime)
+		instanceJob.LastRunDuration = durationpb.New(job.LastDuration)
+	}
+	if job.LastError != nil {
+		instanceJob.LastError = job.LastError.Error()
+	}
+	if !job.NextRunTime.IsZero() {
+		instanceJob.NextRunTime = timestamppb.New(job.NextRunTime)
+	}
+	return instanceJob
+}
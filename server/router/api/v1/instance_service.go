@@ -46,6 +46,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceMemoRelatedSetting(ctx)
 	case storepb.InstanceSettingKey_STORAGE:
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
+	case storepb.InstanceSettingKey_NOTIFICATION:
+		_, err = s.Store.GetInstanceNotificationSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -63,8 +65,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		return nil, status.Errorf(codes.NotFound, "instance setting not found")
 	}
 
-	// For storage setting, only admin can get it.
-	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE {
+	// For storage and notification settings, only admin can get them as they contain credentials.
+	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_NOTIFICATION {
 		user, err := s.fetchCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -96,6 +98,9 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	_ = request.UpdateMask
 
 	updateSetting := convertInstanceSettingToStore(request.Setting)
+	if updateSetting.Key == storepb.InstanceSettingKey_WEBHOOKS {
+		return nil, status.Errorf(codes.InvalidArgument, "instance webhooks are managed through the instance webhook methods")
+	}
 	instanceSetting, err := s.Store.UpsertInstanceSetting(ctx, updateSetting)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert instance setting: %v", err)
@@ -121,6 +126,10 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_MemoRelatedSetting_{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.InstanceSetting_NotificationSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_NotificationSetting_{
+			NotificationSetting: convertInstanceNotificationSettingFromStore(setting.GetNotificationSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -146,6 +155,10 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.InstanceSettingKey_NOTIFICATION:
+		instanceSetting.Value = &storepb.InstanceSetting_NotificationSetting{
+			NotificationSetting: convertInstanceNotificationSettingToStore(setting.GetNotificationSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -253,6 +266,7 @@ func convertInstanceMemoRelatedSettingFromStore(setting *storepb.InstanceMemoRel
 		ContentLengthLimit:       setting.ContentLengthLimit,
 		EnableDoubleClickEdit:    setting.EnableDoubleClickEdit,
 		Reactions:                setting.Reactions,
+		RevisionLimit:            setting.RevisionLimit,
 	}
 }
 
@@ -266,7 +280,52 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 		ContentLengthLimit:       setting.ContentLengthLimit,
 		EnableDoubleClickEdit:    setting.EnableDoubleClickEdit,
 		Reactions:                setting.Reactions,
+		RevisionLimit:            setting.RevisionLimit,
+	}
+}
+
+func convertInstanceNotificationSettingFromStore(setting *storepb.InstanceNotificationSetting) *v1pb.InstanceSetting_NotificationSetting {
+	if setting == nil {
+		return nil
+	}
+	notificationSetting := &v1pb.InstanceSetting_NotificationSetting{}
+	if setting.Email != nil {
+		notificationSetting.Email = &v1pb.InstanceSetting_NotificationSetting_EmailSetting{
+			Enabled:      setting.Email.Enabled,
+			SmtpHost:     setting.Email.SmtpHost,
+			SmtpPort:     setting.Email.SmtpPort,
+			SmtpUsername: setting.Email.SmtpUsername,
+			SmtpPassword: setting.Email.SmtpPassword,
+			FromEmail:    setting.Email.FromEmail,
+			FromName:     setting.Email.FromName,
+			ReplyTo:      setting.Email.ReplyTo,
+			UseTls:       setting.Email.UseTls,
+			UseSsl:       setting.Email.UseSsl,
+		}
+	}
+	return notificationSetting
+}
+
+func convertInstanceNotificationSettingToStore(setting *v1pb.InstanceSetting_NotificationSetting) *storepb.InstanceNotificationSetting {
+	if setting == nil {
+		return nil
+	}
+	notificationSetting := &storepb.InstanceNotificationSetting{}
+	if setting.Email != nil {
+		notificationSetting.Email = &storepb.InstanceNotificationSetting_EmailSetting{
+			Enabled:      setting.Email.Enabled,
+			SmtpHost:     setting.Email.SmtpHost,
+			SmtpPort:     setting.Email.SmtpPort,
+			SmtpUsername: setting.Email.SmtpUsername,
+			SmtpPassword: setting.Email.SmtpPassword,
+			FromEmail:    setting.Email.FromEmail,
+			FromName:     setting.Email.FromName,
+			ReplyTo:      setting.Email.ReplyTo,
+			UseTls:       setting.Email.UseTls,
+			UseSsl:       setting.Email.UseSsl,
+		}
 	}
+	return notificationSetting
 }
 
 func (s *APIV1Service) GetInstanceAdmin(ctx context.Context) (*v1pb.User, error) {
@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListInstanceWebhooks(ctx context.Context, _ *v1pb.ListInstanceWebhooksRequest) (*v1pb.ListInstanceWebhooksResponse, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	instanceWebhooksSetting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance webhooks: %v", err)
+	}
+	response := &v1pb.ListInstanceWebhooksResponse{
+		Webhooks: []*v1pb.InstanceWebhook{},
+	}
+	for _, hook := range instanceWebhooksSetting.Webhooks {
+		response.Webhooks = append(response.Webhooks, convertInstanceWebhookFromStore(hook))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateInstanceWebhook(ctx context.Context, request *v1pb.CreateInstanceWebhookRequest) (*v1pb.InstanceWebhook, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Webhook == nil || strings.TrimSpace(request.Webhook.Url) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
+	}
+
+	activityTypes, err := normalizeInstanceWebhookActivityTypes(request.Webhook.ActivityTypes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid activity types: %v", err)
+	}
+	payloadFormat := convertWebhookPayloadFormatToStore(request.Webhook.PayloadFormat)
+	if err := validateWebhookPayloadTemplate(payloadFormat, request.Webhook.PayloadTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payload template: %v", err)
+	}
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+
+	instanceWebhooksSetting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance webhooks: %v", err)
+	}
+	hook := &storepb.WebhooksUserSetting_Webhook{
+		Id:              generateUserWebhookID(),
+		Title:           request.Webhook.DisplayName,
+		Url:             strings.TrimSpace(request.Webhook.Url),
+		Secret:          secret,
+		ActivityTypes:   activityTypes,
+		PayloadFormat:   payloadFormat,
+		PayloadTemplate: request.Webhook.PayloadTemplate,
+	}
+	webhooks := append(slices.Clone(instanceWebhooksSetting.Webhooks), hook)
+	if err := s.upsertInstanceWebhooks(ctx, webhooks); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
+	}
+
+	// The secret is only returned on creation.
+	response := convertInstanceWebhookFromStore(hook)
+	response.Secret = secret
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateInstanceWebhook(ctx context.Context, request *v1pb.UpdateInstanceWebhookRequest) (*v1pb.InstanceWebhook, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Webhook == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook is required")
+	}
+	webhookID, err := ExtractInstanceWebhookIDFromName(request.Webhook.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+
+	instanceWebhooksSetting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance webhooks: %v", err)
+	}
+	webhooks := slices.Clone(instanceWebhooksSetting.Webhooks)
+	index := slices.IndexFunc(webhooks, func(hook *storepb.WebhooksUserSetting_Webhook) bool {
+		return hook.Id == webhookID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	targetWebhook := webhooks[index]
+	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
+		Id:              targetWebhook.Id,
+		Title:           targetWebhook.Title,
+		Url:             targetWebhook.Url,
+		Secret:          targetWebhook.Secret,
+		ActivityTypes:   targetWebhook.ActivityTypes,
+		PayloadFormat:   targetWebhook.PayloadFormat,
+		PayloadTemplate: targetWebhook.PayloadTemplate,
+	}
+
+	paths := []string{"url", "display_name", "activity_types", "payload_format", "payload_template"}
+	if request.UpdateMask != nil {
+		paths = request.UpdateMask.Paths
+	}
+	rotateSecret := false
+	for _, path := range paths {
+		switch path {
+		case "url":
+			if strings.TrimSpace(request.Webhook.Url) == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
+			}
+			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
+		case "display_name":
+			updatedWebhook.Title = request.Webhook.DisplayName
+		case "secret":
+			rotateSecret = true
+		case "activity_types":
+			activityTypes, err := normalizeInstanceWebhookActivityTypes(request.Webhook.ActivityTypes)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid activity types: %v", err)
+			}
+			updatedWebhook.ActivityTypes = activityTypes
+		case "payload_format":
+			updatedWebhook.PayloadFormat = convertWebhookPayloadFormatToStore(request.Webhook.PayloadFormat)
+		case "payload_template":
+			updatedWebhook.PayloadTemplate = request.Webhook.PayloadTemplate
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := validateWebhookPayloadTemplate(updatedWebhook.PayloadFormat, updatedWebhook.PayloadTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payload template: %v", err)
+	}
+	if rotateSecret {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+		updatedWebhook.Secret = secret
+	}
+
+	webhooks[index] = updatedWebhook
+	if err := s.upsertInstanceWebhooks(ctx, webhooks); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	response := convertInstanceWebhookFromStore(updatedWebhook)
+	// The rotated secret is returned once, like on creation.
+	if rotateSecret {
+		response.Secret = updatedWebhook.Secret
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) DeleteInstanceWebhook(ctx context.Context, request *v1pb.DeleteInstanceWebhookRequest) (*emptypb.Empty, error) {
+	if err := s.checkInstanceAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+	webhookID, err := ExtractInstanceWebhookIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+
+	instanceWebhooksSetting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance webhooks: %v", err)
+	}
+	webhooks := slices.DeleteFunc(slices.Clone(instanceWebhooksSetting.Webhooks), func(hook *storepb.WebhooksUserSetting_Webhook) bool {
+		return hook.Id == webhookID
+	})
+	if len(webhooks) == len(instanceWebhooksSetting.Webhooks) {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	if err := s.upsertInstanceWebhooks(ctx, webhooks); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+	creatorID := store.InstanceWebhookCreatorID
+	if err := s.Store.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &creatorID,
+		WebhookID: &webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) upsertInstanceWebhooks(ctx context.Context, webhooks []*storepb.WebhooksUserSetting_Webhook) error {
+	_, err := s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{
+			WebhooksSetting: &storepb.InstanceWebhooksSetting{Webhooks: webhooks},
+		},
+	})
+	return err
+}
+
+// dispatchInstanceWebhook queues the payload for each instance webhook that subscribes to the activity type.
+func (s *APIV1Service) dispatchInstanceWebhook(ctx context.Context, memo *v1pb.Memo, payload *webhook.WebhookRequestPayload) error {
+	instanceWebhooksSetting, err := s.Store.GetInstanceWebhooksSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if len(instanceWebhooksSetting.Webhooks) == 0 {
+		return nil
+	}
+	return s.queueWebhookDeliveries(ctx, store.InstanceWebhookCreatorID, instanceWebhooksSetting.Webhooks, webhook.InstanceActivityTypes, memo, payload)
+}
+
+// dispatchUserActivityWebhook dispatches a user activity to the instance webhooks.
+// The actor is the user who performed the activity; it defaults to the user the activity is about.
+// Failures are logged so that they never fail the request that triggered the activity.
+func (s *APIV1Service) dispatchUserActivityWebhook(ctx context.Context, actor *store.User, user *store.User, payload *webhook.WebhookRequestPayload) {
+	if actor == nil {
+		actor = user
+	}
+	payload.Creator = fmt.Sprintf("%s%d", UserNamePrefix, actor.ID)
+	payload.User = convertUserFromStore(user)
+	if err := s.dispatchInstanceWebhook(ctx, nil, payload); err != nil {
+		slog.Warn("Failed to dispatch instance webhook", slog.String("activityType", payload.ActivityType), slog.Any("err", err))
+	}
+}
+
+// normalizeInstanceWebhookActivityTypes validates the activity types of an instance webhook and removes duplicates.
+func normalizeInstanceWebhookActivityTypes(activityTypes []string) ([]string, error) {
+	normalized := []string{}
+	for _, activityType := range activityTypes {
+		activityType = strings.TrimSpace(activityType)
+		if !webhook.IsValidInstanceActivityType(activityType) {
+			return nil, errors.Errorf("unsupported activity type %q", activityType)
+		}
+		if !slices.Contains(normalized, activityType) {
+			normalized = append(normalized, activityType)
+		}
+	}
+	return normalized, nil
+}
+
+func convertInstanceWebhookFromStore(hook *storepb.WebhooksUserSetting_Webhook) *v1pb.InstanceWebhook {
+	return &v1pb.InstanceWebhook{
+		Name:            fmt.Sprintf("%s%s", InstanceWebhookNamePrefix, hook.Id),
+		Url:             hook.Url,
+		DisplayName:     hook.Title,
+		ActivityTypes:   hook.ActivityTypes,
+		PayloadFormat:   convertWebhookPayloadFormatFromStoreToAPI(hook.PayloadFormat),
+		PayloadTemplate: hook.PayloadTemplate,
+	}
+}
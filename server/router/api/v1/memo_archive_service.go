@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/netguard"
+	"github.com/usememos/memos/plugin/httpgetter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// archiveFilenameUnsafeChars matches the characters replaced in the hostname part of archive filenames.
+var archiveFilenameUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+func (s *APIV1Service) ArchiveMemoLink(ctx context.Context, request *v1pb.ArchiveMemoLinkRequest) (*v1pb.Attachment, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the creator or admin can attach files to the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Only linked pages are archived, so the server cannot be used to fetch arbitrary URLs.
+	if !slices.Contains(memo.Payload.GetLinks(), request.Url) {
+		return nil, status.Errorf(codes.InvalidArgument, "url is not linked from the memo")
+	}
+
+	archive, err := httpgetter.GetArchive(request.Url)
+	if err != nil {
+		if errors.Is(err, netguard.ErrInternalAddress) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to archive link: %v", err)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to archive link: %v", err)
+	}
+
+	// Create the attachment like an upload, so that the configured storage and upload size limit apply.
+	return s.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: archiveFilename(archive.URL, time.Now()),
+			Type:     "text/html",
+			Content:  archive.HTML,
+			Memo:     &request.Name,
+		},
+	})
+}
+
+// archiveFilename returns the attachment filename of the archive of the page, e.g. example.com-20240501.html.
+func archiveFilename(pageURL string, archivedAt time.Time) string {
+	host := "page"
+	if u, err := url.Parse(pageURL); err == nil && u.Hostname() != "" {
+		host = archiveFilenameUnsafeChars.ReplaceAllString(u.Hostname(), "-")
+	}
+	return fmt.Sprintf("%s-%s.html", host, archivedAt.Format("20060102"))
+}
@@ -0,0 +1,382 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoDigestLimit is the maximum number of memos in each section of a digest.
+	memoDigestLimit = 10
+	// memoDigestHour is the hour of the day in the user's time zone from which the daily digest is sent.
+	memoDigestHour = 8
+	// memoReviewTag is the tag of the memos picked for spaced review.
+	memoReviewTag = "review"
+	// memoDigestDateLayout is the layout of the date of a digest.
+	memoDigestDateLayout = "2006-01-02"
+)
+
+// memoDigest holds the memos to look back at on a day.
+type memoDigest struct {
+	OnThisDay []*store.Memo
+	Review    []*store.Memo
+}
+
+func (d *memoDigest) isEmpty() bool {
+	return len(d.OnThisDay) == 0 && len(d.Review) == 0
+}
+
+func (s *APIV1Service) GetMemoDigest(ctx context.Context, request *v1pb.GetMemoDigestRequest) (*v1pb.MemoDigest, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	location, _, err := s.getUserTimezoneAndLocale(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	day := time.Now().In(location)
+	if request.Date != "" {
+		day, err = time.ParseInLocation(memoDigestDateLayout, request.Date, location)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
+		}
+	}
+
+	digest, err := s.buildMemoDigest(ctx, user.ID, day)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build memo digest: %v", err)
+	}
+	onThisDayMemos, err := s.convertMemoDigestMemos(ctx, digest.OnThisDay)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	reviewMemos, err := s.convertMemoDigestMemos(ctx, digest.Review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	return &v1pb.MemoDigest{
+		Date:           day.Format(memoDigestDateLayout),
+		OnThisDayMemos: onThisDayMemos,
+		ReviewMemos:    reviewMemos,
+	}, nil
+}
+
+// SendMemoDigests sends the daily memo digest to the users who enabled it,
+// once their local time reaches the digest hour. Digests without memos are not sent.
+// The date of the last digest is kept in the notification setting, so that each day's digest
+// is sent once even if the job runs several times, or not at all, during the digest hour.
+// Failures are logged per user, so that one user's digest does not hold up the others.
+func (s *APIV1Service) SendMemoDigests(ctx context.Context) error {
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_NOTIFICATION,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user notification settings")
+	}
+
+	now := time.Now()
+	for _, userSetting := range userSettings {
+		if !userSetting.GetNotification().GetDigestEnabled() {
+			continue
+		}
+		if err := s.sendMemoDigest(ctx, userSetting, now); err != nil {
+			slog.Warn("Failed to send memo digest", slog.Int("user", int(userSetting.UserId)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) sendMemoDigest(ctx context.Context, userSetting *storepb.UserSetting, now time.Time) error {
+	userID := userSetting.UserId
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal {
+		return nil
+	}
+	location, _, err := s.getUserTimezoneAndLocale(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user setting")
+	}
+	day := now.In(location)
+	date := day.Format(memoDigestDateLayout)
+	// Dates in this layout sort chronologically, and a date before the last one may be seen after the time zone changed.
+	if day.Hour() < memoDigestHour || date <= userSetting.GetNotification().GetLastDigestDate() {
+		return nil
+	}
+
+	// Record the date before sending, so that a failing digest is not sent again every run.
+	notification := proto.Clone(userSetting.GetNotification()).(*storepb.NotificationUserSetting)
+	notification.LastDigestDate = date
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: notification},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update user notification setting")
+	}
+
+	digest, err := s.buildMemoDigest(ctx, userID, day)
+	if err != nil {
+		return errors.Wrap(err, "failed to build memo digest")
+	}
+	if digest.isEmpty() {
+		return nil
+	}
+	payload := &storepb.ActivityMemoDigestPayload{
+		Date: date,
+	}
+	for _, memo := range digest.OnThisDay {
+		payload.OnThisDayMemoIds = append(payload.OnThisDayMemoIds, memo.ID)
+	}
+	for _, memo := range digest.Review {
+		payload.ReviewMemoIds = append(payload.ReviewMemoIds, memo.ID)
+	}
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeMemoDigest,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoDigest: payload,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	inbox, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   userID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_DIGEST,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	s.dispatchInboxEmail(ctx, inbox)
+	return nil
+}
+
+// buildMemoDigest selects the memos of the user created on the same day in previous years,
+// and the memos tagged #review that are due for review on the day.
+// The day is taken in its own time zone.
+func (s *APIV1Service) buildMemoDigest(ctx context.Context, userID int32, day time.Time) (*memoDigest, error) {
+	onThisDay, err := s.listOnThisDayMemos(ctx, userID, day)
+	if err != nil {
+		return nil, err
+	}
+	review, err := s.listDueReviewMemos(ctx, userID, day)
+	if err != nil {
+		return nil, err
+	}
+	return &memoDigest{
+		OnThisDay: onThisDay,
+		Review:    review,
+	}, nil
+}
+
+func (s *APIV1Service) listOnThisDayMemos(ctx context.Context, userID int32, day time.Time) ([]*store.Memo, error) {
+	normalStatus := store.Normal
+	limit := 1
+	oldestMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeContent:  true,
+		ExcludeComments: true,
+		OrderByTimeAsc:  true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get oldest memo")
+	}
+	if len(oldestMemos) == 0 {
+		return []*store.Memo{}, nil
+	}
+
+	oldestYear := time.Unix(oldestMemos[0].CreatedTs, 0).In(day.Location()).Year()
+	conditions := []string{}
+	for year := day.Year() - 1; year >= oldestYear; year-- {
+		start := time.Date(year, day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+		// February 29 has no counterpart in other years.
+		if start.Day() != day.Day() {
+			continue
+		}
+		end := start.AddDate(0, 0, 1)
+		conditions = append(conditions, fmt.Sprintf("(created_ts >= %d && created_ts < %d)", start.Unix(), end.Unix()))
+	}
+	if len(conditions) == 0 {
+		return []*store.Memo{}, nil
+	}
+
+	limit = memoDigestLimit
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		Filters:         []string{strings.Join(conditions, " || "), "!scheduled"},
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	return memos, nil
+}
+
+func (s *APIV1Service) listDueReviewMemos(ctx context.Context, userID int32, day time.Time) ([]*store.Memo, error) {
+	normalStatus := store.Normal
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:      &userID,
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+		Filters: []string{
+			fmt.Sprintf(`tag in [%q]`, memoReviewTag),
+			fmt.Sprintf("created_ts < %d", dayStart.Unix()),
+			"!scheduled",
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos to review")
+	}
+
+	dueMemoIDs := []int32{}
+	for _, memo := range memos {
+		if isMemoReviewDue(time.Unix(memo.CreatedTs, 0).In(day.Location()), day) {
+			dueMemoIDs = append(dueMemoIDs, memo.ID)
+		}
+		if len(dueMemoIDs) == memoDigestLimit {
+			break
+		}
+	}
+	if len(dueMemoIDs) == 0 {
+		return []*store.Memo{}, nil
+	}
+	dueMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: dueMemoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos to review")
+	}
+	return dueMemos, nil
+}
+
+// isMemoReviewDue reports whether a memo created at the given time is due for review on the day.
+// Memos are due 1, 3, 7, 14 and 30 days after their creation, and then whenever the interval
+// since their creation has doubled again, so that well-known memos come up less and less often.
+func isMemoReviewDue(createdAt, day time.Time) bool {
+	createdDate := time.Date(createdAt.Year(), createdAt.Month(), createdAt.Day(), 0, 0, 0, 0, time.UTC)
+	dayDate := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(dayDate.Sub(createdDate).Hours() / 24)
+	switch days {
+	case 1, 3, 7, 14:
+		return true
+	}
+	if days < 30 || days%30 != 0 {
+		return false
+	}
+	periods := days / 30
+	return periods&(periods-1) == 0
+}
+
+func (s *APIV1Service) convertMemoDigestMemos(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	memoMessages := []*v1pb.Memo{}
+	if len(memos) == 0 {
+		return memoMessages, nil
+	}
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	attachmentMap := make(map[int32][]*store.Attachment)
+	for _, attachment := range attachments {
+		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
+	}
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachmentMap[memo.ID])
+		if err != nil {
+			return nil, err
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
+}
+
+// getUserTimezoneAndLocale returns the time zone and locale from the general setting of the user.
+// The time zone falls back to the server time zone if it is not set.
+func (s *APIV1Service) getUserTimezoneAndLocale(ctx context.Context, userID int32) (*time.Location, string, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	general := userSetting.GetGeneral()
+	location := time.Local
+	if general.GetTimezone() != "" {
+		if loc, err := time.LoadLocation(general.GetTimezone()); err == nil {
+			location = loc
+		}
+	}
+	return location, general.GetLocale(), nil
+}
+
+// memoDigestTexts holds the translated texts of the digest email.
+type memoDigestTexts struct {
+	// Subject is a format string for the date of the digest.
+	Subject   string
+	OnThisDay string
+	Review    string
+}
+
+// memoDigestTranslations holds the digest texts keyed by locale.
+var memoDigestTranslations = map[string]memoDigestTexts{
+	"en":      {Subject: "Your memos for %s", OnThisDay: "On this day", Review: "To review"},
+	"de":      {Subject: "Deine Memos für den %s", OnThisDay: "An diesem Tag", Review: "Zum Wiederholen"},
+	"es":      {Subject: "Tus memos del %s", OnThisDay: "Un día como hoy", Review: "Para repasar"},
+	"fr":      {Subject: "Vos mémos du %s", OnThisDay: "Ce jour-là", Review: "À revoir"},
+	"it":      {Subject: "I tuoi memo del %s", OnThisDay: "Accadde oggi", Review: "Da ripassare"},
+	"ja":      {Subject: "%s のメモ", OnThisDay: "過去の今日", Review: "振り返り"},
+	"ko":      {Subject: "%s의 메모", OnThisDay: "과거의 오늘", Review: "복습할 메모"},
+	"pt":      {Subject: "Seus memos de %s", OnThisDay: "Neste dia", Review: "Para revisar"},
+	"ru":      {Subject: "Ваши заметки на %s", OnThisDay: "В этот день", Review: "Повторить"},
+	"zh":      {Subject: "%s 的备忘录", OnThisDay: "那年今日", Review: "待回顾"},
+	"zh-Hans": {Subject: "%s 的备忘录", OnThisDay: "那年今日", Review: "待回顾"},
+	"zh-Hant": {Subject: "%s 的備忘錄", OnThisDay: "那年今日", Review: "待回顧"},
+}
+
+// getMemoDigestTexts returns the digest texts for the locale,
+// falling back to its language and then to English.
+func getMemoDigestTexts(locale string) memoDigestTexts {
+	if texts, ok := memoDigestTranslations[locale]; ok {
+		return texts
+	}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		if texts, ok := memoDigestTranslations[language]; ok {
+			return texts
+		}
+	}
+	return memoDigestTranslations["en"]
+}
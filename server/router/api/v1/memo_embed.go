@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/store"
+)
+
+// MemoEmbedResolver resolves memos embedded with ![[memos/{uid}]] for a viewer.
+// It follows the visibility rules of GetMemo, and archived memos are never embedded.
+type MemoEmbedResolver struct {
+	Store *store.Store
+	// Viewer is the user the content is rendered for, or nil for anonymous viewers.
+	Viewer *store.User
+}
+
+var _ markdown.EmbedResolver = (*MemoEmbedResolver)(nil)
+
+// NewMemoEmbedResolver creates a resolver of embedded memos for the viewer.
+func NewMemoEmbedResolver(store *store.Store, viewer *store.User) *MemoEmbedResolver {
+	return &MemoEmbedResolver{
+		Store:  store,
+		Viewer: viewer,
+	}
+}
+
+// ResolveEmbed returns the content of the named memo if it is visible to the viewer.
+func (r *MemoEmbedResolver) ResolveEmbed(ctx context.Context, name string) (string, bool, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return "", false, nil
+	}
+	memo, err := r.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.RowStatus == store.Archived {
+		return "", false, nil
+	}
+	if memo.Visibility != store.Public {
+		if r.Viewer == nil {
+			return "", false, nil
+		}
+		if memo.Visibility == store.Private && memo.CreatorID != r.Viewer.ID {
+			return "", false, nil
+		}
+	}
+	return memo.Content, true, nil
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoMentions creates a MENTION inbox message for each user mentioned in the memo
+// who can see it. Users in notified have already been notified about the memo and are skipped.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, notified []string) error {
+	// Private memos are only visible to their creator, who is never notified about their own mentions,
+	// and archived memos no longer show up for anyone else.
+	if memo.Visibility == store.Private || memo.RowStatus == store.Archived {
+		return nil
+	}
+
+	for _, username := range memo.Payload.GetMentions() {
+		if slices.Contains(notified, username) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get user %s", username)
+		}
+		if user == nil || user.ID == memo.CreatorID || user.RowStatus == store.Archived {
+			continue
+		}
+
+		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeMemoMention,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoMention: &storepb.ActivityMemoMentionPayload{
+					MemoId: memo.ID,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		inbox, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_MENTION,
+				ActivityId: &activity.ID,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		s.dispatchInboxEmail(ctx, inbox)
+	}
+	return nil
+}
@@ -3,6 +3,9 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -83,6 +86,9 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	currentUser, err := s.fetchCurrentUser(ctx)
 	if err != nil {
@@ -130,6 +136,108 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	return response, nil
 }
 
+// syncMemoReferences keeps the REFERENCE relations of the memo in sync with the [[...]] links in its content.
+// Linked memos are related to the memo, and relations to memos that were only linked from the previous
+// content are removed. Relations set explicitly through SetMemoRelations are left untouched.
+func (s *APIV1Service) syncMemoReferences(ctx context.Context, memo *store.Memo, previousContent string) error {
+	linkedMemoIDs, err := s.resolveWikiLinks(ctx, memo, memo.Content)
+	if err != nil {
+		return err
+	}
+	previousLinkedMemoIDs := []int32{}
+	if previousContent != "" && previousContent != memo.Content {
+		previousLinkedMemoIDs, err = s.resolveWikiLinks(ctx, memo, previousContent)
+		if err != nil {
+			return err
+		}
+	}
+
+	referenceType := store.MemoRelationReference
+	for _, relatedMemoID := range previousLinkedMemoIDs {
+		if slices.Contains(linkedMemoIDs, relatedMemoID) {
+			continue
+		}
+		if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relatedMemoID,
+			Type:          &referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+	for _, relatedMemoID := range linkedMemoIDs {
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+	return nil
+}
+
+// resolveWikiLinks returns the IDs of the memos linked from the content.
+// A [[memos/{uid}]] link resolves to the memo with that name, and any other [[title]] link
+// resolves to the most recent memo of the same creator whose first heading matches the title.
+// Links that do not resolve are ignored.
+func (s *APIV1Service) resolveWikiLinks(ctx context.Context, memo *store.Memo, content string) ([]int32, error) {
+	data, err := s.MarkdownService.ExtractAll([]byte(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract wiki links")
+	}
+
+	linkedMemoIDs := []int32{}
+	for _, target := range data.WikiLinks {
+		var linkedMemo *store.Memo
+		if strings.HasPrefix(target, MemoNamePrefix) {
+			memoUID, err := ExtractMemoUIDFromName(target)
+			if err != nil {
+				continue
+			}
+			linkedMemo, err = s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, ExcludeContent: true})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get linked memo")
+			}
+		} else {
+			linkedMemo, err = s.findMemoByTitle(ctx, memo.CreatorID, target)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if linkedMemo == nil || linkedMemo.ID == memo.ID || slices.Contains(linkedMemoIDs, linkedMemo.ID) {
+			continue
+		}
+		linkedMemoIDs = append(linkedMemoIDs, linkedMemo.ID)
+	}
+	return linkedMemoIDs, nil
+}
+
+// findMemoByTitle returns the most recent normal memo of the creator whose title matches, ignoring case.
+func (s *APIV1Service) findMemoByTitle(ctx context.Context, creatorID int32, title string) (*store.Memo, error) {
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &creatorID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		// Narrow down the candidates before comparing their titles.
+		Filters: []string{fmt.Sprintf("content.contains(%s)", strconv.Quote(title))},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	for _, memo := range memos {
+		data, err := s.MarkdownService.ExtractAll([]byte(memo.Content))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to extract memo title")
+		}
+		if strings.EqualFold(data.Title, title) {
+			return memo, nil
+		}
+	}
+	return nil, nil
+}
+
 func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRelation *store.MemoRelation) (*v1pb.MemoRelation, error) {
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.MemoID})
 	if err != nil {
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoReminderBatchSize is the number of due reminders processed at once.
+const memoReminderBatchSize = 100
+
+// SendDueMemoReminders notifies the creators of the memos whose reminders are due.
+// One-time reminders are removed once they fire, and recurring reminders move on to their next time.
+// Occurrences missed while the server was down fire once.
+func (s *APIV1Service) SendDueMemoReminders(ctx context.Context) error {
+	now := time.Now()
+	nowTs := now.Unix()
+	limit := memoReminderBatchSize
+	for {
+		reminders, err := s.Store.ListMemoReminders(ctx, &store.FindMemoReminder{
+			RemindTsBefore: &nowTs,
+			Limit:          &limit,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list due memo reminders")
+		}
+		for _, reminder := range reminders {
+			if err := s.sendMemoReminder(ctx, reminder, now); err != nil {
+				return errors.Wrapf(err, "failed to send memo reminder %d", reminder.ID)
+			}
+		}
+		// Sent reminders are no longer due, so the next batch starts with the remaining ones.
+		if len(reminders) < limit {
+			return nil
+		}
+	}
+}
+
+func (s *APIV1Service) sendMemoReminder(ctx context.Context, reminder *store.MemoReminder, now time.Time) error {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &reminder.MemoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+
+	// Move the reminder on before notifying, so that a failing notification is not repeated every run.
+	rescheduled := false
+	if reminder.Cron != "" && memo != nil {
+		// Recurring reminders follow the time zone of the memo creator.
+		location, _, err := s.getUserTimezoneAndLocale(ctx, memo.CreatorID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get user setting")
+		}
+		next, err := nextCronTime(reminder.Cron, now.In(location))
+		if err != nil {
+			slog.Warn("Failed to schedule the next memo reminder", slog.Int("reminder", int(reminder.ID)), slog.Any("err", err))
+		} else {
+			nextTs := next.Unix()
+			if err := s.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{ID: reminder.ID, RemindTs: &nextTs}); err != nil {
+				return errors.Wrap(err, "failed to update memo reminder")
+			}
+			rescheduled = true
+		}
+	}
+	if !rescheduled {
+		if err := s.Store.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{ID: &reminder.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo reminder")
+		}
+	}
+
+	// Archived memos do not remind their creator.
+	if memo == nil || memo.RowStatus != store.Normal {
+		return nil
+	}
+	return s.notifyMemoReminder(ctx, memo)
+}
+
+// notifyMemoReminder creates a REMINDER inbox message for the memo creator,
+// and sends it by email and webhook if they are configured.
+func (s *APIV1Service) notifyMemoReminder(ctx context.Context, memo *store.Memo) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReminder,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReminder: &storepb.ActivityMemoReminderPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	inbox, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_REMINDER,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	s.dispatchInboxEmail(ctx, inbox)
+
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	if err := s.dispatchMemoActivityWebhook(ctx, memoMessage, &webhook.WebhookRequestPayload{
+		ActivityType: webhook.ActivityTypeMemoReminder,
+	}); err != nil {
+		slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err))
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/cron"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// maxCronLength is the length of the longest cron expression of a reminder or a memo template.
+const maxCronLength = 256
+
+func (s *APIV1Service) CreateMemoReminder(ctx context.Context, request *v1pb.CreateMemoReminderRequest) (*v1pb.MemoReminder, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getReminderMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Reminder == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "reminder is required")
+	}
+
+	location, _, err := s.getUserTimezoneAndLocale(ctx, memo.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+
+	create := &store.MemoReminder{
+		MemoID: memo.ID,
+	}
+	if err := setMemoReminderSchedule(create, request.Reminder.RemindTime, request.Reminder.Cron, time.Now().In(location)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reminder: %v", err)
+	}
+	reminder, err := s.Store.CreateMemoReminder(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo reminder: %v", err)
+	}
+	return convertMemoReminderFromStore(memo, reminder), nil
+}
+
+func (s *APIV1Service) ListMemoReminders(ctx context.Context, request *v1pb.ListMemoRemindersRequest) (*v1pb.ListMemoRemindersResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getReminderMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := s.Store.ListMemoReminders(ctx, &store.FindMemoReminder{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo reminders: %v", err)
+	}
+	response := &v1pb.ListMemoRemindersResponse{
+		Reminders: []*v1pb.MemoReminder{},
+	}
+	for _, reminder := range reminders {
+		response.Reminders = append(response.Reminders, convertMemoReminderFromStore(memo, reminder))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateMemoReminder(ctx context.Context, request *v1pb.UpdateMemoReminderRequest) (*v1pb.MemoReminder, error) {
+	if request.Reminder == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "reminder is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memo, reminder, err := s.getMemoReminderByName(ctx, request.Reminder.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var remindTime *timestamppb.Timestamp
+	if reminder.Cron == "" {
+		remindTime = timestamppb.New(time.Unix(reminder.RemindTs, 0))
+	}
+	cronSpec := reminder.Cron
+	// A reminder is either one-time or recurring, so setting one clears the other.
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "remind_time":
+			remindTime = request.Reminder.RemindTime
+			if remindTime != nil {
+				cronSpec = ""
+			}
+		case "cron":
+			cronSpec = request.Reminder.Cron
+			if cronSpec != "" {
+				remindTime = nil
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+	location, _, err := s.getUserTimezoneAndLocale(ctx, memo.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if err := setMemoReminderSchedule(reminder, remindTime, cronSpec, time.Now().In(location)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reminder: %v", err)
+	}
+	if err := s.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+		ID:       reminder.ID,
+		RemindTs: &reminder.RemindTs,
+		Cron:     &reminder.Cron,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo reminder: %v", err)
+	}
+	return convertMemoReminderFromStore(memo, reminder), nil
+}
+
+func (s *APIV1Service) DeleteMemoReminder(ctx context.Context, request *v1pb.DeleteMemoReminderRequest) (*emptypb.Empty, error) {
+	_, reminder, err := s.getMemoReminderByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{ID: &reminder.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo reminder: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getReminderMemo returns the memo if the current user is allowed to manage its reminders.
+// Reminders notify the memo creator, so only the creator can manage them.
+func (s *APIV1Service) getReminderMemo(ctx context.Context, memoUID string) (*store.Memo, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func (s *APIV1Service) getMemoReminderByName(ctx context.Context, name string) (*store.Memo, *store.MemoReminder, error) {
+	memoUID, reminderID, err := ExtractMemoReminderIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid reminder name: %v", err)
+	}
+	memo, err := s.getReminderMemo(ctx, memoUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	reminder, err := s.Store.GetMemoReminder(ctx, &store.FindMemoReminder{
+		ID:     &reminderID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo reminder: %v", err)
+	}
+	if reminder == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "reminder not found")
+	}
+	return memo, reminder, nil
+}
+
+// setMemoReminderSchedule validates that exactly one of the remind time and the cron expression is set,
+// and sets the next time the reminder fires after now. Cron expressions are evaluated in the time zone of now.
+func setMemoReminderSchedule(reminder *store.MemoReminder, remindTime *timestamppb.Timestamp, cronSpec string, now time.Time) error {
+	cronSpec = strings.TrimSpace(cronSpec)
+	switch {
+	case remindTime != nil && cronSpec != "":
+		return errors.New("only one of remind_time and cron can be set")
+	case remindTime != nil:
+		if !remindTime.IsValid() {
+			return errors.New("invalid remind_time")
+		}
+		if !remindTime.AsTime().After(now) {
+			return errors.New("remind_time must be in the future")
+		}
+		reminder.RemindTs = remindTime.AsTime().Unix()
+		reminder.Cron = ""
+	case cronSpec != "":
+		if len(cronSpec) > maxCronLength {
+			return errors.Errorf("cron must be at most %d characters", maxCronLength)
+		}
+		next, err := nextCronTime(cronSpec, now)
+		if err != nil {
+			return err
+		}
+		reminder.RemindTs = next.Unix()
+		reminder.Cron = cronSpec
+	default:
+		return errors.New("either remind_time or cron is required")
+	}
+	return nil
+}
+
+// nextCronTime returns the first time after the given time that matches the cron expression.
+// The expression is evaluated in the time zone of after, unless it sets its own with CRON_TZ.
+func nextCronTime(cronSpec string, after time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(cronSpec)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "invalid cron")
+	}
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return time.Time{}, errors.New("cron never fires")
+	}
+	return next, nil
+}
+
+func convertMemoReminderFromStore(memo *store.Memo, reminder *store.MemoReminder) *v1pb.MemoReminder {
+	message := &v1pb.MemoReminder{
+		Name:           fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoReminderNamePrefix, reminder.ID),
+		Cron:           reminder.Cron,
+		NextRemindTime: timestamppb.New(time.Unix(reminder.RemindTs, 0)),
+		CreateTime:     timestamppb.New(time.Unix(reminder.CreatedTs, 0)),
+	}
+	if reminder.Cron == "" {
+		message.RemindTime = timestamppb.New(time.Unix(reminder.RemindTs, 0))
+	}
+	return message
+}
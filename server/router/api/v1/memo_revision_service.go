@@ -0,0 +1,258 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getRevisionMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(revisions) == limitPlusOne {
+		revisions = revisions[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions:     []*v1pb.MemoRevision{},
+		NextPageToken: nextPageToken,
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo, revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemoRevisionFromStore(memo, revision), nil
+}
+
+func (s *APIV1Service) DiffMemoRevisions(ctx context.Context, request *v1pb.DiffMemoRevisionsRequest) (*v1pb.DiffMemoRevisionsResponse, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var base *store.MemoRevision
+	if request.Base != "" {
+		baseMemoUID, baseRevisionID, err := ExtractMemoRevisionIDFromName(request.Base)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid base revision name: %v", err)
+		}
+		if baseMemoUID != memo.UID {
+			return nil, status.Errorf(codes.InvalidArgument, "base revision must belong to the same memo")
+		}
+		base, err = s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &baseRevisionID, MemoID: &memo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get base revision: %v", err)
+		}
+		if base == nil {
+			return nil, status.Errorf(codes.NotFound, "base revision not found")
+		}
+	} else {
+		// Default to the revision recorded right before the requested one.
+		revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+		}
+		for _, r := range revisions {
+			if r.ID < revision.ID {
+				base = r
+				break
+			}
+		}
+	}
+
+	response := &v1pb.DiffMemoRevisionsResponse{}
+	baseContent, baseName := "", "/dev/null"
+	if base != nil {
+		baseContent = base.Content
+		baseName = convertMemoRevisionName(memo, base)
+		response.Base = baseName
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(ensureTrailingNewline(baseContent)),
+		B:        difflib.SplitLines(ensureTrailingNewline(revision.Content)),
+		FromFile: baseName,
+		ToFile:   request.Name,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to diff memo revisions: %v", err)
+	}
+	response.Diff = diff
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restoring goes through UpdateMemo so the restored state is recorded as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content:    revision.Content,
+			Visibility: convertVisibilityFromStore(revision.Visibility),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility"}},
+	})
+}
+
+// getRevisionMemo returns the memo if the current user is allowed to access its revisions.
+// Only the creator or admin can access revisions, as they may contain content that has since been removed.
+func (s *APIV1Service) getRevisionMemo(ctx context.Context, memoUID string) (*store.Memo, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func (s *APIV1Service) getMemoRevisionByName(ctx context.Context, name string) (*store.Memo, *store.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid revision name: %v", err)
+	}
+	memo, err := s.getRevisionMemo(ctx, memoUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisionID, MemoID: &memo.ID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return memo, revision, nil
+}
+
+// createMemoRevision records the current content and visibility of the memo
+// and drops the oldest revisions beyond the configured retention.
+func (s *APIV1Service) createMemoRevision(ctx context.Context, memo *store.Memo, creatorID int32) error {
+	if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  creatorID,
+		Content:    memo.Content,
+		Visibility: memo.Visibility,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	if err := s.Store.TrimMemoRevisions(ctx, memo.ID, int(instanceMemoRelatedSetting.RevisionLimit)); err != nil {
+		return errors.Wrap(err, "failed to trim memo revisions")
+	}
+	return nil
+}
+
+// seedMemoRevision records the given state as the initial revision if the memo has none.
+func (s *APIV1Service) seedMemoRevision(ctx context.Context, memoID int32, content string, visibility store.Visibility, creatorID int32, createdTs int64) error {
+	limit := 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memoID, Limit: &limit})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo revisions")
+	}
+	if len(revisions) > 0 {
+		return nil
+	}
+	if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memoID,
+		CreatorID:  creatorID,
+		CreatedTs:  createdTs,
+		Content:    content,
+		Visibility: visibility,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	return nil
+}
+
+func convertMemoRevisionName(memo *store.Memo, revision *store.MemoRevision) string {
+	return fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, revision.ID)
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, revision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       convertMemoRevisionName(memo, revision),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, revision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+	}
+}
+
+func ensureTrailingNewline(s string) string {
+	if s == "" || s[len(s)-1] == '\n' {
+		return s
+	}
+	return s + "\n"
+}
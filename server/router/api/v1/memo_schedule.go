@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// PublishScheduledMemos publishes the scheduled memos whose publish time has passed.
+// Each memo gets its requested visibility and the publish time as its create and update time,
+// and is then announced like a newly created memo.
+// A memo that fails to publish is logged and retried on the next run, without holding up the others.
+func (s *APIV1Service) PublishScheduledMemos(ctx context.Context) error {
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+		Filters:        []string{"scheduled"},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list scheduled memos")
+	}
+
+	now := time.Now().Unix()
+	for _, memo := range memos {
+		schedule := memo.Payload.GetSchedule()
+		if schedule == nil || schedule.PublishTs > now {
+			continue
+		}
+		if err := s.publishScheduledMemo(ctx, memo); err != nil {
+			slog.Warn("Failed to publish scheduled memo", slog.String("memo", memo.UID), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) publishScheduledMemo(ctx context.Context, memo *store.Memo) error {
+	schedule := memo.Payload.GetSchedule()
+	visibility := store.Visibility(schedule.Visibility)
+	if visibility != store.Public && visibility != store.Protected {
+		visibility = store.Private
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	// Public memos may have been disallowed since the memo was scheduled.
+	if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+		visibility = store.Protected
+	}
+
+	payload := memo.Payload
+	payload.Schedule = nil
+	// The update is conditional so that a memo edited since it was listed, or already published
+	// by a concurrent run, is neither overwritten nor announced twice.
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                memo.ID,
+		CreatedTs:         &schedule.PublishTs,
+		UpdatedTs:         &schedule.PublishTs,
+		Visibility:        &visibility,
+		Payload:           payload,
+		ExpectedUpdatedTs: &memo.UpdatedTs,
+	}); err != nil {
+		if errors.Is(err, store.ErrMemoConflict) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to update memo")
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	s.announceMemoCreated(ctx, memo, memoMessage)
+	return nil
+}
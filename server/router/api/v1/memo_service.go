@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/linkpreview"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
@@ -90,6 +93,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	// A memo to publish later stays private until the publish time.
+	if request.Memo.PublishTime != nil && request.Memo.PublishTime.IsValid() && request.Memo.PublishTime.AsTime().After(time.Now()) {
+		create.Payload.Schedule = &storepb.MemoPayload_Schedule{
+			PublishTs:  request.Memo.PublishTime.AsTime().Unix(),
+			Visibility: create.Visibility.String(),
+		}
+		create.Visibility = store.Private
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -102,6 +113,9 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		}
 		return nil, err
 	}
+	if err := s.createMemoRevision(ctx, memo, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo revision: %v", err)
+	}
 
 	attachments := []*store.Attachment{}
 
@@ -131,17 +145,42 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
+	if err := s.syncMemoReferences(ctx, memo, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+	}
+	// Fetch the previews of the linked pages in the background.
+	linkpreview.NewRunner(s.Store).Enqueue(memo.Payload.GetLinks())
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+	// Scheduled memos are announced when they are published.
+	if memo.Payload.GetSchedule() == nil {
+		s.announceMemoCreated(ctx, memo, memoMessage)
+	}
+
+	return memoMessage, nil
+}
+
+// announceMemoCreated dispatches the webhooks of a new memo and notifies the mentioned users.
+func (s *APIV1Service) announceMemoCreated(ctx context.Context, memo *store.Memo, memoMessage *v1pb.Memo) {
 	// Try to dispatch webhook when memo is created.
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
 	}
-
-	return memoMessage, nil
+	if memoMessage.Visibility == v1pb.Visibility_PUBLIC {
+		if err := s.dispatchInstanceWebhook(ctx, memoMessage, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeMemoCreated,
+			Creator:      memoMessage.Creator,
+			Memo:         memoMessage,
+		}); err != nil {
+			slog.Warn("Failed to dispatch instance webhook", slog.Any("err", err))
+		}
+	}
+	if err := s.notifyMemoMentions(ctx, memo, nil); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
 }
 
 func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosRequest) (*v1pb.ListMemosResponse, error) {
@@ -173,6 +212,19 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		memoFind.Filters = append(memoFind.Filters, request.Filter)
 	}
+	if memoFind.OrderByRelevance {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get filter engine: %v", err)
+		}
+		queries, err := filter.CollectSearchQueries(ctx, engine, memoFind.Filters)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		if len(queries) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "ordering by relevance requires a search() filter")
+		}
+	}
 
 	currentUser, err := s.fetchCurrentUser(ctx)
 	if err != nil {
@@ -366,6 +418,12 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	previousContent, previousVisibility := memo.Content, memo.Visibility
+	// Mentioned users have already been notified unless the memo was private.
+	var notifiedMentions []string
+	if previousVisibility != store.Private {
+		notifiedMentions = slices.Clone(memo.Payload.GetMentions())
+	}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -390,6 +448,12 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
+			if schedule := memo.Payload.GetSchedule(); schedule != nil {
+				// A scheduled memo stays private, and gets the visibility when it is published.
+				schedule.Visibility = visibility.String()
+				update.Payload = memo.Payload
+				continue
+			}
 			update.Visibility = &visibility
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
@@ -439,6 +503,16 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		}
 	}
 
+	revisionChanged := (update.Content != nil && *update.Content != previousContent) ||
+		(update.Visibility != nil && *update.Visibility != previousVisibility)
+	if revisionChanged {
+		// Memos created before revision history existed have no revisions yet,
+		// so keep their current state before it gets overwritten.
+		if err := s.seedMemoRevision(ctx, memo.ID, previousContent, previousVisibility, memo.CreatorID, memo.UpdatedTs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create memo revision: %v", err)
+		}
+	}
+
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
@@ -449,6 +523,20 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	if revisionChanged {
+		if err := s.createMemoRevision(ctx, memo, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create memo revision: %v", err)
+		}
+	}
+	// Setting the relations replaces all references, so links in the content are restored as well.
+	if slices.Contains(request.UpdateMask.Paths, "content") || slices.Contains(request.UpdateMask.Paths, "relations") {
+		if err := s.syncMemoReferences(ctx, memo, previousContent); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+	}
+	if slices.Contains(request.UpdateMask.Paths, "content") {
+		linkpreview.NewRunner(s.Store).Enqueue(memo.Payload.GetLinks())
+	}
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Memo.Name,
 	})
@@ -470,6 +558,14 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
+	if update.Visibility != nil && *update.Visibility != previousVisibility {
+		if err := s.DispatchMemoVisibilityChangedWebhook(ctx, memoMessage, convertVisibilityFromStore(previousVisibility)); err != nil {
+			slog.Warn("Failed to dispatch memo visibility changed webhook", slog.Any("err", err))
+		}
+	}
+	if err := s.notifyMemoMentions(ctx, memo, notifiedMentions); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
 
 	return memoMessage, nil
 }
@@ -597,6 +693,14 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
+	// Private comments are only visible to their creator, so only the creator's own are sent to the memo creator.
+	if memoComment.Visibility != v1pb.Visibility_PRIVATE || creatorID == relatedMemo.CreatorID {
+		if relatedMemoMessage, err := s.convertMemoFromStore(ctx, relatedMemo, nil, nil); err == nil {
+			if err := s.DispatchMemoCommentCreatedWebhook(ctx, relatedMemoMessage, memoComment); err != nil {
+				slog.Warn("Failed to dispatch memo comment created webhook", slog.Any("err", err))
+			}
+		}
+	}
 	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
 		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
 			CreatorID: creatorID,
@@ -612,7 +716,7 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create activity")
 		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		inbox, err := s.Store.CreateInbox(ctx, &store.Inbox{
 			SenderID:   creatorID,
 			ReceiverID: relatedMemo.CreatorID,
 			Status:     store.UNREAD,
@@ -620,9 +724,11 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 				Type:       storepb.InboxMessage_MEMO_COMMENT,
 				ActivityId: &activity.ID,
 			},
-		}); err != nil {
+		})
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create inbox")
 		}
+		s.dispatchInboxEmail(ctx, inbox)
 	}
 
 	return memoComment, nil
@@ -732,51 +838,189 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoCreated)
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoUpdated)
 }
 
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoDeleted)
+}
+
+// DispatchMemoVisibilityChangedWebhook dispatches webhook when the visibility of memo is changed.
+func (s *APIV1Service) DispatchMemoVisibilityChangedWebhook(ctx context.Context, memo *v1pb.Memo, previousVisibility v1pb.Visibility) error {
+	return s.dispatchMemoActivityWebhook(ctx, memo, &webhook.WebhookRequestPayload{
+		ActivityType:       webhook.ActivityTypeMemoVisibilityChanged,
+		PreviousVisibility: previousVisibility.String(),
+	})
+}
+
+// DispatchMemoCommentCreatedWebhook dispatches webhook to the memo creator when a comment is created on memo.
+func (s *APIV1Service) DispatchMemoCommentCreatedWebhook(ctx context.Context, memo *v1pb.Memo, comment *v1pb.Memo) error {
+	return s.dispatchMemoActivityWebhook(ctx, memo, &webhook.WebhookRequestPayload{
+		ActivityType: webhook.ActivityTypeMemoCommentCreated,
+		Comment:      comment,
+	})
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
+	return s.dispatchMemoActivityWebhook(ctx, memo, &webhook.WebhookRequestPayload{ActivityType: activityType})
+}
+
+// dispatchMemoActivityWebhook dispatches the activity to the webhooks of the memo creator.
+// The memo and creator of the payload are filled in from the memo.
+func (s *APIV1Service) dispatchMemoActivityWebhook(ctx context.Context, memo *v1pb.Memo, payload *webhook.WebhookRequestPayload) error {
 	creatorID, err := ExtractUserIDFromName(memo.Creator)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
+		return errors.Wrap(err, "invalid memo creator")
 	}
-	webhooks, err := s.Store.GetUserWebhooks(ctx, creatorID)
+	payload.Creator = fmt.Sprintf("%s%d", UserNamePrefix, creatorID)
+	payload.Memo = memo
+	return s.dispatchWebhook(ctx, creatorID, memo, payload)
+}
+
+// dispatchWebhook queues the payload for each webhook of the user that subscribes to
+// the activity type and whose filter matches the memo. The memo may be nil for
+// activities that do not relate to a memo.
+func (s *APIV1Service) dispatchWebhook(ctx context.Context, ownerID int32, memo *v1pb.Memo, payload *webhook.WebhookRequestPayload) error {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, ownerID)
 	if err != nil {
 		return err
 	}
+	return s.queueWebhookDeliveries(ctx, ownerID, webhooks, webhook.DefaultActivityTypes, memo, payload)
+}
+
+// queueWebhookDeliveries renders and queues the payload for each of the webhooks that subscribes to
+// the activity type, falling back to defaultActivityTypes for webhooks without explicit activity types.
+func (s *APIV1Service) queueWebhookDeliveries(ctx context.Context, ownerID int32, webhooks []*storepb.WebhooksUserSetting_Webhook, defaultActivityTypes []string, memo *v1pb.Memo, payload *webhook.WebhookRequestPayload) error {
+	var data *webhook.TemplateData
+	deliveryRunner := s.newWebhookDeliveryRunner()
 	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
+		if !webhookSubscribesTo(hook, payload.ActivityType, defaultActivityTypes) {
+			continue
+		}
+		matched, err := s.webhookFilterMatches(ctx, hook.Filter, memo)
+		if err != nil {
+			slog.Warn("Failed to evaluate webhook filter", slog.String("webhook", hook.Id), slog.Any("err", err))
+			continue
+		}
+		if !matched {
+			continue
+		}
+
+		if data == nil {
+			data = s.buildWebhookTemplateData(payload)
+		}
+		hookPayload := *payload
+		hookPayload.URL = hook.Url
+		hookData := *data
+		hookData.WebhookRequestPayload = &hookPayload
+		delivery := &store.WebhookDelivery{
+			CreatorID:    ownerID,
+			WebhookID:    hook.Id,
+			URL:          hook.Url,
+			ActivityType: payload.ActivityType,
+		}
+		body, contentType, err := webhook.BuildBody(convertWebhookPayloadFormatFromStore(hook.PayloadFormat), hook.PayloadTemplate, &hookData)
 		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
+			// Record the failure so that it shows up in the delivery log of the webhook.
+			delivery.Status = store.WebhookDeliveryFailed
+			delivery.NextAttemptTs = time.Now().Unix()
+			delivery.ErrorMessage = err.Error()
+			if _, err := s.Store.CreateWebhookDelivery(ctx, delivery); err != nil {
+				return errors.Wrap(err, "failed to create webhook delivery")
+			}
+			continue
 		}
-		payload.ActivityType = activityType
-		payload.URL = hook.Url
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+		// Queue the delivery so it is retried if the endpoint is unavailable.
+		delivery.Payload = string(body)
+		delivery.ContentType = contentType
+		if _, err := deliveryRunner.Enqueue(ctx, delivery); err != nil {
+			return errors.Wrap(err, "failed to enqueue webhook delivery")
+		}
 	}
 	return nil
 }
 
-func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
-	creatorID, err := ExtractUserIDFromName(memo.Creator)
+// buildWebhookTemplateData summarizes the activity for chat messages and payload templates.
+func (s *APIV1Service) buildWebhookTemplateData(payload *webhook.WebhookRequestPayload) *webhook.TemplateData {
+	data := &webhook.TemplateData{
+		WebhookRequestPayload: payload,
+		Title:                 webhook.ActivityTitle(payload.ActivityType),
+	}
+	content := ""
+	if payload.Comment != nil {
+		content = payload.Comment.Content
+	} else if payload.Memo != nil {
+		content = payload.Memo.Content
+	}
+	if content != "" {
+		if snippet, err := s.getMemoContentSnippet(content); err == nil {
+			data.Snippet = snippet
+		}
+	} else if payload.User != nil {
+		data.Snippet = payload.User.Username
+	}
+	if payload.Memo != nil {
+		data.Tags = payload.Memo.Tags
+		if s.Profile != nil && s.Profile.InstanceURL != "" {
+			data.Link = fmt.Sprintf("%s/%s", strings.TrimSuffix(s.Profile.InstanceURL, "/"), payload.Memo.Name)
+		}
+	}
+	return data
+}
+
+func convertWebhookPayloadFormatFromStore(format storepb.WebhooksUserSetting_PayloadFormat) webhook.Format {
+	switch format {
+	case storepb.WebhooksUserSetting_SLACK:
+		return webhook.FormatSlack
+	case storepb.WebhooksUserSetting_DISCORD:
+		return webhook.FormatDiscord
+	case storepb.WebhooksUserSetting_MATTERMOST:
+		return webhook.FormatMattermost
+	case storepb.WebhooksUserSetting_TEMPLATE:
+		return webhook.FormatTemplate
+	default:
+		return webhook.FormatMemos
+	}
+}
+
+// webhookSubscribesTo reports whether the webhook receives the activity type.
+// Webhooks without explicit activity types receive the given default activities.
+func webhookSubscribesTo(hook *storepb.WebhooksUserSetting_Webhook, activityType string, defaultActivityTypes []string) bool {
+	if len(hook.ActivityTypes) == 0 {
+		return slices.Contains(defaultActivityTypes, activityType)
+	}
+	return slices.Contains(hook.ActivityTypes, activityType)
+}
+
+// webhookFilterMatches reports whether the memo matches the filter of a webhook.
+// The filter is evaluated by the store with the same engine as memo list filters.
+func (s *APIV1Service) webhookFilterMatches(ctx context.Context, filter string, memo *v1pb.Memo) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	if memo == nil {
+		return false, nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid memo name")
+	}
+	matched, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		UID:            &memoUID,
+		ExcludeContent: true,
+		Filters:        []string{filter},
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid memo creator")
+		return false, err
 	}
-	return &webhook.WebhookRequestPayload{
-		Creator: fmt.Sprintf("%s%d", UserNamePrefix, creatorID),
-		Memo:    memo,
-	}, nil
+	return matched != nil, nil
 }
 
 func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
@@ -830,8 +1074,11 @@ func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo)
 		case "update_time":
 			memoFind.OrderByUpdatedTs = true
 			memoFind.OrderByTimeAsc = fieldDirection == "asc"
+		case "relevance":
+			// Note: relevance is always best match first and requires a search() filter.
+			memoFind.OrderByRelevance = true
 		default:
-			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, display_time, create_time, update_time, name", fieldName)
+			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, relevance, display_time, create_time, update_time, name", fieldName)
 		}
 	}
 
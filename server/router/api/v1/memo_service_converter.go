@@ -39,6 +39,9 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		if memo.Payload.Schedule != nil {
+			memoMessage.PublishTime = timestamppb.New(time.Unix(memo.Payload.Schedule.PublishTs, 0))
+		}
 	}
 
 	if memo.ParentUID != nil {
@@ -72,9 +75,45 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	}
 	memoMessage.Snippet = snippet
 
+	memoMessage.LinkPreviews, err = s.listMemoLinkPreviews(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list link previews")
+	}
+
 	return memoMessage, nil
 }
 
+// listMemoLinkPreviews returns the previews of the links in the memo that have been fetched successfully, in document order.
+func (s *APIV1Service) listMemoLinkPreviews(ctx context.Context, memo *store.Memo) ([]*v1pb.LinkPreview, error) {
+	links := memo.Payload.GetLinks()
+	if len(links) == 0 {
+		return []*v1pb.LinkPreview{}, nil
+	}
+	list, err := s.Store.ListLinkMetadata(ctx, &store.FindLinkMetadata{URLList: links})
+	if err != nil {
+		return nil, err
+	}
+	metadataMap := make(map[string]*store.LinkMetadata, len(list))
+	for _, metadata := range list {
+		metadataMap[metadata.URL] = metadata
+	}
+
+	linkPreviews := []*v1pb.LinkPreview{}
+	for _, link := range links {
+		metadata, ok := metadataMap[link]
+		if !ok || metadata.ErrorMessage != "" {
+			continue
+		}
+		linkPreviews = append(linkPreviews, &v1pb.LinkPreview{
+			Url:         metadata.URL,
+			Title:       metadata.Title,
+			Description: metadata.Description,
+			Image:       metadata.Image,
+		})
+	}
+	return linkPreviews, nil
+}
+
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.Memo_Property {
 	if property == nil {
 		return nil
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	state := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &state,
+		ExcludeComments: true,
+		Filters:         []string{"has_task_list"},
+	}
+	if !request.ShowCompleted {
+		memoFind.Filters = append(memoFind.Filters, "has_incomplete_tasks")
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filters = append(memoFind.Filters, request.Filter)
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisplayWithUpdateTime {
+		memoFind.OrderByUpdatedTs = true
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.ListTasksResponse{
+		Tasks: []*v1pb.Task{},
+	}
+	for _, memo := range memos {
+		for index, task := range memo.Payload.GetTasks() {
+			if task.Completed && !request.ShowCompleted {
+				continue
+			}
+			response.Tasks = append(response.Tasks, convertTaskFromStore(memo, index, task))
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SetTaskCompleted(ctx context.Context, request *v1pb.SetTaskCompletedRequest) (*v1pb.Task, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	// Only the creator or admin can update the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	tasks := memo.Payload.GetTasks()
+	index := int(request.Index)
+	if index < 0 || index >= len(tasks) {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	if tasks[index].Completed == request.Completed {
+		return convertTaskFromStore(memo, index, tasks[index]), nil
+	}
+
+	content, err := s.MarkdownService.SetTaskCompleted([]byte(memo.Content), index, request.Completed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update task: %v", err)
+	}
+	// Go through UpdateMemo so that revisions, webhooks and references stay in sync.
+	if _, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    request.Name,
+			Content: content,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	}); err != nil {
+		return nil, err
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	tasks = memo.Payload.GetTasks()
+	if index >= len(tasks) {
+		return nil, status.Errorf(codes.Internal, "task not found after update")
+	}
+	return convertTaskFromStore(memo, index, tasks[index]), nil
+}
+
+func convertTaskFromStore(memo *store.Memo, index int, task *storepb.MemoPayload_Task) *v1pb.Task {
+	return &v1pb.Task{
+		Memo:      fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Index:     int32(index),
+		Content:   task.Content,
+		Completed: task.Completed,
+		Line:      task.Line,
+		DueDate:   task.DueDate,
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// CreateScheduledTemplateMemos creates memos from the memo templates whose cron schedule is due,
+// on behalf of the users owning the templates.
+// Occurrences missed while the server was down create a single memo.
+// Failures are logged per user, so that one user's templates do not hold up the others.
+func (s *APIV1Service) CreateScheduledTemplateMemos(ctx context.Context) error {
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_TEMPLATES,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo templates")
+	}
+
+	now := time.Now()
+	for _, userSetting := range userSettings {
+		if err := s.runDueMemoTemplates(ctx, userSetting.UserId, userSetting.GetTemplates().GetTemplates(), now); err != nil {
+			slog.Warn("Failed to run memo templates", slog.Int("user", int(userSetting.UserId)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) runDueMemoTemplates(ctx context.Context, userID int32, templates []*storepb.TemplatesUserSetting_Template, now time.Time) error {
+	dueTemplates := []*storepb.TemplatesUserSetting_Template{}
+	for _, template := range templates {
+		if template.Cron != "" && template.NextRunTs != 0 && template.NextRunTs <= now.Unix() {
+			dueTemplates = append(dueTemplates, template)
+		}
+	}
+	if len(dueTemplates) == 0 {
+		return nil
+	}
+	// Archived users do not get new memos, their templates run again once they are restored.
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus != store.Normal {
+		return nil
+	}
+	location, _, err := s.getUserTimezoneAndLocale(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user time zone")
+	}
+	now = now.In(location)
+
+	// Move the templates on before creating the memos, so that a failing memo is not created again every run.
+	for _, template := range dueTemplates {
+		next, err := nextCronTime(template.Cron, now)
+		if err != nil {
+			slog.Warn("Failed to schedule the next memo from template", slog.String("template", template.Id), slog.Any("err", err))
+			template.NextRunTs = 0
+			continue
+		}
+		template.NextRunTs = next.Unix()
+	}
+	if err := s.Store.UpsertUserMemoTemplates(ctx, userID, templates); err != nil {
+		return errors.Wrap(err, "failed to save memo templates")
+	}
+
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	userCtx := context.WithValue(ctx, auth.UserIDContextKey, userID)
+	for _, template := range dueTemplates {
+		visibility := store.Visibility(template.Visibility)
+		// Public memos may have been disallowed since the template was created.
+		if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+			visibility = store.Protected
+		}
+		if _, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{
+				Content:    renderMemoTemplate(template.Content, now.In(memoTemplateLocation(template, location))),
+				Visibility: convertVisibilityFromStore(visibility),
+			},
+		}); err != nil {
+			slog.Warn("Failed to create memo from template", slog.String("template", template.Id), slog.Any("err", err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/cron"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Helper function to extract user ID and template ID from memo template resource name.
+// Format: users/{user}/templates/{template}.
+func extractUserAndMemoTemplateIDFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "templates" {
+		return 0, "", errors.Errorf("invalid memo template name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	templateID := parts[3]
+	if templateID == "" {
+		return 0, "", errors.Errorf("empty template ID in name: %s", name)
+	}
+
+	return userID, templateID, nil
+}
+
+// Helper function to construct memo template resource name.
+func constructMemoTemplateName(userID int32, templateID string) string {
+	return fmt.Sprintf("users/%d/templates/%s", userID, templateID)
+}
+
+func (s *APIV1Service) ListMemoTemplates(ctx context.Context, request *v1pb.ListMemoTemplatesRequest) (*v1pb.ListMemoTemplatesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.Store.GetUserMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	response := &v1pb.ListMemoTemplatesResponse{
+		Templates: []*v1pb.MemoTemplate{},
+	}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, convertMemoTemplateFromStore(userID, template))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoTemplate(ctx context.Context, request *v1pb.GetMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	userID, template, err := s.getMemoTemplateByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemoTemplateFromStore(userID, template), nil
+}
+
+func (s *APIV1Service) CreateMemoTemplate(ctx context.Context, request *v1pb.CreateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+
+	newTemplate := &storepb.TemplatesUserSetting_Template{
+		Id:         util.GenUUID(),
+		Title:      request.Template.Title,
+		Content:    request.Template.Content,
+		Visibility: convertVisibilityToStore(request.Template.Visibility).String(),
+	}
+	if err := s.validateMemoTemplate(ctx, newTemplate); err != nil {
+		return nil, err
+	}
+	location, _, err := s.getUserTimezoneAndLocale(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user time zone: %v", err)
+	}
+	if err := setMemoTemplateSchedule(newTemplate, request.Template.Cron, time.Now().In(location)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cron: %v", err)
+	}
+
+	templates, err := s.Store.GetUserMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	templates = append(templates, newTemplate)
+	if err := s.Store.UpsertUserMemoTemplates(ctx, userID, templates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save memo templates: %v", err)
+	}
+	return convertMemoTemplateFromStore(userID, newTemplate), nil
+}
+
+func (s *APIV1Service) UpdateMemoTemplate(ctx context.Context, request *v1pb.UpdateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userID, template, err := s.getMemoTemplateByName(ctx, request.Template.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Update a copy so that the cached user setting stays intact if the update is invalid.
+	template = proto.Clone(template).(*storepb.TemplatesUserSetting_Template)
+
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			template.Title = request.Template.Title
+		case "content":
+			template.Content = request.Template.Content
+		case "visibility":
+			template.Visibility = convertVisibilityToStore(request.Template.Visibility).String()
+		case "cron":
+			location, _, err := s.getUserTimezoneAndLocale(ctx, userID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user time zone: %v", err)
+			}
+			if err := setMemoTemplateSchedule(template, request.Template.Cron, time.Now().In(location)); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid cron: %v", err)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+	if err := s.validateMemoTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.Store.GetUserMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	for i, existing := range templates {
+		if existing.Id == template.Id {
+			templates[i] = template
+		}
+	}
+	if err := s.Store.UpsertUserMemoTemplates(ctx, userID, templates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save memo templates: %v", err)
+	}
+	return convertMemoTemplateFromStore(userID, template), nil
+}
+
+func (s *APIV1Service) DeleteMemoTemplate(ctx context.Context, request *v1pb.DeleteMemoTemplateRequest) (*emptypb.Empty, error) {
+	userID, template, err := s.getMemoTemplateByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.Store.GetUserMemoTemplates(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	newTemplates := make([]*storepb.TemplatesUserSetting_Template, 0, len(templates))
+	for _, existing := range templates {
+		if existing.Id != template.Id {
+			newTemplates = append(newTemplates, existing)
+		}
+	}
+	if err := s.Store.UpsertUserMemoTemplates(ctx, userID, newTemplates); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save memo templates: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) CreateMemoFromTemplate(ctx context.Context, request *v1pb.CreateMemoFromTemplateRequest) (*v1pb.Memo, error) {
+	userID, template, err := s.getMemoTemplateByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	location, _, err := s.getUserTimezoneAndLocale(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user time zone: %v", err)
+	}
+	return s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    renderMemoTemplate(template.Content, time.Now().In(memoTemplateLocation(template, location))),
+			Visibility: convertVisibilityFromStore(store.Visibility(template.Visibility)),
+		},
+	})
+}
+
+// checkMemoTemplateOwner checks that the current user is the user owning the memo templates.
+func (s *APIV1Service) checkMemoTemplateOwner(ctx context.Context, userID int32) error {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (s *APIV1Service) getMemoTemplateByName(ctx context.Context, name string) (int32, *storepb.TemplatesUserSetting_Template, error) {
+	userID, templateID, err := extractUserAndMemoTemplateIDFromName(name)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return 0, nil, err
+	}
+
+	templates, err := s.Store.GetUserMemoTemplates(ctx, userID)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	for _, template := range templates {
+		if template.Id == templateID {
+			return userID, template, nil
+		}
+	}
+	return 0, nil, status.Errorf(codes.NotFound, "memo template not found")
+}
+
+func (s *APIV1Service) validateMemoTemplate(ctx context.Context, template *storepb.TemplatesUserSetting_Template) error {
+	if strings.TrimSpace(template.Title) == "" {
+		return status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	if strings.TrimSpace(template.Content) == "" {
+		return status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get content length limit")
+	}
+	if len(template.Content) > contentLengthLimit {
+		return status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisallowPublicVisibility && store.Visibility(template.Visibility) == store.Public {
+		return status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return nil
+}
+
+// setMemoTemplateSchedule sets the cron expression of the template and the next time it runs after now.
+// The expression is evaluated in the time zone of now unless it sets its own with CRON_TZ.
+// An empty cron expression leaves the template for manual use only.
+func setMemoTemplateSchedule(template *storepb.TemplatesUserSetting_Template, cronSpec string, now time.Time) error {
+	cronSpec = strings.TrimSpace(cronSpec)
+	if cronSpec == "" {
+		template.Cron = ""
+		template.NextRunTs = 0
+		return nil
+	}
+	if len(cronSpec) > maxCronLength {
+		return errors.Errorf("cron must be at most %d characters", maxCronLength)
+	}
+	next, err := nextCronTime(cronSpec, now)
+	if err != nil {
+		return err
+	}
+	template.Cron = cronSpec
+	template.NextRunTs = next.Unix()
+	return nil
+}
+
+// memoTemplateLocation returns the time zone the placeholders of the template are filled in with,
+// which is the CRON_TZ of its cron expression if set and the time zone of the user otherwise.
+func memoTemplateLocation(template *storepb.TemplatesUserSetting_Template, userLocation *time.Location) *time.Location {
+	if template.Cron == "" {
+		return userLocation
+	}
+	schedule, err := cron.ParseStandard(template.Cron)
+	if err != nil {
+		return userLocation
+	}
+	if spec, ok := schedule.(*cron.SpecSchedule); ok && spec.Location != nil {
+		return spec.Location
+	}
+	return userLocation
+}
+
+// renderMemoTemplate replaces the placeholders in the template content with the given time.
+func renderMemoTemplate(content string, t time.Time) string {
+	isoYear, isoWeek := t.ISOWeek()
+	return strings.NewReplacer(
+		"{{date}}", t.Format("2006-01-02"),
+		"{{time}}", t.Format("15:04"),
+		"{{weekday}}", t.Weekday().String(),
+		"{{week}}", fmt.Sprintf("%d-W%02d", isoYear, isoWeek),
+		"{{month}}", t.Format("2006-01"),
+		"{{year}}", t.Format("2006"),
+	).Replace(content)
+}
+
+func convertMemoTemplateFromStore(userID int32, template *storepb.TemplatesUserSetting_Template) *v1pb.MemoTemplate {
+	message := &v1pb.MemoTemplate{
+		Name:       constructMemoTemplateName(userID, template.Id),
+		Title:      template.Title,
+		Content:    template.Content,
+		Visibility: convertVisibilityFromStore(store.Visibility(template.Visibility)),
+		Cron:       template.Cron,
+	}
+	if template.NextRunTs != 0 {
+		message.NextRunTime = timestamppb.New(time.Unix(template.NextRunTs, 0))
+	}
+	return message
+}
@@ -0,0 +1,444 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webauthn"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultPasskeyRelyingPartyName is shown by authenticators unless the instance has a custom title.
+	defaultPasskeyRelyingPartyName = "Memos"
+	// maxPasskeyDisplayNameLength bounds the user-provided name of a passkey.
+	maxPasskeyDisplayNameLength = 64
+)
+
+// BeginPasskeyRegistration starts registering a passkey for the current user.
+//
+// Authentication: Required (access token).
+// Returns: The creation options for the browser and a session token holding the challenge.
+func (s *APIV1Service) BeginPasskeyRegistration(ctx context.Context, _ *v1pb.BeginPasskeyRegistrationRequest) (*v1pb.BeginPasskeyRegistrationResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	relyingParty, err := s.getPasskeyRelyingParty()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are not available: %v", err)
+	}
+	passkeys, err := s.Store.GetUserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+	}
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate challenge: %v", err)
+	}
+	sessionToken, _, err := auth.GeneratePasskeySessionToken(user.ID, auth.PasskeyRegistrationSession, challenge, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate passkey session token: %v", err)
+	}
+
+	displayName := user.Nickname
+	if displayName == "" {
+		displayName = user.Username
+	}
+	options := &v1pb.PasskeyCreationOptions{
+		Challenge:            challenge,
+		RpId:                 relyingParty.ID,
+		RpName:               s.getPasskeyRelyingPartyName(ctx),
+		UserId:               []byte(fmt.Sprint(user.ID)),
+		UserName:             user.Username,
+		UserDisplayName:      displayName,
+		Algorithms:           webauthn.SupportedAlgorithms,
+		ExcludeCredentialIds: [][]byte{},
+		TimeoutMs:            uint32(auth.PasskeySessionTokenDuration.Milliseconds()),
+	}
+	for _, passkey := range passkeys {
+		options.ExcludeCredentialIds = append(options.ExcludeCredentialIds, passkey.CredentialId)
+	}
+	return &v1pb.BeginPasskeyRegistrationResponse{
+		Options:      options,
+		SessionToken: sessionToken,
+	}, nil
+}
+
+// FinishPasskeyRegistration verifies the attestation of the authenticator and stores the passkey.
+// The attestation statement itself is not verified, any authenticator may be registered.
+//
+// Authentication: Required (access token).
+// Returns: The new passkey.
+func (s *APIV1Service) FinishPasskeyRegistration(ctx context.Context, request *v1pb.FinishPasskeyRegistrationRequest) (*v1pb.Passkey, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	claims, err := auth.ParsePasskeySessionToken(request.SessionToken, auth.PasskeyRegistrationSession, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey session token: %v", err)
+	}
+	if claims.Subject != fmt.Sprint(user.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(claims.Challenge)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey session token: %v", err)
+	}
+	displayName := strings.TrimSpace(request.DisplayName)
+	if len(displayName) > maxPasskeyDisplayNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "display name is too long (maximum %d characters)", maxPasskeyDisplayNameLength)
+	}
+	if displayName == "" {
+		displayName = "Passkey"
+	}
+
+	relyingParty, err := s.getPasskeyRelyingParty()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are not available: %v", err)
+	}
+	credential, err := relyingParty.VerifyRegistration(challenge, request.ClientDataJson, request.AttestationObject)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey registration: %v", err)
+	}
+	claimed, err := s.claimPasskeyChallenge(ctx, claims.Challenge, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to claim passkey session: %v", err)
+	}
+	if !claimed {
+		return nil, status.Errorf(codes.InvalidArgument, "passkey session has already been used")
+	}
+
+	passkeys, err := s.Store.GetUserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+	}
+	for _, passkey := range passkeys {
+		if bytes.Equal(passkey.CredentialId, credential.ID) {
+			return nil, status.Errorf(codes.AlreadyExists, "passkey is already registered")
+		}
+	}
+	passkey := &storepb.PasskeysUserSetting_Passkey{
+		Id:           util.GenUUID(),
+		CredentialId: credential.ID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		DisplayName:  displayName,
+		Transports:   request.Transports,
+		CreatedAt:    timestamppb.Now(),
+	}
+	updatedPasskeys := append(append([]*storepb.PasskeysUserSetting_Passkey{}, passkeys...), passkey)
+	if err := s.Store.UpsertUserPasskeys(ctx, user.ID, updatedPasskeys); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save passkey: %v", err)
+	}
+	return convertPasskeyFromStore(user.ID, passkey), nil
+}
+
+// BeginPasskeySignIn starts a sign-in with a passkey.
+// With a two-factor token the passkey completes a password sign-in and must belong to that user,
+// without one any discoverable passkey may be used but the user must be verified by the authenticator.
+//
+// Authentication: Not required (public endpoint).
+// Returns: The request options for the browser and a session token holding the challenge.
+func (s *APIV1Service) BeginPasskeySignIn(ctx context.Context, request *v1pb.BeginPasskeySignInRequest) (*v1pb.BeginPasskeySignInResponse, error) {
+	relyingParty, err := s.getPasskeyRelyingParty()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are not available: %v", err)
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate challenge: %v", err)
+	}
+
+	options := &v1pb.PasskeyRequestOptions{
+		Challenge:          challenge,
+		RpId:               relyingParty.ID,
+		AllowCredentialIds: [][]byte{},
+		UserVerification:   "required",
+		TimeoutMs:          uint32(auth.PasskeySessionTokenDuration.Milliseconds()),
+	}
+	var userID int32
+	if request.TwoFactorToken != "" {
+		claims, err := auth.ParseTwoFactorToken(request.TwoFactorToken, []byte(s.Secret))
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor token: %v", err)
+		}
+		userID, err = util.ConvertStringToInt32(claims.Subject)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in two-factor token")
+		}
+		passkeys, err := s.Store.GetUserPasskeys(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+		}
+		if len(passkeys) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "no passkeys registered")
+		}
+		for _, passkey := range passkeys {
+			options.AllowCredentialIds = append(options.AllowCredentialIds, passkey.CredentialId)
+		}
+		// The password was the first factor, the passkey only needs to prove possession.
+		options.UserVerification = "preferred"
+	}
+
+	sessionToken, _, err := auth.GeneratePasskeySessionToken(userID, auth.PasskeySignInSession, challenge, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate passkey session token: %v", err)
+	}
+	return &v1pb.BeginPasskeySignInResponse{
+		Options:      options,
+		SessionToken: sessionToken,
+	}, nil
+}
+
+// ListUserPasskeys returns the passkeys of a user.
+//
+// Authorization: The user themselves or an admin.
+func (s *APIV1Service) ListUserPasskeys(ctx context.Context, request *v1pb.ListUserPasskeysRequest) (*v1pb.ListUserPasskeysResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkPasskeyOwnerOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.Store.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+	}
+	response := &v1pb.ListUserPasskeysResponse{
+		Passkeys: []*v1pb.Passkey{},
+	}
+	for _, passkey := range passkeys {
+		response.Passkeys = append(response.Passkeys, convertPasskeyFromStore(userID, passkey))
+	}
+	return response, nil
+}
+
+// DeleteUserPasskey deletes a passkey of a user.
+//
+// Authorization: The user themselves or an admin.
+func (s *APIV1Service) DeleteUserPasskey(ctx context.Context, request *v1pb.DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	userID, passkeyID, err := extractUserAndPasskeyIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey name: %v", err)
+	}
+	if err := s.checkPasskeyOwnerOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.Store.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+	}
+	updatedPasskeys := make([]*storepb.PasskeysUserSetting_Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		if passkey.Id != passkeyID {
+			updatedPasskeys = append(updatedPasskeys, passkey)
+		}
+	}
+	if len(updatedPasskeys) == len(passkeys) {
+		return nil, status.Errorf(codes.NotFound, "passkey not found")
+	}
+	if err := s.Store.UpsertUserPasskeys(ctx, userID, updatedPasskeys); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete passkey: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// verifyPasskeySignIn verifies the passkey credentials of a sign-in and returns the signed in user.
+// The returned errors are gRPC status errors.
+func (s *APIV1Service) verifyPasskeySignIn(ctx context.Context, credentials *v1pb.SignInRequest_PasskeyCredentials) (*store.User, error) {
+	claims, err := auth.ParsePasskeySessionToken(credentials.SessionToken, auth.PasskeySignInSession, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid passkey session token: %v", err)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(claims.Challenge)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid passkey session token: %v", err)
+	}
+
+	// A session bound to a user is the second factor of a password sign-in.
+	secondFactor := claims.Subject != ""
+	var userID int32
+	if secondFactor {
+		userID, err = util.ConvertStringToInt32(claims.Subject)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in passkey session token")
+		}
+	}
+	if len(credentials.UserHandle) > 0 {
+		handleUserID, err := util.ConvertStringToInt32(string(credentials.UserHandle))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user handle")
+		}
+		if secondFactor && handleUserID != userID {
+			return nil, status.Errorf(codes.InvalidArgument, "passkey not found")
+		}
+		userID = handleUserID
+	}
+	if userID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user handle is required")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "passkey not found")
+	}
+	passkeys, err := s.Store.GetUserPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get passkeys: %v", err)
+	}
+	index := -1
+	for i, passkey := range passkeys {
+		if bytes.Equal(passkey.CredentialId, credentials.CredentialId) {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return nil, status.Errorf(codes.InvalidArgument, "passkey not found")
+	}
+
+	relyingParty, err := s.getPasskeyRelyingParty()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are not available: %v", err)
+	}
+	assertion, err := relyingParty.VerifyAssertion(&webauthn.Credential{
+		ID:        passkeys[index].CredentialId,
+		PublicKey: passkeys[index].PublicKey,
+		SignCount: passkeys[index].SignCount,
+	}, challenge, credentials.ClientDataJson, credentials.AuthenticatorData, credentials.Signature)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey assertion: %v", err)
+	}
+	// A passkey on its own replaces both factors, so the authenticator must have verified the user.
+	if !secondFactor && !assertion.UserVerified {
+		return nil, status.Errorf(codes.InvalidArgument, "user verification is required to sign in with a passkey")
+	}
+	// Most passkeys do not keep a signature counter, remember the challenge to reject replayed assertions.
+	claimed, err := s.claimPasskeyChallenge(ctx, claims.Challenge, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to claim passkey session: %v", err)
+	}
+	if !claimed {
+		return nil, status.Errorf(codes.InvalidArgument, "passkey session has already been used")
+	}
+
+	// Work on copies, the store may have handed out its cached setting.
+	updatedPasskeys := make([]*storepb.PasskeysUserSetting_Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		updatedPasskeys = append(updatedPasskeys, proto.CloneOf(passkey))
+	}
+	updatedPasskeys[index].SignCount = assertion.SignCount
+	updatedPasskeys[index].LastUsedAt = timestamppb.Now()
+	if err := s.Store.UpsertUserPasskeys(ctx, user.ID, updatedPasskeys); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save passkey: %v", err)
+	}
+	return user, nil
+}
+
+// claimPasskeyChallenge marks the challenge of a passkey session as used.
+// It returns false if the challenge was used before, so that each session completes at most once.
+// Used challenges are stored until their session expires, so that replays are rejected across restarts and servers.
+func (s *APIV1Service) claimPasskeyChallenge(ctx context.Context, challenge string, expiresAt time.Time) (bool, error) {
+	now := time.Now().Unix()
+	if err := s.Store.DeletePasskeyChallenge(ctx, &store.DeletePasskeyChallenge{ExpiresTsBefore: &now}); err != nil {
+		return false, errors.Wrap(err, "failed to delete expired passkey challenges")
+	}
+	claimed, err := s.Store.CreatePasskeyChallenge(ctx, &store.PasskeyChallenge{
+		Challenge: challenge,
+		ExpiresTs: expiresAt.Unix(),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save passkey challenge")
+	}
+	return claimed, nil
+}
+
+// getPasskeyRelyingParty returns the relying party of the instance, derived from the instance URL.
+// The origin of the request is not used, as it is chosen by the client.
+func (s *APIV1Service) getPasskeyRelyingParty() (*webauthn.RelyingParty, error) {
+	if s.Profile == nil || s.Profile.InstanceURL == "" {
+		return nil, errors.New("the instance URL is not configured")
+	}
+	originURL, err := url.Parse(s.Profile.InstanceURL)
+	if err != nil || originURL.Scheme == "" || originURL.Hostname() == "" {
+		return nil, errors.Errorf("invalid instance URL %q", s.Profile.InstanceURL)
+	}
+	return &webauthn.RelyingParty{
+		ID:     originURL.Hostname(),
+		Origin: fmt.Sprintf("%s://%s", originURL.Scheme, originURL.Host),
+	}, nil
+}
+
+func (s *APIV1Service) getPasskeyRelyingPartyName(ctx context.Context) string {
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err == nil && instanceGeneralSetting.GetCustomProfile().GetTitle() != "" {
+		return instanceGeneralSetting.GetCustomProfile().GetTitle()
+	}
+	return defaultPasskeyRelyingPartyName
+}
+
+func (s *APIV1Service) checkPasskeyOwnerOrAdmin(ctx context.Context, userID int32) error {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != userID && !isSuperUser(currentUser)) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// extractUserAndPasskeyIDFromName returns the user ID and passkey ID from a passkey name.
+// e.g., "users/1/passkeys/abc" -> 1, "abc".
+func extractUserAndPasskeyIDFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "passkeys" || parts[3] == "" {
+		return 0, "", errors.Errorf("invalid passkey name format: %s", name)
+	}
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+	return userID, parts[3], nil
+}
+
+func convertPasskeyFromStore(userID int32, passkey *storepb.PasskeysUserSetting_Passkey) *v1pb.Passkey {
+	return &v1pb.Passkey{
+		Name:         fmt.Sprintf("%s%d/passkeys/%s", UserNamePrefix, userID, passkey.Id),
+		DisplayName:  passkey.DisplayName,
+		Transports:   passkey.Transports,
+		CreateTime:   passkey.CreatedAt,
+		LastUsedTime: passkey.LastUsedAt,
+	}
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/store"
 )
@@ -96,6 +98,7 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	}
 
 	reactionMessage := convertReactionFromStore(reaction)
+	s.dispatchMemoReactionWebhook(ctx, memo, reactionMessage, webhook.ActivityTypeMemoReactionAdded)
 
 	return reactionMessage, nil
 }
@@ -136,9 +139,31 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 		return nil, status.Errorf(codes.Internal, "failed to delete reaction")
 	}
 
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentID)
+	if err == nil {
+		if memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID}); err == nil && memo != nil {
+			s.dispatchMemoReactionWebhook(ctx, memo, convertReactionFromStore(reaction), webhook.ActivityTypeMemoReactionRemoved)
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// dispatchMemoReactionWebhook dispatches webhook to the memo creator when a reaction is added or removed.
+func (s *APIV1Service) dispatchMemoReactionWebhook(ctx context.Context, memo *store.Memo, reaction *v1pb.Reaction, activityType string) {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		slog.Warn("Failed to convert memo for reaction webhook", slog.Any("err", err))
+		return
+	}
+	if err := s.dispatchMemoActivityWebhook(ctx, memoMessage, &webhook.WebhookRequestPayload{
+		ActivityType: activityType,
+		Reaction:     reaction,
+	}); err != nil {
+		slog.Warn("Failed to dispatch memo reaction webhook", slog.String("activityType", activityType), slog.Any("err", err))
+	}
+}
+
 func convertReactionFromStore(reaction *store.Reaction) *v1pb.Reaction {
 	reactionUID := fmt.Sprintf("%d", reaction.ID)
 	// Generate nested resource name: memos/{memo}/reactions/{reaction}
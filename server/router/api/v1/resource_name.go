@@ -11,10 +11,14 @@ import (
 
 const (
 	InstanceSettingNamePrefix  = "instance/settings/"
+	InstanceJobNamePrefix      = "instance/jobs/"
+	InstanceWebhookNamePrefix  = "instance/webhooks/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
 	AttachmentNamePrefix       = "attachments/"
 	ReactionNamePrefix         = "reactions/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoReminderNamePrefix     = "reminders/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identity-providers/"
 	ActivityNamePrefix         = "activities/"
@@ -60,6 +64,32 @@ func ExtractInstanceSettingKeyFromName(name string) (string, error) {
 	return settingKey, nil
 }
 
+// ExtractInstanceJobNameFromName returns the job name from a resource name.
+// e.g., "instance/jobs/s3-presign" -> "s3-presign".
+func ExtractInstanceJobNameFromName(name string) (string, error) {
+	if !strings.HasPrefix(name, InstanceJobNamePrefix) {
+		return "", errors.Errorf("invalid job name: expected prefix %q, got %q", InstanceJobNamePrefix, name)
+	}
+	jobName := strings.TrimPrefix(name, InstanceJobNamePrefix)
+	if jobName == "" || strings.Contains(jobName, "/") {
+		return "", errors.Errorf("invalid job name %q", name)
+	}
+	return jobName, nil
+}
+
+// ExtractInstanceWebhookIDFromName returns the webhook ID from a resource name.
+// e.g., "instance/webhooks/0a1b2c3d" -> "0a1b2c3d".
+func ExtractInstanceWebhookIDFromName(name string) (string, error) {
+	if !strings.HasPrefix(name, InstanceWebhookNamePrefix) {
+		return "", errors.Errorf("invalid webhook name: expected prefix %q, got %q", InstanceWebhookNamePrefix, name)
+	}
+	webhookID := strings.TrimPrefix(name, InstanceWebhookNamePrefix)
+	if webhookID == "" || strings.Contains(webhookID, "/") {
+		return "", errors.Errorf("invalid webhook name %q", name)
+	}
+	return webhookID, nil
+}
+
 // ExtractUserIDFromName returns the uid from a resource name.
 func ExtractUserIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -120,6 +150,36 @@ func ExtractMemoReactionIDFromName(name string) (string, int32, error) {
 	return memoUID, reactionID, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo UID and revision ID from a resource name.
+// e.g., "memos/abc/revisions/123" -> ("abc", 123).
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	memoUID := tokens[0]
+	revisionID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid revision ID %q", tokens[1])
+	}
+	return memoUID, revisionID, nil
+}
+
+// ExtractMemoReminderIDFromName returns the memo UID and reminder ID from a resource name.
+// e.g., "memos/abc/reminders/123" -> ("abc", 123).
+func ExtractMemoReminderIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoReminderNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	memoUID := tokens[0]
+	reminderID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid reminder ID %q", tokens[1])
+	}
+	return memoUID, reminderID, nil
+}
+
 // ExtractInboxIDFromName returns the inbox ID from a resource name.
 func ExtractInboxIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, InboxNamePrefix)
@@ -0,0 +1,432 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// tagColorPattern matches a color in #RRGGBB format.
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+func (s *APIV1Service) ListTags(ctx context.Context, _ *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	tags, err := s.listUserTags(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	return &v1pb.ListTagsResponse{Tags: buildTagTree(tags)}, nil
+}
+
+func (s *APIV1Service) UpdateTag(ctx context.Context, request *v1pb.UpdateTagRequest) (*v1pb.Tag, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if err := s.validateTag(request.Tag.Tag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	settings, err := s.Store.GetUserTagSettings(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag settings: %v", err)
+	}
+	key := strings.ToLower(request.Tag.Tag)
+	setting := &storepb.TagsUserSetting_Tag{}
+	if existing, ok := settings[key]; ok {
+		setting = existing
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "color":
+			if request.Tag.Color != "" && !tagColorPattern.MatchString(request.Tag.Color) {
+				return nil, status.Errorf(codes.InvalidArgument, "color must be in #RRGGBB format")
+			}
+			setting.Color = request.Tag.Color
+		case "description":
+			setting.Description = request.Tag.Description
+		case "pinned":
+			setting.Pinned = request.Tag.Pinned
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	// Drop empty metadata so that unused tags disappear from the list.
+	if setting.Color == "" && setting.Description == "" && !setting.Pinned {
+		delete(settings, key)
+	} else {
+		settings[key] = setting
+	}
+	if err := s.Store.UpsertUserTagSettings(ctx, user.ID, settings); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag settings: %v", err)
+	}
+
+	tags, err := s.listUserTags(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	if tag, ok := tags[key]; ok {
+		// Link the nested tags so that the returned tag includes its children.
+		buildTagTree(tags)
+		return tag, nil
+	}
+	return &v1pb.Tag{Tag: key}, nil
+}
+
+func (s *APIV1Service) RenameTag(ctx context.Context, request *v1pb.RenameTagRequest) (*v1pb.RenameTagResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.validateTag(request.Tag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+	if err := s.validateTag(request.NewTag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new tag: %v", err)
+	}
+
+	count, err := s.renameUserTags(ctx, user.ID, []string{request.Tag}, request.NewTag, request.ValidateOnly)
+	if errors.Is(err, store.ErrMemoConflict) {
+		return nil, status.Errorf(codes.Aborted, "memos changed while updating them, please try again")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rename tag: %v", err)
+	}
+	return &v1pb.RenameTagResponse{AffectedMemoCount: count}, nil
+}
+
+func (s *APIV1Service) MergeTags(ctx context.Context, request *v1pb.MergeTagsRequest) (*v1pb.MergeTagsResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if len(request.SourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+	for _, tag := range request.SourceTags {
+		if err := s.validateTag(tag); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid source tag: %v", err)
+		}
+	}
+	if err := s.validateTag(request.TargetTag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target tag: %v", err)
+	}
+
+	count, err := s.renameUserTags(ctx, user.ID, request.SourceTags, request.TargetTag, request.ValidateOnly)
+	if errors.Is(err, store.ErrMemoConflict) {
+		return nil, status.Errorf(codes.Aborted, "memos changed while updating them, please try again")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to merge tags: %v", err)
+	}
+	return &v1pb.MergeTagsResponse{AffectedMemoCount: count}, nil
+}
+
+func (s *APIV1Service) DeleteTag(ctx context.Context, request *v1pb.DeleteTagRequest) (*v1pb.DeleteTagResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.validateTag(request.Tag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+
+	// Renaming to an empty tag removes it from the content.
+	count, err := s.renameUserTags(ctx, user.ID, []string{request.Tag}, "", request.ValidateOnly)
+	if errors.Is(err, store.ErrMemoConflict) {
+		return nil, status.Errorf(codes.Aborted, "memos changed while updating them, please try again")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	}
+	return &v1pb.DeleteTagResponse{AffectedMemoCount: count}, nil
+}
+
+// renameUserTags renames the tags, and their nested tags, to newTag in all memos of the user,
+// including archived memos and comments. An empty newTag removes the tags.
+// All memos and the tag metadata are updated in a single transaction, which fails with
+// store.ErrMemoConflict if a memo changes in the meantime; if validateOnly is set, nothing is updated.
+// Like edits, the changes bump the update time of the memos and are recorded in their revision history.
+// It returns the number of affected memos.
+func (s *APIV1Service) renameUserTags(ctx context.Context, userID int32, tags []string, newTag string, validateOnly bool) (int32, error) {
+	// Tags are stored lowercase in the payload.
+	quoted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		quoted = append(quoted, strconv.Quote(strings.ToLower(tag)))
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &userID,
+		Filters:   []string{fmt.Sprintf("tag in [%s]", strings.Join(quoted, ", "))},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list memos")
+	}
+
+	now := time.Now().Unix()
+	changed := []*store.Memo{}
+	previousContents := []string{}
+	updates := []*store.UpdateMemo{}
+	for _, memo := range memos {
+		originalContent, content := memo.Content, memo.Content
+		for _, tag := range tags {
+			content, err = s.MarkdownService.RenameTag([]byte(content), tag, newTag)
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to rename tag in memo %s", memo.UID)
+			}
+		}
+		if content == memo.Content {
+			continue
+		}
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return 0, errors.Wrapf(err, "failed to rebuild payload of memo %s", memo.UID)
+		}
+		changed = append(changed, memo)
+		previousContents = append(previousContents, originalContent)
+		updates = append(updates, &store.UpdateMemo{
+			ID:                memo.ID,
+			UpdatedTs:         &now,
+			Content:           &content,
+			Payload:           memo.Payload,
+			ExpectedContent:   &originalContent,
+			ExpectedUpdatedTs: &memo.UpdatedTs,
+		})
+	}
+
+	if validateOnly {
+		return int32(len(updates)), nil
+	}
+	tagSetting, err := s.renameUserTagSettings(ctx, userID, tags, newTag)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to rename tag settings")
+	}
+	// Memos created before revision history existed have no revisions yet,
+	// so keep their current state before it gets overwritten.
+	for i, memo := range changed {
+		if err := s.seedMemoRevision(ctx, memo.ID, previousContents[i], memo.Visibility, memo.CreatorID, memo.UpdatedTs); err != nil {
+			return 0, err
+		}
+	}
+	if len(updates) > 0 || tagSetting != nil {
+		if err := s.Store.UpdateMemos(ctx, updates, tagSetting); err != nil {
+			return 0, errors.Wrap(err, "failed to update memos")
+		}
+	}
+	for _, memo := range changed {
+		if err := s.createMemoRevision(ctx, memo, userID); err != nil {
+			return 0, err
+		}
+	}
+	return int32(len(updates)), nil
+}
+
+// renameUserTagSettings moves the metadata of the tags, and their nested tags, to newTag.
+// Existing metadata of the new tag is kept. An empty newTag removes the metadata.
+// It returns the updated setting to store, or nil if no tag has metadata.
+func (s *APIV1Service) renameUserTagSettings(ctx context.Context, userID int32, tags []string, newTag string) (*storepb.UserSetting, error) {
+	settings, err := s.Store.GetUserTagSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	renamed := make(map[string]*storepb.TagsUserSetting_Tag, len(settings))
+	moved := make(map[string]*storepb.TagsUserSetting_Tag)
+	for key, setting := range settings {
+		newKey, matched := key, false
+		for _, tag := range tags {
+			oldTag := strings.ToLower(tag)
+			if key == oldTag || strings.HasPrefix(key, oldTag+"/") {
+				newKey, matched = strings.ToLower(newTag)+key[len(oldTag):], true
+				break
+			}
+		}
+		if !matched {
+			renamed[key] = setting
+		} else if newTag != "" {
+			moved[newKey] = setting
+		}
+	}
+	if len(renamed) == len(settings) {
+		return nil, nil
+	}
+	for key, setting := range moved {
+		if _, ok := renamed[key]; !ok {
+			renamed[key] = setting
+		}
+	}
+	return &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TAGS,
+		Value: &storepb.UserSetting_Tags{
+			Tags: &storepb.TagsUserSetting{
+				Tags: renamed,
+			},
+		},
+	}, nil
+}
+
+// listUserTags returns the tags used in the normal memos of the user and the tags with metadata,
+// keyed by the lowercase tag path. The parents of nested tags are always included.
+func (s *APIV1Service) listUserTags(ctx context.Context, userID int32) (map[string]*v1pb.Tag, error) {
+	tags := make(map[string]*v1pb.Tag)
+	var ensureTag func(path string) *v1pb.Tag
+	ensureTag = func(path string) *v1pb.Tag {
+		if tag, ok := tags[path]; ok {
+			return tag
+		}
+		tag := &v1pb.Tag{Tag: path, Children: []*v1pb.Tag{}}
+		tags[path] = tag
+		if index := strings.LastIndex(path, "/"); index > 0 {
+			ensureTag(path[:index])
+		}
+		return tag
+	}
+
+	normalStatus := store.Normal
+	limit := 1000
+	offset := 0
+	memoFind := &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		Limit:           &limit,
+		Offset:          &offset,
+	}
+	lastUsedTs := make(map[string]int64)
+	for {
+		memos, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		for _, memo := range memos {
+			// A memo counts once for each tag and each of its parents.
+			seen, paths := make(map[string]bool), make(map[string]bool)
+			for _, path := range memo.Payload.GetTags() {
+				if seen[path] {
+					continue
+				}
+				seen[path] = true
+				ensureTag(path).MemoCount++
+				for {
+					paths[path] = true
+					index := strings.LastIndex(path, "/")
+					if index <= 0 {
+						break
+					}
+					path = path[:index]
+				}
+			}
+			for path := range paths {
+				tags[path].TotalMemoCount++
+				lastUsedTs[path] = max(lastUsedTs[path], memo.UpdatedTs)
+			}
+		}
+
+		offset += limit
+	}
+	for path, ts := range lastUsedTs {
+		tags[path].LastUsedTime = timestamppb.New(time.Unix(ts, 0))
+	}
+
+	settings, err := s.Store.GetUserTagSettings(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tag settings")
+	}
+	for path, setting := range settings {
+		tag := ensureTag(path)
+		tag.Color = setting.Color
+		tag.Description = setting.Description
+		tag.Pinned = setting.Pinned
+	}
+	return tags, nil
+}
+
+// buildTagTree links the nested tags to their parents and returns the sorted top-level tags.
+func buildTagTree(tags map[string]*v1pb.Tag) []*v1pb.Tag {
+	roots := []*v1pb.Tag{}
+	for path, tag := range tags {
+		index := strings.LastIndex(path, "/")
+		if parent, ok := tags[path[:max(index, 0)]]; index > 0 && ok {
+			parent.Children = append(parent.Children, tag)
+		} else {
+			roots = append(roots, tag)
+		}
+	}
+	sortTags(roots)
+	return roots
+}
+
+// sortTags orders pinned tags first, then by name, recursively.
+func sortTags(tags []*v1pb.Tag) {
+	slices.SortFunc(tags, func(a, b *v1pb.Tag) int {
+		if a.Pinned != b.Pinned {
+			if a.Pinned {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	for _, tag := range tags {
+		sortTags(tag.Children)
+	}
+}
+
+// validateTag checks that the tag is written without # and parses as a single tag.
+func (s *APIV1Service) validateTag(tag string) error {
+	if tag == "" {
+		return errors.New("tag is required")
+	}
+	tags, err := s.MarkdownService.ExtractTags([]byte("#" + tag))
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(tags, []string{strings.ToLower(tag)}) {
+		return errors.Errorf("%q is not a valid tag", tag)
+	}
+	return nil
+}
@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"github.com/usememos/memos/plugin/idp/oidc/oidctest"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
 )
 
 func TestCreateIdentityProvider(t *testing.T) {
@@ -550,3 +554,100 @@ func TestIdentityProviderPermissions(t *testing.T) {
 		require.Contains(t, err.Error(), "user not authenticated")
 	})
 }
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	issuer, err := oidctest.NewIssuer("memos")
+	require.NoError(t, err)
+	defer issuer.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	newOIDCProvider := func(issuerURL string) *v1pb.IdentityProvider {
+		return &v1pb.IdentityProvider{
+			Title: "Test OIDC Provider",
+			Type:  v1pb.IdentityProvider_OIDC,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_OidcConfig{
+					OidcConfig: &v1pb.OIDCConfig{
+						Issuer:       issuerURL,
+						ClientId:     "memos",
+						ClientSecret: "test-client-secret",
+					},
+				},
+			},
+		}
+	}
+
+	// The issuer is checked with its discovery document.
+	_, err = ts.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: newOIDCProvider(issuer.URL + "/missing"),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	identityProvider, err := ts.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: newOIDCProvider(issuer.URL),
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.IdentityProvider_OIDC, identityProvider.Type)
+	require.Equal(t, issuer.URL+"/authorize", identityProvider.Config.GetOidcConfig().AuthUrl)
+	require.Equal(t, []string{"openid", "profile", "email"}, identityProvider.Config.GetOidcConfig().Scopes)
+
+	// The client secret is only visible to admins.
+	response, err := ts.Service.GetIdentityProvider(ctx, &v1pb.GetIdentityProviderRequest{Name: identityProvider.Name})
+	require.NoError(t, err)
+	require.Empty(t, response.Config.GetOidcConfig().ClientSecret)
+	require.Equal(t, issuer.URL+"/authorize", response.Config.GetOidcConfig().AuthUrl)
+
+	idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+	require.NoError(t, err)
+	signIn := func(nonce string, claims map[string]any) (*v1pb.SignInResponse, error) {
+		idTokenClaims := issuer.Claims("248289761001", "test-nonce")
+		for key, value := range claims {
+			idTokenClaims[key] = value
+		}
+		idToken, err := issuer.SignIDToken(idTokenClaims)
+		require.NoError(t, err)
+		return ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+			Credentials: &v1pb.SignInRequest_SsoCredentials{
+				SsoCredentials: &v1pb.SignInRequest_SSOCredentials{
+					IdpId:       idpID,
+					Code:        issuer.IssueCode(idToken),
+					RedirectUri: "http://localhost:8080/auth/callback",
+					Nonce:       nonce,
+				},
+			},
+		})
+	}
+
+	_, err = signIn("", map[string]any{"preferred_username": "jane"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = signIn("other-nonce", map[string]any{"preferred_username": "jane"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = signIn("test-nonce", map[string]any{"preferred_username": "jane", "aud": "other-client"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The first sign-in creates the user from the standard claims.
+	signInResponse, err := signIn("test-nonce", map[string]any{
+		"preferred_username": "jane",
+		"name":               "Jane Doe",
+		"email":              "jane@example.com",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, signInResponse.AccessToken)
+	require.Equal(t, "248289761001", signInResponse.User.Username)
+	require.Equal(t, "Jane Doe", signInResponse.User.DisplayName)
+	require.Equal(t, "jane@example.com", signInResponse.User.Email)
+	signInResponse, err = signIn("test-nonce", map[string]any{"preferred_username": "jane"})
+	require.NoError(t, err)
+	require.Equal(t, "248289761001", signInResponse.User.Username)
+
+	// Users are identified by their subject, taking the name of another user does not sign in as them.
+	signInResponse, err = signIn("test-nonce", map[string]any{"sub": "248289761002", "preferred_username": "jane"})
+	require.NoError(t, err)
+	require.Equal(t, "248289761002", signInResponse.User.Username)
+}
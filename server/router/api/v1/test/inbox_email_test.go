@@ -0,0 +1,165 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// fakeSMTPServer is a minimal SMTP server that records the DATA of received emails.
+type fakeSMTPServer struct {
+	listener net.Listener
+	messages chan string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeSMTPServer{
+		listener: listener,
+		messages: make(chan string, 10),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.handle(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	return server
+}
+
+func (s *fakeSMTPServer) port() int32 {
+	return int32(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	write := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+	write("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			write("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			write("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.messages <- data.String()
+			write("250 OK")
+		case strings.HasPrefix(command, "QUIT"):
+			write("221 Bye")
+			return
+		default:
+			write("250 OK")
+		}
+	}
+}
+
+func TestInboxEmailNotification(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	smtpServer := newFakeSMTPServer(t)
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+
+	_, err = ts.Service.UpdateInstanceSetting(hostCtx, &apiv1.UpdateInstanceSettingRequest{
+		Setting: &apiv1.InstanceSetting{
+			Name: "instance/settings/NOTIFICATION",
+			Value: &apiv1.InstanceSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.InstanceSetting_NotificationSetting{
+					Email: &apiv1.InstanceSetting_NotificationSetting_EmailSetting{
+						Enabled:   true,
+						SmtpHost:  "127.0.0.1",
+						SmtpPort:  smtpServer.port(),
+						FromEmail: "memos@example.com",
+						FromName:  "Memos",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(hostCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Hello world", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	comment := func(content string) {
+		_, err := ts.Service.CreateMemoComment(commenterCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+	}
+
+	// The receiver has not opted in yet, so no email is sent.
+	comment("First comment")
+	select {
+	case message := <-smtpServer.messages:
+		t.Fatalf("unexpected email: %s", message)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	settingName := "users/" + strconv.Itoa(int(host.ID)) + "/settings/NOTIFICATION"
+	setting, err := ts.Service.UpdateUserSetting(hostCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: settingName,
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{EmailEnabled: true},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_enabled"}},
+	})
+	require.NoError(t, err)
+	require.True(t, setting.GetNotificationSetting().EmailEnabled)
+
+	comment("Second **comment**")
+	select {
+	case message := <-smtpServer.messages:
+		require.Contains(t, message, "To: admin@example.com")
+		require.Contains(t, message, "Subject: commenter commented on your memo")
+		require.Contains(t, message, "Second comment")
+		require.Contains(t, message, "http://localhost:8080/"+memo.Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an email to be sent")
+	}
+}
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestInstanceJobs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	jobScheduler := scheduler.New()
+	require.NoError(t, jobScheduler.Register(&scheduler.Job{
+		Name:        "cleanup",
+		Schedule:    "0 0 * * *",
+		Description: "Clean up things.",
+		Handler: func(_ context.Context) error {
+			return errors.New("cleanup failed")
+		},
+	}))
+	ts.Service.Scheduler = jobScheduler
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	regularUser, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, regularUser.ID)
+
+	t.Run("ListInstanceJobs requires admin", func(t *testing.T) {
+		_, err := ts.Service.ListInstanceJobs(userCtx, &apiv1.ListInstanceJobsRequest{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+
+		_, err = ts.Service.RunInstanceJob(userCtx, &apiv1.RunInstanceJobRequest{Name: "instance/jobs/cleanup"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("RunInstanceJob records the last run", func(t *testing.T) {
+		resp, err := ts.Service.ListInstanceJobs(hostCtx, &apiv1.ListInstanceJobsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Jobs, 1)
+		require.Equal(t, "instance/jobs/cleanup", resp.Jobs[0].Name)
+		require.Equal(t, "0 0 * * *", resp.Jobs[0].Schedule)
+		require.Nil(t, resp.Jobs[0].LastRunTime)
+
+		_, err = ts.Service.RunInstanceJob(hostCtx, &apiv1.RunInstanceJobRequest{Name: "instance/jobs/cleanup"})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			resp, err := ts.Service.ListInstanceJobs(hostCtx, &apiv1.ListInstanceJobsRequest{})
+			return err == nil && !resp.Jobs[0].Running && resp.Jobs[0].LastRunTime != nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		resp, err = ts.Service.ListInstanceJobs(hostCtx, &apiv1.ListInstanceJobsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "cleanup failed", resp.Jobs[0].LastError)
+		require.NotNil(t, resp.Jobs[0].LastRunDuration)
+	})
+
+	t.Run("RunInstanceJob with unknown job", func(t *testing.T) {
+		_, err := ts.Service.RunInstanceJob(hostCtx, &apiv1.RunInstanceJobRequest{Name: "instance/jobs/unknown"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "job not found")
+	})
+}
@@ -0,0 +1,233 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestInstanceWebhookPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.ListInstanceWebhooks(ctx, &v1pb.ListInstanceWebhooksRequest{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not authenticated")
+	_, err = ts.Service.ListInstanceWebhooks(userCtx, &v1pb.ListInstanceWebhooksRequest{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "permission denied")
+	_, err = ts.Service.CreateInstanceWebhook(userCtx, &v1pb.CreateInstanceWebhookRequest{
+		Webhook: &v1pb.InstanceWebhook{Url: "https://example.com"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "permission denied")
+}
+
+func TestInstanceWebhookCRUD(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+	_, err = ts.Service.CreateInstanceWebhook(adminCtx, &v1pb.CreateInstanceWebhookRequest{
+		Webhook: &v1pb.InstanceWebhook{Url: "https://example.com", ActivityTypes: []string{"memos.memo.updated"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported activity type")
+
+	created, err := ts.Service.CreateInstanceWebhook(adminCtx, &v1pb.CreateInstanceWebhookRequest{
+		Webhook: &v1pb.InstanceWebhook{
+			Url:           "https://example.com/audit",
+			DisplayName:   "Audit",
+			ActivityTypes: []string{"memos.user.signin", "memos.user.signin"},
+		},
+	})
+	require.NoError(t, err)
+	require.Regexp(t, `^instance/webhooks/[0-9a-f]+$`, created.Name)
+	require.NotEmpty(t, created.Secret)
+	require.Equal(t, []string{"memos.user.signin"}, created.ActivityTypes)
+
+	list, err := ts.Service.ListInstanceWebhooks(adminCtx, &v1pb.ListInstanceWebhooksRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Webhooks, 1)
+	require.Equal(t, "Audit", list.Webhooks[0].DisplayName)
+	require.Empty(t, list.Webhooks[0].Secret)
+
+	updated, err := ts.Service.UpdateInstanceWebhook(adminCtx, &v1pb.UpdateInstanceWebhookRequest{
+		Webhook:    &v1pb.InstanceWebhook{Name: created.Name, DisplayName: "Security", PayloadFormat: v1pb.UserWebhook_SLACK},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "payload_format", "secret"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Security", updated.DisplayName)
+	require.Equal(t, "https://example.com/audit", updated.Url)
+	require.Equal(t, v1pb.UserWebhook_SLACK, updated.PayloadFormat)
+	require.NotEmpty(t, updated.Secret)
+	require.NotEqual(t, created.Secret, updated.Secret)
+
+	// Instance webhooks cannot be overwritten through the generic instance settings.
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{Name: "instance/settings/WEBHOOKS"},
+	})
+	require.Error(t, err)
+	_, err = ts.Service.GetInstanceSetting(adminCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/WEBHOOKS"})
+	require.Error(t, err)
+
+	_, err = ts.Service.DeleteInstanceWebhook(adminCtx, &v1pb.DeleteInstanceWebhookRequest{Name: created.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteInstanceWebhook(adminCtx, &v1pb.DeleteInstanceWebhookRequest{Name: created.Name})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+	list, err = ts.Service.ListInstanceWebhooks(adminCtx, &v1pb.ListInstanceWebhooksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.Webhooks)
+}
+
+func TestInstanceWebhookActivities(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+	hook, err := ts.Service.CreateInstanceWebhook(adminCtx, &v1pb.CreateInstanceWebhookRequest{
+		Webhook: &v1pb.InstanceWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+	webhookID, err := apiv1.ExtractInstanceWebhookIDFromName(hook.Name)
+	require.NoError(t, err)
+
+	// Create a user, then sign in, promote, publish a private and a public memo and delete the user.
+	created, err := ts.Service.CreateUser(adminCtx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{Username: "alice", Password: "secret-password"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{Username: "alice", Password: "secret-password"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: created.Name, Role: v1pb.User_ADMIN},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	require.NoError(t, err)
+	for _, visibility := range []v1pb.Visibility{v1pb.Visibility_PRIVATE, v1pb.Visibility_PUBLIC} {
+		_, err = ts.Service.CreateMemo(adminCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Hello " + visibility.String(), Visibility: visibility},
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{Name: created.Name})
+	require.NoError(t, err)
+
+	creatorID := store.InstanceWebhookCreatorID
+	deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &creatorID,
+		WebhookID: &webhookID,
+	})
+	require.NoError(t, err)
+	activityTypes := []string{}
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		activityTypes = append(activityTypes, deliveries[i].ActivityType)
+	}
+	require.Equal(t, []string{
+		"memos.user.created",
+		"memos.user.signin",
+		"memos.user.role.changed",
+		"memos.memo.created",
+		"memos.user.deleted",
+	}, activityTypes)
+	require.Contains(t, deliveries[4].Payload, `"username":"alice"`)
+	require.Contains(t, deliveries[2].Payload, `"previousRole":"USER"`)
+	require.Contains(t, deliveries[1].Payload, "Hello PUBLIC")
+	waitForWebhookDeliveries(t, ts)
+}
+
+func TestInstanceWebhookSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	received := make(chan signedRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- signedRequest{header: r.Header.Get(webhook.SignatureHeader), body: body}
+	}))
+	defer server.Close()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	bob, err := ts.Store.CreateUser(ctx, &store.User{Username: "bob", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+
+	hook, err := ts.Service.CreateInstanceWebhook(adminCtx, &v1pb.CreateInstanceWebhookRequest{
+		Webhook: &v1pb.InstanceWebhook{Url: server.URL, ActivityTypes: []string{"memos.user.deleted"}},
+	})
+	require.NoError(t, err)
+
+	// The webhook does not subscribe to sign-ins.
+	_, err = ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{Username: "bob", Password: "secret-password"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{Name: fmt.Sprintf("users/%d", bob.ID)})
+	require.NoError(t, err)
+
+	// Requests of instance webhooks are signed with the instance webhook secret.
+	select {
+	case request := <-received:
+		require.NoError(t, webhook.VerifySignature(hook.Secret, request.header, request.body, time.Minute, time.Now()))
+		payload := &webhook.WebhookRequestPayload{}
+		require.NoError(t, json.Unmarshal(request.body, payload))
+		require.Equal(t, "memos.user.deleted", payload.ActivityType)
+		require.Equal(t, fmt.Sprintf("users/%d", admin.ID), payload.Creator)
+		require.Equal(t, "bob", payload.User.Username)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a webhook request")
+	}
+	select {
+	case <-received:
+		t.Fatal("unexpected webhook request")
+	case <-time.After(100 * time.Millisecond):
+	}
+	waitForWebhookDeliveries(t, ts)
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestArchiveMemoLink(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	// A server on the loopback interface stands in for an internal service.
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested = true
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><body><article>Secret</article></body></html>`))
+	}))
+	defer server.Close()
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Read " + server.URL, Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	archive := func(userCtx context.Context, url string) codes.Code {
+		_, err := ts.Service.ArchiveMemoLink(userCtx, &apiv1.ArchiveMemoLinkRequest{Name: memo.Name, Url: url})
+		return status.Code(err)
+	}
+	// Only the creator can archive links, and only links in the memo.
+	require.Equal(t, codes.PermissionDenied, archive(bobCtx, server.URL))
+	require.Equal(t, codes.InvalidArgument, archive(aliceCtx, "https://example.com/other"))
+	// Internal addresses are never fetched.
+	require.Equal(t, codes.InvalidArgument, archive(aliceCtx, server.URL))
+	require.False(t, requested)
+
+	attachments, err := ts.Service.ListMemoAttachments(aliceCtx, &apiv1.ListMemoAttachmentsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Empty(t, attachments.Attachments)
+}
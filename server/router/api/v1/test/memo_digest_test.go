@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoDigest(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	smtpServer := newFakeSMTPServer(t)
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateInstanceSetting(ts.CreateUserContext(ctx, host.ID), &apiv1.UpdateInstanceSettingRequest{
+		Setting: &apiv1.InstanceSetting{
+			Name: "instance/settings/NOTIFICATION",
+			Value: &apiv1.InstanceSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.InstanceSetting_NotificationSetting{
+					Email: &apiv1.InstanceSetting_NotificationSetting_EmailSetting{
+						Enabled:   true,
+						SmtpHost:  "127.0.0.1",
+						SmtpPort:  smtpServer.port(),
+						FromEmail: "memos@example.com",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: userName + "/settings/GENERAL",
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Mars/Olympus_Mons"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Pick the time zone in which it is now the digest hour, so that the digest is sent right away.
+	offset := (8 - time.Now().UTC().Hour() + 24) % 24
+	if offset > 12 {
+		offset -= 24
+	}
+	timezone := "Etc/GMT"
+	if offset > 0 {
+		timezone = fmt.Sprintf("Etc/GMT-%d", offset)
+	} else if offset < 0 {
+		timezone = fmt.Sprintf("Etc/GMT+%d", -offset)
+	}
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: userName + "/settings/GENERAL",
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: timezone, Locale: "es"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone", "locale"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, timezone, setting.GetGeneralSetting().Timezone)
+	location, err := time.LoadLocation(timezone)
+	require.NoError(t, err)
+	now := time.Now().In(location)
+
+	createMemo := func(content string, createTime time.Time) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE, CreateTime: timestamppb.New(createTime)},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	oneYearAgo := createMemo("one year ago", now.AddDate(-1, 0, 0))
+	twoYearsAgo := createMemo("two years ago", now.AddDate(-2, 0, 0))
+	dayBefore := createMemo("one year and a day ago", now.AddDate(-1, 0, -1))
+	createMemo("today", now)
+	threeDaysAgo := createMemo("#review three days ago", now.AddDate(0, 0, -3))
+	fourDaysAgo := createMemo("#review four days ago", now.AddDate(0, 0, -4))
+	sixtyDaysAgo := createMemo("#review sixty days ago", now.AddDate(0, 0, -60))
+	createMemo("three days ago without the tag", now.AddDate(0, 0, -3))
+
+	memoNames := func(memos []*apiv1.Memo) []string {
+		names := []string{}
+		for _, memo := range memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	digest, err := ts.Service.GetMemoDigest(userCtx, &apiv1.GetMemoDigestRequest{})
+	require.NoError(t, err)
+	require.Equal(t, now.Format("2006-01-02"), digest.Date)
+	require.Equal(t, []string{oneYearAgo.Name, twoYearsAgo.Name}, memoNames(digest.OnThisDayMemos))
+	require.Equal(t, []string{threeDaysAgo.Name, sixtyDaysAgo.Name}, memoNames(digest.ReviewMemos))
+
+	digest, err = ts.Service.GetMemoDigest(userCtx, &apiv1.GetMemoDigestRequest{Date: now.AddDate(0, 0, -1).Format("2006-01-02")})
+	require.NoError(t, err)
+	require.Equal(t, []string{dayBefore.Name}, memoNames(digest.OnThisDayMemos))
+	require.Equal(t, []string{fourDaysAgo.Name}, memoNames(digest.ReviewMemos))
+
+	_, err = ts.Service.GetMemoDigest(userCtx, &apiv1.GetMemoDigestRequest{Date: "yesterday"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The digest is only sent to users who enabled it.
+	require.NoError(t, ts.Service.SendMemoDigests(ctx))
+	notifications, err := ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{Parent: userName})
+	require.NoError(t, err)
+	require.Empty(t, notifications.Notifications)
+
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: userName + "/settings/NOTIFICATION",
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{DigestEnabled: true, EmailEnabled: true},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"digest_enabled", "email_enabled"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.SendMemoDigests(ctx))
+	// Each day's digest is only sent once.
+	require.NoError(t, ts.Service.SendMemoDigests(ctx))
+
+	notifications, err = ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{Parent: userName})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+	require.Equal(t, apiv1.UserNotification_DIGEST, notifications.Notifications[0].Type)
+	activity, err := ts.Service.GetActivity(userCtx, &apiv1.GetActivityRequest{Name: fmt.Sprintf("activities/%d", notifications.Notifications[0].GetActivityId())})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Activity_MEMO_DIGEST, activity.Type)
+	payload := activity.Payload.GetMemoDigest()
+	require.Equal(t, now.Format("2006-01-02"), payload.Date)
+	require.Equal(t, []string{oneYearAgo.Name, twoYearsAgo.Name}, payload.OnThisDayMemos)
+	require.Equal(t, []string{threeDaysAgo.Name, sixtyDaysAgo.Name}, payload.ReviewMemos)
+
+	// The email is written in the locale of the user.
+	select {
+	case message := <-smtpServer.messages:
+		require.Contains(t, message, "Subject: Tus memos del "+now.Format("2006-01-02"))
+		require.Contains(t, message, "Un día como hoy")
+		require.Contains(t, message, fmt.Sprintf("%d: one year ago", now.Year()-1))
+		require.Contains(t, message, "Para repasar")
+		require.Contains(t, message, "sixty days ago")
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an email to be sent")
+	}
+
+	// Changing the notification setting does not send the day's digest again.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: userName + "/settings/NOTIFICATION",
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{EmailEnabled: false},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_enabled"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.SendMemoDigests(ctx))
+	notifications, err = ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{Parent: userName})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+}
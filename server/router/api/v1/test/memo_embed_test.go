@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/markdown"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEmbedResolver(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+
+	createMemo := func(content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: content, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	publicMemo := createMemo("Public content", v1pb.Visibility_PUBLIC)
+	protectedMemo := createMemo("Protected content", v1pb.Visibility_PROTECTED)
+	privateMemo := createMemo("Private content", v1pb.Visibility_PRIVATE)
+	archivedMemo := createMemo("Archived content", v1pb.Visibility_PUBLIC)
+	_, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: archivedMemo.Name, State: v1pb.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+
+	content := []byte("![[" + publicMemo.Name + "]] ![[" + protectedMemo.Name + "]] ![[" + privateMemo.Name + "]] ![[" + archivedMemo.Name + "]]")
+	render := func(viewer *store.User) string {
+		html, err := ts.Service.MarkdownService.RenderHTML(content, markdown.WithEmbedResolver(ctx, apiv1.NewMemoEmbedResolver(ts.Store, viewer)))
+		require.NoError(t, err)
+		return html
+	}
+
+	// Anonymous viewers only see public memos.
+	html := render(nil)
+	require.Contains(t, html, "Public content")
+	require.NotContains(t, html, "Protected content")
+	require.NotContains(t, html, "Private content")
+	require.NotContains(t, html, "Archived content")
+
+	// Signed-in users also see protected memos.
+	html = render(bob)
+	require.Contains(t, html, "Public content")
+	require.Contains(t, html, "Protected content")
+	require.NotContains(t, html, "Private content")
+
+	// The creator sees their private memos.
+	html = render(alice)
+	require.Contains(t, html, "Private content")
+	require.NotContains(t, html, "Archived content")
+}
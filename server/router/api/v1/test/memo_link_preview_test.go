@@ -0,0 +1,74 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/linkpreview"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoLinkPreviews(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// A server on the loopback interface stands in for an internal service.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><head><title>Internal</title></head></html>`))
+	}))
+	defer server.Close()
+
+	// Fresh metadata is not fetched again, so the test does not depend on the network.
+	now := time.Now().Unix()
+	_, err = ts.Store.UpsertLinkMetadata(ctx, &store.LinkMetadata{
+		URL:         "https://example.com/docs",
+		FetchedTs:   now,
+		Title:       "Docs",
+		Description: "Read the docs",
+		Image:       "https://example.com/cover.png",
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertLinkMetadata(ctx, &store.LinkMetadata{
+		URL:          "https://example.com/broken",
+		FetchedTs:    now,
+		ErrorMessage: "not a HTML page",
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "See " + server.URL + ", https://example.com/broken and [docs](https://example.com/docs)",
+			Visibility: apiv1.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+
+	// Internal addresses are never fetched.
+	require.NoError(t, linkpreview.NewRunner(ts.Store).Refresh(ctx, []string{server.URL}))
+	list, err := ts.Store.ListLinkMetadata(ctx, &store.FindLinkMetadata{URLList: []string{server.URL}})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Contains(t, list[0].ErrorMessage, "internal addresses are not allowed")
+
+	// Only successfully fetched previews are returned.
+	memo, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, memo.LinkPreviews, 1)
+	require.Equal(t, "https://example.com/docs", memo.LinkPreviews[0].Url)
+	require.Equal(t, "Docs", memo.LinkPreviews[0].Title)
+	require.Equal(t, "Read the docs", memo.LinkPreviews[0].Description)
+	require.Equal(t, "https://example.com/cover.png", memo.LinkPreviews[0].Image)
+}
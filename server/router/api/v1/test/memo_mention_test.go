@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoMentions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	listMentions := func() []*apiv1.UserNotification {
+		response, err := ts.Service.ListUserNotifications(bobCtx, &apiv1.ListUserNotificationsRequest{
+			Parent: fmt.Sprintf("users/%d", bob.ID),
+		})
+		require.NoError(t, err)
+		mentions := []*apiv1.UserNotification{}
+		for _, notification := range response.Notifications {
+			if notification.Type == apiv1.UserNotification_MENTION {
+				mentions = append(mentions, notification)
+			}
+		}
+		return mentions
+	}
+
+	// Mentions are stored in the payload; emails, self-mentions and unknown users are not notified.
+	memo, err := ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "Hi @bob, @alice and @ghost. Mail carol@example.com or @bob.",
+			Visibility: apiv1.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	memoUID := strings.TrimPrefix(memo.Name, "memos/")
+	storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob", "alice", "ghost"}, storeMemo.Payload.Mentions)
+
+	mentions := listMentions()
+	require.Len(t, mentions, 1)
+	require.Equal(t, fmt.Sprintf("users/%d", alice.ID), mentions[0].Sender)
+	activity, err := ts.Service.GetActivity(bobCtx, &apiv1.GetActivityRequest{
+		Name: fmt.Sprintf("activities/%d", mentions[0].GetActivityId()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Activity_MEMO_MENTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoMention().Memo)
+
+	// Editing the memo only notifies newly mentioned users.
+	_, err = ts.Service.UpdateMemo(aliceCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "Hi again @bob"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(), 1)
+
+	// Users cannot see private memos, so they are notified once the memo is no longer private.
+	privateMemo, err := ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Secret plan for @bob", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(), 1)
+	_, err = ts.Service.UpdateMemo(aliceCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: privateMemo.Name, Visibility: apiv1.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(), 2)
+
+	// Users can list the memos that mention them.
+	response, err := ts.Service.ListMemos(bobCtx, &apiv1.ListMemosRequest{Filter: `"bob" in mentions`})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	response, err = ts.Service.ListMemos(bobCtx, &apiv1.ListMemosRequest{Filter: `"carol" in mentions`})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 0)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
 )
@@ -167,3 +168,69 @@ func TestSetMemoRelations(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestMemoWikiLinks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	createMemo := func(content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listRelations := func(memo *apiv1.Memo) []*apiv1.MemoRelation {
+		response, err := ts.Service.ListMemoRelations(userCtx, &apiv1.ListMemoRelationsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return response.Relations
+	}
+
+	gardening := createMemo("# Gardening\n\nNotes about plants.")
+	recipes := createMemo("# Recipes\n\nTomato soup.")
+
+	// Links by title and by name create references, and unknown targets are ignored.
+	note := createMemo("See [[gardening]], [[" + recipes.Name + "]] and [[Nothing here]].")
+	relations := listRelations(note)
+	require.Len(t, relations, 2)
+	related := []string{}
+	for _, relation := range relations {
+		require.Equal(t, note.Name, relation.Memo.Name)
+		require.Equal(t, apiv1.MemoRelation_REFERENCE, relation.Type)
+		related = append(related, relation.RelatedMemo.Name)
+	}
+	require.ElementsMatch(t, []string{gardening.Name, recipes.Name}, related)
+
+	// The linked memo reports the backlink.
+	backlinks := listRelations(gardening)
+	require.Len(t, backlinks, 1)
+	require.Equal(t, note.Name, backlinks[0].Memo.Name)
+	require.Equal(t, gardening.Name, backlinks[0].RelatedMemo.Name)
+
+	// Removing a link from the content removes its reference, but keeps explicit relations.
+	other := createMemo("Unlinked memo")
+	_, err = ts.Service.SetMemoRelations(userCtx, &apiv1.SetMemoRelationsRequest{
+		Name: note.Name,
+		Relations: []*apiv1.MemoRelation{
+			{RelatedMemo: &apiv1.MemoRelation_Memo{Name: other.Name}, Type: apiv1.MemoRelation_REFERENCE},
+		},
+	})
+	require.NoError(t, err)
+	updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: note.Name, Content: "Only [[Gardening]] now."},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	related = []string{}
+	for _, relation := range updated.Relations {
+		related = append(related, relation.RelatedMemo.Name)
+	}
+	require.ElementsMatch(t, []string{gardening.Name, other.Name}, related)
+	require.Empty(t, listRelations(recipes))
+}
@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoReminders(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "renew the certificate", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	// A reminder is either one-time or recurring.
+	for _, reminder := range []*apiv1.MemoReminder{
+		{},
+		{RemindTime: timestamppb.New(time.Now().Add(time.Hour)), Cron: "0 9 * * *"},
+		{RemindTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		{Cron: "not a cron"},
+	} {
+		_, err := ts.Service.CreateMemoReminder(aliceCtx, &apiv1.CreateMemoReminderRequest{Parent: memo.Name, Reminder: reminder})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "reminder %v", reminder)
+	}
+
+	// Only the memo creator can manage the reminders.
+	_, err = ts.Service.ListMemoReminders(bobCtx, &apiv1.ListMemoRemindersRequest{Parent: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	remindTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	once, err := ts.Service.CreateMemoReminder(aliceCtx, &apiv1.CreateMemoReminderRequest{
+		Parent:   memo.Name,
+		Reminder: &apiv1.MemoReminder{RemindTime: timestamppb.New(remindTime)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, remindTime.Unix(), once.RemindTime.AsTime().Unix())
+	require.Equal(t, remindTime.Unix(), once.NextRemindTime.AsTime().Unix())
+	daily, err := ts.Service.CreateMemoReminder(aliceCtx, &apiv1.CreateMemoReminderRequest{
+		Parent:   memo.Name,
+		Reminder: &apiv1.MemoReminder{Cron: "CRON_TZ=UTC 0 9 * * *"},
+	})
+	require.NoError(t, err)
+	require.Nil(t, daily.RemindTime)
+	nextRemindTime := daily.NextRemindTime.AsTime()
+	require.True(t, nextRemindTime.After(time.Now()))
+	require.Equal(t, 9, nextRemindTime.UTC().Hour())
+
+	// Without CRON_TZ, the expression is evaluated in the time zone of the memo creator.
+	_, err = ts.Service.UpdateUserSetting(aliceCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", alice.ID),
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Asia/Tokyo"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.NoError(t, err)
+	tokyo, err := ts.Service.CreateMemoReminder(aliceCtx, &apiv1.CreateMemoReminderRequest{
+		Parent:   memo.Name,
+		Reminder: &apiv1.MemoReminder{Cron: "0 9 * * *"},
+	})
+	require.NoError(t, err)
+	// 9:00 in Tokyo is 0:00 UTC.
+	require.Equal(t, 0, tokyo.NextRemindTime.AsTime().UTC().Hour())
+	_, err = ts.Service.DeleteMemoReminder(aliceCtx, &apiv1.DeleteMemoReminderRequest{Name: tokyo.Name})
+	require.NoError(t, err)
+
+	// Setting a cron expression turns a one-time reminder into a recurring one.
+	weekly, err := ts.Service.CreateMemoReminder(aliceCtx, &apiv1.CreateMemoReminderRequest{
+		Parent:   memo.Name,
+		Reminder: &apiv1.MemoReminder{RemindTime: timestamppb.New(remindTime.Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	weekly, err = ts.Service.UpdateMemoReminder(aliceCtx, &apiv1.UpdateMemoReminderRequest{
+		Reminder:   &apiv1.MemoReminder{Name: weekly.Name, Cron: "@weekly"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cron"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "@weekly", weekly.Cron)
+	require.Nil(t, weekly.RemindTime)
+	_, err = ts.Service.DeleteMemoReminder(aliceCtx, &apiv1.DeleteMemoReminderRequest{Name: weekly.Name})
+	require.NoError(t, err)
+
+	reminders, err := ts.Service.ListMemoReminders(aliceCtx, &apiv1.ListMemoRemindersRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, reminders.Reminders, 2)
+	require.Equal(t, once.Name, reminders.Reminders[0].Name)
+	require.Equal(t, daily.Name, reminders.Reminders[1].Name)
+
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+	}))
+	defer server.Close()
+	_, err = ts.Service.CreateUserWebhook(aliceCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", alice.ID),
+		Webhook: &apiv1.UserWebhook{Url: server.URL, DisplayName: "reminders", ActivityTypes: []string{"memos.memo.reminder"}},
+	})
+	require.NoError(t, err)
+
+	// Nothing is due yet.
+	require.NoError(t, ts.Service.SendDueMemoReminders(ctx))
+	notifications, err := ts.Service.ListUserNotifications(aliceCtx, &apiv1.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", alice.ID)})
+	require.NoError(t, err)
+	require.Empty(t, notifications.Notifications)
+
+	// Make both reminders due.
+	dueTs := time.Now().Add(-time.Minute).Unix()
+	stored, err := ts.Store.ListMemoReminders(ctx, &store.FindMemoReminder{})
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	for _, reminder := range stored {
+		require.NoError(t, ts.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{ID: reminder.ID, RemindTs: &dueTs}))
+	}
+	require.NoError(t, ts.Service.SendDueMemoReminders(ctx))
+
+	notifications, err = ts.Service.ListUserNotifications(aliceCtx, &apiv1.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", alice.ID)})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 2)
+	for _, notification := range notifications.Notifications {
+		require.Equal(t, apiv1.UserNotification_REMINDER, notification.Type)
+		require.Equal(t, fmt.Sprintf("users/%d", alice.ID), notification.Sender)
+		activity, err := ts.Service.GetActivity(aliceCtx, &apiv1.GetActivityRequest{Name: fmt.Sprintf("activities/%d", notification.GetActivityId())})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.Activity_MEMO_REMINDER, activity.Type)
+		require.Equal(t, memo.Name, activity.Payload.GetMemoReminder().GetMemo())
+	}
+	for range 2 {
+		select {
+		case body := <-received:
+			require.Contains(t, body, `"activityType":"memos.memo.reminder"`)
+			require.Contains(t, body, "renew the certificate")
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a webhook request")
+		}
+	}
+
+	// The one-time reminder is removed and the recurring one moves on to its next time.
+	reminders, err = ts.Service.ListMemoReminders(aliceCtx, &apiv1.ListMemoRemindersRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, reminders.Reminders, 1)
+	require.Equal(t, daily.Name, reminders.Reminders[0].Name)
+	require.True(t, reminders.Reminders[0].NextRemindTime.AsTime().After(time.Now()))
+}
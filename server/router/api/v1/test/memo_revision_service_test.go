@@ -0,0 +1,139 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("UpdateMemo records revisions", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    "line one\nline two",
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+
+		// Pinning does not touch content or visibility, so no revision is recorded.
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Pinned: true},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Content: "line one\nline 2"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		latest, initial := resp.Revisions[0], resp.Revisions[1]
+		require.Equal(t, "line one\nline 2", latest.Content)
+		require.Equal(t, "line one\nline two", initial.Content)
+		require.Equal(t, apiv1.Visibility_PRIVATE, initial.Visibility)
+
+		got, err := ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: initial.Name})
+		require.NoError(t, err)
+		require.Equal(t, initial.Content, got.Content)
+
+		// Diff defaults to the previous revision.
+		diff, err := ts.Service.DiffMemoRevisions(userCtx, &apiv1.DiffMemoRevisionsRequest{Name: latest.Name})
+		require.NoError(t, err)
+		require.Equal(t, initial.Name, diff.Base)
+		require.Contains(t, diff.Diff, "-line two\n")
+		require.Contains(t, diff.Diff, "+line 2\n")
+
+		diff, err = ts.Service.DiffMemoRevisions(userCtx, &apiv1.DiffMemoRevisionsRequest{Name: initial.Name})
+		require.NoError(t, err)
+		require.Empty(t, diff.Base)
+		require.Contains(t, diff.Diff, "+line one\n")
+
+		// Restoring records a new revision with the restored state.
+		restored, err := ts.Service.RestoreMemoRevision(userCtx, &apiv1.RestoreMemoRevisionRequest{Name: initial.Name})
+		require.NoError(t, err)
+		require.Equal(t, "line one\nline two", restored.Content)
+		require.True(t, restored.Pinned)
+
+		resp, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name, PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		require.NotEmpty(t, resp.NextPageToken)
+		require.Equal(t, "line one\nline two", resp.Revisions[0].Content)
+	})
+
+	t.Run("Retention follows instance setting", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		host, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &apiv1.UpdateInstanceSettingRequest{
+			Setting: &apiv1.InstanceSetting{
+				Name: "instance/settings/MEMO_RELATED",
+				Value: &apiv1.InstanceSetting_MemoRelatedSetting_{
+					MemoRelatedSetting: &apiv1.InstanceSetting_MemoRelatedSetting{
+						ContentLengthLimit: 8 * 1024,
+						RevisionLimit:      2,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		memo, err := ts.Service.CreateMemo(hostCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "v1", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		for _, content := range []string{"v2", "v3"} {
+			_, err = ts.Service.UpdateMemo(hostCtx, &apiv1.UpdateMemoRequest{
+				Memo:       &apiv1.Memo{Name: memo.Name, Content: content},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+			})
+			require.NoError(t, err)
+		}
+
+		resp, err := ts.Service.ListMemoRevisions(hostCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		require.Equal(t, "v3", resp.Revisions[0].Content)
+		require.Equal(t, "v2", resp.Revisions[1].Content)
+	})
+
+	t.Run("Revisions are only visible to the creator", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+
+		memo, err := ts.Service.CreateMemo(ts.CreateUserContext(ctx, owner.ID), &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "public memo", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.ListMemoRevisions(ts.CreateUserContext(ctx, other.ID), &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+}
@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "published now", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+	}))
+	defer server.Close()
+	nextActivity := func() string {
+		select {
+		case body := <-received:
+			return body
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a webhook request")
+			return ""
+		}
+	}
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &apiv1.UserWebhook{Url: server.URL, DisplayName: "test"},
+	})
+	require.NoError(t, err)
+
+	// The memo stays private and is not announced until it is published.
+	publishTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "announcement",
+			Visibility:  apiv1.Visibility_PUBLIC,
+			PublishTime: timestamppb.New(publishTime),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Visibility_PRIVATE, memo.Visibility)
+	require.Equal(t, publishTime.Unix(), memo.PublishTime.AsTime().Unix())
+	deliveries, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Empty(t, deliveries.Deliveries)
+
+	// A publish time in the past publishes the memo right away.
+	pastMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "late",
+			Visibility:  apiv1.Visibility_PRIVATE,
+			PublishTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, pastMemo.PublishTime)
+	require.Contains(t, nextActivity(), `"activityType":"memos.memo.created"`)
+
+	scheduled, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "scheduled"})
+	require.NoError(t, err)
+	require.Len(t, scheduled.Memos, 1)
+	require.Equal(t, memo.Name, scheduled.Memos[0].Name)
+	unscheduled, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "!scheduled"})
+	require.NoError(t, err)
+	require.Len(t, unscheduled.Memos, 2)
+
+	// Changing the visibility of a scheduled memo changes the visibility it is published with.
+	memo, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Visibility: apiv1.Visibility_PROTECTED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Visibility_PRIVATE, memo.Visibility)
+	require.Contains(t, nextActivity(), `"activityType":"memos.memo.updated"`)
+
+	// Memos are not published before their publish time.
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	scheduled, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "scheduled"})
+	require.NoError(t, err)
+	require.Len(t, scheduled.Memos, 1)
+
+	// Move the publish time into the past.
+	memoUID := strings.TrimPrefix(memo.Name, "memos/")
+	stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	publishTime = time.Now().Add(-time.Minute).Truncate(time.Second)
+	stored.Payload.Schedule.PublishTs = publishTime.Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, Payload: stored.Payload}))
+
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	published, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Visibility_PROTECTED, published.Visibility)
+	require.Nil(t, published.PublishTime)
+	require.Equal(t, publishTime.Unix(), published.CreateTime.AsTime().Unix())
+	body := nextActivity()
+	require.Contains(t, body, `"activityType":"memos.memo.created"`)
+	require.Contains(t, body, "announcement")
+
+	scheduled, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "scheduled"})
+	require.NoError(t, err)
+	require.Empty(t, scheduled.Memos)
+}
@@ -367,3 +367,75 @@ func TestCreateMemoWithCustomTimestamps(t *testing.T) {
 	require.NotNil(t, memoWithoutTimestamps.UpdateTime, "update_time should be auto-generated")
 	require.True(t, time.Now().Unix()-memoWithoutTimestamps.CreateTime.AsTime().Unix() < 5, "create_time should be recent (within 5 seconds)")
 }
+
+func TestListMemosOrderByRelevance(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user-search")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, content := range []string{
+		"A long memo that mentions kayaking once among many other words",
+		"Kayaking, kayaking and more kayaking",
+		"Nothing to see here",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter:  `search("kayaking")`,
+		OrderBy: "relevance",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 2)
+	require.Equal(t, "Kayaking, kayaking and more kayaking", resp.Memos[0].Content)
+
+	// Relevance ordering without a search() filter is rejected.
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		OrderBy: "relevance",
+	})
+	require.Error(t, err)
+}
+
+func TestListMemosByFrontmatterProperties(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user-props")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, content := range []string{
+		"---\nstatus: done\n---\nShip the release",
+		"---\nstatus: todo\n---\nWrite the changelog",
+		"No frontmatter",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter: `props.status == "done"`,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, "---\nstatus: done\n---\nShip the release", resp.Memos[0].Content)
+	require.Equal(t, "Ship the release", resp.Memos[0].Snippet)
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTasks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "#groceries\n\n- [ ] Buy milk due:2024-05-01\n- [x] Buy bread",
+			Visibility: apiv1.Visibility_PUBLIC,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(aliceCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "#work\n\n- [ ] Send report", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// Only incomplete tasks are listed by default.
+	response, err := ts.Service.ListTasks(aliceCtx, &apiv1.ListTasksRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Tasks, 2)
+	response, err = ts.Service.ListTasks(aliceCtx, &apiv1.ListTasksRequest{Filter: `tag in ["groceries"]`, ShowCompleted: true})
+	require.NoError(t, err)
+	require.Len(t, response.Tasks, 2)
+	require.Equal(t, &apiv1.Task{Memo: memo.Name, Index: 0, Content: "Buy milk", Line: 3, DueDate: "2024-05-01"}, response.Tasks[0])
+	require.Equal(t, &apiv1.Task{Memo: memo.Name, Index: 1, Content: "Buy bread", Completed: true, Line: 4}, response.Tasks[1])
+
+	// Tasks are personal, even in public memos.
+	response, err = ts.Service.ListTasks(bobCtx, &apiv1.ListTasksRequest{ShowCompleted: true})
+	require.NoError(t, err)
+	require.Empty(t, response.Tasks)
+	_, err = ts.Service.ListTasks(ctx, &apiv1.ListTasksRequest{})
+	require.Error(t, err)
+	_, err = ts.Service.SetTaskCompleted(bobCtx, &apiv1.SetTaskCompletedRequest{Name: memo.Name, Index: 0, Completed: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "permission denied")
+
+	// Checking a task rewrites only its checkbox.
+	task, err := ts.Service.SetTaskCompleted(aliceCtx, &apiv1.SetTaskCompletedRequest{Name: memo.Name, Index: 0, Completed: true})
+	require.NoError(t, err)
+	require.True(t, task.Completed)
+	require.Equal(t, "Buy milk", task.Content)
+	updated, err := ts.Service.GetMemo(aliceCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "#groceries\n\n- [x] Buy milk due:2024-05-01\n- [x] Buy bread", updated.Content)
+	require.False(t, updated.Property.HasIncompleteTasks)
+
+	response, err = ts.Service.ListTasks(aliceCtx, &apiv1.ListTasksRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Tasks, 1)
+	require.Equal(t, "Send report", response.Tasks[0].Content)
+
+	_, err = ts.Service.SetTaskCompleted(aliceCtx, &apiv1.SetTaskCompletedRequest{Name: memo.Name, Index: 2, Completed: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "task not found")
+}
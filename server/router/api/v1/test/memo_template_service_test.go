@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	aliceName := fmt.Sprintf("users/%d", alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	for _, template := range []*apiv1.MemoTemplate{
+		{Content: "no title"},
+		{Title: "no content"},
+		{Title: "bad cron", Content: "standup", Cron: "not a cron"},
+	} {
+		_, err := ts.Service.CreateMemoTemplate(aliceCtx, &apiv1.CreateMemoTemplateRequest{Parent: aliceName, Template: template})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "template %v", template)
+	}
+
+	standup, err := ts.Service.CreateMemoTemplate(aliceCtx, &apiv1.CreateMemoTemplateRequest{
+		Parent: aliceName,
+		Template: &apiv1.MemoTemplate{
+			Title:      "Daily standup",
+			Content:    "# Standup {{date}}\n\n#standup",
+			Visibility: apiv1.Visibility_PROTECTED,
+			Cron:       "CRON_TZ=UTC 0 9 * * 1-5",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 9, standup.NextRunTime.AsTime().UTC().Hour())
+	review, err := ts.Service.CreateMemoTemplate(aliceCtx, &apiv1.CreateMemoTemplateRequest{
+		Parent:   aliceName,
+		Template: &apiv1.MemoTemplate{Title: "Weekly review", Content: "Review of {{week}}"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Visibility_PRIVATE, review.Visibility)
+	require.Nil(t, review.NextRunTime)
+
+	// Only the owner can use the templates.
+	_, err = ts.Service.ListMemoTemplates(bobCtx, &apiv1.ListMemoTemplatesRequest{Parent: aliceName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateMemoFromTemplate(bobCtx, &apiv1.CreateMemoFromTemplateRequest{Name: review.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// An invalid update leaves the template unchanged.
+	_, err = ts.Service.UpdateMemoTemplate(aliceCtx, &apiv1.UpdateMemoTemplateRequest{
+		Template:   &apiv1.MemoTemplate{Name: review.Name, Title: ""},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	review, err = ts.Service.GetMemoTemplate(aliceCtx, &apiv1.GetMemoTemplateRequest{Name: review.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Weekly review", review.Title)
+
+	now := time.Now()
+	isoYear, isoWeek := now.ISOWeek()
+	memo, err := ts.Service.CreateMemoFromTemplate(aliceCtx, &apiv1.CreateMemoFromTemplateRequest{Name: review.Name})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("Review of %d-W%02d", isoYear, isoWeek), memo.Content)
+	require.Equal(t, aliceName, memo.Creator)
+
+	// Nothing is due yet.
+	require.NoError(t, ts.Service.CreateScheduledTemplateMemos(ctx))
+	memos, err := ts.Service.ListMemos(aliceCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+
+	// Make the standup due.
+	templates, err := ts.Store.GetUserMemoTemplates(ctx, alice.ID)
+	require.NoError(t, err)
+	for _, template := range templates {
+		if template.Cron != "" {
+			template.NextRunTs = time.Now().Add(-time.Minute).Unix()
+		}
+	}
+	require.NoError(t, ts.Store.UpsertUserMemoTemplates(ctx, alice.ID, templates))
+	require.NoError(t, ts.Service.CreateScheduledTemplateMemos(ctx))
+	require.NoError(t, ts.Service.CreateScheduledTemplateMemos(ctx))
+
+	memos, err = ts.Service.ListMemos(aliceCtx, &apiv1.ListMemosRequest{Filter: `tag in ["standup"]`})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, fmt.Sprintf("# Standup %s\n\n#standup", time.Now().UTC().Format("2006-01-02")), memos.Memos[0].Content)
+	require.Equal(t, apiv1.Visibility_PROTECTED, memos.Memos[0].Visibility)
+	standup, err = ts.Service.GetMemoTemplate(aliceCtx, &apiv1.GetMemoTemplateRequest{Name: standup.Name})
+	require.NoError(t, err)
+	require.True(t, standup.NextRunTime.AsTime().After(time.Now()))
+
+	// Without CRON_TZ, the schedule and the placeholders use the time zone of the user.
+	_, err = ts.Service.UpdateUserSetting(aliceCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", alice.ID),
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Asia/Tokyo"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	journal, err := ts.Service.CreateMemoTemplate(aliceCtx, &apiv1.CreateMemoTemplateRequest{
+		Parent:   aliceName,
+		Template: &apiv1.MemoTemplate{Title: "Journal", Content: "Journal {{date}}", Cron: "0 9 * * *"},
+	})
+	require.NoError(t, err)
+	// 9:00 in Tokyo is 0:00 UTC.
+	require.Equal(t, 0, journal.NextRunTime.AsTime().UTC().Hour())
+	memo, err = ts.Service.CreateMemoFromTemplate(aliceCtx, &apiv1.CreateMemoFromTemplateRequest{Name: journal.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Journal "+time.Now().In(tokyo).Format("2006-01-02"), memo.Content)
+	_, err = ts.Service.DeleteMemoTemplate(aliceCtx, &apiv1.DeleteMemoTemplateRequest{Name: journal.Name})
+	require.NoError(t, err)
+
+	// Clearing the cron expression stops the schedule.
+	standup, err = ts.Service.UpdateMemoTemplate(aliceCtx, &apiv1.UpdateMemoTemplateRequest{
+		Template:   &apiv1.MemoTemplate{Name: standup.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cron"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, standup.Cron)
+	require.Nil(t, standup.NextRunTime)
+
+	_, err = ts.Service.DeleteMemoTemplate(aliceCtx, &apiv1.DeleteMemoTemplateRequest{Name: review.Name})
+	require.NoError(t, err)
+	list, err := ts.Service.ListMemoTemplates(aliceCtx, &apiv1.ListMemoTemplatesRequest{Parent: aliceName})
+	require.NoError(t, err)
+	require.Len(t, list.Templates, 1)
+	require.Equal(t, standup.Name, list.Templates[0].Name)
+}
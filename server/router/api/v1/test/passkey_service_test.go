@@ -0,0 +1,191 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/webauthn/webauthntest"
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	v1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestPasskeys(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	alice, err := ts.Store.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	aliceName := fmt.Sprintf("users/%d", alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	// The test profile has the instance URL http://localhost:8080.
+	authenticator, err := webauthntest.NewAuthenticator("localhost", "http://localhost:8080")
+	require.NoError(t, err)
+
+	_, err = ts.Service.BeginPasskeyRegistration(ctx, &apiv1.BeginPasskeyRegistrationRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	registration, err := ts.Service.BeginPasskeyRegistration(aliceCtx, &apiv1.BeginPasskeyRegistrationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "localhost", registration.Options.RpId)
+	require.Equal(t, "alice", registration.Options.UserName)
+	require.Empty(t, registration.Options.ExcludeCredentialIds)
+	clientDataJSON, attestationObject, err := authenticator.Register(registration.Options.Challenge)
+	require.NoError(t, err)
+
+	// The session is bound to the user who started it.
+	_, err = ts.Service.FinishPasskeyRegistration(bobCtx, &apiv1.FinishPasskeyRegistrationRequest{
+		SessionToken:      registration.SessionToken,
+		ClientDataJson:    clientDataJSON,
+		AttestationObject: attestationObject,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	passkey, err := ts.Service.FinishPasskeyRegistration(aliceCtx, &apiv1.FinishPasskeyRegistrationRequest{
+		SessionToken:      registration.SessionToken,
+		ClientDataJson:    clientDataJSON,
+		AttestationObject: attestationObject,
+		DisplayName:       "Laptop",
+		Transports:        []string{"internal"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Laptop", passkey.DisplayName)
+	_, err = ts.Service.FinishPasskeyRegistration(aliceCtx, &apiv1.FinishPasskeyRegistrationRequest{
+		SessionToken:      registration.SessionToken,
+		ClientDataJson:    clientDataJSON,
+		AttestationObject: attestationObject,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The same authenticator cannot be registered twice.
+	registration, err = ts.Service.BeginPasskeyRegistration(aliceCtx, &apiv1.BeginPasskeyRegistrationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{authenticator.CredentialID}, registration.Options.ExcludeCredentialIds)
+	clientDataJSON, attestationObject, err = authenticator.Register(registration.Options.Challenge)
+	require.NoError(t, err)
+	_, err = ts.Service.FinishPasskeyRegistration(aliceCtx, &apiv1.FinishPasskeyRegistrationRequest{
+		SessionToken:      registration.SessionToken,
+		ClientDataJson:    clientDataJSON,
+		AttestationObject: attestationObject,
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	passkeySignIn := func(request *apiv1.BeginPasskeySignInRequest, authenticator *webauthntest.Authenticator) (*apiv1.SignInResponse, error) {
+		begin, err := ts.Service.BeginPasskeySignIn(ctx, request)
+		require.NoError(t, err)
+		clientDataJSON, authenticatorData, signature, err := authenticator.Assert(begin.Options.Challenge)
+		require.NoError(t, err)
+		credentials := &apiv1.SignInRequest_PasskeyCredentials{
+			SessionToken:      begin.SessionToken,
+			CredentialId:      authenticator.CredentialID,
+			ClientDataJson:    clientDataJSON,
+			AuthenticatorData: authenticatorData,
+			Signature:         signature,
+		}
+		if request.TwoFactorToken == "" {
+			credentials.UserHandle = []byte(fmt.Sprint(alice.ID))
+		}
+		response, err := ts.Service.SignIn(v1.WithHeaderCarrier(ctx), &apiv1.SignInRequest{
+			Credentials: &apiv1.SignInRequest_PasskeyCredentials_{PasskeyCredentials: credentials},
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Replaying the assertion fails, also on another server sharing the database.
+		for _, service := range []*v1.APIV1Service{ts.Service, {Secret: ts.Secret, Profile: ts.Profile, Store: ts.Store}} {
+			_, err = service.SignIn(v1.WithHeaderCarrier(ctx), &apiv1.SignInRequest{
+				Credentials: &apiv1.SignInRequest_PasskeyCredentials_{PasskeyCredentials: credentials},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		return response, nil
+	}
+
+	// A passkey alone signs in with the usual tokens.
+	response, err := passkeySignIn(&apiv1.BeginPasskeySignInRequest{}, authenticator)
+	require.NoError(t, err)
+	require.Equal(t, "alice", response.User.Username)
+	claims, err := auth.ParseAccessTokenV2(response.AccessToken, []byte(ts.Secret))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprint(alice.ID), claims.Subject)
+
+	// Without user verification a passkey is only a second factor.
+	authenticator.SkipUserVerification = true
+	_, err = passkeySignIn(&apiv1.BeginPasskeySignInRequest{}, authenticator)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A passkey does not enable two-factor authentication, the password alone is still enough.
+	response, err = ts.Service.SignIn(v1.WithHeaderCarrier(ctx), &apiv1.SignInRequest{
+		Credentials: &apiv1.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &apiv1.SignInRequest_PasswordCredentials{Username: "alice", Password: "secret-password"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+	require.Empty(t, response.TwoFactorToken)
+
+	setup, err := ts.Service.SetupUserTwoFactor(aliceCtx, &apiv1.SetupUserTwoFactorRequest{Name: aliceName + "/twoFactor"})
+	require.NoError(t, err)
+	code, err := auth.GenerateTOTPCode(setup.Secret, time.Now())
+	require.NoError(t, err)
+	_, err = ts.Service.EnableUserTwoFactor(aliceCtx, &apiv1.EnableUserTwoFactorRequest{Name: aliceName + "/twoFactor", Code: code})
+	require.NoError(t, err)
+	response, err = ts.Service.SignIn(v1.WithHeaderCarrier(ctx), &apiv1.SignInRequest{
+		Credentials: &apiv1.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &apiv1.SignInRequest_PasswordCredentials{Username: "alice", Password: "secret-password"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.TwoFactorToken)
+
+	_, err = ts.Service.BeginPasskeySignIn(ctx, &apiv1.BeginPasskeySignInRequest{TwoFactorToken: "not a token"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	begin, err := ts.Service.BeginPasskeySignIn(ctx, &apiv1.BeginPasskeySignInRequest{TwoFactorToken: response.TwoFactorToken})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{authenticator.CredentialID}, begin.Options.AllowCredentialIds)
+	response, err = passkeySignIn(&apiv1.BeginPasskeySignInRequest{TwoFactorToken: response.TwoFactorToken}, authenticator)
+	require.NoError(t, err)
+	require.Equal(t, "alice", response.User.Username)
+	require.NotEmpty(t, response.AccessToken)
+
+	// Only the owner and admins can manage the passkeys.
+	_, err = ts.Service.ListUserPasskeys(bobCtx, &apiv1.ListUserPasskeysRequest{Parent: aliceName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	passkeys, err := ts.Service.ListUserPasskeys(aliceCtx, &apiv1.ListUserPasskeysRequest{Parent: aliceName})
+	require.NoError(t, err)
+	require.Len(t, passkeys.Passkeys, 1)
+	require.Equal(t, []string{"internal"}, passkeys.Passkeys[0].Transports)
+	require.NotNil(t, passkeys.Passkeys[0].LastUsedTime)
+
+	_, err = ts.Service.DeleteUserPasskey(aliceCtx, &apiv1.DeleteUserPasskeyRequest{Name: passkey.Name})
+	require.NoError(t, err)
+	authenticator.SkipUserVerification = false
+	_, err = passkeySignIn(&apiv1.BeginPasskeySignInRequest{}, authenticator)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DeleteUserPasskey(aliceCtx, &apiv1.DeleteUserPasskeyRequest{Name: passkey.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Passkeys need the instance URL, the origin header of the request is not trusted.
+	noInstanceURL := &v1.APIV1Service{Secret: ts.Secret, Profile: &profile.Profile{}, Store: ts.Store}
+	originCtx := metadata.NewIncomingContext(aliceCtx, metadata.Pairs("origin", "http://localhost:8080"))
+	_, err = noInstanceURL.BeginPasskeyRegistration(originCtx, &apiv1.BeginPasskeyRegistrationRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = noInstanceURL.BeginPasskeySignIn(originCtx, &apiv1.BeginPasskeySignInRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,176 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestTagService(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	createMemo := func(userCtx context.Context, content string) string {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		return memo.Name
+	}
+	getMemo := func(name string) *store.Memo {
+		uid := strings.TrimPrefix(name, "memos/")
+		memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		require.NoError(t, err)
+		return memo
+	}
+
+	workMemo := createMemo(aliceCtx, "Plan #Work/project-a today")
+	jobMemo := createMemo(aliceCtx, "Apply #job and #career")
+	bobMemo := createMemo(bobCtx, "Bob's #work")
+
+	// A dry run counts the affected memos without updating them.
+	renamed, err := ts.Service.RenameTag(aliceCtx, &apiv1.RenameTagRequest{Tag: "work", NewTag: "office", ValidateOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), renamed.AffectedMemoCount)
+	require.Equal(t, "Plan #Work/project-a today", getMemo(workMemo).Content)
+
+	previousUpdatedTs := time.Now().Add(-time.Hour).Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: getMemo(workMemo).ID, UpdatedTs: &previousUpdatedTs}))
+	renamed, err = ts.Service.RenameTag(aliceCtx, &apiv1.RenameTagRequest{Tag: "work", NewTag: "office"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), renamed.AffectedMemoCount)
+	memo := getMemo(workMemo)
+	require.Equal(t, "Plan #office/project-a today", memo.Content)
+	require.Equal(t, []string{"office/project-a"}, memo.Payload.Tags)
+	require.Greater(t, memo.UpdatedTs, previousUpdatedTs)
+	// The rename is recorded in the revision history of the memo.
+	revisions, err := ts.Service.ListMemoRevisions(aliceCtx, &apiv1.ListMemoRevisionsRequest{Parent: workMemo})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 2)
+	require.Equal(t, "Plan #office/project-a today", revisions.Revisions[0].Content)
+	require.Equal(t, "Plan #Work/project-a today", revisions.Revisions[1].Content)
+	// Other users' memos are not affected.
+	require.Equal(t, "Bob's #work", getMemo(bobMemo).Content)
+
+	merged, err := ts.Service.MergeTags(aliceCtx, &apiv1.MergeTagsRequest{SourceTags: []string{"job", "career"}, TargetTag: "office"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), merged.AffectedMemoCount)
+	memo = getMemo(jobMemo)
+	require.Equal(t, "Apply #office and #office", memo.Content)
+	require.Equal(t, []string{"office"}, memo.Payload.Tags)
+
+	deleted, err := ts.Service.DeleteTag(aliceCtx, &apiv1.DeleteTagRequest{Tag: "office"})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), deleted.AffectedMemoCount)
+	require.Empty(t, getMemo(workMemo).Payload.Tags)
+	require.Empty(t, getMemo(jobMemo).Payload.Tags)
+
+	_, err = ts.Service.RenameTag(aliceCtx, &apiv1.RenameTagRequest{Tag: "work", NewTag: "two words"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid new tag")
+	_, err = ts.Service.DeleteTag(ctx, &apiv1.DeleteTagRequest{Tag: "work"})
+	require.Error(t, err)
+}
+
+func TestTagCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	createMemo := func(userCtx context.Context, content string) {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+	}
+	createMemo(aliceCtx, "#work/project-a and #work/project-b")
+	createMemo(aliceCtx, "More #work/project-a and #idea")
+	createMemo(bobCtx, "Bob's #private")
+
+	// Nested tags are listed as a tree with per-tag and subtree counts.
+	response, err := ts.Service.ListTags(aliceCtx, &apiv1.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Tags, 2)
+	idea, work := response.Tags[0], response.Tags[1]
+	require.Equal(t, "idea", idea.Tag)
+	require.Equal(t, int32(1), idea.MemoCount)
+	require.NotNil(t, idea.LastUsedTime)
+	require.Equal(t, "work", work.Tag)
+	require.Equal(t, int32(0), work.MemoCount)
+	require.Equal(t, int32(2), work.TotalMemoCount)
+	require.Len(t, work.Children, 2)
+	require.Equal(t, "work/project-a", work.Children[0].Tag)
+	require.Equal(t, int32(2), work.Children[0].MemoCount)
+	require.Equal(t, "work/project-b", work.Children[1].Tag)
+	require.Equal(t, int32(1), work.Children[1].MemoCount)
+
+	// Metadata is validated and pinned tags are listed first.
+	_, err = ts.Service.UpdateTag(aliceCtx, &apiv1.UpdateTagRequest{
+		Tag:        &apiv1.Tag{Tag: "work", Color: "red"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color"}},
+	})
+	require.Error(t, err)
+	tag, err := ts.Service.UpdateTag(aliceCtx, &apiv1.UpdateTagRequest{
+		Tag:        &apiv1.Tag{Tag: "Work", Color: "#FF0000", Description: "Day job", Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color", "description", "pinned"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "work", tag.Tag)
+	require.Equal(t, "#FF0000", tag.Color)
+	require.Len(t, tag.Children, 2)
+	response, err = ts.Service.ListTags(aliceCtx, &apiv1.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "work", response.Tags[0].Tag)
+	require.True(t, response.Tags[0].Pinned)
+	require.Equal(t, "Day job", response.Tags[0].Description)
+
+	// Metadata follows renamed tags and is removed with deleted tags.
+	_, err = ts.Service.UpdateTag(aliceCtx, &apiv1.UpdateTagRequest{
+		Tag:        &apiv1.Tag{Tag: "work/project-a", Color: "#00FF00"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.RenameTag(aliceCtx, &apiv1.RenameTagRequest{Tag: "work", NewTag: "office"})
+	require.NoError(t, err)
+	settings, err := ts.Store.GetUserTagSettings(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, settings, 2)
+	require.Equal(t, "Day job", settings["office"].Description)
+	require.Equal(t, "#00FF00", settings["office/project-a"].Color)
+	_, err = ts.Service.DeleteTag(aliceCtx, &apiv1.DeleteTagRequest{Tag: "office/project-a"})
+	require.NoError(t, err)
+	settings, err = ts.Store.GetUserTagSettings(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+
+	// Other users only see their own tags.
+	response, err = ts.Service.ListTags(bobCtx, &apiv1.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Tags, 1)
+	require.Equal(t, "private", response.Tags[0].Tag)
+	require.Empty(t, response.Tags[0].Color)
+}
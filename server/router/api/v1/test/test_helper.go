@@ -34,12 +34,18 @@ func NewTestService(t *testing.T) *TestService {
 		InstanceURL: "http://localhost:8080",
 		Driver:      "sqlite",
 		DSN:         ":memory:",
+		// Webhooks are delivered to local test servers.
+		AllowInternalWebhooks: true,
 	}
 
 	// Create APIV1Service with nil grpcServer since we're testing direct calls
 	secret := "test-secret"
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMentionExtension(),
+		markdown.WithWikiLinkExtension(),
+		markdown.WithEmbedExtension(),
+		markdown.WithFrontmatterExtension(),
 	)
 	service := &apiv1.APIV1Service{
 		Secret:          secret,
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	v1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	alice, err := ts.Store.CreateUser(ctx, &store.User{Username: "alice", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	twoFactorName := fmt.Sprintf("users/%d/twoFactor", alice.ID)
+
+	signIn := func(credentials *apiv1.SignInRequest) (*apiv1.SignInResponse, error) {
+		return ts.Service.SignIn(v1.WithHeaderCarrier(ctx), credentials)
+	}
+	passwordSignIn := &apiv1.SignInRequest{
+		Credentials: &apiv1.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &apiv1.SignInRequest_PasswordCredentials{Username: "alice", Password: "secret-password"},
+		},
+	}
+	twoFactorSignIn := func(token, code string) (*apiv1.SignInResponse, error) {
+		return signIn(&apiv1.SignInRequest{
+			Credentials: &apiv1.SignInRequest_TwoFactorCredentials_{
+				TwoFactorCredentials: &apiv1.SignInRequest_TwoFactorCredentials{Token: token, Code: code},
+			},
+		})
+	}
+
+	// Without two-factor authentication the password is enough.
+	response, err := signIn(passwordSignIn)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+	require.Empty(t, response.TwoFactorToken)
+
+	// The secret stays pending until it is confirmed with a code.
+	_, err = ts.Service.EnableUserTwoFactor(aliceCtx, &apiv1.EnableUserTwoFactorRequest{Name: twoFactorName, Code: "123456"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.SetupUserTwoFactor(adminCtx, &apiv1.SetupUserTwoFactorRequest{Name: twoFactorName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	setup, err := ts.Service.SetupUserTwoFactor(aliceCtx, &apiv1.SetupUserTwoFactorRequest{Name: twoFactorName})
+	require.NoError(t, err)
+	require.Contains(t, setup.OtpauthUri, "otpauth://totp/Memos:alice?")
+	require.Contains(t, setup.OtpauthUri, "secret="+setup.Secret)
+	// The two-factor setting is not listed as a user setting.
+	settings, err := ts.Service.ListUserSettings(aliceCtx, &apiv1.ListUserSettingsRequest{Parent: fmt.Sprintf("users/%d", alice.ID)})
+	require.NoError(t, err)
+	require.Len(t, settings.Settings, 1)
+	require.Equal(t, fmt.Sprintf("users/%d/settings/general", alice.ID), settings.Settings[0].Name)
+	response, err = signIn(passwordSignIn)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+
+	code, err := auth.GenerateTOTPCode(setup.Secret, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = ts.Service.EnableUserTwoFactor(aliceCtx, &apiv1.EnableUserTwoFactorRequest{Name: twoFactorName, Code: code})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	code, err = auth.GenerateTOTPCode(setup.Secret, time.Now())
+	require.NoError(t, err)
+	enabled, err := ts.Service.EnableUserTwoFactor(aliceCtx, &apiv1.EnableUserTwoFactorRequest{Name: twoFactorName, Code: code})
+	require.NoError(t, err)
+	require.True(t, enabled.TwoFactor.Enabled)
+	require.Len(t, enabled.RecoveryCodes, 10)
+	_, err = ts.Service.SetupUserTwoFactor(aliceCtx, &apiv1.SetupUserTwoFactorRequest{Name: twoFactorName})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Only hashes of the recovery codes are stored.
+	stored, err := ts.Store.GetUserTwoFactor(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.RecoveryCodeHashes, 10)
+	for _, recoveryCode := range enabled.RecoveryCodes {
+		require.NotContains(t, stored.RecoveryCodeHashes, recoveryCode)
+	}
+
+	// The password sign-in now only returns a two-factor token.
+	response, err = signIn(passwordSignIn)
+	require.NoError(t, err)
+	require.Empty(t, response.AccessToken)
+	require.Nil(t, response.User)
+	twoFactorToken := response.TwoFactorToken
+	require.NotEmpty(t, twoFactorToken)
+
+	_, err = twoFactorSignIn("not a token", code)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = twoFactorSignIn(twoFactorToken, "000000")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	// The code used to enable two-factor authentication cannot be replayed.
+	_, err = twoFactorSignIn(twoFactorToken, code)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	nextCode, err := auth.GenerateTOTPCode(setup.Secret, time.Now().Add(auth.TOTPPeriod))
+	require.NoError(t, err)
+	response, err = twoFactorSignIn(twoFactorToken, nextCode)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+	require.Equal(t, "alice", response.User.Username)
+	_, err = twoFactorSignIn(twoFactorToken, nextCode)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Recovery codes work once, however they are typed.
+	recoveryCode := enabled.RecoveryCodes[0]
+	response, err = twoFactorSignIn(twoFactorToken, " "+recoveryCode[:5]+recoveryCode[6:]+" ")
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+	_, err = twoFactorSignIn(twoFactorToken, recoveryCode)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	twoFactor, err := ts.Service.GetUserTwoFactor(aliceCtx, &apiv1.GetUserTwoFactorRequest{Name: twoFactorName})
+	require.NoError(t, err)
+	require.True(t, twoFactor.Enabled)
+	require.Equal(t, int32(9), twoFactor.RemainingRecoveryCodes)
+
+	// A code sent several times at once is only accepted once.
+	var accepted atomic.Int32
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := twoFactorSignIn(twoFactorToken, enabled.RecoveryCodes[1]); err == nil {
+				accepted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), accepted.Load())
+	response, err = twoFactorSignIn(twoFactorToken, enabled.RecoveryCodes[2])
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+
+	// Users need a code to disable two-factor authentication.
+	_, err = ts.Service.DisableUserTwoFactor(aliceCtx, &apiv1.DisableUserTwoFactorRequest{Name: twoFactorName})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Too many invalid codes lock the verification, even of valid codes.
+	for range 4 {
+		_, err = twoFactorSignIn(twoFactorToken, "000000")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	_, err = twoFactorSignIn(twoFactorToken, enabled.RecoveryCodes[3])
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = ts.Service.DisableUserTwoFactor(aliceCtx, &apiv1.DisableUserTwoFactorRequest{Name: twoFactorName, Code: enabled.RecoveryCodes[3]})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Admins can reset the two-factor authentication of users who lost their authenticator app.
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	_, err = ts.Service.DisableUserTwoFactor(ts.CreateUserContext(ctx, bob.ID), &apiv1.DisableUserTwoFactorRequest{Name: twoFactorName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DisableUserTwoFactor(adminCtx, &apiv1.DisableUserTwoFactorRequest{Name: twoFactorName})
+	require.NoError(t, err)
+	twoFactor, err = ts.Service.GetUserTwoFactor(adminCtx, &apiv1.GetUserTwoFactorRequest{Name: twoFactorName})
+	require.NoError(t, err)
+	require.False(t, twoFactor.Enabled)
+
+	// Pending two-factor tokens stop working after the reset.
+	_, err = twoFactorSignIn(twoFactorToken, enabled.RecoveryCodes[1])
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	response, err = signIn(passwordSignIn)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The endpoint is unavailable for the first request and accepts the following ones.
+	var requests atomic.Int32
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("try again later"))
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &apiv1.UserWebhook{Url: server.URL, DisplayName: "test"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "hello", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	select {
+	case body := <-received:
+		require.Contains(t, body, `"activityType":"memos.memo.created"`)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a webhook request")
+	}
+
+	// The failed attempt is recorded and scheduled for retry.
+	var failed *apiv1.UserWebhookDelivery
+	require.Eventually(t, func() bool {
+		resp, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		failed = resp.Deliveries[0]
+		return failed.Error != ""
+	}, 5*time.Second, 20*time.Millisecond)
+	require.Equal(t, apiv1.UserWebhookDelivery_PENDING, failed.State)
+	require.Equal(t, int32(1), failed.Attempts)
+	require.Equal(t, int32(http.StatusServiceUnavailable), failed.StatusCode)
+	require.Equal(t, "try again later", failed.ResponseBody)
+	require.NotEmpty(t, failed.Error)
+	require.NotNil(t, failed.NextAttemptTime)
+	require.Equal(t, "memos.memo.created", failed.ActivityType)
+
+	redelivery, err := ts.Service.RedeliverUserWebhookDelivery(userCtx, &apiv1.RedeliverUserWebhookDeliveryRequest{Name: failed.Name})
+	require.NoError(t, err)
+	require.NotEqual(t, failed.Name, redelivery.Name)
+	require.Equal(t, failed.Payload, redelivery.Payload)
+	select {
+	case body := <-received:
+		require.Equal(t, failed.Payload, body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a webhook request")
+	}
+	require.Eventually(t, func() bool {
+		resp, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 2)
+		return resp.Deliveries[0].State == apiv1.UserWebhookDelivery_SUCCEEDED
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// Pagination returns the newest delivery first.
+	page, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Deliveries, 1)
+	require.Equal(t, redelivery.Name, page.Deliveries[0].Name)
+	require.NotEmpty(t, page.NextPageToken)
+
+	// Other users cannot see the delivery log.
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	_, err = ts.Service.ListUserWebhookDeliveries(ts.CreateUserContext(ctx, other.ID), &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "permission denied")
+
+	// Deleting the webhook removes its delivery log.
+	_, err = ts.Service.DeleteUserWebhook(userCtx, &apiv1.DeleteUserWebhookRequest{Name: hook.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "webhook not found")
+}
+
+// waitForWebhookDeliveries waits until every queued delivery has been attempted,
+// so that no background delivery writes to the store after the test cleans it up.
+func waitForWebhookDeliveries(t *testing.T, ts *TestService) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		deliveries, err := ts.Store.ListWebhookDeliveries(context.Background(), &store.FindWebhookDelivery{})
+		require.NoError(t, err)
+		for _, delivery := range deliveries {
+			// A failed attempt always records an error, so a pending delivery without one is still being attempted.
+			if delivery.Status == store.WebhookDeliveryPending && delivery.ErrorMessage == "" {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 20*time.Millisecond)
+}
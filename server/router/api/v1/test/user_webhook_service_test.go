@@ -0,0 +1,307 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+type signedRequest struct {
+	header string
+	body   []byte
+}
+
+func TestUserWebhookSignature(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	received := make(chan signedRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- signedRequest{header: r.Header.Get(webhook.SignatureHeader), body: body}
+	}))
+	defer server.Close()
+	nextRequest := func() signedRequest {
+		select {
+		case request := <-received:
+			return request
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a webhook request")
+			return signedRequest{}
+		}
+	}
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &apiv1.UserWebhook{Url: server.URL, DisplayName: "test"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, hook.Secret)
+	secret := hook.Secret
+
+	// The secret is not returned after creation.
+	list, err := ts.Service.ListUserWebhooks(userCtx, &apiv1.ListUserWebhooksRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	require.Len(t, list.Webhooks, 1)
+	require.Empty(t, list.Webhooks[0].Secret)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "hello", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	request := nextRequest()
+	require.NoError(t, webhook.VerifySignature(secret, request.header, request.body, time.Minute, time.Now()))
+
+	// Updating other fields keeps the secret.
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: hook.Name, DisplayName: "renamed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.Secret)
+
+	// Rotating returns a new secret, which is used for the following requests.
+	rotated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: hook.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated.Secret)
+	require.NotEqual(t, secret, rotated.Secret)
+	require.Equal(t, "renamed", rotated.DisplayName)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "world", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	request = nextRequest()
+	require.NoError(t, webhook.VerifySignature(rotated.Secret, request.header, request.body, time.Minute, time.Now()))
+	require.Error(t, webhook.VerifySignature(secret, request.header, request.body, time.Minute, time.Now()))
+	waitForWebhookDeliveries(t, ts)
+}
+
+func TestUserWebhookSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL, ActivityTypes: []string{"memos.memo.unknown"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported activity type")
+	_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL, Filter: "unknown_field == 1"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid filter")
+
+	defaultHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL},
+	})
+	require.NoError(t, err)
+	deployHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent: parent,
+		Webhook: &apiv1.UserWebhook{
+			Url: server.URL,
+			ActivityTypes: []string{
+				"memos.memo.reaction.added",
+				"memos.memo.comment.created",
+				"memos.memo.visibility.changed",
+				"memos.memo.reaction.added",
+			},
+			Filter: `tag in ["deploy"]`,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, deployHook.ActivityTypes, 3)
+	require.Equal(t, `tag in ["deploy"]`, deployHook.Filter)
+
+	deployMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Release #deploy", Visibility: apiv1.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	otherMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Lunch", Visibility: apiv1.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	for _, memo := range []*apiv1.Memo{deployMemo, otherMemo} {
+		_, err = ts.Service.UpsertMemoReaction(otherCtx, &apiv1.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: "Nice", Visibility: apiv1.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Visibility: apiv1.Visibility_PUBLIC},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		require.NoError(t, err)
+	}
+
+	listActivityTypes := func(hook *apiv1.UserWebhook) []string {
+		resp, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		activityTypes := []string{}
+		for i := len(resp.Deliveries) - 1; i >= 0; i-- {
+			activityTypes = append(activityTypes, resp.Deliveries[i].ActivityType)
+		}
+		return activityTypes
+	}
+
+	// The default webhook receives memo created and updated activities of both memos.
+	require.Equal(t, []string{
+		"memos.memo.created",
+		"memos.memo.created",
+		"memos.memo.updated",
+		"memos.memo.updated",
+	}, listActivityTypes(defaultHook))
+	// The filtered webhook only receives the subscribed activities of the #deploy memo.
+	require.Equal(t, []string{
+		"memos.memo.reaction.added",
+		"memos.memo.comment.created",
+		"memos.memo.visibility.changed",
+	}, listActivityTypes(deployHook))
+
+	resp, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: deployHook.Name, PageSize: 1})
+	require.NoError(t, err)
+	require.Contains(t, resp.Deliveries[0].Payload, `"previousVisibility":"PROTECTED"`)
+
+	// Clearing the filter through an update makes the webhook fire for every memo.
+	_, err = ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: deployHook.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filter"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(otherCtx, &apiv1.UpsertMemoReactionRequest{
+		Name:     otherMemo.Name,
+		Reaction: &apiv1.Reaction{ContentId: otherMemo.Name, ReactionType: "🎉"},
+	})
+	require.NoError(t, err)
+	activityTypes := listActivityTypes(deployHook)
+	require.Len(t, activityTypes, 4)
+	require.Equal(t, "memos.memo.reaction.added", activityTypes[3])
+	waitForWebhookDeliveries(t, ts)
+}
+
+func TestUserWebhookPayloadFormats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL, PayloadFormat: apiv1.UserWebhook_TEMPLATE},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid payload template")
+	_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL, PayloadFormat: apiv1.UserWebhook_TEMPLATE, PayloadTemplate: "{{ .Title"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid payload template")
+
+	slackHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: server.URL, PayloadFormat: apiv1.UserWebhook_SLACK},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.UserWebhook_SLACK, slackHook.PayloadFormat)
+	templateHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent: parent,
+		Webhook: &apiv1.UserWebhook{
+			Url:             server.URL,
+			PayloadFormat:   apiv1.UserWebhook_TEMPLATE,
+			PayloadTemplate: `{"event": {{ json .ActivityType }}, "link": {{ json .Link }}, "tags": {{ json (join .Tags ",") }}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Ship the release #deploy", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	link := "http://localhost:8080/" + memo.Name
+
+	latestPayload := func(hook *apiv1.UserWebhook) string {
+		resp, err := ts.Service.ListUserWebhookDeliveries(userCtx, &apiv1.ListUserWebhookDeliveriesRequest{Parent: hook.Name, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		return resp.Deliveries[0].Payload
+	}
+
+	slackPayload := latestPayload(slackHook)
+	require.Contains(t, slackPayload, `*New memo*`)
+	require.Contains(t, slackPayload, `Ship the release`)
+	require.Contains(t, slackPayload, `#deploy`)
+	require.Contains(t, slackPayload, "<"+link+"|View memo>")
+	require.JSONEq(t, `{"event":"memos.memo.created","link":"`+link+`","tags":"deploy"}`, latestPayload(templateHook))
+
+	// Switching the format through an update changes the following payloads.
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: slackHook.Name, PayloadFormat: apiv1.UserWebhook_TEMPLATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"payload_format"}},
+	})
+	require.Error(t, err)
+	require.Nil(t, updated)
+	_, err = ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: slackHook.Name, PayloadFormat: apiv1.UserWebhook_DISCORD},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"payload_format"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "Shipped #deploy"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	discordPayload := latestPayload(slackHook)
+	require.Contains(t, discordPayload, `"embeds"`)
+	require.Contains(t, discordPayload, `"url":"`+link+`"`)
+	waitForWebhookDeliveries(t, ts)
+}
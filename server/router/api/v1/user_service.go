@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -176,6 +178,7 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	s.dispatchUserActivityWebhook(ctx, currentUser, user, &webhook.WebhookRequestPayload{ActivityType: webhook.ActivityTypeUserCreated})
 
 	return convertUserFromStore(user), nil
 }
@@ -288,6 +291,12 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if updatedUser.Role != user.Role {
+		s.dispatchUserActivityWebhook(ctx, currentUser, updatedUser, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeUserRoleChanged,
+			PreviousRole: convertUserRoleFromStore(user.Role).String(),
+		})
+	}
 
 	return convertUserFromStore(updatedUser), nil
 }
@@ -321,6 +330,7 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	s.dispatchUserActivityWebhook(ctx, currentUser, user, &webhook.WebhookRequestPayload{ActivityType: webhook.ActivityTypeUserDeleted})
 
 	return &emptypb.Empty{}, nil
 }
@@ -400,9 +410,9 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	// Only GENERAL and NOTIFICATION settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
-	if storeKey != storepb.UserSetting_GENERAL {
+	if storeKey != storepb.UserSetting_GENERAL && storeKey != storepb.UserSetting_NOTIFICATION {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
 	}
 
@@ -411,6 +421,10 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		Key:    storeKey,
 	})
 
+	if storeKey == storepb.UserSetting_NOTIFICATION {
+		return s.updateUserNotificationSetting(ctx, userID, existingUserSetting, request)
+	}
+
 	generalSetting := &storepb.GeneralUserSetting{}
 	if existingUserSetting != nil {
 		// Start with existing general setting values
@@ -421,6 +435,7 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		MemoVisibility: generalSetting.GetMemoVisibility(),
 		Locale:         generalSetting.GetLocale(),
 		Theme:          generalSetting.GetTheme(),
+		Timezone:       generalSetting.GetTimezone(),
 	}
 
 	// Apply updates for fields specified in the update mask
@@ -433,6 +448,13 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			updatedGeneral.Theme = incomingGeneral.Theme
 		case "locale":
 			updatedGeneral.Locale = incomingGeneral.Locale
+		case "timezone":
+			if incomingGeneral.Timezone != "" {
+				if _, err := time.LoadLocation(incomingGeneral.Timezone); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %v", err)
+				}
+			}
+			updatedGeneral.Timezone = incomingGeneral.Timezone
 		default:
 			// Ignore unsupported fields
 		}
@@ -460,6 +482,42 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
 }
 
+// updateUserNotificationSetting applies the update mask to the user's notification preferences.
+func (s *APIV1Service) updateUserNotificationSetting(ctx context.Context, userID int32, existingUserSetting *storepb.UserSetting, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	updatedNotification := &v1pb.UserSetting_NotificationSetting{
+		EmailEnabled:  existingUserSetting.GetNotification().GetEmailEnabled(),
+		DigestEnabled: existingUserSetting.GetNotification().GetDigestEnabled(),
+	}
+	incomingNotification := request.Setting.GetNotificationSetting()
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "email_enabled":
+			updatedNotification.EmailEnabled = incomingNotification.GetEmailEnabled()
+		case "digest_enabled":
+			updatedNotification.DigestEnabled = incomingNotification.GetDigestEnabled()
+		default:
+			// Ignore unsupported fields
+		}
+	}
+
+	storeSetting, err := convertUserSettingToStore(&v1pb.UserSetting{
+		Name: request.Setting.Name,
+		Value: &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: updatedNotification,
+		},
+	}, userID, storepb.UserSetting_NOTIFICATION)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
+	}
+	// Keep the date of the last digest, which is not part of the API.
+	storeSetting.GetNotification().LastDigestDate = existingUserSetting.GetNotification().GetLastDigestDate()
+	if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
 func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListUserSettingsRequest) (*v1pb.ListUserSettingsResponse, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -732,19 +790,47 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
 
-	webhookID := generateUserWebhookID()
-	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+	activityTypes, err := normalizeWebhookActivityTypes(request.Webhook.ActivityTypes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid activity types: %v", err)
+	}
+	filter := strings.TrimSpace(request.Webhook.Filter)
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+
+	payloadFormat := convertWebhookPayloadFormatToStore(request.Webhook.PayloadFormat)
+	if err := validateWebhookPayloadTemplate(payloadFormat, request.Webhook.PayloadTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payload template: %v", err)
 	}
 
-	err = s.Store.AddUserWebhook(ctx, userID, webhook)
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+	webhookID := generateUserWebhookID()
+	userWebhook := &storepb.WebhooksUserSetting_Webhook{
+		Id:              webhookID,
+		Title:           request.Webhook.DisplayName,
+		Url:             strings.TrimSpace(request.Webhook.Url),
+		Secret:          secret,
+		ActivityTypes:   activityTypes,
+		Filter:          filter,
+		PayloadFormat:   payloadFormat,
+		PayloadTemplate: request.Webhook.PayloadTemplate,
+	}
+
+	err = s.Store.AddUserWebhook(ctx, userID, userWebhook)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(webhook, userID), nil
+	// The secret is only returned on creation.
+	response := convertUserWebhookFromUserSetting(userWebhook, userID)
+	response.Secret = secret
+	return response, nil
 }
 
 func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.UpdateUserWebhookRequest) (*v1pb.UserWebhook, error) {
@@ -789,11 +875,18 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 
 	// Update the webhook
 	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
-	}
-
+		Id:              webhookID,
+		Title:           targetWebhook.Title,
+		Url:             targetWebhook.Url,
+		Secret:          targetWebhook.Secret,
+		ActivityTypes:   targetWebhook.ActivityTypes,
+		Filter:          targetWebhook.Filter,
+		PayloadFormat:   targetWebhook.PayloadFormat,
+		PayloadTemplate: targetWebhook.PayloadTemplate,
+	}
+
+	rotateSecret := false
+	updateActivityTypes, updateFilter := false, false
 	if request.UpdateMask != nil {
 		for _, path := range request.UpdateMask.Paths {
 			switch path {
@@ -803,6 +896,16 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "secret":
+				rotateSecret = true
+			case "activity_types":
+				updateActivityTypes = true
+			case "filter":
+				updateFilter = true
+			case "payload_format":
+				updatedWebhook.PayloadFormat = convertWebhookPayloadFormatToStore(request.Webhook.PayloadFormat)
+			case "payload_template":
+				updatedWebhook.PayloadTemplate = request.Webhook.PayloadTemplate
 			default:
 				// Ignore unsupported fields
 			}
@@ -813,6 +916,36 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
 		}
 		updatedWebhook.Title = request.Webhook.DisplayName
+		updatedWebhook.PayloadFormat = convertWebhookPayloadFormatToStore(request.Webhook.PayloadFormat)
+		updatedWebhook.PayloadTemplate = request.Webhook.PayloadTemplate
+		updateActivityTypes, updateFilter = true, true
+	}
+	if err := validateWebhookPayloadTemplate(updatedWebhook.PayloadFormat, updatedWebhook.PayloadTemplate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payload template: %v", err)
+	}
+	if updateActivityTypes {
+		activityTypes, err := normalizeWebhookActivityTypes(request.Webhook.ActivityTypes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid activity types: %v", err)
+		}
+		updatedWebhook.ActivityTypes = activityTypes
+	}
+	if updateFilter {
+		filter := strings.TrimSpace(request.Webhook.Filter)
+		if filter != "" {
+			if err := s.validateFilter(ctx, filter); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+			}
+		}
+		updatedWebhook.Filter = filter
+	}
+
+	if rotateSecret {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+		updatedWebhook.Secret = secret
 	}
 
 	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
@@ -820,7 +953,12 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(updatedWebhook, userID), nil
+	response := convertUserWebhookFromUserSetting(updatedWebhook, userID)
+	// The rotated secret is returned once, like on creation.
+	if rotateSecret {
+		response.Secret = updatedWebhook.Secret
+	}
+	return response, nil
 }
 
 func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.DeleteUserWebhookRequest) (*emptypb.Empty, error) {
@@ -863,6 +1001,12 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -892,12 +1036,75 @@ func parseUserWebhookName(name string) (string, int32, error) {
 	return parts[3], int32(userID), nil
 }
 
+// normalizeWebhookActivityTypes validates the activity types and removes duplicates.
+func normalizeWebhookActivityTypes(activityTypes []string) ([]string, error) {
+	normalized := []string{}
+	for _, activityType := range activityTypes {
+		activityType = strings.TrimSpace(activityType)
+		if !webhook.IsValidActivityType(activityType) {
+			return nil, errors.Errorf("unsupported activity type %q", activityType)
+		}
+		if !slices.Contains(normalized, activityType) {
+			normalized = append(normalized, activityType)
+		}
+	}
+	return normalized, nil
+}
+
+// validateWebhookPayloadTemplate checks that the TEMPLATE format comes with a template that parses.
+func validateWebhookPayloadTemplate(format storepb.WebhooksUserSetting_PayloadFormat, payloadTemplate string) error {
+	if format != storepb.WebhooksUserSetting_TEMPLATE {
+		return nil
+	}
+	if strings.TrimSpace(payloadTemplate) == "" {
+		return errors.New("template is required for the TEMPLATE format")
+	}
+	_, err := webhook.ParseTemplate(payloadTemplate)
+	return err
+}
+
+func convertWebhookPayloadFormatToStore(format v1pb.UserWebhook_PayloadFormat) storepb.WebhooksUserSetting_PayloadFormat {
+	switch format {
+	case v1pb.UserWebhook_MEMOS:
+		return storepb.WebhooksUserSetting_MEMOS
+	case v1pb.UserWebhook_SLACK:
+		return storepb.WebhooksUserSetting_SLACK
+	case v1pb.UserWebhook_DISCORD:
+		return storepb.WebhooksUserSetting_DISCORD
+	case v1pb.UserWebhook_MATTERMOST:
+		return storepb.WebhooksUserSetting_MATTERMOST
+	case v1pb.UserWebhook_TEMPLATE:
+		return storepb.WebhooksUserSetting_TEMPLATE
+	default:
+		return storepb.WebhooksUserSetting_PAYLOAD_FORMAT_UNSPECIFIED
+	}
+}
+
+func convertWebhookPayloadFormatFromStoreToAPI(format storepb.WebhooksUserSetting_PayloadFormat) v1pb.UserWebhook_PayloadFormat {
+	switch format {
+	case storepb.WebhooksUserSetting_SLACK:
+		return v1pb.UserWebhook_SLACK
+	case storepb.WebhooksUserSetting_DISCORD:
+		return v1pb.UserWebhook_DISCORD
+	case storepb.WebhooksUserSetting_MATTERMOST:
+		return v1pb.UserWebhook_MATTERMOST
+	case storepb.WebhooksUserSetting_TEMPLATE:
+		return v1pb.UserWebhook_TEMPLATE
+	default:
+		return v1pb.UserWebhook_MEMOS
+	}
+}
+
 // convertUserWebhookFromUserSetting converts a storepb webhook to a v1pb UserWebhook.
 func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, userID int32) *v1pb.UserWebhook {
 	return &v1pb.UserWebhook{
-		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-		Url:         webhook.Url,
-		DisplayName: webhook.Title,
+		Name:            fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
+		Url:             webhook.Url,
+		DisplayName:     webhook.Title,
+		ActivityTypes:   webhook.ActivityTypes,
+		Filter:          webhook.Filter,
+		PayloadFormat:   convertWebhookPayloadFormatFromStoreToAPI(webhook.PayloadFormat),
+		PayloadTemplate: webhook.PayloadTemplate,
 		// Note: create_time and update_time are not available in the user setting webhook structure
 		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
 	}
@@ -989,6 +1196,8 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_GENERAL, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]:
+		return storepb.UserSetting_NOTIFICATION, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1003,12 +1212,15 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_NOTIFICATION:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]
 	default:
 		return "unknown"
 	}
 }
 
 // convertUserSettingFromStore converts store UserSetting to API UserSetting.
+// It returns nil for stored settings the API does not expose, such as shortcuts or passkeys.
 func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32, key storepb.UserSetting_Key) *v1pb.UserSetting {
 	if storeSetting == nil {
 		// Return default setting if none exists
@@ -1024,6 +1236,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_NOTIFICATION:
+			setting.Value = &v1pb.UserSetting_NotificationSetting_{
+				NotificationSetting: &v1pb.UserSetting_NotificationSetting{},
+			}
 		default:
 			// Default to general setting
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1046,6 +1262,7 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Locale:         general.Locale,
 					MemoVisibility: general.MemoVisibility,
 					Theme:          general.Theme,
+					Timezone:       general.Timezone,
 				},
 			}
 		} else {
@@ -1057,23 +1274,22 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		webhooks := storeSetting.GetWebhooks()
 		apiWebhooks := make([]*v1pb.UserWebhook, 0, len(webhooks.Webhooks))
 		for _, webhook := range webhooks.Webhooks {
-			apiWebhook := &v1pb.UserWebhook{
-				Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-				Url:         webhook.Url,
-				DisplayName: webhook.Title,
-			}
-			apiWebhooks = append(apiWebhooks, apiWebhook)
+			apiWebhooks = append(apiWebhooks, convertUserWebhookFromUserSetting(webhook, userID))
 		}
 		setting.Value = &v1pb.UserSetting_WebhooksSetting_{
 			WebhooksSetting: &v1pb.UserSetting_WebhooksSetting{
 				Webhooks: apiWebhooks,
 			},
 		}
-	default:
-		// Default to general setting if unknown key
-		setting.Value = &v1pb.UserSetting_GeneralSetting_{
-			GeneralSetting: getDefaultUserGeneralSetting(),
+	case storepb.UserSetting_NOTIFICATION:
+		setting.Value = &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: &v1pb.UserSetting_NotificationSetting{
+				EmailEnabled:  storeSetting.GetNotification().GetEmailEnabled(),
+				DigestEnabled: storeSetting.GetNotification().GetDigestEnabled(),
+			},
 		}
+	default:
+		return nil
 	}
 
 	return setting
@@ -1094,6 +1310,7 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 					Locale:         general.Locale,
 					MemoVisibility: general.MemoVisibility,
 					Theme:          general.Theme,
+					Timezone:       general.Timezone,
 				},
 			}
 		} else {
@@ -1118,6 +1335,17 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		if notification := apiSetting.GetNotificationSetting(); notification != nil {
+			storeSetting.Value = &storepb.UserSetting_Notification{
+				Notification: &storepb.NotificationUserSetting{
+					EmailEnabled:  notification.EmailEnabled,
+					DigestEnabled: notification.DigestEnabled,
+				},
+			}
+		} else {
+			return nil, errors.Errorf("notification setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1250,11 +1478,10 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	}
 
 	// Fetch inbox items from storage
-	// Filter at database level to only include MEMO_COMMENT notifications (ignore legacy VERSION_UPDATE entries)
-	memoCommentType := storepb.InboxMessage_MEMO_COMMENT
+	// Filter at database level to only include MEMO_COMMENT, MENTION, REMINDER and DIGEST notifications (ignore legacy VERSION_UPDATE entries)
 	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
-		ReceiverID:  &userID,
-		MessageType: &memoCommentType,
+		ReceiverID:      &userID,
+		MessageTypeList: []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_MENTION, storepb.InboxMessage_REMINDER, storepb.InboxMessage_DIGEST},
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
@@ -1411,6 +1638,12 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
+		case storepb.InboxMessage_MENTION:
+			notification.Type = v1pb.UserNotification_MENTION
+		case storepb.InboxMessage_REMINDER:
+			notification.Type = v1pb.UserNotification_REMINDER
+		case storepb.InboxMessage_DIGEST:
+			notification.Type = v1pb.UserNotification_DIGEST
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
@@ -0,0 +1,309 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// userTwoFactorRecoveryCodeCount is the number of recovery codes issued when two-factor authentication is enabled.
+	userTwoFactorRecoveryCodeCount = 10
+	// userTwoFactorRecoveryCodeLength is the number of characters of a recovery code, without the separator.
+	userTwoFactorRecoveryCodeLength = 10
+
+	// maxTwoFactorFailedAttempts is the number of invalid codes after which two-factor verification is locked.
+	maxTwoFactorFailedAttempts = 5
+	// twoFactorLockoutDuration is how long two-factor verification stays locked.
+	twoFactorLockoutDuration = 15 * time.Minute
+
+	invalidTwoFactorCodeError = "invalid two-factor code"
+)
+
+// errTwoFactorLocked is returned while two-factor verification is locked after too many invalid codes.
+var errTwoFactorLocked = errors.New("too many invalid two-factor codes, try again later")
+
+// GetUserTwoFactor returns the two-factor authentication status of a user.
+//
+// Authorization: The user themselves or an admin.
+func (s *APIV1Service) GetUserTwoFactor(ctx context.Context, request *v1pb.GetUserTwoFactorRequest) (*v1pb.UserTwoFactor, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != userID && !isSuperUser(currentUser)) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication: %v", err)
+	}
+	return convertUserTwoFactorFromStore(userID, twoFactor), nil
+}
+
+// SetupUserTwoFactor generates a new TOTP secret for the current user.
+// The secret replaces any earlier pending secret and is only used for sign-in once it is enabled.
+//
+// Authorization: The user themselves.
+func (s *APIV1Service) SetupUserTwoFactor(ctx context.Context, request *v1pb.SetupUserTwoFactorRequest) (*v1pb.SetupUserTwoFactorResponse, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate two-factor secret: %v", err)
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, &storepb.TwoFactorUserSetting{Secret: secret}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save two-factor authentication: %v", err)
+	}
+	return &v1pb.SetupUserTwoFactorResponse{
+		Secret:     secret,
+		OtpauthUri: auth.BuildTOTPURI(currentUser.Username, secret),
+	}, nil
+}
+
+// EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+// Recovery codes are generated and only returned in this response, their SHA-256 hashes are stored.
+//
+// Authorization: The user themselves.
+func (s *APIV1Service) EnableUserTwoFactor(ctx context.Context, request *v1pb.EnableUserTwoFactorRequest) (*v1pb.EnableUserTwoFactorResponse, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if twoFactor.Secret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication has not been set up")
+	}
+	step, ok := auth.ValidateTOTPCode(twoFactor.Secret, request.Code, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, invalidTwoFactorCodeError)
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateTwoFactorRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	enabledTwoFactor := &storepb.TwoFactorUserSetting{
+		Secret:             twoFactor.Secret,
+		Enabled:            true,
+		EnabledAt:          timestamppb.Now(),
+		RecoveryCodeHashes: recoveryCodeHashes,
+		LastUsedStep:       step,
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, enabledTwoFactor); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save two-factor authentication: %v", err)
+	}
+	return &v1pb.EnableUserTwoFactorResponse{
+		TwoFactor:     convertUserTwoFactorFromStore(userID, enabledTwoFactor),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableUserTwoFactor disables two-factor authentication and removes the secret and recovery codes.
+//
+// Authorization: The user themselves with a TOTP or recovery code,
+// or an admin resetting another user who lost access to their authenticator app.
+func (s *APIV1Service) DisableUserTwoFactor(ctx context.Context, request *v1pb.DisableUserTwoFactorRequest) (*emptypb.Empty, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != userID && !isSuperUser(currentUser)) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if currentUser.ID == userID {
+		twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor authentication: %v", err)
+		}
+		if twoFactor.Enabled {
+			ok, err := s.verifyTwoFactorCode(ctx, userID, twoFactor, request.Code)
+			if errors.Is(err, errTwoFactorLocked) {
+				return nil, status.Error(codes.ResourceExhausted, err.Error())
+			}
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to verify two-factor code: %v", err)
+			}
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, invalidTwoFactorCodeError)
+			}
+		}
+	} else {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+	}
+
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, &storepb.TwoFactorUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save two-factor authentication: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// verifyTwoFactorCode checks a TOTP or recovery code of a user with two-factor authentication enabled.
+// Accepted codes are used up: the time step of a TOTP code is remembered to reject replays
+// and a recovery code is removed.
+// Invalid codes are counted, and after maxTwoFactorFailedAttempts of them verification is locked
+// for twoFactorLockoutDuration and errTwoFactorLocked is returned.
+func (s *APIV1Service) verifyTwoFactorCode(ctx context.Context, userID int32, twoFactor *storepb.TwoFactorUserSetting, code string) (bool, error) {
+	now := time.Now()
+	if twoFactor.LockedUntil != nil && now.Before(twoFactor.LockedUntil.AsTime()) {
+		return false, errTwoFactorLocked
+	}
+
+	// Work on a copy, the store may have handed out its cached setting.
+	updated, ok := proto.Clone(twoFactor).(*storepb.TwoFactorUserSetting)
+	if !ok {
+		return false, errors.New("unexpected two-factor setting type")
+	}
+	verified := false
+	if step, valid := auth.ValidateTOTPCode(updated.Secret, code, now); valid && step > updated.LastUsedStep {
+		updated.LastUsedStep = step
+		verified = true
+	} else if normalized := normalizeTwoFactorRecoveryCode(code); normalized != "" {
+		codeHash := hashTwoFactorRecoveryCode(normalized)
+		for i, recoveryCodeHash := range updated.RecoveryCodeHashes {
+			if subtle.ConstantTimeCompare([]byte(recoveryCodeHash), []byte(codeHash)) == 1 {
+				updated.RecoveryCodeHashes = append(updated.RecoveryCodeHashes[:i], updated.RecoveryCodeHashes[i+1:]...)
+				verified = true
+				break
+			}
+		}
+	}
+	if verified {
+		updated.FailedAttempts = 0
+		updated.LockedUntil = nil
+	} else {
+		updated.FailedAttempts++
+		if updated.FailedAttempts >= maxTwoFactorFailedAttempts {
+			updated.FailedAttempts = 0
+			updated.LockedUntil = timestamppb.New(now.Add(twoFactorLockoutDuration))
+		}
+	}
+
+	// Every attempt changes the setting, and the update fails if another attempt changed it first.
+	// Concurrent attempts thus cannot use the same code twice, and each accepted attempt has been counted.
+	swapped, err := s.Store.UpdateUserTwoFactor(ctx, userID, twoFactor, updated)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save two-factor authentication")
+	}
+	return verified && swapped, nil
+}
+
+// generateTwoFactorRecoveryCodes returns new recovery codes formatted as "xxxxx-xxxxx" and their hashes.
+func generateTwoFactorRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes := make([]string, 0, userTwoFactorRecoveryCodeCount)
+	recoveryCodeHashes := make([]string, 0, userTwoFactorRecoveryCodeCount)
+	for range userTwoFactorRecoveryCodeCount {
+		randomString, err := util.RandomString(userTwoFactorRecoveryCodeLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(randomString)
+		half := userTwoFactorRecoveryCodeLength / 2
+		recoveryCodes = append(recoveryCodes, code[:half]+"-"+code[half:])
+		recoveryCodeHashes = append(recoveryCodeHashes, hashTwoFactorRecoveryCode(code))
+	}
+	return recoveryCodes, recoveryCodeHashes, nil
+}
+
+// normalizeTwoFactorRecoveryCode strips the separator and spaces, so that codes are accepted however they were copied.
+func normalizeTwoFactorRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != userTwoFactorRecoveryCodeLength {
+		return ""
+	}
+	return code
+}
+
+func hashTwoFactorRecoveryCode(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+// extractUserIDFromTwoFactorName returns the user ID from a two-factor name.
+// e.g., "users/1/twoFactor" -> 1.
+func extractUserIDFromTwoFactorName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, "/twoFactor")
+	if !ok {
+		return 0, errors.Errorf("invalid two-factor name format: %s", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+func convertUserTwoFactorFromStore(userID int32, twoFactor *storepb.TwoFactorUserSetting) *v1pb.UserTwoFactor {
+	userTwoFactor := &v1pb.UserTwoFactor{
+		Name:    fmt.Sprintf("%s%d/twoFactor", UserNamePrefix, userID),
+		Enabled: twoFactor.Enabled,
+	}
+	if twoFactor.Enabled {
+		userTwoFactor.EnableTime = twoFactor.EnabledAt
+		userTwoFactor.RemainingRecoveryCodes = int32(len(twoFactor.RecoveryCodeHashes))
+	}
+	return userTwoFactor
+}
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListUserWebhookDeliveries(ctx context.Context, request *v1pb.ListUserWebhookDeliveriesRequest) (*v1pb.ListUserWebhookDeliveriesResponse, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	if _, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListUserWebhookDeliveriesResponse{
+		Deliveries:    []*v1pb.UserWebhookDelivery{},
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertUserWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RedeliverUserWebhookDelivery(ctx context.Context, request *v1pb.RedeliverUserWebhookDeliveryRequest) (*v1pb.UserWebhookDelivery, error) {
+	userID, webhookID, deliveryID, err := parseUserWebhookDeliveryName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery name: %v", err)
+	}
+	hook, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID:        &deliveryID,
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+	if delivery.Payload == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook delivery has no payload to redeliver")
+	}
+
+	// Send the original payload to the current URL of the webhook, as it may have been fixed since.
+	redelivery, err := s.newWebhookDeliveryRunner().Enqueue(ctx, &store.WebhookDelivery{
+		CreatorID:    userID,
+		WebhookID:    webhookID,
+		URL:          hook.Url,
+		ActivityType: delivery.ActivityType,
+		Payload:      delivery.Payload,
+		ContentType:  delivery.ContentType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook: %v", err)
+	}
+	return convertUserWebhookDeliveryFromStore(redelivery), nil
+}
+
+// getUserWebhookForCurrentUser returns the webhook if the current user is allowed to manage it.
+// newWebhookDeliveryRunner returns the runner delivering webhooks with the webhook settings of the server.
+func (s *APIV1Service) newWebhookDeliveryRunner() *webhookdelivery.Runner {
+	return webhookdelivery.NewRunner(s.Store, webhook.NewClient(s.Profile.AllowInternalWebhooks))
+}
+
+func (s *APIV1Service) getUserWebhookForCurrentUser(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	for _, webhook := range webhooks {
+		if webhook.Id == webhookID {
+			return webhook, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "webhook not found")
+}
+
+// parseUserWebhookDeliveryName parses a delivery name and returns the user ID, webhook ID and delivery ID.
+// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}.
+func parseUserWebhookDeliveryName(name string) (int32, string, int32, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 6 || parts[4] != "deliveries" {
+		return 0, "", 0, errors.New("invalid delivery name format")
+	}
+	webhookID, userID, err := parseUserWebhookName(strings.Join(parts[:4], "/"))
+	if err != nil {
+		return 0, "", 0, err
+	}
+	deliveryID, err := strconv.ParseInt(parts[5], 10, 32)
+	if err != nil {
+		return 0, "", 0, errors.New("invalid delivery ID in delivery name")
+	}
+	return userID, webhookID, int32(deliveryID), nil
+}
+
+func convertUserWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.UserWebhookDelivery {
+	deliverypb := &v1pb.UserWebhookDelivery{
+		Name:         fmt.Sprintf("users/%d/webhooks/%s/deliveries/%d", delivery.CreatorID, delivery.WebhookID, delivery.ID),
+		ActivityType: delivery.ActivityType,
+		Url:          delivery.URL,
+		Payload:      delivery.Payload,
+		State:        convertWebhookDeliveryStatusFromStore(delivery.Status),
+		Attempts:     delivery.Attempts,
+		StatusCode:   delivery.ResponseStatus,
+		ResponseBody: delivery.ResponseBody,
+		Error:        delivery.ErrorMessage,
+		CreateTime:   timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime:   timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+	if delivery.Status == store.WebhookDeliveryPending {
+		deliverypb.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	}
+	return deliverypb
+}
+
+func convertWebhookDeliveryStatusFromStore(deliveryStatus store.WebhookDeliveryStatus) v1pb.UserWebhookDelivery_State {
+	switch deliveryStatus {
+	case store.WebhookDeliveryPending:
+		return v1pb.UserWebhookDelivery_PENDING
+	case store.WebhookDeliverySucceeded:
+		return v1pb.UserWebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		return v1pb.UserWebhookDelivery_FAILED
+	default:
+		return v1pb.UserWebhookDelivery_STATE_UNSPECIFIED
+	}
+}
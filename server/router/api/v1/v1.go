@@ -12,6 +12,7 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/scheduler"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
@@ -24,6 +25,8 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedAttachmentServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedMemoTemplateServiceServer
+	v1pb.UnimplementedTagServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
 
@@ -31,6 +34,8 @@ type APIV1Service struct {
 	Profile         *profile.Profile
 	Store           *store.Store
 	MarkdownService markdown.Service
+	// Scheduler runs the background jobs of the server; nil if jobs are not available.
+	Scheduler *scheduler.Scheduler
 
 	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
 	thumbnailSemaphore *semaphore.Weighted
@@ -39,6 +44,10 @@ type APIV1Service struct {
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store) *APIV1Service {
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMentionExtension(),
+		markdown.WithWikiLinkExtension(),
+		markdown.WithEmbedExtension(),
+		markdown.WithFrontmatterExtension(),
 	)
 	return &APIV1Service{
 		Secret:             secret,
@@ -112,6 +121,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterMemoTemplateServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTagServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterActivityServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
@@ -44,6 +44,7 @@ type RSSService struct {
 	Profile         *profile.Profile
 	Store           *store.Store
 	MarkdownService markdown.Service
+	EmbedResolver   markdown.EmbedResolver
 
 	// Cache for RSS feeds
 	cache      map[string]*cacheEntry
@@ -56,11 +57,12 @@ type RSSHeading struct {
 	Language    string
 }
 
-func NewRSSService(profile *profile.Profile, store *store.Store, markdownService markdown.Service) *RSSService {
+func NewRSSService(profile *profile.Profile, store *store.Store, markdownService markdown.Service, embedResolver markdown.EmbedResolver) *RSSService {
 	return &RSSService{
 		Profile:         profile,
 		Store:           store,
 		MarkdownService: markdownService,
+		EmbedResolver:   embedResolver,
 		cache:           make(map[string]*cacheEntry),
 	}
 }
@@ -236,7 +238,7 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 		title := s.generateItemTitle(memo.Content)
 
 		// Render content as HTML
-		htmlContent, err := s.getRSSItemDescription(memo.Content)
+		htmlContent, err := s.getRSSItemDescription(ctx, memo.Content)
 		if err != nil {
 			return "", lastModified, err
 		}
@@ -332,8 +334,12 @@ func (*RSSService) generateItemTitle(content string) string {
 	return title
 }
 
-func (s *RSSService) getRSSItemDescription(content string) (string, error) {
-	html, err := s.MarkdownService.RenderHTML([]byte(content))
+func (s *RSSService) getRSSItemDescription(ctx context.Context, content string) (string, error) {
+	opts := []markdown.RenderOption{}
+	if s.EmbedResolver != nil {
+		opts = append(opts, markdown.WithEmbedResolver(ctx, s.EmbedResolver))
+	}
+	html, err := s.MarkdownService.RenderHTML([]byte(content), opts...)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,164 @@
+package linkpreview
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// TTL is how long fetched metadata is used before the page is fetched again.
+	TTL = 7 * 24 * time.Hour
+	// retryAfter is how long to wait before fetching a page again after a failed fetch.
+	retryAfter = 24 * time.Hour
+	// maxURLLength is the length of the longest URL that is previewed, as the URL is the key of the metadata.
+	maxURLLength = 768
+	// maxTextLength is the maximum number of bytes kept of the title and description.
+	maxTextLength = 1024
+	// batchSize is the number of memos and URLs processed at once.
+	batchSize = 100
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Enqueue fetches the metadata of the URLs in the background.
+// If the process stops before the fetch finishes, RunOnce picks the URLs up.
+func (r *Runner) Enqueue(urls []string) {
+	if len(urls) == 0 {
+		return
+	}
+	go func() {
+		if err := r.Refresh(context.Background(), urls); err != nil {
+			slog.Warn("Failed to fetch link previews", slog.Any("err", err))
+		}
+	}()
+}
+
+// RunOnce fetches the metadata of the links in all memos that is missing or expired,
+// and removes the metadata of links that are no longer used.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	normalStatus := store.Normal
+	limit := batchSize
+	offset := 0
+	memoFind := &store.FindMemo{
+		RowStatus:      &normalStatus,
+		ExcludeContent: true,
+		Limit:          &limit,
+		Offset:         &offset,
+	}
+	urls := []string{}
+	for {
+		memos, err := r.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+		for _, memo := range memos {
+			urls = append(urls, memo.Payload.GetLinks()...)
+		}
+		offset += limit
+	}
+
+	slices.Sort(urls)
+	urls = slices.Compact(urls)
+	for batch := range slices.Chunk(urls, batchSize) {
+		if err := r.Refresh(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	// The metadata of all used links has just been refreshed, so older metadata is unused.
+	expiredBefore := time.Now().Add(-TTL).Unix()
+	if err := r.Store.DeleteLinkMetadata(ctx, &store.DeleteLinkMetadata{FetchedTsBefore: &expiredBefore}); err != nil {
+		return errors.Wrap(err, "failed to delete expired link metadata")
+	}
+	return nil
+}
+
+// Refresh fetches the metadata of the URLs that has not been fetched yet or is expired.
+func (r *Runner) Refresh(ctx context.Context, urls []string) error {
+	urls = slices.DeleteFunc(slices.Clone(urls), func(url string) bool {
+		return len(url) > maxURLLength
+	})
+	if len(urls) == 0 {
+		return nil
+	}
+	list, err := r.Store.ListLinkMetadata(ctx, &store.FindLinkMetadata{URLList: urls})
+	if err != nil {
+		return errors.Wrap(err, "failed to list link metadata")
+	}
+	now := time.Now()
+	fresh := make(map[string]bool)
+	for _, metadata := range list {
+		fetchedTime := time.Unix(metadata.FetchedTs, 0)
+		if metadata.ErrorMessage != "" {
+			fresh[metadata.URL] = now.Before(fetchedTime.Add(retryAfter))
+		} else {
+			fresh[metadata.URL] = now.Before(fetchedTime.Add(TTL))
+		}
+	}
+
+	for _, url := range urls {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if fresh[url] {
+			continue
+		}
+		fresh[url] = true
+		if err := r.fetch(ctx, url); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetch fetches the metadata of the page and stores it, along with the error if the fetch failed.
+// Internal addresses are rejected by httpgetter.
+func (r *Runner) fetch(ctx context.Context, url string) error {
+	metadata := &store.LinkMetadata{
+		URL:       url,
+		FetchedTs: time.Now().Unix(),
+	}
+	htmlMeta, err := httpgetter.GetHTMLMeta(url)
+	if err != nil {
+		slog.Debug("Failed to fetch link metadata", slog.String("url", url), slog.Any("err", err))
+		metadata.ErrorMessage = err.Error()
+	} else {
+		metadata.Title = truncate(htmlMeta.Title, maxTextLength)
+		metadata.Description = truncate(htmlMeta.Description, maxTextLength)
+		if len(htmlMeta.Image) <= maxURLLength {
+			metadata.Image = htmlMeta.Image
+		}
+	}
+	if _, err := r.Store.UpsertLinkMetadata(ctx, metadata); err != nil {
+		return errors.Wrap(err, "failed to upsert link metadata")
+	}
+	return nil
+}
+
+// truncate trims s and cuts it to size bytes, replacing invalid UTF-8 such as a rune cut in half.
+func truncate(s string, size int) string {
+	s = strings.TrimSpace(s)
+	if len(s) > size {
+		s = s[:size]
+	}
+	return strings.ToValidUTF8(s, "")
+}
@@ -24,7 +24,7 @@ func NewRunner(store *store.Store, markdownService markdown.Service) *Runner {
 }
 
 // RunOnce rebuilds the payload of all memos.
-func (r *Runner) RunOnce(ctx context.Context) {
+func (r *Runner) RunOnce(ctx context.Context) error {
 	// Process memos in batches to avoid loading all memos into memory at once
 	const batchSize = 100
 	offset := 0
@@ -37,8 +37,7 @@ func (r *Runner) RunOnce(ctx context.Context) {
 			Offset: &offset,
 		})
 		if err != nil {
-			slog.Error("failed to list memos", "err", err)
-			return
+			return errors.Wrap(err, "failed to list memos")
 		}
 
 		// Break if no more memos
@@ -53,10 +52,16 @@ func (r *Runner) RunOnce(ctx context.Context) {
 				slog.Error("failed to rebuild memo payload", "err", err, "memoID", memo.ID)
 				continue
 			}
+			// Skip memos edited since they were listed, so the rebuilt payload does not overwrite theirs.
 			if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
-				ID:      memo.ID,
-				Payload: memo.Payload,
+				ID:                memo.ID,
+				Payload:           memo.Payload,
+				ExpectedContent:   &memo.Content,
+				ExpectedUpdatedTs: &memo.UpdatedTs,
 			}); err != nil {
+				if errors.Is(err, store.ErrMemoConflict) {
+					continue
+				}
 				slog.Error("failed to update memo", "err", err, "memoID", memo.ID)
 				continue
 			}
@@ -69,6 +74,7 @@ func (r *Runner) RunOnce(ctx context.Context) {
 		// Move to next batch
 		offset += len(memos)
 	}
+	return nil
 }
 
 func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) error {
@@ -83,6 +89,10 @@ func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) erro
 	}
 
 	memo.Payload.Tags = data.Tags
+	memo.Payload.Mentions = data.Mentions
+	memo.Payload.Properties = data.Properties
+	memo.Payload.Tasks = data.Tasks
+	memo.Payload.Links = data.Links
 	memo.Payload.Property = data.Property
 	return nil
 }
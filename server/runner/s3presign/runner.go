@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/plugin/storage/s3"
@@ -22,31 +23,15 @@ func NewRunner(store *store.Store) *Runner {
 	}
 }
 
-// Schedule runner every 12 hours.
-const runnerInterval = time.Hour * 12
-
-func (r *Runner) Run(ctx context.Context) {
-	ticker := time.NewTicker(runnerInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			r.RunOnce(ctx)
-		case <-ctx.Done():
-			return
-		}
-	}
-}
-
-func (r *Runner) RunOnce(ctx context.Context) {
-	r.CheckAndPresign(ctx)
+// RunOnce presigns the S3 attachments whose presigned URL is about to expire.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	return r.CheckAndPresign(ctx)
 }
 
-func (r *Runner) CheckAndPresign(ctx context.Context) {
+func (r *Runner) CheckAndPresign(ctx context.Context) error {
 	instanceStorageSetting, err := r.Store.GetInstanceStorageSetting(ctx)
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to get instance storage setting")
 	}
 
 	s3StorageType := storepb.AttachmentStorageType_S3
@@ -63,8 +48,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 			Offset:      &offset,
 		})
 		if err != nil {
-			slog.Error("Failed to list attachments for presigning", "error", err)
-			return
+			return errors.Wrap(err, "failed to list attachments for presigning")
 		}
 
 		// Break if no more attachments
@@ -131,4 +115,5 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		// Move to next batch
 		offset += len(attachments)
 	}
+	return nil
 }
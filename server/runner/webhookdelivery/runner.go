@@ -0,0 +1,223 @@
+package webhookdelivery
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/netguard"
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// MaxAttempts is the number of attempts made before a delivery is marked as failed.
+	MaxAttempts = 8
+	// retention is how long deliveries are kept in the delivery log.
+	retention = 30 * 24 * time.Hour
+	// maxStoredResponseBodySize is the maximum number of bytes of the response body kept in the delivery log.
+	maxStoredResponseBodySize = 4 * 1024
+	// batchSize is the maximum number of due deliveries attempted per run, the rest are attempted in later runs.
+	batchSize = 100
+)
+
+// backoffBase is the delay before the first retry. It doubles after each failed attempt.
+var backoffBase = time.Minute
+
+type Runner struct {
+	Store  *store.Store
+	Client *webhook.Client
+}
+
+func NewRunner(store *store.Store, client *webhook.Client) *Runner {
+	return &Runner{
+		Store:  store,
+		Client: client,
+	}
+}
+
+// Enqueue stores a pending delivery and attempts it right away in the background.
+// If the process stops before the attempt finishes, RunOnce picks the delivery up once it is due.
+func (r *Runner) Enqueue(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	create.Status = store.WebhookDeliveryPending
+	create.Attempts = 0
+	create.NextAttemptTs = time.Now().Add(backoffBase).Unix()
+	delivery, err := r.Store.CreateWebhookDelivery(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+
+	go func(delivery *store.WebhookDelivery) {
+		if _, err := r.Deliver(context.Background(), delivery); err != nil {
+			slog.Warn("Failed to deliver webhook",
+				slog.Int("deliveryID", int(delivery.ID)),
+				slog.String("url", delivery.URL),
+				slog.String("activityType", delivery.ActivityType),
+				slog.Any("err", err))
+		}
+	}(delivery)
+	return delivery, nil
+}
+
+// RunOnce attempts up to batchSize pending deliveries that are due and removes expired deliveries from the log.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	pending := store.WebhookDeliveryPending
+	now := time.Now().Unix()
+	limit := batchSize
+	deliveries, err := r.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:              &pending,
+		NextAttemptTsBefore: &now,
+		Limit:               &limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending webhook deliveries")
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := r.Deliver(ctx, delivery); err != nil {
+			slog.Warn("Failed to deliver webhook",
+				slog.Int("deliveryID", int(delivery.ID)),
+				slog.String("url", delivery.URL),
+				slog.Int("attempts", int(delivery.Attempts)+1),
+				slog.Any("err", err))
+		}
+	}
+
+	expiredBefore := time.Now().Add(-retention).Unix()
+	if err := r.Store.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{CreatedTsBefore: &expiredBefore}); err != nil {
+		return errors.Wrap(err, "failed to delete expired webhook deliveries")
+	}
+	return nil
+}
+
+// Deliver attempts the delivery once and records the outcome.
+// A failed attempt is scheduled for retry with exponential backoff until MaxAttempts is reached.
+// The returned error describes why the attempt failed; the updated delivery is returned in either case.
+// If another worker has claimed the attempt already, nothing is sent and nil is returned.
+func (r *Runner) Deliver(ctx context.Context, delivery *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	attempts := delivery.Attempts + 1
+	// Claim the attempt first, so that the background attempt of Enqueue and RunOnce, or several
+	// instances sharing the database, never send the same delivery twice. If the attempt never
+	// records its outcome, the delivery is retried once the claim expires.
+	claimed, err := r.Store.ClaimWebhookDelivery(ctx, &store.ClaimWebhookDelivery{
+		ID:            delivery.ID,
+		Attempts:      delivery.Attempts,
+		NextAttemptTs: time.Now().Add(NextAttemptDelay(attempts)).Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim webhook delivery")
+	}
+	if !claimed {
+		return nil, nil
+	}
+
+	// Look up the secret on every attempt so that retries use the latest rotated secret.
+	hook, err := r.findWebhook(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		// The webhook was removed after the delivery was queued.
+		return r.abandon(ctx, delivery, "webhook no longer exists")
+	}
+	response, deliverErr := r.Client.Send(ctx, delivery.URL, []byte(delivery.Payload), delivery.ContentType, hook.Secret)
+
+	now := time.Now()
+	updatedTs := now.Unix()
+	status := store.WebhookDeliverySucceeded
+	nextAttemptTs := updatedTs
+	responseStatus, responseBody, errorMessage := int32(0), "", ""
+	if response != nil {
+		responseStatus = int32(response.StatusCode)
+		responseBody = truncate(response.Body, maxStoredResponseBodySize)
+	}
+	if deliverErr != nil {
+		errorMessage = deliverErr.Error()
+		if errors.Is(deliverErr, netguard.ErrInternalAddress) {
+			errorMessage += " (the server must be started with --allow-internal-webhooks to send webhooks to internal addresses)"
+		}
+		if attempts >= MaxAttempts {
+			status = store.WebhookDeliveryFailed
+		} else {
+			status = store.WebhookDeliveryPending
+			nextAttemptTs = now.Add(NextAttemptDelay(attempts)).Unix()
+		}
+	}
+
+	updated, err := r.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:             delivery.ID,
+		UpdatedTs:      &updatedTs,
+		Status:         &status,
+		Attempts:       &attempts,
+		NextAttemptTs:  &nextAttemptTs,
+		ResponseStatus: &responseStatus,
+		ResponseBody:   &responseBody,
+		ErrorMessage:   &errorMessage,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update webhook delivery")
+	}
+	return updated, deliverErr
+}
+
+func (r *Runner) findWebhook(ctx context.Context, delivery *store.WebhookDelivery) (*storepb.WebhooksUserSetting_Webhook, error) {
+	var webhooks []*storepb.WebhooksUserSetting_Webhook
+	if delivery.CreatorID == store.InstanceWebhookCreatorID {
+		instanceWebhooksSetting, err := r.Store.GetInstanceWebhooksSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get instance webhooks")
+		}
+		webhooks = instanceWebhooksSetting.Webhooks
+	} else {
+		userWebhooks, err := r.Store.GetUserWebhooks(ctx, delivery.CreatorID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user webhooks")
+		}
+		webhooks = userWebhooks
+	}
+	for _, hook := range webhooks {
+		if hook.Id == delivery.WebhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
+
+// abandon marks the delivery as failed without attempting it.
+func (r *Runner) abandon(ctx context.Context, delivery *store.WebhookDelivery, reason string) (*store.WebhookDelivery, error) {
+	updatedTs := time.Now().Unix()
+	status := store.WebhookDeliveryFailed
+	updated, err := r.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:           delivery.ID,
+		UpdatedTs:    &updatedTs,
+		Status:       &status,
+		ErrorMessage: &reason,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update webhook delivery")
+	}
+	return updated, errors.New(reason)
+}
+
+// NextAttemptDelay returns the delay before retrying a delivery that has failed the given number of attempts.
+func NextAttemptDelay(attempts int32) time.Duration {
+	if attempts < 1 {
+		return backoffBase
+	}
+	return backoffBase << (attempts - 1)
+}
+
+// truncate cuts s to size bytes and replaces invalid UTF-8, such as a rune cut in half, so it can be stored as text.
+func truncate(s string, size int) string {
+	if len(s) > size {
+		s = s[:size]
+	}
+	return strings.ToValidUTF8(s, "\uFFFD")
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	"github.com/usememos/memos/plugin/webhook"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/linkpreview"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+)
+
+const (
+	// S3PresignJobName refreshes presigned URLs of S3 attachments.
+	S3PresignJobName = "s3-presign"
+	// MemoPayloadJobName rebuilds the payload (tags, properties) of all memos.
+	MemoPayloadJobName = "memo-payload-rebuild"
+	// WebhookDeliveryJobName retries pending webhook deliveries.
+	WebhookDeliveryJobName = "webhook-delivery"
+	// LinkPreviewJobName fetches the previews of links in memos.
+	LinkPreviewJobName = "link-preview"
+	// MemoPublishJobName publishes scheduled memos.
+	MemoPublishJobName = "memo-publish"
+	// MemoReminderJobName sends due memo reminders.
+	MemoReminderJobName = "memo-reminder"
+	// MemoTemplateJobName creates memos from recurring memo templates.
+	MemoTemplateJobName = "memo-template"
+	// MemoDigestJobName sends the daily memo digests.
+	MemoDigestJobName = "memo-digest"
+)
+
+// newScheduler creates the scheduler that runs all background jobs of the server.
+func (s *Server) newScheduler(apiV1Service *apiv1.APIV1Service) (*scheduler.Scheduler, error) {
+	jobScheduler := scheduler.New(
+		scheduler.WithMiddleware(
+			scheduler.Recovery(func(jobName string, recovered interface{}) {
+				slog.Error("job panicked", "job", jobName, "panic", recovered)
+			}),
+			scheduler.Logging(jobLogger{logger: slog.Default()}),
+		),
+	)
+
+	jobs := []*scheduler.Job{
+		{
+			Name:        S3PresignJobName,
+			Schedule:    "0 */12 * * *",
+			Description: "Refresh presigned URLs of S3 attachments before they expire.",
+			Handler:     s3presign.NewRunner(s.Store).RunOnce,
+		},
+		{
+			Name:        MemoPayloadJobName,
+			Schedule:    "30 3 * * 0",
+			Description: "Rebuild the tags and properties of all memos from their content.",
+			Handler:     memopayload.NewRunner(s.Store, apiV1Service.MarkdownService).RunOnce,
+		},
+		{
+			Name:        WebhookDeliveryJobName,
+			Schedule:    "* * * * *",
+			Description: "Retry pending webhook deliveries and prune the delivery log.",
+			Handler:     webhookdelivery.NewRunner(s.Store, webhook.NewClient(s.Profile.AllowInternalWebhooks)).RunOnce,
+		},
+		{
+			Name:        LinkPreviewJobName,
+			Schedule:    "15 * * * *",
+			Description: "Fetch missing and expired previews of links in memos and prune unused previews.",
+			Handler:     linkpreview.NewRunner(s.Store).RunOnce,
+		},
+		{
+			Name:        MemoPublishJobName,
+			Schedule:    "* * * * *",
+			Description: "Publish scheduled memos whose publish time has passed.",
+			Handler:     apiV1Service.PublishScheduledMemos,
+		},
+		{
+			Name:        MemoReminderJobName,
+			Schedule:    "* * * * *",
+			Description: "Send due memo reminders and schedule the next time of recurring reminders.",
+			Handler:     apiV1Service.SendDueMemoReminders,
+		},
+		{
+			Name:        MemoTemplateJobName,
+			Schedule:    "* * * * *",
+			Description: "Create memos from the memo templates whose cron schedule is due.",
+			Handler:     apiV1Service.CreateScheduledTemplateMemos,
+		},
+		{
+			Name:        MemoDigestJobName,
+			Schedule:    "0 * * * *",
+			Description: "Send the daily digest of memos from this day in previous years and memos due for review.",
+			Handler:     apiV1Service.SendMemoDigests,
+		},
+	}
+	for _, job := range jobs {
+		if err := jobScheduler.Register(job); err != nil {
+			return nil, errors.Wrapf(err, "failed to register job %q", job.Name)
+		}
+	}
+	return jobScheduler, nil
+}
+
+// jobLogger logs the runs of jobs at debug level and their failures at error level,
+// so that jobs running every minute do not flood the log.
+type jobLogger struct {
+	logger *slog.Logger
+}
+
+func (l jobLogger) Info(msg string, args ...interface{}) {
+	l.logger.Debug(msg, args...)
+}
+
+func (l jobLogger) Error(msg string, args ...interface{}) {
+	l.logger.Error(msg, args...)
+}
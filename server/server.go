@@ -15,13 +15,13 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/scheduler"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/fileserver"
 	immichrouter "github.com/usememos/memos/server/router/immich"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
-	"github.com/usememos/memos/server/runner/s3presign"
 	"github.com/usememos/memos/store"
 )
 
@@ -30,8 +30,8 @@ type Server struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
-	echoServer        *echo.Echo
-	runnerCancelFuncs []context.CancelFunc
+	echoServer *echo.Echo
+	scheduler  *scheduler.Scheduler
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -70,6 +70,13 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store)
 
+	jobScheduler, err := s.newScheduler(apiV1Service)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create scheduler")
+	}
+	s.scheduler = jobScheduler
+	apiV1Service.Scheduler = jobScheduler
+
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
 	// This uses native HTTP serving (http.ServeContent) instead of gRPC for video/audio files.
 	fileServerService := fileserver.NewFileServerService(s.Profile, s.Store, s.Secret)
@@ -77,7 +84,8 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	immichrouter.NewService(s.Store, s.Secret).RegisterRoutes(echoServer)
 
 	// Create and register RSS routes (needs markdown service from apiV1Service).
-	rss.NewRSSService(s.Profile, s.Store, apiV1Service.MarkdownService).RegisterRoutes(rootGroup)
+	// Feeds are public, so memos are embedded as seen by an anonymous viewer.
+	rss.NewRSSService(s.Profile, s.Store, apiV1Service.MarkdownService, apiv1.NewMemoEmbedResolver(s.Store, nil)).RegisterRoutes(rootGroup)
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -118,11 +126,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 
 	slog.Info("server shutting down")
 
-	// Cancel all background runners
-	for _, cancelFunc := range s.runnerCancelFuncs {
-		if cancelFunc != nil {
-			cancelFunc()
-		}
+	// Stop background jobs, waiting for running ones to finish.
+	if err := s.scheduler.Stop(ctx); err != nil {
+		slog.Error("failed to stop scheduler", slog.String("error", err.Error()))
 	}
 
 	// Shutdown echo server.
@@ -138,23 +144,16 @@ func (s *Server) Shutdown(ctx context.Context) {
 	slog.Info("memos stopped properly")
 }
 
-func (s *Server) StartBackgroundRunners(ctx context.Context) {
-	// Create a separate context for each background runner
-	// This allows us to control cancellation for each runner independently
-	s3Context, s3Cancel := context.WithCancel(ctx)
-
-	// Store the cancel function so we can properly shut down runners
-	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel)
-
-	// Create and start S3 presign runner
-	s3presignRunner := s3presign.NewRunner(s.Store)
-	s3presignRunner.RunOnce(ctx)
+func (s *Server) StartBackgroundRunners(_ context.Context) {
+	if err := s.scheduler.Start(); err != nil {
+		slog.Error("failed to start scheduler", slog.String("error", err.Error()))
+		return
+	}
 
-	// Start continuous S3 presign runner
-	go func() {
-		s3presignRunner.Run(s3Context)
-		slog.Info("s3presign runner stopped")
-	}()
+	// Presign S3 attachments on startup as well, as the server may have been down for a while.
+	if err := s.scheduler.Trigger(S3PresignJobName); err != nil {
+		slog.Error("failed to trigger s3 presign job", slog.String("error", err.Error()))
+	}
 
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
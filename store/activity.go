@@ -9,7 +9,10 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment  ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoMention  ActivityType = "MEMO_MENTION"
+	ActivityTypeMemoReminder ActivityType = "MEMO_REMINDER"
+	ActivityTypeMemoDigest   ActivityType = "MEMO_DIGEST"
 )
 
 func (t ActivityType) String() string {
@@ -69,6 +69,14 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 			where, args = append(where, "JSON_EXTRACT(`message`, '$.type') = ?"), append(args, find.MessageType.String())
 		}
 	}
+	if len(find.MessageTypeList) > 0 {
+		placeholders := make([]string, 0, len(find.MessageTypeList))
+		for _, messageType := range find.MessageTypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, messageType.String())
+		}
+		where = append(where, "JSON_EXTRACT(`message`, '$.type') IN ("+strings.Join(placeholders, ",")+")")
+	}
 
 	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
 	if find.Limit != nil {
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -121,6 +122,16 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if find.OrderByRelevance {
+		queries, err := filter.CollectSearchQueries(ctx, engine, find.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if len(queries) > 0 {
+			orderBy = append(orderBy, "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE) DESC")
+			args = append(args, filter.FormatSearchQuery(filter.DialectMySQL, strings.Join(queries, " ")))
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
@@ -214,6 +225,36 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return updateMemo(ctx, d.db, update)
+}
+
+// UpdateMemos applies the updates, and upserts the user setting if not nil, in a single transaction.
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo, userSetting *store.UserSetting) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	if userSetting != nil {
+		if err := upsertUserSetting(ctx, tx, userSetting); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func updateMemo(ctx context.Context, db execer, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -246,12 +287,30 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if len(set) == 0 {
 		return nil
 	}
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedContent; v != nil {
+		where, args = append(where, "`content` = ?"), append(args, *v)
+	}
+	if v := update.ExpectedUpdatedTs; v != nil {
+		where, args = append(where, "UNIX_TIMESTAMP(`updated_ts`) = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if len(where) > 1 {
+		// MySQL counts changed rows, which is the same as matched rows as long as the update changes the memo.
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return store.ErrMemoConflict
+		}
+	}
 	return nil
 }
 
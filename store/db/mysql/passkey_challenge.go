@@ -0,0 +1,38 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreatePasskeyChallenge(ctx context.Context, create *store.PasskeyChallenge) (bool, error) {
+	stmt := "INSERT IGNORE INTO `passkey_challenge` (`challenge`, `expires_ts`) VALUES (?, FROM_UNIXTIME(?))"
+	result, err := d.db.ExecContext(ctx, stmt, create.Challenge, create.ExpiresTs)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (d *DB) DeletePasskeyChallenge(ctx context.Context, delete *store.DeletePasskeyChallenge) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ExpiresTsBefore != nil {
+		where, args = append(where, "`expires_ts` < FROM_UNIXTIME(?)"), append(args, *delete.ExpiresTsBefore)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting passkey challenges")
+	}
+	stmt := "DELETE FROM `passkey_challenge` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -11,13 +11,34 @@ import (
 )
 
 func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (*store.UserSetting, error) {
-	stmt := "INSERT INTO `user_setting` (`user_id`, `key`, `value`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `value` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value, upsert.Value); err != nil {
+	if err := upsertUserSetting(ctx, d.db, upsert); err != nil {
 		return nil, err
 	}
 	return upsert, nil
 }
 
+func upsertUserSetting(ctx context.Context, db execer, upsert *store.UserSetting) error {
+	stmt := "INSERT INTO `user_setting` (`user_id`, `key`, `value`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `value` = ?"
+	if _, err := db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value, upsert.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) UpdateUserSetting(ctx context.Context, update *store.UpdateUserSetting) (bool, error) {
+	stmt := "UPDATE `user_setting` SET `value` = ? WHERE `user_id` = ? AND `key` = ? AND `value` = ?"
+	result, err := d.db.ExecContext(ctx, stmt, update.Value, update.UserID, update.Key.String(), update.ExpectedValue)
+	if err != nil {
+		return false, err
+	}
+	// MySQL counts changed rows, which is the same as matched rows as long as the update changes the value.
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
 func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting) ([]*store.UserSetting, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
@@ -0,0 +1,192 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const webhookDeliveryFields = "`id`, `creator_id`, `webhook_id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `url`, `activity_type`, `payload`, `content_type`, `status`, `attempts`, UNIX_TIMESTAMP(`next_attempt_ts`), `response_status`, `response_body`, `error_message`"
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"`creator_id`", "`webhook_id`", "`url`", "`activity_type`", "`payload`", "`content_type`", "`status`", "`attempts`", "`next_attempt_ts`", "`response_status`", "`response_body`", "`error_message`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "FROM_UNIXTIME(?)", "?", "?", "?"}
+	args := []any{create.CreatorID, create.WebhookID, create.URL, create.ActivityType, create.Payload, create.ContentType, create.Status, create.Attempts, create.NextAttemptTs, create.ResponseStatus, create.ResponseBody, create.ErrorMessage}
+
+	stmt := "INSERT INTO `webhook_delivery` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	delivery, err := d.getWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, errors.Errorf("failed to create webhook delivery")
+	}
+	return delivery, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *find.WebhookID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, *find.Status)
+	}
+	if find.NextAttemptTsBefore != nil {
+		where, args = append(where, "`next_attempt_ts` <= FROM_UNIXTIME(?)"), append(args, *find.NextAttemptTsBefore)
+	}
+
+	query := "SELECT " + webhookDeliveryFields + " FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+			&delivery.URL,
+			&delivery.ActivityType,
+			&delivery.Payload,
+			&delivery.ContentType,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptTs,
+			&delivery.ResponseStatus,
+			&delivery.ResponseBody,
+			&delivery.ErrorMessage,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "`attempts` = ?"), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "`next_attempt_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.ResponseStatus; v != nil {
+		set, args = append(set, "`response_status` = ?"), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "`response_body` = ?"), append(args, *v)
+	}
+	if v := update.ErrorMessage; v != nil {
+		set, args = append(set, "`error_message` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `webhook_delivery` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	delivery, err := d.getWebhookDelivery(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, errors.Errorf("webhook delivery %d not found", update.ID)
+	}
+	return delivery, nil
+}
+
+func (d *DB) ClaimWebhookDelivery(ctx context.Context, claim *store.ClaimWebhookDelivery) (bool, error) {
+	stmt := "UPDATE `webhook_delivery` SET `attempts` = `attempts` + 1, `next_attempt_ts` = FROM_UNIXTIME(?) WHERE `id` = ? AND `attempts` = ? AND `status` = ?"
+	result, err := d.db.ExecContext(ctx, stmt, claim.NextAttemptTs, claim.ID, claim.Attempts, store.WebhookDeliveryPending)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (d *DB) DeleteWebhookDelivery(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < FROM_UNIXTIME(?)"), append(args, *delete.CreatedTsBefore)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting webhook deliveries")
+	}
+	stmt := "DELETE FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) getWebhookDelivery(ctx context.Context, id int32) (*store.WebhookDelivery, error) {
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
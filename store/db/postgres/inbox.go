@@ -60,6 +60,14 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 			where, args = append(where, "message::JSONB->>'type' = "+placeholder(len(args)+1)), append(args, find.MessageType.String())
 		}
 	}
+	if len(find.MessageTypeList) > 0 {
+		holders := make([]string, 0, len(find.MessageTypeList))
+		for _, messageType := range find.MessageTypeList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, messageType.String())
+		}
+		where = append(where, "message::JSONB->>'type' IN ("+strings.Join(holders, ", ")+")")
+	}
 
 	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
 	if find.Limit != nil {
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoReminder(ctx context.Context, create *store.MemoReminder) (*store.MemoReminder, error) {
+	fields := []string{"memo_id", "remind_ts", "cron"}
+	args := []any{create.MemoID, create.RemindTs, create.Cron}
+
+	stmt := "INSERT INTO memo_reminder (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListMemoReminders(ctx context.Context, find *store.FindMemoReminder) ([]*store.MemoReminder, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.RemindTsBefore != nil {
+		where, args = append(where, "remind_ts <= "+placeholder(len(args)+1)), append(args, *find.RemindTsBefore)
+	}
+
+	query := "SELECT id, memo_id, created_ts, remind_ts, cron FROM memo_reminder WHERE " + strings.Join(where, " AND ") + " ORDER BY remind_ts ASC, id ASC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoReminder{}
+	for rows.Next() {
+		reminder := &store.MemoReminder{}
+		if err := rows.Scan(
+			&reminder.ID,
+			&reminder.MemoID,
+			&reminder.CreatedTs,
+			&reminder.RemindTs,
+			&reminder.Cron,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoReminder(ctx context.Context, update *store.UpdateMemoReminder) error {
+	set, args := []string{}, []any{}
+	if v := update.RemindTs; v != nil {
+		set, args = append(set, "remind_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Cron; v != nil {
+		set, args = append(set, "cron = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return errors.New("no fields to update")
+	}
+
+	stmt := "UPDATE memo_reminder SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteMemoReminder(ctx context.Context, delete *store.DeleteMemoReminder) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting memo reminders")
+	}
+	stmt := "DELETE FROM memo_reminder WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
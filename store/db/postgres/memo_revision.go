@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRevision(ctx context.Context, create *store.MemoRevision) (*store.MemoRevision, error) {
+	fields := []string{"memo_id", "creator_id", "content", "visibility"}
+	args := []any{create.MemoID, create.CreatorID, create.Content, create.Visibility}
+	if create.CreatedTs != 0 {
+		fields = append(fields, "created_ts")
+		args = append(args, create.CreatedTs)
+	}
+
+	stmt := "INSERT INTO memo_revision (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListMemoRevisions(ctx context.Context, find *store.FindMemoRevision) ([]*store.MemoRevision, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+
+	query := "SELECT id, memo_id, creator_id, created_ts, content, visibility FROM memo_revision WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRevision{}
+	for rows.Next() {
+		revision := &store.MemoRevision{}
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.MemoID,
+			&revision.CreatorID,
+			&revision.CreatedTs,
+			&revision.Content,
+			&revision.Visibility,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoRevision(ctx context.Context, delete *store.DeleteMemoRevision) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting memo revisions")
+	}
+	stmt := "DELETE FROM memo_revision WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TrimMemoRevisions deletes all but the newest limit revisions of a memo in a single statement.
+func (d *DB) TrimMemoRevisions(ctx context.Context, memoID int32, limit int) error {
+	stmt := "DELETE FROM memo_revision WHERE memo_id = $1 AND id NOT IN (SELECT id FROM memo_revision WHERE memo_id = $1 ORDER BY id DESC LIMIT $2)"
+	if _, err := d.db.ExecContext(ctx, stmt, memoID, limit); err != nil {
+		return err
+	}
+	return nil
+}
@@ -11,6 +11,13 @@ import (
 )
 
 func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (*store.UserSetting, error) {
+	if err := upsertUserSetting(ctx, d.db, upsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func upsertUserSetting(ctx context.Context, db execer, upsert *store.UserSetting) error {
 	stmt := `
 		INSERT INTO user_setting (
 			user_id, key, value
@@ -19,10 +26,23 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (
 		ON CONFLICT(user_id, key) DO UPDATE 
 		SET value = EXCLUDED.value
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
-		return nil, err
+	if _, err := db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
+		return err
 	}
-	return upsert, nil
+	return nil
+}
+
+func (d *DB) UpdateUserSetting(ctx context.Context, update *store.UpdateUserSetting) (bool, error) {
+	stmt := "UPDATE user_setting SET value = $1 WHERE user_id = $2 AND key = $3 AND value = $4"
+	result, err := d.db.ExecContext(ctx, stmt, update.Value, update.UserID, update.Key.String(), update.ExpectedValue)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
 }
 
 func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting) ([]*store.UserSetting, error) {
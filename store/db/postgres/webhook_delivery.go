@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const webhookDeliveryFields = "id, creator_id, webhook_id, created_ts, updated_ts, url, activity_type, payload, content_type, status, attempts, next_attempt_ts, response_status, response_body, error_message"
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"creator_id", "webhook_id", "url", "activity_type", "payload", "content_type", "status", "attempts", "next_attempt_ts", "response_status", "response_body", "error_message"}
+	args := []any{create.CreatorID, create.WebhookID, create.URL, create.ActivityType, create.Payload, create.ContentType, create.Status, create.Attempts, create.NextAttemptTs, create.ResponseStatus, create.ResponseBody, create.ErrorMessage}
+
+	stmt := "INSERT INTO webhook_delivery (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *find.WebhookID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
+	}
+	if find.NextAttemptTsBefore != nil {
+		where, args = append(where, "next_attempt_ts <= "+placeholder(len(args)+1)), append(args, *find.NextAttemptTsBefore)
+	}
+
+	query := "SELECT " + webhookDeliveryFields + " FROM webhook_delivery WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+			&delivery.URL,
+			&delivery.ActivityType,
+			&delivery.Payload,
+			&delivery.ContentType,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptTs,
+			&delivery.ResponseStatus,
+			&delivery.ResponseBody,
+			&delivery.ErrorMessage,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "attempts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "next_attempt_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseStatus; v != nil {
+		set, args = append(set, "response_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "response_body = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ErrorMessage; v != nil {
+		set, args = append(set, "error_message = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	args = append(args, update.ID)
+
+	query := "UPDATE webhook_delivery SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)) + " RETURNING " + webhookDeliveryFields
+	delivery := &store.WebhookDelivery{}
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+		&delivery.ID,
+		&delivery.CreatorID,
+		&delivery.WebhookID,
+		&delivery.CreatedTs,
+		&delivery.UpdatedTs,
+		&delivery.URL,
+		&delivery.ActivityType,
+		&delivery.Payload,
+		&delivery.ContentType,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptTs,
+		&delivery.ResponseStatus,
+		&delivery.ResponseBody,
+		&delivery.ErrorMessage,
+	); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (d *DB) ClaimWebhookDelivery(ctx context.Context, claim *store.ClaimWebhookDelivery) (bool, error) {
+	stmt := "UPDATE webhook_delivery SET attempts = attempts + 1, next_attempt_ts = $1 WHERE id = $2 AND attempts = $3 AND status = $4"
+	result, err := d.db.ExecContext(ctx, stmt, claim.NextAttemptTs, claim.ID, claim.Attempts, store.WebhookDeliveryPending)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (d *DB) DeleteWebhookDelivery(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *delete.ID)
+	}
+	if delete.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *delete.CreatedTsBefore)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting webhook deliveries")
+	}
+	stmt := "DELETE FROM webhook_delivery WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertLinkMetadata(ctx context.Context, upsert *store.LinkMetadata) (*store.LinkMetadata, error) {
+	stmt := `
+		INSERT INTO link_metadata (
+			url, fetched_ts, title, description, image, error_message
+		)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(url) DO UPDATE
+		SET
+			fetched_ts = EXCLUDED.fetched_ts,
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			image = EXCLUDED.image,
+			error_message = EXCLUDED.error_message
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.URL, upsert.FetchedTs, upsert.Title, upsert.Description, upsert.Image, upsert.ErrorMessage); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListLinkMetadata(ctx context.Context, find *store.FindLinkMetadata) ([]*store.LinkMetadata, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if len(find.URLList) > 0 {
+		placeholders := make([]string, 0, len(find.URLList))
+		for _, url := range find.URLList {
+			placeholders = append(placeholders, "?")
+			args = append(args, url)
+		}
+		where = append(where, "`url` IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if find.FetchedTsBefore != nil {
+		where, args = append(where, "`fetched_ts` < ?"), append(args, *find.FetchedTsBefore)
+	}
+
+	query := "SELECT `url`, `fetched_ts`, `title`, `description`, `image`, `error_message` FROM `link_metadata` WHERE " + strings.Join(where, " AND ") + " ORDER BY `url`"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.LinkMetadata{}
+	for rows.Next() {
+		metadata := &store.LinkMetadata{}
+		if err := rows.Scan(
+			&metadata.URL,
+			&metadata.FetchedTs,
+			&metadata.Title,
+			&metadata.Description,
+			&metadata.Image,
+			&metadata.ErrorMessage,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, metadata)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteLinkMetadata(ctx context.Context, delete *store.DeleteLinkMetadata) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.FetchedTsBefore != nil {
+		where, args = append(where, "`fetched_ts` < ?"), append(args, *delete.FetchedTsBefore)
+	}
+	if len(where) == 1 {
+		return errors.New("no condition provided for deleting link metadata")
+	}
+	stmt := "DELETE FROM `link_metadata` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -27,8 +27,37 @@ type Driver interface {
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	UpdateMemos(ctx context.Context, updates []*UpdateMemo, userSetting *UserSetting) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+	TrimMemoRevisions(ctx context.Context, memoID int32, limit int) error
+
+	// MemoReminder model related methods.
+	CreateMemoReminder(ctx context.Context, create *MemoReminder) (*MemoReminder, error)
+	ListMemoReminders(ctx context.Context, find *FindMemoReminder) ([]*MemoReminder, error)
+	UpdateMemoReminder(ctx context.Context, update *UpdateMemoReminder) error
+	DeleteMemoReminder(ctx context.Context, delete *DeleteMemoReminder) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error)
+	ClaimWebhookDelivery(ctx context.Context, claim *ClaimWebhookDelivery) (bool, error)
+	DeleteWebhookDelivery(ctx context.Context, delete *DeleteWebhookDelivery) error
+
+	// LinkMetadata model related methods.
+	UpsertLinkMetadata(ctx context.Context, upsert *LinkMetadata) (*LinkMetadata, error)
+	ListLinkMetadata(ctx context.Context, find *FindLinkMetadata) ([]*LinkMetadata, error)
+	DeleteLinkMetadata(ctx context.Context, delete *DeleteLinkMetadata) error
+
+	// PasskeyChallenge model related methods.
+	CreatePasskeyChallenge(ctx context.Context, create *PasskeyChallenge) (bool, error)
+	DeletePasskeyChallenge(ctx context.Context, delete *DeletePasskeyChallenge) error
+
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
@@ -47,6 +76,7 @@ type Driver interface {
 
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *UserSetting) (*UserSetting, error)
+	UpdateUserSetting(ctx context.Context, update *UpdateUserSetting) (bool, error)
 	ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*UserSetting, error)
 	GetUserByPATHash(ctx context.Context, tokenHash string) (*PATQueryResult, error)
 
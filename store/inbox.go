@@ -44,6 +44,8 @@ type FindInbox struct {
 	ReceiverID  *int32
 	Status      *InboxStatus
 	MessageType *storepb.InboxMessage_Type
+	// MessageTypeList matches inbox items of any of the given message types.
+	MessageTypeList []storepb.InboxMessage_Type
 
 	// Pagination
 	Limit  *int
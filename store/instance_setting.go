@@ -37,6 +37,10 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetStorageSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_MEMO_RELATED {
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_NOTIFICATION {
+		valueBytes, err = protojson.Marshal(upsert.GetNotificationSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_WEBHOOKS {
+		valueBytes, err = protojson.Marshal(upsert.GetWebhooksSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -143,6 +147,9 @@ const DefaultContentLengthLimit = 8 * 1024
 // DefaultReactions is the default reactions for memo related setting.
 var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕", "😢", "😡"}
 
+// DefaultMemoRevisionLimit is the default number of revisions kept for each memo.
+const DefaultMemoRevisionLimit = 50
+
 func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.InstanceMemoRelatedSetting, error) {
 	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
 		Name: storepb.InstanceSettingKey_MEMO_RELATED.String(),
@@ -161,6 +168,9 @@ func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.Ins
 	if len(instanceMemoRelatedSetting.Reactions) == 0 {
 		instanceMemoRelatedSetting.Reactions = append(instanceMemoRelatedSetting.Reactions, DefaultReactions...)
 	}
+	if instanceMemoRelatedSetting.RevisionLimit <= 0 {
+		instanceMemoRelatedSetting.RevisionLimit = DefaultMemoRevisionLimit
+	}
 	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_MEMO_RELATED.String(), &storepb.InstanceSetting{
 		Key:   storepb.InstanceSettingKey_MEMO_RELATED,
 		Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: instanceMemoRelatedSetting},
@@ -202,6 +212,47 @@ func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.Instanc
 	return instanceStorageSetting, nil
 }
 
+func (s *Store) GetInstanceNotificationSetting(ctx context.Context) (*storepb.InstanceNotificationSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_NOTIFICATION.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance notification setting")
+	}
+
+	instanceNotificationSetting := &storepb.InstanceNotificationSetting{}
+	if instanceSetting != nil {
+		instanceNotificationSetting = instanceSetting.GetNotificationSetting()
+	}
+	if instanceNotificationSetting.Email == nil {
+		instanceNotificationSetting.Email = &storepb.InstanceNotificationSetting_EmailSetting{}
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_NOTIFICATION.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_NOTIFICATION,
+		Value: &storepb.InstanceSetting_NotificationSetting{NotificationSetting: instanceNotificationSetting},
+	})
+	return instanceNotificationSetting, nil
+}
+
+func (s *Store) GetInstanceWebhooksSetting(ctx context.Context) (*storepb.InstanceWebhooksSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_WEBHOOKS.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance webhooks setting")
+	}
+
+	instanceWebhooksSetting := &storepb.InstanceWebhooksSetting{}
+	if instanceSetting != nil {
+		instanceWebhooksSetting = instanceSetting.GetWebhooksSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_WEBHOOKS.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{WebhooksSetting: instanceWebhooksSetting},
+	})
+	return instanceWebhooksSetting, nil
+}
+
 func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storepb.InstanceSetting, error) {
 	instanceSetting := &storepb.InstanceSetting{
 		Key: storepb.InstanceSettingKey(storepb.InstanceSettingKey_value[instanceSettingRaw.Name]),
@@ -231,6 +282,18 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting}
+	case storepb.InstanceSettingKey_NOTIFICATION.String():
+		notificationSetting := &storepb.InstanceNotificationSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), notificationSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_NotificationSetting{NotificationSetting: notificationSetting}
+	case storepb.InstanceSettingKey_WEBHOOKS.String():
+		webhooksSetting := &storepb.InstanceWebhooksSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), webhooksSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_WebhooksSetting{WebhooksSetting: webhooksSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+)
+
+// LinkMetadata is the cached preview of a web page linked from memos.
+type LinkMetadata struct {
+	URL string
+	// FetchedTs is the time of the latest fetch of the page.
+	FetchedTs int64
+
+	Title       string
+	Description string
+	// Image is the URL of the og:image of the page.
+	Image string
+	// ErrorMessage describes why the latest fetch failed, or is empty if it succeeded.
+	ErrorMessage string
+}
+
+type FindLinkMetadata struct {
+	URLList []string
+	// FetchedTsBefore filters metadata fetched before the given time.
+	FetchedTsBefore *int64
+}
+
+type DeleteLinkMetadata struct {
+	// FetchedTsBefore deletes metadata fetched before the given time.
+	FetchedTsBefore *int64
+}
+
+// UpsertLinkMetadata creates or replaces the metadata of the URL.
+func (s *Store) UpsertLinkMetadata(ctx context.Context, upsert *LinkMetadata) (*LinkMetadata, error) {
+	return s.driver.UpsertLinkMetadata(ctx, upsert)
+}
+
+func (s *Store) ListLinkMetadata(ctx context.Context, find *FindLinkMetadata) ([]*LinkMetadata, error) {
+	return s.driver.ListLinkMetadata(ctx, find)
+}
+
+func (s *Store) DeleteLinkMetadata(ctx context.Context, delete *DeleteLinkMetadata) error {
+	return s.driver.DeleteLinkMetadata(ctx, delete)
+}
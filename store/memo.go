@@ -79,6 +79,8 @@ type FindMemo struct {
 	OrderByPinned    bool
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
+	// OrderByRelevance ranks memos by how well they match the search() calls in Filters.
+	OrderByRelevance bool
 }
 
 type FindMemoPayload struct {
@@ -100,8 +102,16 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+
+	// ExpectedContent and ExpectedUpdatedTs make the update conditional on the memo being
+	// unchanged since it was read. If it has changed, the update fails with ErrMemoConflict.
+	ExpectedContent   *string
+	ExpectedUpdatedTs *int64
 }
 
+// ErrMemoConflict is returned by conditional updates of a memo that has changed since it was read.
+var ErrMemoConflict = errors.New("memo has changed since it was read")
+
 type DeleteMemo struct {
 	ID int32
 }
@@ -137,6 +147,31 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	return s.driver.UpdateMemo(ctx, update)
 }
 
+// UpdateMemos applies the updates atomically: either all memos are updated or none.
+// If userSetting is not nil, it is upserted in the same transaction.
+func (s *Store) UpdateMemos(ctx context.Context, updates []*UpdateMemo, userSetting *storepb.UserSetting) error {
+	for _, update := range updates {
+		if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+	}
+	var userSettingRaw *UserSetting
+	if userSetting != nil {
+		raw, err := convertUserSettingToRaw(userSetting)
+		if err != nil {
+			return err
+		}
+		userSettingRaw = raw
+	}
+	if err := s.driver.UpdateMemos(ctx, updates, userSettingRaw); err != nil {
+		return err
+	}
+	if userSetting != nil {
+		s.userSettingCache.Set(ctx, getUserSettingCacheKey(userSetting.UserId, userSetting.Key.String()), userSetting)
+	}
+	return nil
+}
+
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	// Clean up memo_relation records where this memo is either the source or target.
 	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &delete.ID}); err != nil {
@@ -145,6 +180,14 @@ func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{RelatedMemoID: &delete.ID}); err != nil {
 		return err
 	}
+	// Clean up the revision history of this memo.
+	if err := s.driver.DeleteMemoRevision(ctx, &DeleteMemoRevision{MemoID: &delete.ID}); err != nil {
+		return err
+	}
+	// Clean up the reminders of this memo.
+	if err := s.driver.DeleteMemoReminder(ctx, &DeleteMemoReminder{MemoID: &delete.ID}); err != nil {
+		return err
+	}
 	// Clean up attachments linked to this memo.
 	attachments, err := s.ListAttachments(ctx, &FindAttachment{MemoID: &delete.ID})
 	if err != nil {
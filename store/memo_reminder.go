@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoReminder is a one-time or recurring reminder of a memo for its creator.
+type MemoReminder struct {
+	ID        int32
+	MemoID    int32
+	CreatedTs int64
+
+	// RemindTs is the next time the reminder fires.
+	RemindTs int64
+	// Cron is the cron expression of a recurring reminder, or empty for a one-time reminder.
+	Cron string
+}
+
+type FindMemoReminder struct {
+	ID     *int32
+	MemoID *int32
+	// RemindTsBefore filters reminders that are due at or before the given time.
+	RemindTsBefore *int64
+
+	// Pagination
+	Limit *int
+}
+
+type UpdateMemoReminder struct {
+	ID       int32
+	RemindTs *int64
+	Cron     *string
+}
+
+type DeleteMemoReminder struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoReminder(ctx context.Context, create *MemoReminder) (*MemoReminder, error) {
+	return s.driver.CreateMemoReminder(ctx, create)
+}
+
+// ListMemoReminders returns reminders ordered from the soonest to the latest.
+func (s *Store) ListMemoReminders(ctx context.Context, find *FindMemoReminder) ([]*MemoReminder, error) {
+	return s.driver.ListMemoReminders(ctx, find)
+}
+
+func (s *Store) GetMemoReminder(ctx context.Context, find *FindMemoReminder) (*MemoReminder, error) {
+	list, err := s.ListMemoReminders(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoReminder(ctx context.Context, update *UpdateMemoReminder) error {
+	return s.driver.UpdateMemoReminder(ctx, update)
+}
+
+func (s *Store) DeleteMemoReminder(ctx context.Context, delete *DeleteMemoReminder) error {
+	return s.driver.DeleteMemoReminder(ctx, delete)
+}
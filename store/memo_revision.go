@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoRevision is a snapshot of a memo's content and visibility.
+type MemoRevision struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+
+	Content    string
+	Visibility Visibility
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions returns revisions ordered from newest to oldest.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevision(ctx, delete)
+}
+
+// TrimMemoRevisions removes the oldest revisions of a memo so that at most limit revisions remain.
+func (s *Store) TrimMemoRevisions(ctx context.Context, memoID int32, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	return s.driver.TrimMemoRevisions(ctx, memoID, limit)
+}
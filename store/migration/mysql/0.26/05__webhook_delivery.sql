@@ -0,0 +1,21 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` MEDIUMTEXT NOT NULL,
+  `content_type` VARCHAR(256) NOT NULL DEFAULT 'application/json',
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `response_status` INT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL,
+  `error_message` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
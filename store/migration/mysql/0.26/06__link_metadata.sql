@@ -0,0 +1,8 @@
+CREATE TABLE `link_metadata` (
+  `url` VARCHAR(768) NOT NULL PRIMARY KEY,
+  `fetched_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` TEXT NOT NULL,
+  `description` TEXT NOT NULL,
+  `image` TEXT NOT NULL,
+  `error_message` TEXT NOT NULL
+);
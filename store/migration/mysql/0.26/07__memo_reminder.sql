@@ -0,0 +1,11 @@
+CREATE TABLE `memo_reminder` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `remind_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `cron` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_memo_reminder_remind_ts` ON `memo_reminder` (`remind_ts`);
+
+CREATE INDEX `idx_memo_reminder_memo_id` ON `memo_reminder` (`memo_id`);
@@ -0,0 +1,4 @@
+CREATE TABLE `passkey_challenge` (
+  `challenge` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `expires_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -39,7 +39,8 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  FULLTEXT INDEX `idx_memo_content_fulltext` (`content`)
 );
 
 -- memo_relation
@@ -105,3 +106,67 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`, `created_ts`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` MEDIUMTEXT NOT NULL,
+  `content_type` VARCHAR(256) NOT NULL DEFAULT 'application/json',
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `response_status` INT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL,
+  `error_message` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+
+-- link_metadata
+CREATE TABLE `link_metadata` (
+  `url` VARCHAR(768) NOT NULL PRIMARY KEY,
+  `fetched_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` TEXT NOT NULL,
+  `description` TEXT NOT NULL,
+  `image` TEXT NOT NULL,
+  `error_message` TEXT NOT NULL
+);
+
+-- memo_reminder
+CREATE TABLE `memo_reminder` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `remind_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `cron` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_memo_reminder_remind_ts` ON `memo_reminder` (`remind_ts`);
+
+CREATE INDEX `idx_memo_reminder_memo_id` ON `memo_reminder` (`memo_id`);
+
+-- passkey_challenge
+CREATE TABLE `passkey_challenge` (
+  `challenge` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `expires_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,21 @@
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  url TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_type TEXT NOT NULL DEFAULT 'application/json',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  response_status INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
@@ -0,0 +1,11 @@
+CREATE TABLE memo_reminder (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  remind_ts BIGINT NOT NULL,
+  cron TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_reminder_remind_ts ON memo_reminder (remind_ts);
+
+CREATE INDEX idx_memo_reminder_memo_id ON memo_reminder (memo_id);
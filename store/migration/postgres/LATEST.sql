@@ -42,6 +42,8 @@ CREATE TABLE memo (
   payload JSONB NOT NULL DEFAULT '{}'
 );
 
+CREATE INDEX idx_memo_content_search ON memo USING GIN (to_tsvector('simple', content));
+
 -- memo_relation
 CREATE TABLE memo_relation (
   memo_id INTEGER NOT NULL,
@@ -105,3 +107,67 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id, created_ts);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  url TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_type TEXT NOT NULL DEFAULT 'application/json',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  response_status INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
+
+-- link_metadata
+CREATE TABLE link_metadata (
+  url TEXT NOT NULL PRIMARY KEY,
+  fetched_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
+
+-- memo_reminder
+CREATE TABLE memo_reminder (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  remind_ts BIGINT NOT NULL,
+  cron TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_reminder_remind_ts ON memo_reminder (remind_ts);
+
+CREATE INDEX idx_memo_reminder_memo_id ON memo_reminder (memo_id);
+
+-- passkey_challenge
+CREATE TABLE passkey_challenge (
+  challenge TEXT NOT NULL PRIMARY KEY,
+  expires_ts BIGINT NOT NULL
+);
@@ -0,0 +1,21 @@
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content='memo',
+  content_rowid='id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER memo_fts_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts(memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts(memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+INSERT INTO memo_fts(memo_fts) VALUES ('rebuild');
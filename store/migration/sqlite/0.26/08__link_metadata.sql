@@ -0,0 +1,8 @@
+CREATE TABLE link_metadata (
+  url TEXT NOT NULL PRIMARY KEY,
+  fetched_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
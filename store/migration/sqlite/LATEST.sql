@@ -43,6 +43,27 @@ CREATE TABLE memo (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(
+  content,
+  content='memo',
+  content_rowid='id',
+  tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER memo_fts_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts(memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts(memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
 -- memo_relation
 CREATE TABLE memo_relation (
   memo_id INTEGER NOT NULL,
@@ -106,3 +127,67 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id, created_ts);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  url TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_type TEXT NOT NULL DEFAULT 'application/json',
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  response_status INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
+
+-- link_metadata
+CREATE TABLE link_metadata (
+  url TEXT NOT NULL PRIMARY KEY,
+  fetched_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  image TEXT NOT NULL DEFAULT '',
+  error_message TEXT NOT NULL DEFAULT ''
+);
+
+-- memo_reminder
+CREATE TABLE memo_reminder (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  remind_ts BIGINT NOT NULL,
+  cron TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_reminder_remind_ts ON memo_reminder (remind_ts);
+
+CREATE INDEX idx_memo_reminder_memo_id ON memo_reminder (memo_id);
+
+-- passkey_challenge
+CREATE TABLE passkey_challenge (
+  challenge TEXT NOT NULL PRIMARY KEY,
+  expires_ts BIGINT NOT NULL
+);
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"context"
+)
+
+// PasskeyChallenge is the challenge of a completed passkey session.
+// It is kept until the session expires, so that the session cannot be completed again.
+type PasskeyChallenge struct {
+	Challenge string
+	// ExpiresTs is the time the session expires.
+	ExpiresTs int64
+}
+
+type DeletePasskeyChallenge struct {
+	// ExpiresTsBefore deletes challenges of sessions expired before the given time.
+	ExpiresTsBefore *int64
+}
+
+// CreatePasskeyChallenge records the challenge and reports whether it was recorded,
+// which is false if the challenge has been recorded before.
+func (s *Store) CreatePasskeyChallenge(ctx context.Context, create *PasskeyChallenge) (bool, error) {
+	return s.driver.CreatePasskeyChallenge(ctx, create)
+}
+
+func (s *Store) DeletePasskeyChallenge(ctx context.Context, delete *DeletePasskeyChallenge) error {
+	return s.driver.DeletePasskeyChallenge(ctx, delete)
+}
@@ -74,6 +74,22 @@ func (b *MemoBuilder) Tags(tags ...string) *MemoBuilder {
 	return b
 }
 
+func (b *MemoBuilder) Mentions(usernames ...string) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	b.memo.Payload.Mentions = usernames
+	return b
+}
+
+func (b *MemoBuilder) Properties(properties map[string]string) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	b.memo.Payload.Properties = properties
+	return b
+}
+
 func (b *MemoBuilder) Property(fn func(*storepb.MemoPayload_Property)) *MemoBuilder {
 	if b.memo.Payload == nil {
 		b.memo.Payload = &storepb.MemoPayload{}
@@ -85,6 +101,17 @@ func (b *MemoBuilder) Property(fn func(*storepb.MemoPayload_Property)) *MemoBuil
 	return b
 }
 
+func (b *MemoBuilder) Schedule(publishTs int64, visibility store.Visibility) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	b.memo.Payload.Schedule = &storepb.MemoPayload_Schedule{
+		PublishTs:  publishTs,
+		Visibility: visibility.String(),
+	}
+	return b
+}
+
 func (b *MemoBuilder) Build() *store.Memo {
 	return b.memo
 }
@@ -220,6 +220,86 @@ func TestInstanceSettingStorageSetting(t *testing.T) {
 	ts.Close()
 }
 
+func TestInstanceSettingNotificationSetting(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	// Get default notification setting (email disabled)
+	notificationSetting, err := ts.GetInstanceNotificationSetting(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, notificationSetting.Email)
+	require.False(t, notificationSetting.Email.Enabled)
+
+	// Set SMTP configuration
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_NOTIFICATION,
+		Value: &storepb.InstanceSetting_NotificationSetting{
+			NotificationSetting: &storepb.InstanceNotificationSetting{
+				Email: &storepb.InstanceNotificationSetting_EmailSetting{
+					Enabled:   true,
+					SmtpHost:  "smtp.example.com",
+					SmtpPort:  587,
+					FromEmail: "memos@example.com",
+					UseTls:    true,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify
+	notificationSetting, err = ts.GetInstanceNotificationSetting(ctx)
+	require.NoError(t, err)
+	require.True(t, notificationSetting.Email.Enabled)
+	require.Equal(t, "smtp.example.com", notificationSetting.Email.SmtpHost)
+	require.Equal(t, int32(587), notificationSetting.Email.SmtpPort)
+	require.Equal(t, "memos@example.com", notificationSetting.Email.FromEmail)
+	require.True(t, notificationSetting.Email.UseTls)
+
+	ts.Close()
+}
+
+func TestInstanceSettingWebhooksSetting(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	// Get default webhooks setting (no webhooks)
+	webhooksSetting, err := ts.GetInstanceWebhooksSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, webhooksSetting.Webhooks)
+
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{
+			WebhooksSetting: &storepb.InstanceWebhooksSetting{
+				Webhooks: []*storepb.WebhooksUserSetting_Webhook{
+					{
+						Id:            "audit",
+						Url:           "https://example.com/audit",
+						Secret:        "whsec_test",
+						ActivityTypes: []string{"memos.user.signin"},
+						PayloadFormat: storepb.WebhooksUserSetting_SLACK,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify
+	webhooksSetting, err = ts.GetInstanceWebhooksSetting(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooksSetting.Webhooks, 1)
+	require.Equal(t, "https://example.com/audit", webhooksSetting.Webhooks[0].Url)
+	require.Equal(t, "whsec_test", webhooksSetting.Webhooks[0].Secret)
+	require.Equal(t, []string{"memos.user.signin"}, webhooksSetting.Webhooks[0].ActivityTypes)
+	require.Equal(t, storepb.WebhooksUserSetting_SLACK, webhooksSetting.Webhooks[0].PayloadFormat)
+
+	ts.Close()
+}
+
 func TestInstanceSettingListAll(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestLinkMetadataStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	now := time.Now().Unix()
+	_, err := ts.UpsertLinkMetadata(ctx, &store.LinkMetadata{
+		URL:       "https://example.com/a",
+		FetchedTs: now - 3600,
+		Title:     "Old title",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertLinkMetadata(ctx, &store.LinkMetadata{
+		URL:          "https://example.com/b",
+		FetchedTs:    now,
+		ErrorMessage: "not a HTML page",
+	})
+	require.NoError(t, err)
+
+	// Upserting replaces the existing metadata of the URL.
+	_, err = ts.UpsertLinkMetadata(ctx, &store.LinkMetadata{
+		URL:         "https://example.com/a",
+		FetchedTs:   now - 60,
+		Title:       "Title",
+		Description: "Description",
+		Image:       "https://example.com/a.png",
+	})
+	require.NoError(t, err)
+	list, err := ts.ListLinkMetadata(ctx, &store.FindLinkMetadata{URLList: []string{"https://example.com/a", "https://example.com/c"}})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, &store.LinkMetadata{
+		URL:         "https://example.com/a",
+		FetchedTs:   now - 60,
+		Title:       "Title",
+		Description: "Description",
+		Image:       "https://example.com/a.png",
+	}, list[0])
+
+	before := now - 30
+	list, err = ts.ListLinkMetadata(ctx, &store.FindLinkMetadata{FetchedTsBefore: &before})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "https://example.com/a", list[0].URL)
+
+	require.NoError(t, ts.DeleteLinkMetadata(ctx, &store.DeleteLinkMetadata{FetchedTsBefore: &before}))
+	list, err = ts.ListLinkMetadata(ctx, &store.FindLinkMetadata{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "https://example.com/b", list[0].URL)
+	require.Equal(t, "not a HTML page", list[0].ErrorMessage)
+
+	require.Error(t, ts.DeleteLinkMetadata(ctx, &store.DeleteLinkMetadata{}))
+
+	ts.Close()
+}
@@ -83,6 +83,98 @@ func TestMemoFilterContentCaseSensitivity(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Full-Text Search Tests
+// Schema: search(query) backed by the native full-text index on content
+// =============================================================================
+
+func TestMemoFilterSearch(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-coffee", tc.User.ID).Content("Morning coffee with friends"))
+	tc.CreateMemo(NewMemoBuilder("memo-tea", tc.User.ID).Content("Afternoon green tea with friends"))
+	tc.CreateMemo(NewMemoBuilder("memo-code", tc.User.ID).Content("Refactor the search module"))
+
+	// Test: single term
+	memos := tc.ListWithFilter(`search("coffee")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-coffee", memos[0].UID)
+
+	// Test: every term must match
+	memos = tc.ListWithFilter(`search("friends")`)
+	require.Len(t, memos, 2)
+	memos = tc.ListWithFilter(`search("green friends")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-tea", memos[0].UID)
+
+	// Test: operator characters are treated as plain text instead of query syntax
+	memos = tc.ListWithFilter(`search("coffee -friends")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-coffee", memos[0].UID)
+
+	// Test: negation and combination with other filters
+	memos = tc.ListWithFilter(`!search("friends")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-code", memos[0].UID)
+	memos = tc.ListWithFilter(`search("friends") && content.contains("tea")`)
+	require.Len(t, memos, 1)
+}
+
+func TestMemoFilterSearchStaysInSync(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	memo := tc.CreateMemo(NewMemoBuilder("memo-sync", tc.User.ID).Content("Original paragraph"))
+	require.Len(t, tc.ListWithFilter(`search("original")`), 1)
+
+	// Updating content re-indexes the memo.
+	content := "Rewritten paragraph"
+	require.NoError(t, tc.Store.UpdateMemo(tc.Ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	require.Len(t, tc.ListWithFilter(`search("original")`), 0)
+	require.Len(t, tc.ListWithFilter(`search("rewritten")`), 1)
+
+	// Updating other columns keeps the index intact.
+	tc.PinMemo(memo.ID)
+	require.Len(t, tc.ListWithFilter(`search("rewritten")`), 1)
+
+	// Deleting the memo removes it from the index.
+	require.NoError(t, tc.Store.DeleteMemo(tc.Ctx, &store.DeleteMemo{ID: memo.ID}))
+	require.Len(t, tc.ListWithFilter(`search("rewritten")`), 0)
+}
+
+func TestMemoFilterSearchOrderByRelevance(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-weak", tc.User.ID).Content("Notes about gardening, cooking, travel and many other unrelated things like weather"))
+	tc.CreateMemo(NewMemoBuilder("memo-strong", tc.User.ID).Content("Gardening gardening gardening"))
+	tc.CreateMemo(NewMemoBuilder("memo-none", tc.User.ID).Content("Nothing relevant here"))
+
+	memos, err := tc.Store.ListMemos(tc.Ctx, &store.FindMemo{
+		Filters:          []string{`search("gardening")`},
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+	require.Equal(t, "memo-strong", memos[0].UID)
+	require.Equal(t, "memo-weak", memos[1].UID)
+}
+
+func TestMemoFilterSearchInvalid(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	_, err := tc.Store.ListMemos(tc.Ctx, &store.FindMemo{Filters: []string{`search("  ")`}})
+	require.Error(t, err)
+	_, err = tc.Store.ListMemos(tc.Ctx, &store.FindMemo{Filters: []string{`search(content)`}})
+	require.Error(t, err)
+}
+
 // =============================================================================
 // Visibility Field Tests
 // Schema: visibility (string, ==, !=)
@@ -286,6 +378,67 @@ func TestMemoFilterEmptyTags(t *testing.T) {
 	require.Len(t, memos, 0)
 }
 
+func TestMemoFilterElementInMentions(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-mention-alice", tc.User.ID).Content("Hi @alice").Mentions("alice"))
+	tc.CreateMemo(NewMemoBuilder("memo-mention-both", tc.User.ID).Content("Hi @alice and @alice-bob").Mentions("alice", "alice-bob"))
+	tc.CreateMemo(NewMemoBuilder("memo-no-mention", tc.User.ID).Content("No mentions"))
+
+	memos := tc.ListWithFilter(`"alice" in mentions`)
+	require.Len(t, memos, 2)
+
+	// Usernames must match exactly.
+	memos = tc.ListWithFilter(`"bob" in mentions`)
+	require.Len(t, memos, 0)
+
+	memos = tc.ListWithFilter(`size(mentions) == 0`)
+	require.Len(t, memos, 1)
+}
+
+// =============================================================================
+// JSON Map Field Tests
+// Schema: props (frontmatter properties)
+// Operators: props.key ==, !=, <, in [...], "key" in props, has(props.key)
+// =============================================================================
+
+func TestMemoFilterProperties(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-done", tc.User.ID).Properties(map[string]string{"status": "done", "due": "2024-01-10"}))
+	tc.CreateMemo(NewMemoBuilder("memo-todo", tc.User.ID).Properties(map[string]string{"status": "todo", "due-date": "2024-03-01"}))
+	tc.CreateMemo(NewMemoBuilder("memo-no-props", tc.User.ID).Content("No properties"))
+
+	memos := tc.ListWithFilter(`props.status == "done"`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-done", memos[0].UID)
+
+	memos = tc.ListWithFilter(`props["due-date"] >= "2024-02-01"`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-todo", memos[0].UID)
+
+	memos = tc.ListWithFilter(`props.status in ["todo", "doing"]`)
+	require.Len(t, memos, 1)
+
+	// Memos without the property never match a comparison.
+	memos = tc.ListWithFilter(`props.status != "done"`)
+	require.Len(t, memos, 1)
+
+	memos = tc.ListWithFilter(`"status" in props`)
+	require.Len(t, memos, 2)
+
+	memos = tc.ListWithFilter(`!has(props.due)`)
+	require.Len(t, memos, 2)
+
+	// Keys are embedded in a JSON path and must be plain identifiers.
+	_, err := tc.Store.ListMemos(tc.Ctx, &store.FindMemo{Filters: []string{`props["a'b"] == "x"`}})
+	require.Error(t, err)
+}
+
 // =============================================================================
 // JSON Bool Field Tests
 // Schema: has_task_list, has_link, has_code, has_incomplete_tasks
@@ -330,6 +483,30 @@ func TestMemoFilterHasLink(t *testing.T) {
 	require.True(t, memos[0].Payload.Property.HasLink)
 }
 
+func TestMemoFilterScheduled(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-scheduled", tc.User.ID).
+		Content("Announcement").
+		Visibility(store.Private).
+		Schedule(time.Now().Add(time.Hour).Unix(), store.Public))
+	tc.CreateMemo(NewMemoBuilder("memo-published", tc.User.ID).Content("Published"))
+
+	memos := tc.ListWithFilter(`scheduled`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-scheduled", memos[0].UID)
+
+	memos = tc.ListWithFilter(`scheduled == false`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-published", memos[0].UID)
+
+	memos = tc.ListWithFilter(`!scheduled`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-published", memos[0].UID)
+}
+
 func TestMemoFilterHasCode(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoReminderStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	later, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{
+		MemoID:   memo.ID,
+		RemindTs: 1800000000,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, later.ID)
+	require.NotZero(t, later.CreatedTs)
+	sooner, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{
+		MemoID:   memo.ID,
+		RemindTs: 1700000000,
+		Cron:     "0 9 * * *",
+	})
+	require.NoError(t, err)
+
+	// Reminders are listed soonest first.
+	reminders, err := ts.ListMemoReminders(ctx, &store.FindMemoReminder{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, reminders, 2)
+	require.Equal(t, sooner.ID, reminders[0].ID)
+	require.Equal(t, "0 9 * * *", reminders[0].Cron)
+	require.Equal(t, int64(1700000000), reminders[0].RemindTs)
+	require.Equal(t, later.ID, reminders[1].ID)
+
+	dueBefore := int64(1750000000)
+	due, err := ts.ListMemoReminders(ctx, &store.FindMemoReminder{RemindTsBefore: &dueBefore})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, sooner.ID, due[0].ID)
+
+	nextTs := int64(1900000000)
+	require.NoError(t, ts.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{ID: sooner.ID, RemindTs: &nextTs}))
+	reminder, err := ts.GetMemoReminder(ctx, &store.FindMemoReminder{ID: &sooner.ID})
+	require.NoError(t, err)
+	require.Equal(t, nextTs, reminder.RemindTs)
+	require.Equal(t, "0 9 * * *", reminder.Cron)
+
+	require.NoError(t, ts.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{ID: &later.ID}))
+	reminders, err = ts.ListMemoReminders(ctx, &store.FindMemoReminder{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+
+	// Deleting the memo deletes its reminders.
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}))
+	reminders, err = ts.ListMemoReminders(ctx, &store.FindMemoReminder{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, reminders)
+}
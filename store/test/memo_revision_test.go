@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	first, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		CreatedTs:  1700000000,
+		Content:    "first",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.ID)
+	require.Equal(t, int64(1700000000), first.CreatedTs)
+	second, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "second",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, second.CreatedTs)
+
+	// Revisions are listed newest first.
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, second.ID, revisions[0].ID)
+	require.Equal(t, first.ID, revisions[1].ID)
+	require.Equal(t, "first", revisions[1].Content)
+	require.Equal(t, store.Private, revisions[1].Visibility)
+
+	got, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, first, got)
+
+	limit, offset := 1, 1
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Equal(t, first.ID, revisions[0].ID)
+
+	// Trimming keeps only the newest revisions.
+	_, err = ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "third",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.TrimMemoRevisions(ctx, memo.ID, 2))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "third", revisions[0].Content)
+	require.Equal(t, "second", revisions[1].Content)
+
+	// Deleting the memo deletes its revisions.
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 0)
+
+	ts.Close()
+}
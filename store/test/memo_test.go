@@ -387,6 +387,71 @@ func TestMemoInvalidUID(t *testing.T) {
 	ts.Close()
 }
 
+func TestMemoUpdateMemos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	first, err := ts.CreateMemo(ctx, &store.Memo{UID: "batch-first", CreatorID: user.ID, Content: "first", Visibility: store.Public})
+	require.NoError(t, err)
+	second, err := ts.CreateMemo(ctx, &store.Memo{UID: "batch-second", CreatorID: user.ID, Content: "second", Visibility: store.Public})
+	require.NoError(t, err)
+
+	firstContent, secondContent := "first updated", "second updated"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &firstContent},
+		{ID: second.ID, Content: &secondContent},
+	}, nil)
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, secondContent, memo.Content)
+
+	// A failing update rolls back the whole batch.
+	rolledBackContent, duplicateUID := "rolled back", second.UID
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &rolledBackContent},
+		{ID: first.ID, UID: &duplicateUID},
+	}, nil)
+	require.Error(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, memo.Content)
+
+	// A conditional update of a memo that has changed since it was read fails, and so does the batch
+	// together with the user setting.
+	staleContent, tagSetting := "first", &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_TAGS,
+		Value:  &storepb.UserSetting_Tags{Tags: &storepb.TagsUserSetting{Tags: map[string]*storepb.TagsUserSetting_Tag{"work": {}}}},
+	}
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: second.ID, Content: &rolledBackContent, ExpectedContent: &secondContent},
+		{ID: first.ID, Content: &rolledBackContent, ExpectedContent: &staleContent},
+	}, tagSetting)
+	require.ErrorIs(t, err, store.ErrMemoConflict)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, secondContent, memo.Content)
+	tags, err := ts.GetUserTagSettings(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &rolledBackContent, ExpectedContent: &firstContent, ExpectedUpdatedTs: &memo.UpdatedTs},
+	}, tagSetting)
+	require.NoError(t, err)
+	tags, err = ts.GetUserTagSettings(ctx, user.ID)
+	require.NoError(t, err)
+	require.Contains(t, tags, "work")
+
+	ts.Close()
+}
+
 func TestMemoWithPayload(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
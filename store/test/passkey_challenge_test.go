@@ -0,0 +1,42 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestPasskeyChallengeStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	now := time.Now().Unix()
+	expired := &store.PasskeyChallenge{Challenge: "expired", ExpiresTs: now - 60}
+	active := &store.PasskeyChallenge{Challenge: "active", ExpiresTs: now + 60}
+	for _, challenge := range []*store.PasskeyChallenge{expired, active} {
+		created, err := ts.CreatePasskeyChallenge(ctx, challenge)
+		require.NoError(t, err)
+		require.True(t, created)
+	}
+	// A challenge is only recorded once.
+	created, err := ts.CreatePasskeyChallenge(ctx, active)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	require.NoError(t, ts.DeletePasskeyChallenge(ctx, &store.DeletePasskeyChallenge{ExpiresTsBefore: &now}))
+	created, err = ts.CreatePasskeyChallenge(ctx, expired)
+	require.NoError(t, err)
+	require.True(t, created)
+	created, err = ts.CreatePasskeyChallenge(ctx, active)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	require.Error(t, ts.DeletePasskeyChallenge(ctx, &store.DeletePasskeyChallenge{}))
+
+	ts.Close()
+}
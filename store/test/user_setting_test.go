@@ -657,6 +657,37 @@ func TestUserSettingMultipleSettingTypes(t *testing.T) {
 	ts.Close()
 }
 
+func TestUserSettingUpdateTwoFactor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// There is nothing to update before two-factor authentication is set up.
+	loaded := &storepb.TwoFactorUserSetting{Secret: "secret", Enabled: true, RecoveryCodeHashes: []string{"a", "b"}}
+	updated, err := ts.UpdateUserTwoFactor(ctx, user.ID, loaded, &storepb.TwoFactorUserSetting{})
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	require.NoError(t, ts.UpsertUserTwoFactor(ctx, user.ID, loaded))
+	first := &storepb.TwoFactorUserSetting{Secret: "secret", Enabled: true, RecoveryCodeHashes: []string{"b"}}
+	second := &storepb.TwoFactorUserSetting{Secret: "secret", Enabled: true, RecoveryCodeHashes: []string{"a"}}
+	updated, err = ts.UpdateUserTwoFactor(ctx, user.ID, loaded, first)
+	require.NoError(t, err)
+	require.True(t, updated)
+	// An update based on the same loaded setting fails.
+	updated, err = ts.UpdateUserTwoFactor(ctx, user.ID, loaded, second)
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	twoFactor, err := ts.GetUserTwoFactor(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, twoFactor.RecoveryCodeHashes)
+
+	ts.Close()
+}
+
 func TestUserSettingShortcutsEdgeCases(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -0,0 +1,105 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	due, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:     user.ID,
+		WebhookID:     "hook",
+		URL:           "https://example.com/hook",
+		ActivityType:  "memos.memo.created",
+		Payload:       `{"activityType":"memos.memo.created"}`,
+		ContentType:   "application/json",
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now - 60,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, due.ID)
+	require.NotZero(t, due.CreatedTs)
+	later, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:     user.ID,
+		WebhookID:     "hook",
+		URL:           "https://example.com/hook",
+		ActivityType:  "memos.memo.updated",
+		Payload:       "{}",
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now + 3600,
+	})
+	require.NoError(t, err)
+
+	// Deliveries are listed newest first.
+	webhookID := "hook"
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, later.ID, deliveries[0].ID)
+	require.Equal(t, due.ID, deliveries[1].ID)
+	require.Equal(t, `{"activityType":"memos.memo.created"}`, deliveries[1].Payload)
+	require.Equal(t, "application/json", deliveries[1].ContentType)
+
+	// Only deliveries whose next attempt is due are returned.
+	pending := store.WebhookDeliveryPending
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{Status: &pending, NextAttemptTsBefore: &now})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, due.ID, deliveries[0].ID)
+
+	// A delivery can only be claimed once per attempt.
+	claim := &store.ClaimWebhookDelivery{ID: due.ID, Attempts: 0, NextAttemptTs: now + 60}
+	claimed, err := ts.ClaimWebhookDelivery(ctx, claim)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = ts.ClaimWebhookDelivery(ctx, claim)
+	require.NoError(t, err)
+	require.False(t, claimed)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{Status: &pending, NextAttemptTsBefore: &now})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	succeeded := store.WebhookDeliverySucceeded
+	attempts := int32(1)
+	responseStatus := int32(200)
+	responseBody := "ok"
+	updated, err := ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:             due.ID,
+		UpdatedTs:      &now,
+		Status:         &succeeded,
+		Attempts:       &attempts,
+		ResponseStatus: &responseStatus,
+		ResponseBody:   &responseBody,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, updated.Status)
+	require.Equal(t, int32(1), updated.Attempts)
+	require.Equal(t, int32(200), updated.ResponseStatus)
+	require.Equal(t, "ok", updated.ResponseBody)
+	require.Equal(t, now, updated.UpdatedTs)
+
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{Status: &pending, NextAttemptTsBefore: &now})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	err = ts.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{CreatorID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	ts.Close()
+}
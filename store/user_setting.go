@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -16,6 +17,16 @@ type UserSetting struct {
 	Value  string
 }
 
+// UpdateUserSetting replaces the value of an existing user setting if it has not changed since it was loaded.
+type UpdateUserSetting struct {
+	UserID int32
+	Key    storepb.UserSetting_Key
+	Value  string
+	// ExpectedValue is the value the setting had when it was loaded.
+	// The update fails if the setting has been changed since.
+	ExpectedValue string
+}
+
 type FindUserSetting struct {
 	UserID *int32
 	Key    storepb.UserSetting_Key
@@ -394,6 +405,174 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserTagSettings returns the tag metadata of the user keyed by the lowercase tag.
+func (s *Store) GetUserTagSettings(ctx context.Context, userID int32) (map[string]*storepb.TagsUserSetting_Tag, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TAGS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetTags().GetTags() == nil {
+		return map[string]*storepb.TagsUserSetting_Tag{}, nil
+	}
+	return userSetting.GetTags().Tags, nil
+}
+
+// UpsertUserTagSettings replaces the tag metadata of the user.
+func (s *Store) UpsertUserTagSettings(ctx context.Context, userID int32, tags map[string]*storepb.TagsUserSetting_Tag) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TAGS,
+		Value: &storepb.UserSetting_Tags{
+			Tags: &storepb.TagsUserSetting{
+				Tags: tags,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserMemoTemplates returns the memo templates of the user.
+func (s *Store) GetUserMemoTemplates(ctx context.Context, userID int32) ([]*storepb.TemplatesUserSetting_Template, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TEMPLATES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.TemplatesUserSetting_Template{}, nil
+	}
+	return userSetting.GetTemplates().Templates, nil
+}
+
+// UpsertUserMemoTemplates replaces the memo templates of the user.
+func (s *Store) UpsertUserMemoTemplates(ctx context.Context, userID int32, templates []*storepb.TemplatesUserSetting_Template) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TEMPLATES,
+		Value: &storepb.UserSetting_Templates{
+			Templates: &storepb.TemplatesUserSetting{
+				Templates: templates,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserTwoFactor returns the two-factor authentication setting of the user.
+func (s *Store) GetUserTwoFactor(ctx context.Context, userID int32) (*storepb.TwoFactorUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.TwoFactorUserSetting{}, nil
+	}
+	return userSetting.GetTwoFactor(), nil
+}
+
+// UpsertUserTwoFactor replaces the two-factor authentication setting of the user.
+func (s *Store) UpsertUserTwoFactor(ctx context.Context, userID int32, twoFactor *storepb.TwoFactorUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+		Value: &storepb.UserSetting_TwoFactor{
+			TwoFactor: twoFactor,
+		},
+	})
+	return err
+}
+
+// UpdateUserTwoFactor replaces the two-factor authentication setting of the user if it still equals expected,
+// so that of concurrent updates based on the same setting only one succeeds.
+// It reports whether the setting was replaced.
+func (s *Store) UpdateUserTwoFactor(ctx context.Context, userID int32, expected, twoFactor *storepb.TwoFactorUserSetting) (bool, error) {
+	// Compare with the stored value rather than the cache, which may be stale if several servers share the database.
+	list, err := s.driver.ListUserSettings(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(list) != 1 {
+		return false, nil
+	}
+	current, err := convertUserSettingFromRaw(list[0])
+	if err != nil {
+		return false, err
+	}
+	cacheKey := getUserSettingCacheKey(userID, storepb.UserSetting_TWO_FACTOR.String())
+	if !proto.Equal(current.GetTwoFactor(), expected) {
+		s.userSettingCache.Set(ctx, cacheKey, current)
+		return false, nil
+	}
+
+	userSetting := &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+		Value: &storepb.UserSetting_TwoFactor{
+			TwoFactor: twoFactor,
+		},
+	}
+	raw, err := convertUserSettingToRaw(userSetting)
+	if err != nil {
+		return false, err
+	}
+	updated, err := s.driver.UpdateUserSetting(ctx, &UpdateUserSetting{
+		UserID:        userID,
+		Key:           storepb.UserSetting_TWO_FACTOR,
+		Value:         raw.Value,
+		ExpectedValue: list[0].Value,
+	})
+	if err != nil {
+		return false, err
+	}
+	if !updated {
+		// Let the next attempt load the setting written by the concurrent update.
+		s.userSettingCache.Delete(ctx, cacheKey)
+		return false, nil
+	}
+	s.userSettingCache.Set(ctx, cacheKey, userSetting)
+	return true, nil
+}
+
+// GetUserPasskeys returns the passkeys of the user.
+func (s *Store) GetUserPasskeys(ctx context.Context, userID int32) ([]*storepb.PasskeysUserSetting_Passkey, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_PASSKEYS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.PasskeysUserSetting_Passkey{}, nil
+	}
+	return userSetting.GetPasskeys().Passkeys, nil
+}
+
+// UpsertUserPasskeys replaces the passkeys of the user.
+func (s *Store) UpsertUserPasskeys(ctx context.Context, userID int32, passkeys []*storepb.PasskeysUserSetting_Passkey) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PASSKEYS,
+		Value: &storepb.UserSetting_Passkeys{
+			Passkeys: &storepb.PasskeysUserSetting{
+				Passkeys: passkeys,
+			},
+		},
+	})
+	return err
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -431,6 +610,36 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := &storepb.NotificationUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notification{Notification: notificationUserSetting}
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := &storepb.TagsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), tagsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Tags{Tags: tagsUserSetting}
+	case storepb.UserSetting_TEMPLATES:
+		templatesUserSetting := &storepb.TemplatesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), templatesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Templates{Templates: templatesUserSetting}
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := &storepb.TwoFactorUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), twoFactorUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TwoFactor{TwoFactor: twoFactorUserSetting}
+	case storepb.UserSetting_PASSKEYS:
+		passkeysUserSetting := &storepb.PasskeysUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), passkeysUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Passkeys{Passkeys: passkeysUserSetting}
 	default:
 		return nil, nil
 	}
@@ -479,6 +688,41 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := userSetting.GetNotification()
+		value, err := protojson.Marshal(notificationUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := userSetting.GetTags()
+		value, err := protojson.Marshal(tagsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TEMPLATES:
+		templatesUserSetting := userSetting.GetTemplates()
+		value, err := protojson.Marshal(templatesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := userSetting.GetTwoFactor()
+		value, err := protojson.Marshal(twoFactorUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_PASSKEYS:
+		passkeysUserSetting := userSetting.GetPasskeys()
+		value, err := protojson.Marshal(passkeysUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
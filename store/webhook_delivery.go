@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus represents the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending indicates the delivery is waiting for its next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded indicates the endpoint accepted the delivery.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed indicates the delivery ran out of attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// InstanceWebhookCreatorID is the creator ID of deliveries of instance webhooks, which are not owned by a user.
+const InstanceWebhookCreatorID int32 = 0
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is a queued webhook request together with the outcome of its latest attempt.
+type WebhookDelivery struct {
+	ID int32
+	// CreatorID is the ID of the user who owns the webhook, or InstanceWebhookCreatorID for instance webhooks.
+	CreatorID int32
+	WebhookID string
+	CreatedTs int64
+	UpdatedTs int64
+
+	URL          string
+	ActivityType string
+	// Payload is the request body sent to the endpoint.
+	Payload string
+	// ContentType is the content type of the payload.
+	ContentType string
+
+	Status        WebhookDeliveryStatus
+	Attempts      int32
+	NextAttemptTs int64
+	// ResponseStatus is the HTTP status code of the latest attempt, or 0 if no response was received.
+	ResponseStatus int32
+	ResponseBody   string
+	ErrorMessage   string
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	CreatorID *int32
+	WebhookID *string
+	Status    *WebhookDeliveryStatus
+	// NextAttemptTsBefore filters deliveries whose next attempt is due at or before the given time.
+	NextAttemptTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateWebhookDelivery struct {
+	ID             int32
+	UpdatedTs      *int64
+	Status         *WebhookDeliveryStatus
+	Attempts       *int32
+	NextAttemptTs  *int64
+	ResponseStatus *int32
+	ResponseBody   *string
+	ErrorMessage   *string
+}
+
+// ClaimWebhookDelivery claims a pending delivery for an attempt, so that it is attempted by a single worker.
+type ClaimWebhookDelivery struct {
+	ID int32
+	// Attempts is the number of attempts the delivery had when it was loaded.
+	// The claim fails if another worker has claimed the delivery since.
+	Attempts int32
+	// NextAttemptTs is when the delivery is due again if the claimed attempt never records its outcome.
+	NextAttemptTs int64
+}
+
+type DeleteWebhookDelivery struct {
+	ID        *int32
+	CreatorID *int32
+	WebhookID *string
+	// CreatedTsBefore deletes deliveries created before the given time.
+	CreatedTsBefore *int64
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries returns deliveries ordered from newest to oldest.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+// ClaimWebhookDelivery counts the attempt of a pending delivery and reports whether the claim succeeded.
+func (s *Store) ClaimWebhookDelivery(ctx context.Context, claim *ClaimWebhookDelivery) (bool, error) {
+	return s.driver.ClaimWebhookDelivery(ctx, claim)
+}
+
+func (s *Store) DeleteWebhookDelivery(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDelivery(ctx, delete)
+}
@@ -19,6 +19,8 @@ import {
   IdentityProviderSchema,
   OAuth2Config,
   OAuth2ConfigSchema,
+  OIDCConfig,
+  OIDCConfigSchema,
 } from "@/types/proto/api/v1/idp_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
@@ -119,8 +121,35 @@ const templateList: IdentityProvider[] = [
       },
     }),
   }),
+  create(IdentityProviderSchema, {
+    name: "",
+    title: "OpenID Connect",
+    type: IdentityProvider_Type.OIDC,
+    identifierFilter: "",
+    config: create(IdentityProviderConfigSchema, {
+      config: {
+        case: "oidcConfig",
+        value: create(OIDCConfigSchema, {
+          issuer: "",
+          clientId: "",
+          clientSecret: "",
+          scopes: ["openid", "profile", "email"],
+          identifierClaim: "sub",
+        }),
+      },
+    }),
+  }),
 ];
 
+const emptyOIDCConfig = () =>
+  create(OIDCConfigSchema, {
+    issuer: "",
+    clientId: "",
+    clientSecret: "",
+    scopes: [],
+    identifierClaim: "",
+  });
+
 interface Props {
   open: boolean;
   onOpenChange: (open: boolean) => void;
@@ -152,6 +181,8 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
     }),
   );
   const [oauth2Scopes, setOAuth2Scopes] = useState<string>("");
+  const [oidcConfig, setOIDCConfig] = useState<OIDCConfig>(emptyOIDCConfig());
+  const [oidcScopes, setOIDCScopes] = useState<string>("");
   const [selectedTemplate, setSelectedTemplate] = useState<string>("GitHub");
   const isCreating = identityProvider === undefined;
 
@@ -180,6 +211,8 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         }),
       );
       setOAuth2Scopes("");
+      setOIDCConfig(emptyOIDCConfig());
+      setOIDCScopes("");
       setSelectedTemplate("GitHub");
     }
   }, [open]);
@@ -197,6 +230,11 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         setOAuth2Config(oauth2Config);
         setOAuth2Scopes(oauth2Config.scopes.join(" "));
       }
+      if (identityProvider.type === IdentityProvider_Type.OIDC && identityProvider.config?.config?.case === "oidcConfig") {
+        const oidcConfig = create(OIDCConfigSchema, identityProvider.config.config.value || {});
+        setOIDCConfig(oidcConfig);
+        setOIDCScopes(oidcConfig.scopes.join(" "));
+      }
     }
   }, [open, identityProvider]);
 
@@ -218,6 +256,11 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         setOAuth2Config(oauth2Config);
         setOAuth2Scopes(oauth2Config.scopes.join(" "));
       }
+      if (template.type === IdentityProvider_Type.OIDC && template.config?.config?.case === "oidcConfig") {
+        const oidcConfig = create(OIDCConfigSchema, template.config.config.value || {});
+        setOIDCConfig(oidcConfig);
+        setOIDCScopes(oidcConfig.scopes.join(" "));
+      }
     }
   }, [selectedTemplate, isCreating, open]);
 
@@ -246,10 +289,35 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         }
       }
     }
+    if (type === IdentityProvider_Type.OIDC) {
+      if (oidcConfig.issuer === "" || oidcConfig.clientId === "") {
+        return false;
+      }
+    }
 
     return true;
   };
 
+  const buildConfig = () =>
+    create(IdentityProviderConfigSchema, {
+      config:
+        type === IdentityProvider_Type.OIDC
+          ? {
+              case: "oidcConfig",
+              value: {
+                ...oidcConfig,
+                scopes: oidcScopes.split(" ").filter((scope) => scope !== ""),
+              },
+            }
+          : {
+              case: "oauth2Config",
+              value: {
+                ...oauth2Config,
+                scopes: oauth2Scopes.split(" "),
+              },
+            },
+    });
+
   const handleConfirmBtnClick = async () => {
     try {
       if (isCreating) {
@@ -257,15 +325,7 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
           identityProvider: create(IdentityProviderSchema, {
             ...basicInfo,
             type: type,
-            config: create(IdentityProviderConfigSchema, {
-              config: {
-                case: "oauth2Config",
-                value: {
-                  ...oauth2Config,
-                  scopes: oauth2Scopes.split(" "),
-                },
-              },
-            }),
+            config: buildConfig(),
           }),
         });
         toast.success(t("setting.sso-section.sso-created", { name: basicInfo.title }));
@@ -275,15 +335,7 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
             ...basicInfo,
             name: identityProvider!.name,
             type: type,
-            config: create(IdentityProviderConfigSchema, {
-              config: {
-                case: "oauth2Config",
-                value: {
-                  ...oauth2Config,
-                  scopes: oauth2Scopes.split(" "),
-                },
-              },
-            }),
+            config: buildConfig(),
           }),
           updateMask: create(FieldMaskSchema, { paths: ["title", "identifier_filter", "config"] }),
         });
@@ -305,6 +357,13 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
     });
   };
 
+  const setPartialOIDCConfig = (state: Partial<OIDCConfig>) => {
+    setOIDCConfig({
+      ...oidcConfig,
+      ...state,
+    });
+  };
+
   return (
     <Dialog open={open} onOpenChange={onOpenChange}>
       <DialogContent className="max-w-2xl max-h-[80vh] overflow-y-auto">
@@ -480,6 +539,57 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
               />
             </>
           )}
+          {type === IdentityProvider_Type.OIDC && (
+            <>
+              {isCreating && (
+                <p className="border border-border rounded-md p-2 text-sm w-full mb-2 break-all">
+                  {t("setting.sso-section.redirect-url")}: {absolutifyLink("/auth/callback")}
+                </p>
+              )}
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.issuer")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="https://accounts.example.com"
+                value={oidcConfig.issuer}
+                onChange={(e) => setPartialOIDCConfig({ issuer: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.client-id")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.client-id")}
+                value={oidcConfig.clientId}
+                onChange={(e) => setPartialOIDCConfig({ clientId: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.client-secret")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.client-secret")}
+                value={oidcConfig.clientSecret}
+                onChange={(e) => setPartialOIDCConfig({ clientSecret: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.scopes")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="openid profile email"
+                value={oidcScopes}
+                onChange={(e) => setOIDCScopes(e.target.value)}
+              />
+              <Separator className="my-2" />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.identifier-claim")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="sub"
+                value={oidcConfig.identifierClaim}
+                onChange={(e) => setPartialOIDCConfig({ identifierClaim: e.target.value })}
+              />
+            </>
+          )}
         </div>
         <DialogFooter>
           <Button variant="ghost" onClick={handleCloseBtnClick}>
@@ -21,6 +21,9 @@ function PasswordSignInForm() {
   const actionBtnLoadingState = useLoading(false);
   const [username, setUsername] = useState(profile.demo ? "demo" : "");
   const [password, setPassword] = useState(profile.demo ? "secret" : "");
+  // Set when the password was accepted and a two-factor code is needed to complete the sign-in.
+  const [twoFactorToken, setTwoFactorToken] = useState("");
+  const [twoFactorCode, setTwoFactorCode] = useState("");
 
   const handleUsernameInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
     const text = e.target.value as string;
@@ -32,13 +35,18 @@ function PasswordSignInForm() {
     setPassword(text);
   };
 
+  const handleTwoFactorCodeInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const text = e.target.value as string;
+    setTwoFactorCode(text);
+  };
+
   const handleFormSubmit = (e: React.FormEvent<HTMLFormElement>) => {
     e.preventDefault();
     handleSignInButtonClick();
   };
 
   const handleSignInButtonClick = async () => {
-    if (username === "" || password === "") {
+    if (username === "" || password === "" || (twoFactorToken !== "" && twoFactorCode === "")) {
       return;
     }
 
@@ -49,11 +57,21 @@ function PasswordSignInForm() {
     try {
       actionBtnLoadingState.setLoading();
       const response = await authServiceClient.signIn({
-        credentials: {
-          case: "passwordCredentials",
-          value: { username, password },
-        },
+        credentials: twoFactorToken
+          ? {
+              case: "twoFactorCredentials",
+              value: { token: twoFactorToken, code: twoFactorCode },
+            }
+          : {
+              case: "passwordCredentials",
+              value: { username, password },
+            },
       });
+      if (response.twoFactorToken) {
+        setTwoFactorToken(response.twoFactorToken);
+        actionBtnLoadingState.setFinish();
+        return;
+      }
       // Store access token from login response
       if (response.accessToken) {
         setAccessToken(response.accessToken, response.accessTokenExpiresAt ? timestampDate(response.accessTokenExpiresAt) : undefined);
@@ -76,7 +94,7 @@ function PasswordSignInForm() {
           <Input
             className="w-full bg-background h-10"
             type="text"
-            readOnly={actionBtnLoadingState.isLoading}
+            readOnly={actionBtnLoadingState.isLoading || twoFactorToken !== ""}
             placeholder={t("common.username")}
             value={username}
             autoComplete="username"
@@ -91,7 +109,7 @@ function PasswordSignInForm() {
           <Input
             className="w-full bg-background h-10"
             type="password"
-            readOnly={actionBtnLoadingState.isLoading}
+            readOnly={actionBtnLoadingState.isLoading || twoFactorToken !== ""}
             placeholder={t("common.password")}
             value={password}
             autoComplete="current-password"
@@ -101,6 +119,25 @@ function PasswordSignInForm() {
             required
           />
         </div>
+        {twoFactorToken && (
+          <div className="w-full flex flex-col justify-start items-start">
+            <span className="leading-8 text-muted-foreground">{t("auth.two-factor-code")}</span>
+            <Input
+              className="w-full bg-background h-10"
+              type="text"
+              readOnly={actionBtnLoadingState.isLoading}
+              placeholder="123456"
+              value={twoFactorCode}
+              autoComplete="one-time-code"
+              autoCapitalize="off"
+              autoFocus
+              spellCheck={false}
+              onChange={handleTwoFactorCodeInputChanged}
+              required
+            />
+            <span className="text-sm text-muted-foreground mt-1">{t("auth.two-factor-code-tip")}</span>
+          </div>
+        )}
       </div>
       <div className="flex flex-row justify-end items-center w-full mt-6">
         <Button type="submit" className="w-full h-10" disabled={actionBtnLoadingState.isLoading} onClick={handleSignInButtonClick}>
@@ -39,35 +39,43 @@ const SignIn = () => {
   }, []);
 
   const handleSignInWithIdentityProvider = async (identityProvider: IdentityProvider) => {
-    if (identityProvider.type === IdentityProvider_Type.OAUTH2) {
-      const redirectUri = absolutifyLink("/auth/callback");
-      const oauth2Config = identityProvider.config?.config?.case === "oauth2Config" ? identityProvider.config.config.value : undefined;
-      if (!oauth2Config) {
-        toast.error("Identity provider configuration is invalid.");
-        return;
-      }
-
-      try {
-        // Generate and store secure state parameter with CSRF protection
-        // Also generate PKCE parameters (code_challenge) for enhanced security
-        const identityProviderId = extractIdentityProviderIdFromName(identityProvider.name);
-        const { state, codeChallenge } = await storeOAuthState(identityProviderId);
+    const redirectUri = absolutifyLink("/auth/callback");
+    // OpenID Connect providers carry the authorization endpoint discovered from their issuer.
+    const config = identityProvider.config?.config;
+    const providerConfig =
+      identityProvider.type === IdentityProvider_Type.OAUTH2 && config?.case === "oauth2Config"
+        ? config.value
+        : identityProvider.type === IdentityProvider_Type.OIDC && config?.case === "oidcConfig"
+          ? config.value
+          : undefined;
+    if (!providerConfig || !providerConfig.authUrl) {
+      toast.error("Identity provider configuration is invalid.");
+      return;
+    }
 
-        // Build OAuth authorization URL with secure state and PKCE
-        // Using S256 (SHA-256) as the code_challenge_method per RFC 7636
-        const authUrl = `${oauth2Config.authUrl}?client_id=${
-          oauth2Config.clientId
-        }&redirect_uri=${encodeURIComponent(redirectUri)}&state=${state}&response_type=code&scope=${encodeURIComponent(
-          oauth2Config.scopes.join(" "),
-        )}&code_challenge=${codeChallenge}&code_challenge_method=S256`;
+    try {
+      // Generate and store secure state parameter with CSRF protection
+      // Also generate PKCE parameters (code_challenge) for enhanced security
+      const identityProviderId = extractIdentityProviderIdFromName(identityProvider.name);
+      const { state, codeChallenge, nonce } = await storeOAuthState(identityProviderId);
 
-        window.location.href = authUrl;
-      } catch (error) {
-        handleError(error, toast.error, {
-          context: "Failed to initiate OAuth flow",
-          fallbackMessage: "Failed to initiate sign-in. Please try again.",
-        });
+      // Build OAuth authorization URL with secure state and PKCE
+      // Using S256 (SHA-256) as the code_challenge_method per RFC 7636
+      let authUrl = `${providerConfig.authUrl}?client_id=${
+        providerConfig.clientId
+      }&redirect_uri=${encodeURIComponent(redirectUri)}&state=${state}&response_type=code&scope=${encodeURIComponent(
+        providerConfig.scopes.join(" "),
+      )}&code_challenge=${codeChallenge}&code_challenge_method=S256`;
+      if (identityProvider.type === IdentityProvider_Type.OIDC) {
+        authUrl += `&nonce=${nonce}`;
       }
+
+      window.location.href = authUrl;
+    } catch (error) {
+      handleError(error, toast.error, {
+        context: "Failed to initiate OAuth flow",
+        fallbackMessage: "Failed to initiate sign-in. Please try again.",
+      });
     }
   };
 
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/activity_service.proto.
  */
 export const file_api_v1_activity_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsEDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyIuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQASI9CgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARIICgRXQVJOEAISCQoFRVJST1IQAzpN6kFKChVtZW1vcy5hcGkudjEvQWN0aXZpdHkSFWFjdGl2aXRpZXMve2FjdGl2aXR5fRoEbmFtZSoKYWN0aXZpdGllczIIYWN0aXZpdHkiXgoPQWN0aXZpdHlQYXlsb2FkEkAKDG1lbW9fY29tbWVudBgBIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZEgAQgkKB3BheWxvYWQiQAoaQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkiPgoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Mv8BCg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIvcDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyJkCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQARIQCgxNRU1PX01FTlRJT04QAhIRCg1NRU1PX1JFTUlOREVSEAMSDwoLTUVNT19ESUdFU1QQBCI9CgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARIICgRXQVJOEAISCQoFRVJST1IQAzpN6kFKChVtZW1vcy5hcGkudjEvQWN0aXZpdHkSFWFjdGl2aXRpZXMve2FjdGl2aXR5fRoEbmFtZSoKYWN0aXZpdGllczIIYWN0aXZpdHkipAIKD0FjdGl2aXR5UGF5bG9hZBJACgxtZW1vX2NvbW1lbnQYASABKAsyKC5tZW1vcy5hcGkudjEuQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWRIABJACgxtZW1vX21lbnRpb24YAiABKAsyKC5tZW1vcy5hcGkudjEuQWN0aXZpdHlNZW1vTWVudGlvblBheWxvYWRIABJCCg1tZW1vX3JlbWluZGVyGAMgASgLMikubWVtb3MuYXBpLnYxLkFjdGl2aXR5TWVtb1JlbWluZGVyUGF5bG9hZEgAEj4KC21lbW9fZGlnZXN0GAQgASgLMicubWVtb3MuYXBpLnYxLkFjdGl2aXR5TWVtb0RpZ2VzdFBheWxvYWRIAEIJCgdwYXlsb2FkIkAKGkFjdGl2aXR5TWVtb0NvbW1lbnRQYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMcmVsYXRlZF9tZW1vGAIgASgJIioKGkFjdGl2aXR5TWVtb01lbnRpb25QYXlsb2FkEgwKBG1lbW8YASABKAkiKwobQWN0aXZpdHlNZW1vUmVtaW5kZXJQYXlsb2FkEgwKBG1lbW8YASABKAkiWgoZQWN0aXZpdHlNZW1vRGlnZXN0UGF5bG9hZBIMCgRkYXRlGAEgASgJEhkKEW9uX3RoaXNfZGF5X21lbW9zGAIgAygJEhQKDHJldmlld19tZW1vcxgDIAMoCSI+ChVMaXN0QWN0aXZpdGllc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkiXQoWTGlzdEFjdGl2aXRpZXNSZXNwb25zZRIqCgphY3Rpdml0aWVzGAEgAygLMhYubWVtb3MuYXBpLnYxLkFjdGl2aXR5EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJBChJHZXRBY3Rpdml0eVJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvQWN0aXZpdHky/wEKD0FjdGl2aXR5U2VydmljZRJ3Cg5MaXN0QWN0aXZpdGllcxIjLm1lbW9zLmFwaS52MS5MaXN0QWN0aXZpdGllc1JlcXVlc3QaJC5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXNwb25zZSIagtPkkwIUEhIvYXBpL3YxL2FjdGl2aXRpZXMScwoLR2V0QWN0aXZpdHkSIC5tZW1vcy5hcGkudjEuR2V0QWN0aXZpdHlSZXF1ZXN0GhYubWVtb3MuYXBpLnYxLkFjdGl2aXR5IiraQQRuYW1lgtPkkwIdEhsvYXBpL3YxL3tuYW1lPWFjdGl2aXRpZXMvKn1CrAEKEGNvbS5tZW1vcy5hcGkudjFCFEFjdGl2aXR5U2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Activity
@@ -93,6 +93,27 @@ export enum Activity_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * Memo mention activity.
+   *
+   * @generated from enum value: MEMO_MENTION = 2;
+   */
+  MEMO_MENTION = 2,
+
+  /**
+   * Memo reminder activity.
+   *
+   * @generated from enum value: MEMO_REMINDER = 3;
+   */
+  MEMO_REMINDER = 3,
+
+  /**
+   * Memo digest activity.
+   *
+   * @generated from enum value: MEMO_DIGEST = 4;
+   */
+  MEMO_DIGEST = 4,
 }
 
 /**
@@ -157,6 +178,30 @@ export type ActivityPayload = Message<"memos.api.v1.ActivityPayload"> & {
      */
     value: ActivityMemoCommentPayload;
     case: "memoComment";
+  } | {
+    /**
+     * Memo mention activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoMentionPayload memo_mention = 2;
+     */
+    value: ActivityMemoMentionPayload;
+    case: "memoMention";
+  } | {
+    /**
+     * Memo reminder activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoReminderPayload memo_reminder = 3;
+     */
+    value: ActivityMemoReminderPayload;
+    case: "memoReminder";
+  } | {
+    /**
+     * Memo digest activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoDigestPayload memo_digest = 4;
+     */
+    value: ActivityMemoDigestPayload;
+    case: "memoDigest";
   } | { case: undefined; value?: undefined };
 };
 
@@ -197,6 +242,87 @@ export type ActivityMemoCommentPayload = Message<"memos.api.v1.ActivityMemoComme
 export const ActivityMemoCommentPayloadSchema: GenMessage<ActivityMemoCommentPayload> = /*@__PURE__*/
   messageDesc(file_api_v1_activity_service, 2);
 
+/**
+ * ActivityMemoMentionPayload represents the payload of a memo mention activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoMentionPayload
+ */
+export type ActivityMemoMentionPayload = Message<"memos.api.v1.ActivityMemoMentionPayload"> & {
+  /**
+   * The name of the memo that mentions the user.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoMentionPayload.
+ * Use `create(ActivityMemoMentionPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoMentionPayloadSchema: GenMessage<ActivityMemoMentionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 3);
+
+/**
+ * ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoReminderPayload
+ */
+export type ActivityMemoReminderPayload = Message<"memos.api.v1.ActivityMemoReminderPayload"> & {
+  /**
+   * The name of the memo the reminder is for.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoReminderPayload.
+ * Use `create(ActivityMemoReminderPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoReminderPayloadSchema: GenMessage<ActivityMemoReminderPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 4);
+
+/**
+ * ActivityMemoDigestPayload represents the payload of a daily memo digest activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoDigestPayload
+ */
+export type ActivityMemoDigestPayload = Message<"memos.api.v1.ActivityMemoDigestPayload"> & {
+  /**
+   * The day of the digest in the user's time zone, formatted as YYYY-MM-DD.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * The names of the memos created on the same day in previous years.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string on_this_day_memos = 2;
+   */
+  onThisDayMemos: string[];
+
+  /**
+   * The names of the memos tagged #review that are due for review.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string review_memos = 3;
+   */
+  reviewMemos: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoDigestPayload.
+ * Use `create(ActivityMemoDigestPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoDigestPayloadSchema: GenMessage<ActivityMemoDigestPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 5);
+
 /**
  * @generated from message memos.api.v1.ListActivitiesRequest
  */
@@ -225,7 +351,7 @@ export type ListActivitiesRequest = Message<"memos.api.v1.ListActivitiesRequest"
  * Use `create(ListActivitiesRequestSchema)` to create a new message.
  */
 export const ListActivitiesRequestSchema: GenMessage<ListActivitiesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 3);
+  messageDesc(file_api_v1_activity_service, 6);
 
 /**
  * @generated from message memos.api.v1.ListActivitiesResponse
@@ -253,7 +379,7 @@ export type ListActivitiesResponse = Message<"memos.api.v1.ListActivitiesRespons
  * Use `create(ListActivitiesResponseSchema)` to create a new message.
  */
 export const ListActivitiesResponseSchema: GenMessage<ListActivitiesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 4);
+  messageDesc(file_api_v1_activity_service, 7);
 
 /**
  * @generated from message memos.api.v1.GetActivityRequest
@@ -273,7 +399,7 @@ export type GetActivityRequest = Message<"memos.api.v1.GetActivityRequest"> & {
  * Use `create(GetActivityRequestSchema)` to create a new message.
  */
 export const GetActivityRequestSchema: GenMessage<GetActivityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 5);
+  messageDesc(file_api_v1_activity_service, 8);
 
 /**
  * @generated from service memos.api.v1.ActivityService
@@ -4,7 +4,7 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { User } from "./user_service_pb";
+import type { PasskeySchema, User } from "./user_service_pb";
 import { file_api_v1_user_service } from "./user_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/auth_service.proto.
  */
 export const file_api_v1_auth_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiFwoVR2V0Q3VycmVudFVzZXJSZXF1ZXN0IjoKFkdldEN1cnJlbnRVc2VyUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyIuwCCg1TaWduSW5SZXF1ZXN0Ek8KFHBhc3N3b3JkX2NyZWRlbnRpYWxzGAEgASgLMi8ubWVtb3MuYXBpLnYxLlNpZ25JblJlcXVlc3QuUGFzc3dvcmRDcmVkZW50aWFsc0gAEkUKD3Nzb19jcmVkZW50aWFscxgCIAEoCzIqLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAAaQwoTUGFzc3dvcmRDcmVkZW50aWFscxIVCgh1c2VybmFtZRgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQIabwoOU1NPQ3JlZGVudGlhbHMSEwoGaWRwX2lkGAEgASgFQgPgQQISEQoEY29kZRgCIAEoCUID4EECEhkKDHJlZGlyZWN0X3VyaRgDIAEoCUID4EECEhoKDWNvZGVfdmVyaWZpZXIYBCABKAlCA+BBAUINCgtjcmVkZW50aWFscyKFAQoOU2lnbkluUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhQKDGFjY2Vzc190b2tlbhgCIAEoCRI7ChdhY2Nlc3NfdG9rZW5fZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEAoOU2lnbk91dFJlcXVlc3QiFQoTUmVmcmVzaFRva2VuUmVxdWVzdCJcChRSZWZyZXNoVG9rZW5SZXNwb25zZRIUCgxhY2Nlc3NfdG9rZW4YASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAyvwMKC0F1dGhTZXJ2aWNlEnQKDkdldEN1cnJlbnRVc2VyEiMubWVtb3MuYXBpLnYxLkdldEN1cnJlbnRVc2VyUmVxdWVzdBokLm1lbW9zLmFwaS52MS5HZXRDdXJyZW50VXNlclJlc3BvbnNlIheC0+STAhESDy9hcGkvdjEvYXV0aC9tZRJjCgZTaWduSW4SGy5tZW1vcy5hcGkudjEuU2lnbkluUmVxdWVzdBocLm1lbW9zLmFwaS52MS5TaWduSW5SZXNwb25zZSIegtPkkwIYOgEqIhMvYXBpL3YxL2F1dGgvc2lnbmluEl0KB1NpZ25PdXQSHC5tZW1vcy5hcGkudjEuU2lnbk91dFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiHILT5JMCFiIUL2FwaS92MS9hdXRoL3NpZ25vdXQSdgoMUmVmcmVzaFRva2VuEiEubWVtb3MuYXBpLnYxLlJlZnJlc2hUb2tlblJlcXVlc3QaIi5tZW1vcy5hcGkudjEuUmVmcmVzaFRva2VuUmVzcG9uc2UiH4LT5JMCGToBKiIUL2FwaS92MS9hdXRoL3JlZnJlc2hCqAEKEGNvbS5tZW1vcy5hcGkudjFCEEF1dGhTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiFwoVR2V0Q3VycmVudFVzZXJSZXF1ZXN0IjoKFkdldEN1cnJlbnRVc2VyUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyIqQGCg1TaWduSW5SZXF1ZXN0Ek8KFHBhc3N3b3JkX2NyZWRlbnRpYWxzGAEgASgLMi8ubWVtb3MuYXBpLnYxLlNpZ25JblJlcXVlc3QuUGFzc3dvcmRDcmVkZW50aWFsc0gAEkUKD3Nzb19jcmVkZW50aWFscxgCIAEoCzIqLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAASUgoWdHdvX2ZhY3Rvcl9jcmVkZW50aWFscxgDIAEoCzIwLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlR3b0ZhY3RvckNyZWRlbnRpYWxzSAASTQoTcGFzc2tleV9jcmVkZW50aWFscxgEIAEoCzIuLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlBhc3NrZXlDcmVkZW50aWFsc0gAGkMKE1Bhc3N3b3JkQ3JlZGVudGlhbHMSFQoIdXNlcm5hbWUYASABKAlCA+BBAhIVCghwYXNzd29yZBgCIAEoCUID4EECGoMBCg5TU09DcmVkZW50aWFscxITCgZpZHBfaWQYASABKAVCA+BBAhIRCgRjb2RlGAIgASgJQgPgQQISGQoMcmVkaXJlY3RfdXJpGAMgASgJQgPgQQISGgoNY29kZV92ZXJpZmllchgEIAEoCUID4EEBEhIKBW5vbmNlGAUgASgJQgPgQQEaPQoUVHdvRmFjdG9yQ3JlZGVudGlhbHMSEgoFdG9rZW4YASABKAlCA+BBAhIRCgRjb2RlGAIgASgJQgPgQQIavgEKElBhc3NrZXlDcmVkZW50aWFscxIaCg1zZXNzaW9uX3Rva2VuGAEgASgJQgPgQQISGgoNY3JlZGVudGlhbF9pZBgCIAEoDEID4EECEh0KEGNsaWVudF9kYXRhX2pzb24YAyABKAxCA+BBAhIfChJhdXRoZW50aWNhdG9yX2RhdGEYBCABKAxCA+BBAhIWCglzaWduYXR1cmUYBSABKAxCA+BBAhIYCgt1c2VyX2hhbmRsZRgGIAEoDEID4EEBQg0KC2NyZWRlbnRpYWxzIp8BCg5TaWduSW5SZXNwb25zZRIgCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXISFAoMYWNjZXNzX3Rva2VuGAIgASgJEjsKF2FjY2Vzc190b2tlbl9leHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIYChB0d29fZmFjdG9yX3Rva2VuGAQgASgJIhAKDlNpZ25PdXRSZXF1ZXN0IhUKE1JlZnJlc2hUb2tlblJlcXVlc3QiXAoUUmVmcmVzaFRva2VuUmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiEKH0JlZ2luUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QicAogQmVnaW5QYXNza2V5UmVnaXN0cmF0aW9uUmVzcG9uc2USNQoHb3B0aW9ucxgBIAEoCzIkLm1lbW9zLmFwaS52MS5QYXNza2V5Q3JlYXRpb25PcHRpb25zEhUKDXNlc3Npb25fdG9rZW4YAiABKAki0gEKFlBhc3NrZXlDcmVhdGlvbk9wdGlvbnMSEQoJY2hhbGxlbmdlGAEgASgMEg0KBXJwX2lkGAIgASgJEg8KB3JwX25hbWUYAyABKAkSDwoHdXNlcl9pZBgEIAEoDBIRCgl1c2VyX25hbWUYBSABKAkSGQoRdXNlcl9kaXNwbGF5X25hbWUYBiABKAkSEgoKYWxnb3JpdGhtcxgHIAMoAxIeChZleGNsdWRlX2NyZWRlbnRpYWxfaWRzGAggAygMEhIKCnRpbWVvdXRfbXMYCSABKA0isgEKIEZpbmlzaFBhc3NrZXlSZWdpc3RyYXRpb25SZXF1ZXN0EhoKDXNlc3Npb25fdG9rZW4YASABKAlCA+BBAhIdChBjbGllbnRfZGF0YV9qc29uGAIgASgMQgPgQQISHwoSYXR0ZXN0YXRpb25fb2JqZWN0GAMgASgMQgPgQQISGQoMZGlzcGxheV9uYW1lGAQgASgJQgPgQQESFwoKdHJhbnNwb3J0cxgFIAMoCUID4EEBIjoKGUJlZ2luUGFzc2tleVNpZ25JblJlcXVlc3QSHQoQdHdvX2ZhY3Rvcl90b2tlbhgBIAEoCUID4EEBImkKGkJlZ2luUGFzc2tleVNpZ25JblJlc3BvbnNlEjQKB29wdGlvbnMYASABKAsyIy5tZW1vcy5hcGkudjEuUGFzc2tleVJlcXVlc3RPcHRpb25zEhUKDXNlc3Npb25fdG9rZW4YAiABKAkihgEKFVBhc3NrZXlSZXF1ZXN0T3B0aW9ucxIRCgljaGFsbGVuZ2UYASABKAwSDQoFcnBfaWQYAiABKAkSHAoUYWxsb3dfY3JlZGVudGlhbF9pZHMYAyADKAwSGQoRdXNlcl92ZXJpZmljYXRpb24YBCABKAkSEgoKdGltZW91dF9tcxgFIAEoDTKhBwoLQXV0aFNlcnZpY2USdAoOR2V0Q3VycmVudFVzZXISIy5tZW1vcy5hcGkudjEuR2V0Q3VycmVudFVzZXJSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkdldEN1cnJlbnRVc2VyUmVzcG9uc2UiF4LT5JMCERIPL2FwaS92MS9hdXRoL21lEmMKBlNpZ25JbhIbLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0GhwubWVtb3MuYXBpLnYxLlNpZ25JblJlc3BvbnNlIh6C0+STAhg6ASoiEy9hcGkvdjEvYXV0aC9zaWduaW4SXQoHU2lnbk91dBIcLm1lbW9zLmFwaS52MS5TaWduT3V0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIcgtPkkwIWIhQvYXBpL3YxL2F1dGgvc2lnbm91dBKtAQoYQmVnaW5QYXNza2V5UmVnaXN0cmF0aW9uEi0ubWVtb3MuYXBpLnYxLkJlZ2luUGFzc2tleVJlZ2lzdHJhdGlvblJlcXVlc3QaLi5tZW1vcy5hcGkudjEuQmVnaW5QYXNza2V5UmVnaXN0cmF0aW9uUmVzcG9uc2UiMoLT5JMCLDoBKiInL2FwaS92MS9hdXRoL3Bhc3NrZXlzOmJlZ2luUmVnaXN0cmF0aW9uEpcBChlGaW5pc2hQYXNza2V5UmVnaXN0cmF0aW9uEi4ubWVtb3MuYXBpLnYxLkZpbmlzaFBhc3NrZXlSZWdpc3RyYXRpb25SZXF1ZXN0GhUubWVtb3MuYXBpLnYxLlBhc3NrZXkiM4LT5JMCLToBKiIoL2FwaS92MS9hdXRoL3Bhc3NrZXlzOmZpbmlzaFJlZ2lzdHJhdGlvbhKVAQoSQmVnaW5QYXNza2V5U2lnbkluEicubWVtb3MuYXBpLnYxLkJlZ2luUGFzc2tleVNpZ25JblJlcXVlc3QaKC5tZW1vcy5hcGkudjEuQmVnaW5QYXNza2V5U2lnbkluUmVzcG9uc2UiLILT5JMCJjoBKiIhL2FwaS92MS9hdXRoL3Bhc3NrZXlzOmJlZ2luU2lnbkluEnYKDFJlZnJlc2hUb2tlbhIhLm1lbW9zLmFwaS52MS5SZWZyZXNoVG9rZW5SZXF1ZXN0GiIubWVtb3MuYXBpLnYxLlJlZnJlc2hUb2tlblJlc3BvbnNlIh+C0+STAhk6ASoiFC9hcGkvdjEvYXV0aC9yZWZyZXNoQqgBChBjb20ubWVtb3MuYXBpLnYxQhBBdXRoU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.GetCurrentUserRequest
@@ -75,6 +75,22 @@ export type SignInRequest = Message<"memos.api.v1.SignInRequest"> & {
      */
     value: SignInRequest_SSOCredentials;
     case: "ssoCredentials";
+  } | {
+    /**
+     * Two-factor authentication, after a password sign-in returned a two-factor token.
+     *
+     * @generated from field: memos.api.v1.SignInRequest.TwoFactorCredentials two_factor_credentials = 3;
+     */
+    value: SignInRequest_TwoFactorCredentials;
+    case: "twoFactorCredentials";
+  } | {
+    /**
+     * Passkey authentication, as the only credential or as the second factor of a password sign-in.
+     *
+     * @generated from field: memos.api.v1.SignInRequest.PasskeyCredentials passkey_credentials = 4;
+     */
+    value: SignInRequest_PasskeyCredentials;
+    case: "passkeyCredentials";
   } | { case: undefined; value?: undefined };
 };
 
@@ -147,6 +163,14 @@ export type SignInRequest_SSOCredentials = Message<"memos.api.v1.SignInRequest.S
    * @generated from field: string code_verifier = 4;
    */
   codeVerifier: string;
+
+  /**
+   * The nonce sent in the authorization request.
+   * Required for OpenID Connect providers, the ID token must contain the same nonce.
+   *
+   * @generated from field: string nonce = 5;
+   */
+  nonce: string;
 };
 
 /**
@@ -156,6 +180,91 @@ export type SignInRequest_SSOCredentials = Message<"memos.api.v1.SignInRequest.S
 export const SignInRequest_SSOCredentialsSchema: GenMessage<SignInRequest_SSOCredentials> = /*@__PURE__*/
   messageDesc(file_api_v1_auth_service, 2, 1);
 
+/**
+ * Nested message for the second step of a password sign-in with two-factor authentication.
+ *
+ * @generated from message memos.api.v1.SignInRequest.TwoFactorCredentials
+ */
+export type SignInRequest_TwoFactorCredentials = Message<"memos.api.v1.SignInRequest.TwoFactorCredentials"> & {
+  /**
+   * The two-factor token returned by the password sign-in.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * A current TOTP code or an unused recovery code.
+   * After 5 invalid codes, codes are rejected with RESOURCE_EXHAUSTED for 15 minutes.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SignInRequest.TwoFactorCredentials.
+ * Use `create(SignInRequest_TwoFactorCredentialsSchema)` to create a new message.
+ */
+export const SignInRequest_TwoFactorCredentialsSchema: GenMessage<SignInRequest_TwoFactorCredentials> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 2, 2);
+
+/**
+ * Nested message for the response of navigator.credentials.get() to a passkey sign-in.
+ *
+ * @generated from message memos.api.v1.SignInRequest.PasskeyCredentials
+ */
+export type SignInRequest_PasskeyCredentials = Message<"memos.api.v1.SignInRequest.PasskeyCredentials"> & {
+  /**
+   * The session token returned by BeginPasskeySignIn.
+   *
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * The raw ID of the credential.
+   *
+   * @generated from field: bytes credential_id = 2;
+   */
+  credentialId: Uint8Array;
+
+  /**
+   * The client data JSON of the assertion.
+   *
+   * @generated from field: bytes client_data_json = 3;
+   */
+  clientDataJson: Uint8Array;
+
+  /**
+   * The authenticator data of the assertion.
+   *
+   * @generated from field: bytes authenticator_data = 4;
+   */
+  authenticatorData: Uint8Array;
+
+  /**
+   * The signature of the assertion.
+   *
+   * @generated from field: bytes signature = 5;
+   */
+  signature: Uint8Array;
+
+  /**
+   * The user handle of the assertion, set by discoverable credentials.
+   *
+   * @generated from field: bytes user_handle = 6;
+   */
+  userHandle: Uint8Array;
+};
+
+/**
+ * Describes the message memos.api.v1.SignInRequest.PasskeyCredentials.
+ * Use `create(SignInRequest_PasskeyCredentialsSchema)` to create a new message.
+ */
+export const SignInRequest_PasskeyCredentialsSchema: GenMessage<SignInRequest_PasskeyCredentials> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 2, 3);
+
 /**
  * @generated from message memos.api.v1.SignInResponse
  */
@@ -182,6 +291,15 @@ export type SignInResponse = Message<"memos.api.v1.SignInResponse"> & {
    * @generated from field: google.protobuf.Timestamp access_token_expires_at = 3;
    */
   accessTokenExpiresAt?: Timestamp;
+
+  /**
+   * Set instead of the access token when the user has two-factor authentication enabled.
+   * Sign in again with two_factor_credentials holding this token and a TOTP or recovery code.
+   * The token expires after five minutes.
+   *
+   * @generated from field: string two_factor_token = 4;
+   */
+  twoFactorToken: string;
 };
 
 /**
@@ -243,6 +361,266 @@ export type RefreshTokenResponse = Message<"memos.api.v1.RefreshTokenResponse">
 export const RefreshTokenResponseSchema: GenMessage<RefreshTokenResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_auth_service, 6);
 
+/**
+ * @generated from message memos.api.v1.BeginPasskeyRegistrationRequest
+ */
+export type BeginPasskeyRegistrationRequest = Message<"memos.api.v1.BeginPasskeyRegistrationRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.BeginPasskeyRegistrationRequest.
+ * Use `create(BeginPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationRequestSchema: GenMessage<BeginPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 7);
+
+/**
+ * @generated from message memos.api.v1.BeginPasskeyRegistrationResponse
+ */
+export type BeginPasskeyRegistrationResponse = Message<"memos.api.v1.BeginPasskeyRegistrationResponse"> & {
+  /**
+   * The options for navigator.credentials.create().
+   *
+   * @generated from field: memos.api.v1.PasskeyCreationOptions options = 1;
+   */
+  options?: PasskeyCreationOptions;
+
+  /**
+   * The session token to send with FinishPasskeyRegistration.
+   * The token expires after five minutes.
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.BeginPasskeyRegistrationResponse.
+ * Use `create(BeginPasskeyRegistrationResponseSchema)` to create a new message.
+ */
+export const BeginPasskeyRegistrationResponseSchema: GenMessage<BeginPasskeyRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 8);
+
+/**
+ * PasskeyCreationOptions are the PublicKeyCredentialCreationOptions of a passkey registration.
+ *
+ * @generated from message memos.api.v1.PasskeyCreationOptions
+ */
+export type PasskeyCreationOptions = Message<"memos.api.v1.PasskeyCreationOptions"> & {
+  /**
+   * The challenge to sign.
+   *
+   * @generated from field: bytes challenge = 1;
+   */
+  challenge: Uint8Array;
+
+  /**
+   * The relying party ID, the host name of the instance.
+   *
+   * @generated from field: string rp_id = 2;
+   */
+  rpId: string;
+
+  /**
+   * The relying party name shown by the authenticator.
+   *
+   * @generated from field: string rp_name = 3;
+   */
+  rpName: string;
+
+  /**
+   * The user handle stored with the credential.
+   *
+   * @generated from field: bytes user_id = 4;
+   */
+  userId: Uint8Array;
+
+  /**
+   * The username of the user.
+   *
+   * @generated from field: string user_name = 5;
+   */
+  userName: string;
+
+  /**
+   * The display name of the user.
+   *
+   * @generated from field: string user_display_name = 6;
+   */
+  userDisplayName: string;
+
+  /**
+   * The accepted COSE algorithms, in order of preference.
+   *
+   * @generated from field: repeated int64 algorithms = 7;
+   */
+  algorithms: bigint[];
+
+  /**
+   * The IDs of the credentials the user already registered.
+   *
+   * @generated from field: repeated bytes exclude_credential_ids = 8;
+   */
+  excludeCredentialIds: Uint8Array[];
+
+  /**
+   * The time the user has to complete the ceremony in milliseconds.
+   *
+   * @generated from field: uint32 timeout_ms = 9;
+   */
+  timeoutMs: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PasskeyCreationOptions.
+ * Use `create(PasskeyCreationOptionsSchema)` to create a new message.
+ */
+export const PasskeyCreationOptionsSchema: GenMessage<PasskeyCreationOptions> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 9);
+
+/**
+ * @generated from message memos.api.v1.FinishPasskeyRegistrationRequest
+ */
+export type FinishPasskeyRegistrationRequest = Message<"memos.api.v1.FinishPasskeyRegistrationRequest"> & {
+  /**
+   * The session token returned by BeginPasskeyRegistration.
+   *
+   * @generated from field: string session_token = 1;
+   */
+  sessionToken: string;
+
+  /**
+   * The client data JSON of the attestation.
+   *
+   * @generated from field: bytes client_data_json = 2;
+   */
+  clientDataJson: Uint8Array;
+
+  /**
+   * The attestation object of the attestation.
+   *
+   * @generated from field: bytes attestation_object = 3;
+   */
+  attestationObject: Uint8Array;
+
+  /**
+   * Optional. A name to recognize the passkey by.
+   *
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * Optional. The transports reported by getTransports(), e.g. "internal" or "usb".
+   *
+   * @generated from field: repeated string transports = 5;
+   */
+  transports: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.FinishPasskeyRegistrationRequest.
+ * Use `create(FinishPasskeyRegistrationRequestSchema)` to create a new message.
+ */
+export const FinishPasskeyRegistrationRequestSchema: GenMessage<FinishPasskeyRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 10);
+
+/**
+ * @generated from message memos.api.v1.BeginPasskeySignInRequest
+ */
+export type BeginPasskeySignInRequest = Message<"memos.api.v1.BeginPasskeySignInRequest"> & {
+  /**
+   * Optional. The two-factor token of a password sign-in, to use a passkey as the second factor.
+   * Without it the sign-in uses discoverable credentials and requires user verification.
+   *
+   * @generated from field: string two_factor_token = 1;
+   */
+  twoFactorToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.BeginPasskeySignInRequest.
+ * Use `create(BeginPasskeySignInRequestSchema)` to create a new message.
+ */
+export const BeginPasskeySignInRequestSchema: GenMessage<BeginPasskeySignInRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 11);
+
+/**
+ * @generated from message memos.api.v1.BeginPasskeySignInResponse
+ */
+export type BeginPasskeySignInResponse = Message<"memos.api.v1.BeginPasskeySignInResponse"> & {
+  /**
+   * The options for navigator.credentials.get().
+   *
+   * @generated from field: memos.api.v1.PasskeyRequestOptions options = 1;
+   */
+  options?: PasskeyRequestOptions;
+
+  /**
+   * The session token to send with SignIn in passkey_credentials.
+   * The token expires after five minutes.
+   *
+   * @generated from field: string session_token = 2;
+   */
+  sessionToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.BeginPasskeySignInResponse.
+ * Use `create(BeginPasskeySignInResponseSchema)` to create a new message.
+ */
+export const BeginPasskeySignInResponseSchema: GenMessage<BeginPasskeySignInResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 12);
+
+/**
+ * PasskeyRequestOptions are the PublicKeyCredentialRequestOptions of a passkey sign-in.
+ *
+ * @generated from message memos.api.v1.PasskeyRequestOptions
+ */
+export type PasskeyRequestOptions = Message<"memos.api.v1.PasskeyRequestOptions"> & {
+  /**
+   * The challenge to sign.
+   *
+   * @generated from field: bytes challenge = 1;
+   */
+  challenge: Uint8Array;
+
+  /**
+   * The relying party ID, the host name of the instance.
+   *
+   * @generated from field: string rp_id = 2;
+   */
+  rpId: string;
+
+  /**
+   * The IDs of the credentials that may be used, empty for discoverable credentials.
+   *
+   * @generated from field: repeated bytes allow_credential_ids = 3;
+   */
+  allowCredentialIds: Uint8Array[];
+
+  /**
+   * The user verification requirement, "required" or "preferred".
+   *
+   * @generated from field: string user_verification = 4;
+   */
+  userVerification: string;
+
+  /**
+   * The time the user has to complete the ceremony in milliseconds.
+   *
+   * @generated from field: uint32 timeout_ms = 5;
+   */
+  timeoutMs: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PasskeyRequestOptions.
+ * Use `create(PasskeyRequestOptionsSchema)` to create a new message.
+ */
+export const PasskeyRequestOptionsSchema: GenMessage<PasskeyRequestOptions> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 13);
+
 /**
  * @generated from service memos.api.v1.AuthService
  */
@@ -262,7 +640,8 @@ export const AuthService: GenService<{
   /**
    * SignIn authenticates a user with credentials and returns tokens.
    * On success, returns an access token and sets a refresh token cookie.
-   * Supports password-based and SSO authentication methods.
+   * Supports password-based, passkey and SSO authentication methods.
+   * Users with two-factor authentication enabled complete a password sign-in in a second step.
    *
    * @generated from rpc memos.api.v1.AuthService.SignIn
    */
@@ -282,6 +661,38 @@ export const AuthService: GenService<{
     input: typeof SignOutRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * BeginPasskeyRegistration starts registering a passkey for the current user.
+   * Returns the options for navigator.credentials.create() and a session token for FinishPasskeyRegistration.
+   *
+   * @generated from rpc memos.api.v1.AuthService.BeginPasskeyRegistration
+   */
+  beginPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof BeginPasskeyRegistrationRequestSchema;
+    output: typeof BeginPasskeyRegistrationResponseSchema;
+  },
+  /**
+   * FinishPasskeyRegistration verifies the response of the authenticator and stores the passkey.
+   *
+   * @generated from rpc memos.api.v1.AuthService.FinishPasskeyRegistration
+   */
+  finishPasskeyRegistration: {
+    methodKind: "unary";
+    input: typeof FinishPasskeyRegistrationRequestSchema;
+    output: typeof PasskeySchema;
+  },
+  /**
+   * BeginPasskeySignIn starts a sign-in with a passkey.
+   * Returns the options for navigator.credentials.get() and a session token for SignIn with passkey_credentials.
+   *
+   * @generated from rpc memos.api.v1.AuthService.BeginPasskeySignIn
+   */
+  beginPasskeySignIn: {
+    methodKind: "unary";
+    input: typeof BeginPasskeySignInRequestSchema;
+    output: typeof BeginPasskeySignInResponseSchema;
+  },
   /**
    * RefreshToken exchanges a valid refresh token for a new access token.
    * The refresh token is read from the HttpOnly cookie.
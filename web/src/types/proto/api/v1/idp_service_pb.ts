@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/idp_service.proto.
  */
 export const file_api_v1_idp_service: GenFile = /*@__PURE__*/
-  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLfAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiIoCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQATpn6kFkCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlchIYaWRlbnRpdHktcHJvdmlkZXJzL3tpZHB9GgRuYW1lKhFpZGVudGl0eVByb3ZpZGVyczIQaWRlbnRpdHlQcm92aWRlciJXChZJZGVudGl0eVByb3ZpZGVyQ29uZmlnEjMKDW9hdXRoMl9jb25maWcYASABKAsyGi5tZW1vcy5hcGkudjEuT0F1dGgyQ29uZmlnSABCCAoGY29uZmlnIlsKDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJIrcBCgxPQXV0aDJDb25maWcSEQoJY2xpZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkSEAoIYXV0aF91cmwYAyABKAkSEQoJdG9rZW5fdXJsGAQgASgJEhUKDXVzZXJfaW5mb191cmwYBSABKAkSDgoGc2NvcGVzGAYgAygJEjEKDWZpZWxkX21hcHBpbmcYByABKAsyGi5tZW1vcy5hcGkudjEuRmllbGRNYXBwaW5nIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWwodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOgoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiUQoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlciKCAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPgoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlckID4EECEiEKFGlkZW50aXR5X3Byb3ZpZGVyX2lkGAIgASgJQgPgQQEilQEKHVVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Ej4KEWlkZW50aXR5X3Byb3ZpZGVyGAEgASgLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyMucGChdJZGVudGl0eVByb3ZpZGVyU2VydmljZRKUAQoVTGlzdElkZW50aXR5UHJvdmlkZXJzEioubWVtb3MuYXBpLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2UiIoLT5JMCHBIaL2FwaS92MS9pZGVudGl0eS1wcm92aWRlcnMSkwEKE0dldElkZW50aXR5UHJvdmlkZXISKC5tZW1vcy5hcGkudjEuR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn0SsAEKFkNyZWF0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJJ2kERaWRlbnRpdHlfcHJvdmlkZXKC0+STAi86EWlkZW50aXR5X3Byb3ZpZGVyIhovYXBpL3YxL2lkZW50aXR5LXByb3ZpZGVycxLXAQoWVXBkYXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyInDaQR1pZGVudGl0eV9wcm92aWRlcix1cGRhdGVfbWFza4LT5JMCSjoRaWRlbnRpdHlfcHJvdmlkZXIyNS9hcGkvdjEve2lkZW50aXR5X3Byb3ZpZGVyLm5hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9EpEBChZEZWxldGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLkRlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfUKnAQoQY29tLm1lbW9zLmFwaS52MUIPSWRwU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
+  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLpAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiIyCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQARIICgRPSURDEAI6Z+pBZAodbWVtb3MuYXBpLnYxL0lkZW50aXR5UHJvdmlkZXISGGlkZW50aXR5LXByb3ZpZGVycy97aWRwfRoEbmFtZSoRaWRlbnRpdHlQcm92aWRlcnMyEGlkZW50aXR5UHJvdmlkZXIiiAEKFklkZW50aXR5UHJvdmlkZXJDb25maWcSMwoNb2F1dGgyX2NvbmZpZxgBIAEoCzIaLm1lbW9zLmFwaS52MS5PQXV0aDJDb25maWdIABIvCgtvaWRjX2NvbmZpZxgCIAEoCzIYLm1lbW9zLmFwaS52MS5PSURDQ29uZmlnSABCCAoGY29uZmlnIlsKDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJIrcBCgxPQXV0aDJDb25maWcSEQoJY2xpZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkSEAoIYXV0aF91cmwYAyABKAkSEQoJdG9rZW5fdXJsGAQgASgJEhUKDXVzZXJfaW5mb191cmwYBSABKAkSDgoGc2NvcGVzGAYgAygJEjEKDWZpZWxkX21hcHBpbmcYByABKAsyGi5tZW1vcy5hcGkudjEuRmllbGRNYXBwaW5nIpsBCgpPSURDQ29uZmlnEhMKBmlzc3VlchgBIAEoCUID4EECEhYKCWNsaWVudF9pZBgCIAEoCUID4EECEhUKDWNsaWVudF9zZWNyZXQYAyABKAkSEwoGc2NvcGVzGAQgAygJQgPgQQESHQoQaWRlbnRpZmllcl9jbGFpbRgFIAEoCUID4EEBEhUKCGF1dGhfdXJsGAYgASgJQgPgQQMiHgocTGlzdElkZW50aXR5UHJvdmlkZXJzUmVxdWVzdCJbCh1MaXN0SWRlbnRpdHlQcm92aWRlcnNSZXNwb25zZRI6ChJpZGVudGl0eV9wcm92aWRlcnMYASADKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJRChpHZXRJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyIoIBCh1DcmVhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBI+ChFpZGVudGl0eV9wcm92aWRlchgBIAEoCzIeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISIQoUaWRlbnRpdHlfcHJvdmlkZXJfaWQYAiABKAlCA+BBASKVAQodVXBkYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPgoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlckID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL0lkZW50aXR5UHJvdmlkZXIy5wYKF0lkZW50aXR5UHJvdmlkZXJTZXJ2aWNlEpQBChVMaXN0SWRlbnRpdHlQcm92aWRlcnMSKi5tZW1vcy5hcGkudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVxdWVzdBorLm1lbW9zLmFwaS52MS5MaXN0SWRlbnRpdHlQcm92aWRlcnNSZXNwb25zZSIigtPkkwIcEhovYXBpL3YxL2lkZW50aXR5LXByb3ZpZGVycxKTAQoTR2V0SWRlbnRpdHlQcm92aWRlchIoLm1lbW9zLmFwaS52MS5HZXRJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyIjLaQQRuYW1lgtPkkwIlEiMvYXBpL3YxL3tuYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfRKwAQoWQ3JlYXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5DcmVhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyIknaQRFpZGVudGl0eV9wcm92aWRlcoLT5JMCLzoRaWRlbnRpdHlfcHJvdmlkZXIiGi9hcGkvdjEvaWRlbnRpdHktcHJvdmlkZXJzEtcBChZVcGRhdGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLlVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIicNpBHWlkZW50aXR5X3Byb3ZpZGVyLHVwZGF0ZV9tYXNrgtPkkwJKOhFpZGVudGl0eV9wcm92aWRlcjI1L2FwaS92MS97aWRlbnRpdHlfcHJvdmlkZXIubmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn0SkQEKFkRlbGV0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuRGVsZXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMtpBBG5hbWWC0+STAiUqIy9hcGkvdjEve25hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9QqcBChBjb20ubWVtb3MuYXBpLnYxQg9JZHBTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
 
 /**
  * @generated from message memos.api.v1.IdentityProvider
@@ -81,6 +81,13 @@ export enum IdentityProvider_Type {
    * @generated from enum value: OAUTH2 = 1;
    */
   OAUTH2 = 1,
+
+  /**
+   * OpenID Connect identity provider, configured from the discovery document of its issuer.
+   *
+   * @generated from enum value: OIDC = 2;
+   */
+  OIDC = 2,
 }
 
 /**
@@ -102,6 +109,12 @@ export type IdentityProviderConfig = Message<"memos.api.v1.IdentityProviderConfi
      */
     value: OAuth2Config;
     case: "oauth2Config";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.OIDCConfig oidc_config = 2;
+     */
+    value: OIDCConfig;
+    case: "oidcConfig";
   } | { case: undefined; value?: undefined };
 };
 
@@ -191,6 +204,64 @@ export type OAuth2Config = Message<"memos.api.v1.OAuth2Config"> & {
 export const OAuth2ConfigSchema: GenMessage<OAuth2Config> = /*@__PURE__*/
   messageDesc(file_api_v1_idp_service, 3);
 
+/**
+ * @generated from message memos.api.v1.OIDCConfig
+ */
+export type OIDCConfig = Message<"memos.api.v1.OIDCConfig"> & {
+  /**
+   * Required. The issuer URL, the discovery document is fetched from
+   * {issuer}/.well-known/openid-configuration.
+   *
+   * @generated from field: string issuer = 1;
+   */
+  issuer: string;
+
+  /**
+   * Required. The client ID registered with the issuer.
+   *
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * The client secret registered with the issuer.
+   *
+   * @generated from field: string client_secret = 3;
+   */
+  clientSecret: string;
+
+  /**
+   * Optional. The requested scopes, "openid" is always included.
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * Optional. The ID token claim used as the username.
+   * Defaults to "sub", the only claim that is unique and stable per issuer.
+   * Only use a claim such as "preferred_username" or "email" if the issuer does not let users change it,
+   * otherwise a user can sign in as another user by taking their name.
+   *
+   * @generated from field: string identifier_claim = 5;
+   */
+  identifierClaim: string;
+
+  /**
+   * Output only. The authorization endpoint of the issuer.
+   *
+   * @generated from field: string auth_url = 6;
+   */
+  authUrl: string;
+};
+
+/**
+ * Describes the message memos.api.v1.OIDCConfig.
+ * Use `create(OIDCConfigSchema)` to create a new message.
+ */
+export const OIDCConfigSchema: GenMessage<OIDCConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1_idp_service, 4);
+
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersRequest
  */
@@ -202,7 +273,7 @@ export type ListIdentityProvidersRequest = Message<"memos.api.v1.ListIdentityPro
  * Use `create(ListIdentityProvidersRequestSchema)` to create a new message.
  */
 export const ListIdentityProvidersRequestSchema: GenMessage<ListIdentityProvidersRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 4);
+  messageDesc(file_api_v1_idp_service, 5);
 
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersResponse
@@ -221,7 +292,7 @@ export type ListIdentityProvidersResponse = Message<"memos.api.v1.ListIdentityPr
  * Use `create(ListIdentityProvidersResponseSchema)` to create a new message.
  */
 export const ListIdentityProvidersResponseSchema: GenMessage<ListIdentityProvidersResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 5);
+  messageDesc(file_api_v1_idp_service, 6);
 
 /**
  * @generated from message memos.api.v1.GetIdentityProviderRequest
@@ -241,7 +312,7 @@ export type GetIdentityProviderRequest = Message<"memos.api.v1.GetIdentityProvid
  * Use `create(GetIdentityProviderRequestSchema)` to create a new message.
  */
 export const GetIdentityProviderRequestSchema: GenMessage<GetIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 6);
+  messageDesc(file_api_v1_idp_service, 7);
 
 /**
  * @generated from message memos.api.v1.CreateIdentityProviderRequest
@@ -268,7 +339,7 @@ export type CreateIdentityProviderRequest = Message<"memos.api.v1.CreateIdentity
  * Use `create(CreateIdentityProviderRequestSchema)` to create a new message.
  */
 export const CreateIdentityProviderRequestSchema: GenMessage<CreateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 7);
+  messageDesc(file_api_v1_idp_service, 8);
 
 /**
  * @generated from message memos.api.v1.UpdateIdentityProviderRequest
@@ -295,7 +366,7 @@ export type UpdateIdentityProviderRequest = Message<"memos.api.v1.UpdateIdentity
  * Use `create(UpdateIdentityProviderRequestSchema)` to create a new message.
  */
 export const UpdateIdentityProviderRequestSchema: GenMessage<UpdateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 8);
+  messageDesc(file_api_v1_idp_service, 9);
 
 /**
  * @generated from message memos.api.v1.DeleteIdentityProviderRequest
@@ -315,7 +386,7 @@ export type DeleteIdentityProviderRequest = Message<"memos.api.v1.DeleteIdentity
  * Use `create(DeleteIdentityProviderRequestSchema)` to create a new message.
  */
 export const DeleteIdentityProviderRequestSchema: GenMessage<DeleteIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 9);
+  messageDesc(file_api_v1_idp_service, 10);
 
 /**
  * @generated from service memos.api.v1.IdentityProviderService
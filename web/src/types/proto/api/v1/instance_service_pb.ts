@@ -4,21 +4,21 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { User } from "./user_service_pb";
+import type { User, UserWebhook_PayloadFormat } from "./user_service_pb";
 import { file_api_v1_user_service } from "./user_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/instance_service.proto.
  */
 export const file_api_v1_instance_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCKzCwoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABqHAwoOR2VuZXJhbFNldHRpbmcSIgoaZGlzYWxsb3dfdXNlcl9yZWdpc3RyYXRpb24YAiABKAgSHgoWZGlzYWxsb3dfcGFzc3dvcmRfYXV0aBgDIAEoCBIZChFhZGRpdGlvbmFsX3NjcmlwdBgEIAEoCRIYChBhZGRpdGlvbmFsX3N0eWxlGAUgASgJElIKDmN1c3RvbV9wcm9maWxlGAYgASgLMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZy5DdXN0b21Qcm9maWxlEh0KFXdlZWtfc3RhcnRfZGF5X29mZnNldBgHIAEoBRIgChhkaXNhbGxvd19jaGFuZ2VfdXNlcm5hbWUYCCABKAgSIAoYZGlzYWxsb3dfY2hhbmdlX25pY2tuYW1lGAkgASgIGkUKDUN1c3RvbVByb2ZpbGUSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEAoIbG9nb191cmwYAyABKAkaugMKDlN0b3JhZ2VTZXR0aW5nEk4KDHN0b3JhZ2VfdHlwZRgBIAEoDjI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuU3RvcmFnZVR5cGUSGQoRZmlsZXBhdGhfdGVtcGxhdGUYAiABKAkSHAoUdXBsb2FkX3NpemVfbGltaXRfbWIYAyABKAMSSAoJczNfY29uZmlnGAQgASgLMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TM0NvbmZpZxqGAQoIUzNDb25maWcSFQoNYWNjZXNzX2tleV9pZBgBIAEoCRIZChFhY2Nlc3Nfa2V5X3NlY3JldBgCIAEoCRIQCghlbmRwb2ludBgDIAEoCRIOCgZyZWdpb24YBCABKAkSDgoGYnVja2V0GAUgASgJEhYKDnVzZV9wYXRoX3N0eWxlGAYgASgIIkwKC1N0b3JhZ2VUeXBlEhwKGFNUT1JBR0VfVFlQRV9VTlNQRUNJRklFRBAAEgwKCERBVEFCQVNFEAESCQoFTE9DQUwQAhIGCgJTMxADGq0BChJNZW1vUmVsYXRlZFNldHRpbmcSIgoaZGlzYWxsb3dfcHVibGljX3Zpc2liaWxpdHkYASABKAgSIAoYZGlzcGxheV93aXRoX3VwZGF0ZV90aW1lGAIgASgIEhwKFGNvbnRlbnRfbGVuZ3RoX2xpbWl0GAMgASgFEiAKGGVuYWJsZV9kb3VibGVfY2xpY2tfZWRpdBgEIAEoCBIRCglyZWFjdGlvbnMYByADKAkiRgoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARILCgdTVE9SQUdFEAISEAoMTUVNT19SRUxBVEVEEAM6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZxIbaW5zdGFuY2Uvc2V0dGluZ3Mve3NldHRpbmd9KhBpbnN0YW5jZVNldHRpbmdzMg9pbnN0YW5jZVNldHRpbmdCBwoFdmFsdWUiTwoZR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmciiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBATLbAwoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn1CrAEKEGNvbS5tZW1vcy5hcGkudjFCFEluc3RhbmNlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCLoDgoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABJRChRub3RpZmljYXRpb25fc2V0dGluZxgFIAEoCzIxLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTm90aWZpY2F0aW9uU2V0dGluZ0gAGocDCg5HZW5lcmFsU2V0dGluZxIiChpkaXNhbGxvd191c2VyX3JlZ2lzdHJhdGlvbhgCIAEoCBIeChZkaXNhbGxvd19wYXNzd29yZF9hdXRoGAMgASgIEhkKEWFkZGl0aW9uYWxfc2NyaXB0GAQgASgJEhgKEGFkZGl0aW9uYWxfc3R5bGUYBSABKAkSUgoOY3VzdG9tX3Byb2ZpbGUYBiABKAsyOi5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nLkN1c3RvbVByb2ZpbGUSHQoVd2Vla19zdGFydF9kYXlfb2Zmc2V0GAcgASgFEiAKGGRpc2FsbG93X2NoYW5nZV91c2VybmFtZRgIIAEoCBIgChhkaXNhbGxvd19jaGFuZ2Vfbmlja25hbWUYCSABKAgaRQoNQ3VzdG9tUHJvZmlsZRINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIQCghsb2dvX3VybBgDIAEoCRq6AwoOU3RvcmFnZVNldHRpbmcSTgoMc3RvcmFnZV90eXBlGAEgASgOMjgubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TdG9yYWdlVHlwZRIZChFmaWxlcGF0aF90ZW1wbGF0ZRgCIAEoCRIcChR1cGxvYWRfc2l6ZV9saW1pdF9tYhgDIAEoAxJICglzM19jb25maWcYBCABKAsyNS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nLlMzQ29uZmlnGoYBCghTM0NvbmZpZxIVCg1hY2Nlc3Nfa2V5X2lkGAEgASgJEhkKEWFjY2Vzc19rZXlfc2VjcmV0GAIgASgJEhAKCGVuZHBvaW50GAMgASgJEg4KBnJlZ2lvbhgEIAEoCRIOCgZidWNrZXQYBSABKAkSFgoOdXNlX3BhdGhfc3R5bGUYBiABKAgiTAoLU3RvcmFnZVR5cGUSHAoYU1RPUkFHRV9UWVBFX1VOU1BFQ0lGSUVEEAASDAoIREFUQUJBU0UQARIJCgVMT0NBTBACEgYKAlMzEAMaxQEKEk1lbW9SZWxhdGVkU2V0dGluZxIiChpkaXNhbGxvd19wdWJsaWNfdmlzaWJpbGl0eRgBIAEoCBIgChhkaXNwbGF5X3dpdGhfdXBkYXRlX3RpbWUYAiABKAgSHAoUY29udGVudF9sZW5ndGhfbGltaXQYAyABKAUSIAoYZW5hYmxlX2RvdWJsZV9jbGlja19lZGl0GAQgASgIEhEKCXJlYWN0aW9ucxgHIAMoCRIWCg5yZXZpc2lvbl9saW1pdBgIIAEoBRq1AgoTTm90aWZpY2F0aW9uU2V0dGluZxJNCgVlbWFpbBgBIAEoCzI+Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTm90aWZpY2F0aW9uU2V0dGluZy5FbWFpbFNldHRpbmcazgEKDEVtYWlsU2V0dGluZxIPCgdlbmFibGVkGAEgASgIEhEKCXNtdHBfaG9zdBgCIAEoCRIRCglzbXRwX3BvcnQYAyABKAUSFQoNc210cF91c2VybmFtZRgEIAEoCRIVCg1zbXRwX3Bhc3N3b3JkGAUgASgJEhIKCmZyb21fZW1haWwYBiABKAkSEQoJZnJvbV9uYW1lGAcgASgJEhAKCHJlcGx5X3RvGAggASgJEg8KB3VzZV90bHMYCSABKAgSDwoHdXNlX3NzbBgKIAEoCCJYCgNLZXkSEwoPS0VZX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEgsKB1NUT1JBR0UQAhIQCgxNRU1PX1JFTEFURUQQAxIQCgxOT1RJRklDQVRJT04QBDph6kFeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VTZXR0aW5nEhtpbnN0YW5jZS9zZXR0aW5ncy97c2V0dGluZ30qEGluc3RhbmNlU2V0dGluZ3MyD2luc3RhbmNlU2V0dGluZ0IHCgV2YWx1ZSJPChlHZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0EjIKBG5hbWUYASABKAlCJOBBAvpBHgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZyKJAQocVXBkYXRlSW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIzCgdzZXR0aW5nGAEgASgLMh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIvoCCgtJbnN0YW5jZUpvYhIRCgRuYW1lGAEgASgJQgPgQQgSFQoIc2NoZWR1bGUYAiABKAlCA+BBAxIYCgtkZXNjcmlwdGlvbhgDIAEoCUID4EEDEhQKB3J1bm5pbmcYBCABKAhCA+BBAxI2Cg1sYXN0X3J1bl90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjkKEWxhc3RfcnVuX2R1cmF0aW9uGAYgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uQgPgQQMSFwoKbGFzdF9lcnJvchgHIAEoCUID4EEDEjYKDW5leHRfcnVuX3RpbWUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6TepBSgoYbWVtb3MuYXBpLnYxL0luc3RhbmNlSm9iEhNpbnN0YW5jZS9qb2JzL3tqb2J9KgxpbnN0YW5jZUpvYnMyC2luc3RhbmNlSm9iIhkKF0xpc3RJbnN0YW5jZUpvYnNSZXF1ZXN0IkMKGExpc3RJbnN0YW5jZUpvYnNSZXNwb25zZRInCgRqb2JzGAEgAygLMhkubWVtb3MuYXBpLnYxLkluc3RhbmNlSm9iIkcKFVJ1bkluc3RhbmNlSm9iUmVxdWVzdBIuCgRuYW1lGAEgASgJQiDgQQL6QRoKGG1lbW9zLmFwaS52MS9JbnN0YW5jZUpvYiLLAgoPSW5zdGFuY2VXZWJob29rEhEKBG5hbWUYASABKAlCA+BBCBIQCgN1cmwYAiABKAlCA+BBAhIZCgxkaXNwbGF5X25hbWUYAyABKAlCA+BBARITCgZzZWNyZXQYBCABKAlCA+BBAxIbCg5hY3Rpdml0eV90eXBlcxgFIAMoCUID4EEBEkQKDnBheWxvYWRfZm9ybWF0GAYgASgOMicubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rLlBheWxvYWRGb3JtYXRCA+BBARIdChBwYXlsb2FkX3RlbXBsYXRlGAcgASgJQgPgQQE6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlV2ViaG9vaxIbaW5zdGFuY2Uvd2ViaG9va3Mve3dlYmhvb2t9KhBpbnN0YW5jZVdlYmhvb2tzMg9pbnN0YW5jZVdlYmhvb2siHQobTGlzdEluc3RhbmNlV2ViaG9va3NSZXF1ZXN0Ik8KHExpc3RJbnN0YW5jZVdlYmhvb2tzUmVzcG9uc2USLwoId2ViaG9va3MYASADKAsyHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VXZWJob29rIlMKHENyZWF0ZUluc3RhbmNlV2ViaG9va1JlcXVlc3QSMwoHd2ViaG9vaxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVdlYmhvb2tCA+BBAiKJAQocVXBkYXRlSW5zdGFuY2VXZWJob29rUmVxdWVzdBIzCgd3ZWJob29rGAEgASgLMh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlV2ViaG9va0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIlIKHERlbGV0ZUluc3RhbmNlV2ViaG9va1JlcXVlc3QSMgoEbmFtZRgBIAEoCUIk4EEC+kEeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VXZWJob29rMt4KCg9JbnN0YW5jZVNlcnZpY2USfgoSR2V0SW5zdGFuY2VQcm9maWxlEicubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlUHJvZmlsZVJlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VQcm9maWxlIiCC0+STAhoSGC9hcGkvdjEvaW5zdGFuY2UvcHJvZmlsZRKPAQoSR2V0SW5zdGFuY2VTZXR0aW5nEicubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPWluc3RhbmNlL3NldHRpbmdzLyp9ErUBChVVcGRhdGVJbnN0YW5jZVNldHRpbmcSKi5tZW1vcy5hcGkudjEuVXBkYXRlSW5zdGFuY2VTZXR0aW5nUmVxdWVzdBodLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmciUdpBE3NldHRpbmcsdXBkYXRlX21hc2uC0+STAjU6B3NldHRpbmcyKi9hcGkvdjEve3NldHRpbmcubmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRKAAQoQTGlzdEluc3RhbmNlSm9icxIlLm1lbW9zLmFwaS52MS5MaXN0SW5zdGFuY2VKb2JzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0SW5zdGFuY2VKb2JzUmVzcG9uc2UiHYLT5JMCFxIVL2FwaS92MS9pbnN0YW5jZS9qb2JzEoYBCg5SdW5JbnN0YW5jZUpvYhIjLm1lbW9zLmFwaS52MS5SdW5JbnN0YW5jZUpvYlJlcXVlc3QaGS5tZW1vcy5hcGkudjEuSW5zdGFuY2VKb2IiNNpBBG5hbWWC0+STAic6ASoiIi9hcGkvdjEve25hbWU9aW5zdGFuY2Uvam9icy8qfTpydW4SkAEKFExpc3RJbnN0YW5jZVdlYmhvb2tzEikubWVtb3MuYXBpLnYxLkxpc3RJbnN0YW5jZVdlYmhvb2tzUmVxdWVzdBoqLm1lbW9zLmFwaS52MS5MaXN0SW5zdGFuY2VXZWJob29rc1Jlc3BvbnNlIiGC0+STAhsSGS9hcGkvdjEvaW5zdGFuY2Uvd2ViaG9va3MSmAEKFUNyZWF0ZUluc3RhbmNlV2ViaG9vaxIqLm1lbW9zLmFwaS52MS5DcmVhdGVJbnN0YW5jZVdlYmhvb2tSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlV2ViaG9vayI02kEHd2ViaG9va4LT5JMCJDoHd2ViaG9vayIZL2FwaS92MS9pbnN0YW5jZS93ZWJob29rcxK1AQoVVXBkYXRlSW5zdGFuY2VXZWJob29rEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlV2ViaG9va1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VXZWJob29rIlHaQRN3ZWJob29rLHVwZGF0ZV9tYXNrgtPkkwI1Ogd3ZWJob29rMiovYXBpL3YxL3t3ZWJob29rLm5hbWU9aW5zdGFuY2Uvd2ViaG9va3MvKn0SjgEKFURlbGV0ZUluc3RhbmNlV2ViaG9vaxIqLm1lbW9zLmFwaS52MS5EZWxldGVJbnN0YW5jZVdlYmhvb2tSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPWluc3RhbmNlL3dlYmhvb2tzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Instance profile message containing basic instance information.
@@ -113,6 +113,12 @@ export type InstanceSetting = Message<"memos.api.v1.InstanceSetting"> & {
      */
     value: InstanceSetting_MemoRelatedSetting;
     case: "memoRelatedSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.NotificationSetting notification_setting = 5;
+     */
+    value: InstanceSetting_NotificationSetting;
+    case: "notificationSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -391,6 +397,14 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
    * @generated from field: repeated string reactions = 7;
    */
   reactions: string[];
+
+  /**
+   * revision_limit is the maximum number of revisions kept for each memo.
+   * Older revisions are removed when the limit is exceeded.
+   *
+   * @generated from field: int32 revision_limit = 8;
+   */
+  revisionLimit: number;
 };
 
 /**
@@ -400,6 +414,111 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
 export const InstanceSetting_MemoRelatedSettingSchema: GenMessage<InstanceSetting_MemoRelatedSetting> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 2);
 
+/**
+ * Notification settings for the instance.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.NotificationSetting
+ */
+export type InstanceSetting_NotificationSetting = Message<"memos.api.v1.InstanceSetting.NotificationSetting"> & {
+  /**
+   * email is the SMTP configuration for email notifications.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting email = 1;
+   */
+  email?: InstanceSetting_NotificationSetting_EmailSetting;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.NotificationSetting.
+ * Use `create(InstanceSetting_NotificationSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_NotificationSettingSchema: GenMessage<InstanceSetting_NotificationSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 3);
+
+/**
+ * SMTP configuration used to send notification emails.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting
+ */
+export type InstanceSetting_NotificationSetting_EmailSetting = Message<"memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting"> & {
+  /**
+   * enabled enables sending notification emails.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * smtp_host is the SMTP server hostname.
+   *
+   * @generated from field: string smtp_host = 2;
+   */
+  smtpHost: string;
+
+  /**
+   * smtp_port is the SMTP server port.
+   *
+   * @generated from field: int32 smtp_port = 3;
+   */
+  smtpPort: number;
+
+  /**
+   * smtp_username is the SMTP authentication username.
+   *
+   * @generated from field: string smtp_username = 4;
+   */
+  smtpUsername: string;
+
+  /**
+   * smtp_password is the SMTP authentication password.
+   *
+   * @generated from field: string smtp_password = 5;
+   */
+  smtpPassword: string;
+
+  /**
+   * from_email is the address emails are sent from.
+   *
+   * @generated from field: string from_email = 6;
+   */
+  fromEmail: string;
+
+  /**
+   * from_name is the display name emails are sent from.
+   *
+   * @generated from field: string from_name = 7;
+   */
+  fromName: string;
+
+  /**
+   * reply_to is the optional Reply-To address.
+   *
+   * @generated from field: string reply_to = 8;
+   */
+  replyTo: string;
+
+  /**
+   * use_tls enables STARTTLS.
+   *
+   * @generated from field: bool use_tls = 9;
+   */
+  useTls: boolean;
+
+  /**
+   * use_ssl enables implicit SSL/TLS.
+   *
+   * @generated from field: bool use_ssl = 10;
+   */
+  useSsl: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.NotificationSetting.EmailSetting.
+ * Use `create(InstanceSetting_NotificationSetting_EmailSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_NotificationSetting_EmailSettingSchema: GenMessage<InstanceSetting_NotificationSetting_EmailSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 3, 0);
+
 /**
  * Enumeration of instance setting keys.
  *
@@ -431,6 +550,13 @@ export enum InstanceSetting_Key {
    * @generated from enum value: MEMO_RELATED = 3;
    */
   MEMO_RELATED = 3,
+
+  /**
+   * NOTIFICATION is the key for notification settings.
+   *
+   * @generated from enum value: NOTIFICATION = 4;
+   */
+  NOTIFICATION = 4,
 }
 
 /**
@@ -489,6 +615,310 @@ export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceS
 export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 4);
 
+/**
+ * A background job run by the instance scheduler.
+ *
+ * @generated from message memos.api.v1.InstanceJob
+ */
+export type InstanceJob = Message<"memos.api.v1.InstanceJob"> & {
+  /**
+   * The resource name of the job.
+   * Format: instance/jobs/{job}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The cron expression defining when the job runs.
+   *
+   * @generated from field: string schedule = 2;
+   */
+  schedule: string;
+
+  /**
+   * A human-readable description of what the job does.
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * Whether the job is currently running.
+   *
+   * @generated from field: bool running = 4;
+   */
+  running: boolean;
+
+  /**
+   * The start time of the most recent run. Unset if the job has never run.
+   *
+   * @generated from field: google.protobuf.Timestamp last_run_time = 5;
+   */
+  lastRunTime?: Timestamp;
+
+  /**
+   * The duration of the most recent run.
+   *
+   * @generated from field: google.protobuf.Duration last_run_duration = 6;
+   */
+  lastRunDuration?: Duration;
+
+  /**
+   * The error of the most recent run. Empty if it succeeded.
+   *
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+
+  /**
+   * The next scheduled run time.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_time = 8;
+   */
+  nextRunTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceJob.
+ * Use `create(InstanceJobSchema)` to create a new message.
+ */
+export const InstanceJobSchema: GenMessage<InstanceJob> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 5);
+
+/**
+ * Request message for ListInstanceJobs method.
+ *
+ * @generated from message memos.api.v1.ListInstanceJobsRequest
+ */
+export type ListInstanceJobsRequest = Message<"memos.api.v1.ListInstanceJobsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceJobsRequest.
+ * Use `create(ListInstanceJobsRequestSchema)` to create a new message.
+ */
+export const ListInstanceJobsRequestSchema: GenMessage<ListInstanceJobsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6);
+
+/**
+ * Response message for ListInstanceJobs method.
+ *
+ * @generated from message memos.api.v1.ListInstanceJobsResponse
+ */
+export type ListInstanceJobsResponse = Message<"memos.api.v1.ListInstanceJobsResponse"> & {
+  /**
+   * The list of jobs, sorted by name.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceJob jobs = 1;
+   */
+  jobs: InstanceJob[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceJobsResponse.
+ * Use `create(ListInstanceJobsResponseSchema)` to create a new message.
+ */
+export const ListInstanceJobsResponseSchema: GenMessage<ListInstanceJobsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 7);
+
+/**
+ * Request message for RunInstanceJob method.
+ *
+ * @generated from message memos.api.v1.RunInstanceJobRequest
+ */
+export type RunInstanceJobRequest = Message<"memos.api.v1.RunInstanceJobRequest"> & {
+  /**
+   * The resource name of the job to run.
+   * Format: instance/jobs/{job}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RunInstanceJobRequest.
+ * Use `create(RunInstanceJobRequestSchema)` to create a new message.
+ */
+export const RunInstanceJobRequestSchema: GenMessage<RunInstanceJobRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 8);
+
+/**
+ * A webhook receiving activities across the whole instance.
+ *
+ * @generated from message memos.api.v1.InstanceWebhook
+ */
+export type InstanceWebhook = Message<"memos.api.v1.InstanceWebhook"> & {
+  /**
+   * The resource name of the webhook.
+   * Format: instance/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The URL to send the webhook to.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * Optional. Human-readable name for the webhook.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * The secret used to sign webhook requests with HMAC-SHA256.
+   * Only returned when the webhook is created or its secret is rotated.
+   *
+   * @generated from field: string secret = 4;
+   */
+  secret: string;
+
+  /**
+   * Optional. The activity types the webhook subscribes to.
+   * Supported types: memos.user.created, memos.user.deleted, memos.user.role.changed,
+   * memos.user.signin, memos.user.idp.signin and memos.memo.created, which is only sent for public memos.
+   * If empty, the webhook receives all of them.
+   *
+   * @generated from field: repeated string activity_types = 5;
+   */
+  activityTypes: string[];
+
+  /**
+   * Optional. The format of the request body.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.PayloadFormat payload_format = 6;
+   */
+  payloadFormat: UserWebhook_PayloadFormat;
+
+  /**
+   * Optional. A Go text/template rendering the request body when payload_format is TEMPLATE.
+   *
+   * @generated from field: string payload_template = 7;
+   */
+  payloadTemplate: string;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceWebhook.
+ * Use `create(InstanceWebhookSchema)` to create a new message.
+ */
+export const InstanceWebhookSchema: GenMessage<InstanceWebhook> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 9);
+
+/**
+ * Request message for ListInstanceWebhooks method.
+ *
+ * @generated from message memos.api.v1.ListInstanceWebhooksRequest
+ */
+export type ListInstanceWebhooksRequest = Message<"memos.api.v1.ListInstanceWebhooksRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceWebhooksRequest.
+ * Use `create(ListInstanceWebhooksRequestSchema)` to create a new message.
+ */
+export const ListInstanceWebhooksRequestSchema: GenMessage<ListInstanceWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 10);
+
+/**
+ * Response message for ListInstanceWebhooks method.
+ *
+ * @generated from message memos.api.v1.ListInstanceWebhooksResponse
+ */
+export type ListInstanceWebhooksResponse = Message<"memos.api.v1.ListInstanceWebhooksResponse"> & {
+  /**
+   * The instance webhooks.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceWebhook webhooks = 1;
+   */
+  webhooks: InstanceWebhook[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceWebhooksResponse.
+ * Use `create(ListInstanceWebhooksResponseSchema)` to create a new message.
+ */
+export const ListInstanceWebhooksResponseSchema: GenMessage<ListInstanceWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 11);
+
+/**
+ * Request message for CreateInstanceWebhook method.
+ *
+ * @generated from message memos.api.v1.CreateInstanceWebhookRequest
+ */
+export type CreateInstanceWebhookRequest = Message<"memos.api.v1.CreateInstanceWebhookRequest"> & {
+  /**
+   * The webhook to create.
+   *
+   * @generated from field: memos.api.v1.InstanceWebhook webhook = 1;
+   */
+  webhook?: InstanceWebhook;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateInstanceWebhookRequest.
+ * Use `create(CreateInstanceWebhookRequestSchema)` to create a new message.
+ */
+export const CreateInstanceWebhookRequestSchema: GenMessage<CreateInstanceWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 12);
+
+/**
+ * Request message for UpdateInstanceWebhook method.
+ *
+ * @generated from message memos.api.v1.UpdateInstanceWebhookRequest
+ */
+export type UpdateInstanceWebhookRequest = Message<"memos.api.v1.UpdateInstanceWebhookRequest"> & {
+  /**
+   * The webhook to update.
+   *
+   * @generated from field: memos.api.v1.InstanceWebhook webhook = 1;
+   */
+  webhook?: InstanceWebhook;
+
+  /**
+   * The list of fields to update. Include "secret" to rotate the signing secret.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateInstanceWebhookRequest.
+ * Use `create(UpdateInstanceWebhookRequestSchema)` to create a new message.
+ */
+export const UpdateInstanceWebhookRequestSchema: GenMessage<UpdateInstanceWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 13);
+
+/**
+ * Request message for DeleteInstanceWebhook method.
+ *
+ * @generated from message memos.api.v1.DeleteInstanceWebhookRequest
+ */
+export type DeleteInstanceWebhookRequest = Message<"memos.api.v1.DeleteInstanceWebhookRequest"> & {
+  /**
+   * The resource name of the webhook to delete.
+   * Format: instance/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteInstanceWebhookRequest.
+ * Use `create(DeleteInstanceWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteInstanceWebhookRequestSchema: GenMessage<DeleteInstanceWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 14);
+
 /**
  * @generated from service memos.api.v1.InstanceService
  */
@@ -523,6 +953,66 @@ export const InstanceService: GenService<{
     input: typeof UpdateInstanceSettingRequestSchema;
     output: typeof InstanceSettingSchema;
   },
+  /**
+   * Lists the background jobs of the instance.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ListInstanceJobs
+   */
+  listInstanceJobs: {
+    methodKind: "unary";
+    input: typeof ListInstanceJobsRequestSchema;
+    output: typeof ListInstanceJobsResponseSchema;
+  },
+  /**
+   * Runs a background job immediately, outside of its schedule.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.RunInstanceJob
+   */
+  runInstanceJob: {
+    methodKind: "unary";
+    input: typeof RunInstanceJobRequestSchema;
+    output: typeof InstanceJobSchema;
+  },
+  /**
+   * Lists the instance webhooks. Only available to admins.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ListInstanceWebhooks
+   */
+  listInstanceWebhooks: {
+    methodKind: "unary";
+    input: typeof ListInstanceWebhooksRequestSchema;
+    output: typeof ListInstanceWebhooksResponseSchema;
+  },
+  /**
+   * Creates an instance webhook. Only available to admins.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.CreateInstanceWebhook
+   */
+  createInstanceWebhook: {
+    methodKind: "unary";
+    input: typeof CreateInstanceWebhookRequestSchema;
+    output: typeof InstanceWebhookSchema;
+  },
+  /**
+   * Updates an instance webhook. Only available to admins.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.UpdateInstanceWebhook
+   */
+  updateInstanceWebhook: {
+    methodKind: "unary";
+    input: typeof UpdateInstanceWebhookRequestSchema;
+    output: typeof InstanceWebhookSchema;
+  },
+  /**
+   * Deletes an instance webhook. Only available to admins.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.DeleteInstanceWebhook
+   */
+  deleteInstanceWebhook: {
+    methodKind: "unary";
+    input: typeof DeleteInstanceWebhookRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_instance_service, 0);
 
@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Attachment } from "./attachment_service_pb";
+import type { Attachment, AttachmentSchema } from "./attachment_service_pb";
 import { file_api_v1_attachment_service } from "./attachment_service_pb";
 import type { State } from "./common_pb";
 import { file_api_v1_common } from "./common_pb";
@@ -20,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/memo_service.proto.
  */
 export const file_api_v1_memo_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEipwIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOljqQVUKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIhbWVtb3Mve21lbW99L3JlYWN0aW9ucy97cmVhY3Rpb259GgRuYW1lKglyZWFjdGlvbnMyCHJlYWN0aW9uIv4GCgRNZW1vEhEKBG5hbWUYASABKAlCA+BBCBInCgVzdGF0ZRgCIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EECEioKB2NyZWF0b3IYAyABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNQoMZGlzcGxheV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEhQKB2NvbnRlbnQYByABKAlCA+BBAhIxCgp2aXNpYmlsaXR5GAkgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBAhIRCgR0YWdzGAogAygJQgPgQQMSEwoGcGlubmVkGAsgASgIQgPgQQESMgoLYXR0YWNobWVudHMYDCADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EEBEjIKCXJlbGF0aW9ucxgNIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb25CA+BBARIuCglyZWFjdGlvbnMYDiADKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAxIyCghwcm9wZXJ0eRgPIAEoCzIbLm1lbW9zLmFwaS52MS5NZW1vLlByb3BlcnR5QgPgQQMSLgoGcGFyZW50GBAgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9NZW1vSACIAQESFAoHc25pcHBldBgRIAEoCUID4EEDEjIKCGxvY2F0aW9uGBIgASgLMhYubWVtb3MuYXBpLnYxLkxvY2F0aW9uQgPgQQFIAYgBARpjCghQcm9wZXJ0eRIQCghoYXNfbGluaxgBIAEoCBIVCg1oYXNfdGFza19saXN0GAIgASgIEhAKCGhhc19jb2RlGAMgASgIEhwKFGhhc19pbmNvbXBsZXRlX3Rhc2tzGAQgASgIOjfqQTQKEW1lbW9zLmFwaS52MS9NZW1vEgxtZW1vcy97bWVtb30aBG5hbWUqBW1lbW9zMgRtZW1vQgkKB19wYXJlbnRCCwoJX2xvY2F0aW9uIlMKCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBASJQChFDcmVhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIUCgdtZW1vX2lkGAIgASgJQgPgQQEiswEKEExpc3RNZW1vc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEicKBXN0YXRlGAMgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBiABKAhCA+BBASJPChFMaXN0TWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI5Cg5HZXRNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vInAKEVVwZGF0ZU1lbW9SZXF1ZXN0EiUKBG1lbW8YASABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlAKEURlbGV0ZU1lbW9SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SEgoFZm9yY2UYAiABKAhCA+BBASJ4ChlTZXRNZW1vQXR0YWNobWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoLYXR0YWNobWVudHMYAiADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EECInYKGkxpc3RNZW1vQXR0YWNobWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBImUKG0xpc3RNZW1vQXR0YWNobWVudHNSZXNwb25zZRItCgthdHRhY2htZW50cxgBIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKzAgoMTWVtb1JlbGF0aW9uEjIKBG1lbW8YASABKAsyHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLk1lbW9CA+BBAhI6CgxyZWxhdGVkX21lbW8YAiABKAsyHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLk1lbW9CA+BBAhIyCgR0eXBlGAMgASgOMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5UeXBlQgPgQQIaRQoETWVtbxInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhQKB3NuaXBwZXQYAiABKAlCA+BBAyI4CgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABINCglSRUZFUkVOQ0UQARILCgdDT01NRU5UEAIidgoXU2V0TWVtb1JlbGF0aW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIyCglyZWxhdGlvbnMYAiADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uQgPgQQIidAoYTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBImMKGUxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2USLQoJcmVsYXRpb25zGAEgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkihgEKGENyZWF0ZU1lbW9Db21tZW50UmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEigKB2NvbW1lbnQYAiABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEhcKCmNvbW1lbnRfaWQYAyABKAlCA+BBASKKAQoXTGlzdE1lbW9Db21tZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBASJqChhMaXN0TWVtb0NvbW1lbnRzUmVzcG9uc2USIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJ0ChhMaXN0TWVtb1JlYWN0aW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEicwoZTGlzdE1lbW9SZWFjdGlvbnNSZXNwb25zZRIpCglyZWFjdGlvbnMYASADKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUicwoZVXBzZXJ0TWVtb1JlYWN0aW9uUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEi0KCHJlYWN0aW9uGAIgASgLMhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uQgPgQQIiSAoZRGVsZXRlTWVtb1JlYWN0aW9uUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFW1lbW9zLmFwaS52MS9SZWFjdGlvbipQCgpWaXNpYmlsaXR5EhoKFlZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABILCgdQUklWQVRFEAESDQoJUFJPVEVDVEVEEAISCgoGUFVCTElDEAMy0w4KC01lbW9TZXJ2aWNlEmUKCkNyZWF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIi2kEEbWVtb4LT5JMCFToEbWVtbyINL2FwaS92MS9tZW1vcxJmCglMaXN0TWVtb3MSHi5tZW1vcy5hcGkudjEuTGlzdE1lbW9zUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXNwb25zZSIY2kEAgtPkkwIPEg0vYXBpL3YxL21lbW9zEmIKB0dldE1lbW8SHC5tZW1vcy5hcGkudjEuR2V0TWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIl2kEEbmFtZYLT5JMCGBIWL2FwaS92MS97bmFtZT1tZW1vcy8qfRJ/CgpVcGRhdGVNZW1vEh8ubWVtb3MuYXBpLnYxLlVwZGF0ZU1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iPNpBEG1lbW8sdXBkYXRlX21hc2uC0+STAiM6BG1lbW8yGy9hcGkvdjEve21lbW8ubmFtZT1tZW1vcy8qfRJsCgpEZWxldGVNZW1vEh8ubWVtb3MuYXBpLnYxLkRlbGV0ZU1lbW9SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiXaQQRuYW1lgtPkkwIYKhYvYXBpL3YxL3tuYW1lPW1lbW9zLyp9EosBChJTZXRNZW1vQXR0YWNobWVudHMSJy5tZW1vcy5hcGkudjEuU2V0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI02kEEbmFtZYLT5JMCJzoBKjIiL2FwaS92MS97bmFtZT1tZW1vcy8qfS9hdHRhY2htZW50cxKdAQoTTGlzdE1lbW9BdHRhY2htZW50cxIoLm1lbW9zLmFwaS52MS5MaXN0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBopLm1lbW9zLmFwaS52MS5MaXN0TWVtb0F0dGFjaG1lbnRzUmVzcG9uc2UiMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9bWVtb3MvKn0vYXR0YWNobWVudHMShQEKEFNldE1lbW9SZWxhdGlvbnMSJS5tZW1vcy5hcGkudjEuU2V0TWVtb1JlbGF0aW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMtpBBG5hbWWC0+STAiU6ASoyIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVsYXRpb25zEpUBChFMaXN0TWVtb1JlbGF0aW9ucxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlbGF0aW9uc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWxhdGlvbnNSZXNwb25zZSIv2kEEbmFtZYLT5JMCIhIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWxhdGlvbnMSkAEKEUNyZWF0ZU1lbW9Db21tZW50EiYubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9Db21tZW50UmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIj/aQQxuYW1lLGNvbW1lbnSC0+STAio6B2NvbW1lbnQiHy9hcGkvdjEve25hbWU9bWVtb3MvKn0vY29tbWVudHMSkQEKEExpc3RNZW1vQ29tbWVudHMSJS5tZW1vcy5hcGkudjEuTGlzdE1lbW9Db21tZW50c1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9Db21tZW50c1Jlc3BvbnNlIi7aQQRuYW1lgtPkkwIhEh8vYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2NvbW1lbnRzEpUBChFMaXN0TWVtb1JlYWN0aW9ucxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlYWN0aW9uc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWFjdGlvbnNSZXNwb25zZSIv2kEEbmFtZYLT5JMCIhIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWFjdGlvbnMSiQEKElVwc2VydE1lbW9SZWFjdGlvbhInLm1lbW9zLmFwaS52MS5VcHNlcnRNZW1vUmVhY3Rpb25SZXF1ZXN0GhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uIjLaQQRuYW1lgtPkkwIlOgEqIiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlYWN0aW9ucxKIAQoSRGVsZXRlTWVtb1JlYWN0aW9uEicubWVtb3MuYXBpLnYxLkRlbGV0ZU1lbW9SZWFjdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMdpBBG5hbWWC0+STAiQqIi9hcGkvdjEve25hbWU9bWVtb3MvKi9yZWFjdGlvbnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEE1lbW9TZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEipwIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOljqQVUKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIhbWVtb3Mve21lbW99L3JlYWN0aW9ucy97cmVhY3Rpb259GgRuYW1lKglyZWFjdGlvbnMyCHJlYWN0aW9uIoIICgRNZW1vEhEKBG5hbWUYASABKAlCA+BBCBInCgVzdGF0ZRgCIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EECEioKB2NyZWF0b3IYAyABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNQoMZGlzcGxheV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEhQKB2NvbnRlbnQYByABKAlCA+BBAhIxCgp2aXNpYmlsaXR5GAkgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBAhIRCgR0YWdzGAogAygJQgPgQQMSEwoGcGlubmVkGAsgASgIQgPgQQESMgoLYXR0YWNobWVudHMYDCADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EEBEjIKCXJlbGF0aW9ucxgNIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb25CA+BBARIuCglyZWFjdGlvbnMYDiADKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAxIyCghwcm9wZXJ0eRgPIAEoCzIbLm1lbW9zLmFwaS52MS5NZW1vLlByb3BlcnR5QgPgQQMSLgoGcGFyZW50GBAgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9NZW1vSACIAQESFAoHc25pcHBldBgRIAEoCUID4EEDEjIKCGxvY2F0aW9uGBIgASgLMhYubWVtb3MuYXBpLnYxLkxvY2F0aW9uQgPgQQFIAYgBARI1Cg1saW5rX3ByZXZpZXdzGBMgAygLMhkubWVtb3MuYXBpLnYxLkxpbmtQcmV2aWV3QgPgQQMSOgoMcHVibGlzaF90aW1lGBQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBSAKIAQEaYwoIUHJvcGVydHkSEAoIaGFzX2xpbmsYASABKAgSFQoNaGFzX3Rhc2tfbGlzdBgCIAEoCBIQCghoYXNfY29kZRgDIAEoCBIcChRoYXNfaW5jb21wbGV0ZV90YXNrcxgEIAEoCDo36kE0ChFtZW1vcy5hcGkudjEvTWVtbxIMbWVtb3Mve21lbW99GgRuYW1lKgVtZW1vczIEbWVtb0IJCgdfcGFyZW50QgsKCV9sb2NhdGlvbkIPCg1fcHVibGlzaF90aW1lIlMKCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBASJNCgtMaW5rUHJldmlldxILCgN1cmwYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDQoFaW1hZ2UYBCABKAkiUAoRQ3JlYXRlTWVtb1JlcXVlc3QSJQoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFAoHbWVtb19pZBgCIAEoCUID4EEBIrMBChBMaXN0TWVtb3NSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARInCgVzdGF0ZRgDIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQESEwoGZmlsdGVyGAUgASgJQgPgQQESGQoMc2hvd19kZWxldGVkGAYgASgIQgPgQQEiTwoRTGlzdE1lbW9zUmVzcG9uc2USIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiOQoOR2V0TWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyJwChFVcGRhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJQChFEZWxldGVNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhIKBWZvcmNlGAIgASgIQgPgQQEieAoZU2V0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKC2F0dGFjaG1lbnRzGAIgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAiJ2ChpMaXN0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJlChtMaXN0TWVtb0F0dGFjaG1lbnRzUmVzcG9uc2USLQoLYXR0YWNobWVudHMYASADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiswIKDE1lbW9SZWxhdGlvbhIyCgRtZW1vGAEgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISOgoMcmVsYXRlZF9tZW1vGAIgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISMgoEdHlwZRgDIAEoDjIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uVHlwZUID4EECGkUKBE1lbW8SJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIUCgdzbmlwcGV0GAIgASgJQgPgQQMiOAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDQoJUkVGRVJFTkNFEAESCwoHQ09NTUVOVBACInYKF1NldE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoJcmVsYXRpb25zGAIgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EECInQKGExpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlEi0KCXJlbGF0aW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIoYBChhDcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIoCgdjb21tZW50GAIgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIXCgpjb21tZW50X2lkGAMgASgJQgPgQQEiigEKF0xpc3RNZW1vQ29tbWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQEiagoYTGlzdE1lbW9Db21tZW50c1Jlc3BvbnNlEiEKBW1lbW9zGAEgAygLMhIubWVtb3MuYXBpLnYxLk1lbW8SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUidAoYTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInMKGUxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2USKQoJcmVhY3Rpb25zGAEgAygLMhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInMKGVVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxItCghyZWFjdGlvbhgCIAEoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EECIkgKGURlbGV0ZU1lbW9SZWFjdGlvblJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvUmVhY3Rpb24itQIKDE1lbW9SZXZpc2lvbhIUCgRuYW1lGAEgASgJQgbgQQPgQQgSKgoHY3JlYXRvchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI0CgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIUCgdjb250ZW50GAQgASgJQgPgQQMSMQoKdmlzaWJpbGl0eRgFIAEoDjIYLm1lbW9zLmFwaS52MS5WaXNpYmlsaXR5QgPgQQM6ZOpBYQoZbWVtb3MuYXBpLnYxL01lbW9SZXZpc2lvbhIhbWVtb3Mve21lbW99L3JldmlzaW9ucy97cmV2aXNpb259GgRuYW1lKg1tZW1vUmV2aXNpb25zMgxtZW1vUmV2aXNpb24idgoYTGlzdE1lbW9SZXZpc2lvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiYwoZTGlzdE1lbW9SZXZpc2lvbnNSZXNwb25zZRItCglyZXZpc2lvbnMYASADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JldmlzaW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJJChZHZXRNZW1vUmV2aXNpb25SZXF1ZXN0Ei8KBG5hbWUYASABKAlCIeBBAvpBGwoZbWVtb3MuYXBpLnYxL01lbW9SZXZpc2lvbiJ8ChhEaWZmTWVtb1JldmlzaW9uc1JlcXVlc3QSLwoEbmFtZRgBIAEoCUIh4EEC+kEbChltZW1vcy5hcGkudjEvTWVtb1JldmlzaW9uEi8KBGJhc2UYAiABKAlCIeBBAfpBGwoZbWVtb3MuYXBpLnYxL01lbW9SZXZpc2lvbiI3ChlEaWZmTWVtb1JldmlzaW9uc1Jlc3BvbnNlEgwKBGRpZmYYASABKAkSDAoEYmFzZRgCIAEoCSJNChpSZXN0b3JlTWVtb1JldmlzaW9uUmVxdWVzdBIvCgRuYW1lGAEgASgJQiHgQQL6QRsKGW1lbW9zLmFwaS52MS9NZW1vUmV2aXNpb24imwEKBFRhc2sSJwoEbWVtbxgBIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvTWVtbxISCgVpbmRleBgCIAEoBUID4EEDEhQKB2NvbnRlbnQYAyABKAlCA+BBAxIWCgljb21wbGV0ZWQYBCABKAhCA+BBAxIRCgRsaW5lGAUgASgFQgPgQQMSFQoIZHVlX2RhdGUYBiABKAlCA+BBAyJEChBMaXN0VGFza3NSZXF1ZXN0EhMKBmZpbHRlchgBIAEoCUID4EEBEhsKDnNob3dfY29tcGxldGVkGAIgASgIQgPgQQEiNgoRTGlzdFRhc2tzUmVzcG9uc2USIQoFdGFza3MYASADKAsyEi5tZW1vcy5hcGkudjEuVGFzayJuChdTZXRUYXNrQ29tcGxldGVkUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhIKBWluZGV4GAIgASgFQgPgQQISFgoJY29tcGxldGVkGAMgASgIQgPgQQIiUwoWQXJjaGl2ZU1lbW9MaW5rUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhAKA3VybBgCIAEoCUID4EECIsQCCgxNZW1vUmVtaW5kZXISFAoEbmFtZRgBIAEoCUIG4EED4EEIEjQKC3JlbWluZF90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEhEKBGNyb24YAyABKAlCA+BBARI5ChBuZXh0X3JlbWluZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOmTqQWEKGW1lbW9zLmFwaS52MS9NZW1vUmVtaW5kZXISIW1lbW9zL3ttZW1vfS9yZW1pbmRlcnMve3JlbWluZGVyfRoEbmFtZSoNbWVtb1JlbWluZGVyczIMbWVtb1JlbWluZGVyInkKGUNyZWF0ZU1lbW9SZW1pbmRlclJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjEKCHJlbWluZGVyGAIgASgLMhoubWVtb3MuYXBpLnYxLk1lbW9SZW1pbmRlckID4EECIkUKGExpc3RNZW1vUmVtaW5kZXJzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8iSgoZTGlzdE1lbW9SZW1pbmRlcnNSZXNwb25zZRItCglyZW1pbmRlcnMYASADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JlbWluZGVyIoQBChlVcGRhdGVNZW1vUmVtaW5kZXJSZXF1ZXN0EjEKCHJlbWluZGVyGAEgASgLMhoubWVtb3MuYXBpLnYxLk1lbW9SZW1pbmRlckID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIkwKGURlbGV0ZU1lbW9SZW1pbmRlclJlcXVlc3QSLwoEbmFtZRgBIAEoCUIh4EEC+kEbChltZW1vcy5hcGkudjEvTWVtb1JlbWluZGVyIikKFEdldE1lbW9EaWdlc3RSZXF1ZXN0EhEKBGRhdGUYASABKAlCA+BBASKCAQoKTWVtb0RpZ2VzdBIRCgRkYXRlGAEgASgJQgPgQQMSMgoRb25fdGhpc19kYXlfbWVtb3MYAiADKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EEDEi0KDHJldmlld19tZW1vcxgDIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQMqUAoKVmlzaWJpbGl0eRIaChZWSVNJQklMSVRZX1VOU1BFQ0lGSUVEEAASCwoHUFJJVkFURRABEg0KCVBST1RFQ1RFRBACEgoKBlBVQkxJQxADMqMcCgtNZW1vU2VydmljZRJlCgpDcmVhdGVNZW1vEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iItpBBG1lbW+C0+STAhU6BG1lbW8iDS9hcGkvdjEvbWVtb3MSZgoJTGlzdE1lbW9zEh4ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuTGlzdE1lbW9zUmVzcG9uc2UiGNpBAILT5JMCDxINL2FwaS92MS9tZW1vcxJiCgdHZXRNZW1vEhwubWVtb3MuYXBpLnYxLkdldE1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iJdpBBG5hbWWC0+STAhgSFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SfwoKVXBkYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5VcGRhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjzaQRBtZW1vLHVwZGF0ZV9tYXNrgtPkkwIjOgRtZW1vMhsvYXBpL3YxL3ttZW1vLm5hbWU9bWVtb3MvKn0SbAoKRGVsZXRlTWVtbxIfLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT1tZW1vcy8qfRKLAQoSU2V0TWVtb0F0dGFjaG1lbnRzEicubWVtb3MuYXBpLnYxLlNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNNpBBG5hbWWC0+STAic6ASoyIi9hcGkvdjEve25hbWU9bWVtb3MvKn0vYXR0YWNobWVudHMSnQEKE0xpc3RNZW1vQXR0YWNobWVudHMSKC5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QaKS5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEoUBChBTZXRNZW1vUmVsYXRpb25zEiUubWVtb3MuYXBpLnYxLlNldE1lbW9SZWxhdGlvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlOgEqMiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKVAQoRTGlzdE1lbW9SZWxhdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVsYXRpb25zEpABChFDcmVhdGVNZW1vQ29tbWVudBImLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI/2kEMbmFtZSxjb21tZW50gtPkkwIqOgdjb21tZW50Ih8vYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2NvbW1lbnRzEpEBChBMaXN0TWVtb0NvbW1lbnRzEiUubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXNwb25zZSIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKVAQoRTGlzdE1lbW9SZWFjdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEokBChJVcHNlcnRNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuVXBzZXJ0TWVtb1JlYWN0aW9uUmVxdWVzdBoWLm1lbW9zLmFwaS52MS5SZWFjdGlvbiIy2kEEbmFtZYLT5JMCJToBKiIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWFjdGlvbnMSiAEKEkRlbGV0ZU1lbW9SZWFjdGlvbhInLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmVhY3Rpb25zLyp9EpkBChFMaXN0TWVtb1JldmlzaW9ucxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JldmlzaW9uc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZXZpc2lvbnNSZXNwb25zZSIz2kEGcGFyZW50gtPkkwIkEiIvYXBpL3YxL3twYXJlbnQ9bWVtb3MvKn0vcmV2aXNpb25zEoYBCg9HZXRNZW1vUmV2aXNpb24SJC5tZW1vcy5hcGkudjEuR2V0TWVtb1JldmlzaW9uUmVxdWVzdBoaLm1lbW9zLmFwaS52MS5NZW1vUmV2aXNpb24iMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9bWVtb3MvKi9yZXZpc2lvbnMvKn0SnAEKEURpZmZNZW1vUmV2aXNpb25zEiYubWVtb3MuYXBpLnYxLkRpZmZNZW1vUmV2aXNpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5EaWZmTWVtb1JldmlzaW9uc1Jlc3BvbnNlIjbaQQRuYW1lgtPkkwIpEicvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmV2aXNpb25zLyp9OmRpZmYSkQEKE1Jlc3RvcmVNZW1vUmV2aXNpb24SKC5tZW1vcy5hcGkudjEuUmVzdG9yZU1lbW9SZXZpc2lvblJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI82kEEbmFtZYLT5JMCLzoBKiIqL2FwaS92MS97bmFtZT1tZW1vcy8qL3JldmlzaW9ucy8qfTpyZXN0b3JlEmMKCUxpc3RUYXNrcxIeLm1lbW9zLmFwaS52MS5MaXN0VGFza3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RUYXNrc1Jlc3BvbnNlIhWC0+STAg8SDS9hcGkvdjEvdGFza3MSmAEKEFNldFRhc2tDb21wbGV0ZWQSJS5tZW1vcy5hcGkudjEuU2V0VGFza0NvbXBsZXRlZFJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVGFzayJJ2kEUbmFtZSxpbmRleCxjb21wbGV0ZWSC0+STAiw6ASoiJy9hcGkvdjEve25hbWU9bWVtb3MvKn06c2V0VGFza0NvbXBsZXRlZBKLAQoPQXJjaGl2ZU1lbW9MaW5rEiQubWVtb3MuYXBpLnYxLkFyY2hpdmVNZW1vTGlua1JlcXVlc3QaGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudCI42kEIbmFtZSx1cmyC0+STAic6ASoiIi9hcGkvdjEve25hbWU9bWVtb3MvKn06YXJjaGl2ZUxpbmsSoQEKEkNyZWF0ZU1lbW9SZW1pbmRlchInLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVtaW5kZXJSZXF1ZXN0GhoubWVtb3MuYXBpLnYxLk1lbW9SZW1pbmRlciJG2kEPcGFyZW50LHJlbWluZGVygtPkkwIuOghyZW1pbmRlciIiL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3JlbWluZGVycxKZAQoRTGlzdE1lbW9SZW1pbmRlcnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZW1pbmRlcnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVtaW5kZXJzUmVzcG9uc2UiM9pBBnBhcmVudILT5JMCJBIiL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3JlbWluZGVycxKvAQoSVXBkYXRlTWVtb1JlbWluZGVyEicubWVtb3MuYXBpLnYxLlVwZGF0ZU1lbW9SZW1pbmRlclJlcXVlc3QaGi5tZW1vcy5hcGkudjEuTWVtb1JlbWluZGVyIlTaQRRyZW1pbmRlcix1cGRhdGVfbWFza4LT5JMCNzoIcmVtaW5kZXIyKy9hcGkvdjEve3JlbWluZGVyLm5hbWU9bWVtb3MvKi9yZW1pbmRlcnMvKn0SiAEKEkRlbGV0ZU1lbW9SZW1pbmRlchInLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVtaW5kZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmVtaW5kZXJzLyp9EmsKDUdldE1lbW9EaWdlc3QSIi5tZW1vcy5hcGkudjEuR2V0TWVtb0RpZ2VzdFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuTWVtb0RpZ2VzdCIcgtPkkwIWEhQvYXBpL3YxL21lbW9zOmRpZ2VzdEKoAQoQY29tLm1lbW9zLmFwaS52MUIQTWVtb1NlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Reaction
@@ -200,6 +200,24 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
    * @generated from field: optional memos.api.v1.Location location = 18;
    */
   location?: Location;
+
+  /**
+   * Output only. The previews of the web pages linked from the memo content.
+   * Previews are fetched in the background, so they may be missing right after the memo is saved.
+   *
+   * @generated from field: repeated memos.api.v1.LinkPreview link_previews = 19;
+   */
+  linkPreviews: LinkPreview[];
+
+  /**
+   * Optional. The time at which a scheduled memo is published.
+   * Only used on creation, and only if it is in the future. The memo stays private until then,
+   * and is then published with the requested visibility and its create and update time set to this time.
+   * Unset once the memo is published. Use the `scheduled` filter to list scheduled memos.
+   *
+   * @generated from field: optional google.protobuf.Timestamp publish_time = 20;
+   */
+  publishTime?: Timestamp;
 };
 
 /**
@@ -276,6 +294,46 @@ export type Location = Message<"memos.api.v1.Location"> & {
 export const LocationSchema: GenMessage<Location> = /*@__PURE__*/
   messageDesc(file_api_v1_memo_service, 2);
 
+/**
+ * @generated from message memos.api.v1.LinkPreview
+ */
+export type LinkPreview = Message<"memos.api.v1.LinkPreview"> & {
+  /**
+   * The URL of the linked page.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * The title of the page.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The description of the page.
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * The URL of the preview image of the page.
+   *
+   * @generated from field: string image = 4;
+   */
+  image: string;
+};
+
+/**
+ * Describes the message memos.api.v1.LinkPreview.
+ * Use `create(LinkPreviewSchema)` to create a new message.
+ */
+export const LinkPreviewSchema: GenMessage<LinkPreview> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 3);
+
 /**
  * @generated from message memos.api.v1.CreateMemoRequest
  */
@@ -301,7 +359,7 @@ export type CreateMemoRequest = Message<"memos.api.v1.CreateMemoRequest"> & {
  * Use `create(CreateMemoRequestSchema)` to create a new message.
  */
 export const CreateMemoRequestSchema: GenMessage<CreateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 3);
+  messageDesc(file_api_v1_memo_service, 4);
 
 /**
  * @generated from message memos.api.v1.ListMemosRequest
@@ -338,7 +396,8 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
    * Default to "display_time desc".
    * Supports comma-separated list of fields following AIP-132.
    * Example: "pinned desc, display_time desc" or "create_time asc"
-   * Supported fields: pinned, display_time, create_time, update_time, name
+   * Supported fields: pinned, relevance, display_time, create_time, update_time, name
+   * Ordering by relevance requires a `search("query")` call in the filter.
    *
    * @generated from field: string order_by = 4;
    */
@@ -366,7 +425,7 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
  * Use `create(ListMemosRequestSchema)` to create a new message.
  */
 export const ListMemosRequestSchema: GenMessage<ListMemosRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 4);
+  messageDesc(file_api_v1_memo_service, 5);
 
 /**
  * @generated from message memos.api.v1.ListMemosResponse
@@ -393,7 +452,7 @@ export type ListMemosResponse = Message<"memos.api.v1.ListMemosResponse"> & {
  * Use `create(ListMemosResponseSchema)` to create a new message.
  */
 export const ListMemosResponseSchema: GenMessage<ListMemosResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 5);
+  messageDesc(file_api_v1_memo_service, 6);
 
 /**
  * @generated from message memos.api.v1.GetMemoRequest
@@ -413,7 +472,7 @@ export type GetMemoRequest = Message<"memos.api.v1.GetMemoRequest"> & {
  * Use `create(GetMemoRequestSchema)` to create a new message.
  */
 export const GetMemoRequestSchema: GenMessage<GetMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 6);
+  messageDesc(file_api_v1_memo_service, 7);
 
 /**
  * @generated from message memos.api.v1.UpdateMemoRequest
@@ -440,7 +499,7 @@ export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
  * Use `create(UpdateMemoRequestSchema)` to create a new message.
  */
 export const UpdateMemoRequestSchema: GenMessage<UpdateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 7);
+  messageDesc(file_api_v1_memo_service, 8);
 
 /**
  * @generated from message memos.api.v1.DeleteMemoRequest
@@ -467,7 +526,7 @@ export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
  * Use `create(DeleteMemoRequestSchema)` to create a new message.
  */
 export const DeleteMemoRequestSchema: GenMessage<DeleteMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 8);
+  messageDesc(file_api_v1_memo_service, 9);
 
 /**
  * @generated from message memos.api.v1.SetMemoAttachmentsRequest
@@ -494,7 +553,7 @@ export type SetMemoAttachmentsRequest = Message<"memos.api.v1.SetMemoAttachments
  * Use `create(SetMemoAttachmentsRequestSchema)` to create a new message.
  */
 export const SetMemoAttachmentsRequestSchema: GenMessage<SetMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 9);
+  messageDesc(file_api_v1_memo_service, 10);
 
 /**
  * @generated from message memos.api.v1.ListMemoAttachmentsRequest
@@ -528,7 +587,7 @@ export type ListMemoAttachmentsRequest = Message<"memos.api.v1.ListMemoAttachmen
  * Use `create(ListMemoAttachmentsRequestSchema)` to create a new message.
  */
 export const ListMemoAttachmentsRequestSchema: GenMessage<ListMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 10);
+  messageDesc(file_api_v1_memo_service, 11);
 
 /**
  * @generated from message memos.api.v1.ListMemoAttachmentsResponse
@@ -554,7 +613,7 @@ export type ListMemoAttachmentsResponse = Message<"memos.api.v1.ListMemoAttachme
  * Use `create(ListMemoAttachmentsResponseSchema)` to create a new message.
  */
 export const ListMemoAttachmentsResponseSchema: GenMessage<ListMemoAttachmentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 11);
+  messageDesc(file_api_v1_memo_service, 12);
 
 /**
  * @generated from message memos.api.v1.MemoRelation
@@ -585,7 +644,7 @@ export type MemoRelation = Message<"memos.api.v1.MemoRelation"> & {
  * Use `create(MemoRelationSchema)` to create a new message.
  */
 export const MemoRelationSchema: GenMessage<MemoRelation> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 12);
+  messageDesc(file_api_v1_memo_service, 13);
 
 /**
  * Memo reference in relations.
@@ -614,7 +673,7 @@ export type MemoRelation_Memo = Message<"memos.api.v1.MemoRelation.Memo"> & {
  * Use `create(MemoRelation_MemoSchema)` to create a new message.
  */
 export const MemoRelation_MemoSchema: GenMessage<MemoRelation_Memo> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 12, 0);
+  messageDesc(file_api_v1_memo_service, 13, 0);
 
 /**
  * The type of the relation.
@@ -642,7 +701,7 @@ export enum MemoRelation_Type {
  * Describes the enum memos.api.v1.MemoRelation.Type.
  */
 export const MemoRelation_TypeSchema: GenEnum<MemoRelation_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_memo_service, 12, 0);
+  enumDesc(file_api_v1_memo_service, 13, 0);
 
 /**
  * @generated from message memos.api.v1.SetMemoRelationsRequest
@@ -669,7 +728,7 @@ export type SetMemoRelationsRequest = Message<"memos.api.v1.SetMemoRelationsRequ
  * Use `create(SetMemoRelationsRequestSchema)` to create a new message.
  */
 export const SetMemoRelationsRequestSchema: GenMessage<SetMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 13);
+  messageDesc(file_api_v1_memo_service, 14);
 
 /**
  * @generated from message memos.api.v1.ListMemoRelationsRequest
@@ -703,7 +762,7 @@ export type ListMemoRelationsRequest = Message<"memos.api.v1.ListMemoRelationsRe
  * Use `create(ListMemoRelationsRequestSchema)` to create a new message.
  */
 export const ListMemoRelationsRequestSchema: GenMessage<ListMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 14);
+  messageDesc(file_api_v1_memo_service, 15);
 
 /**
  * @generated from message memos.api.v1.ListMemoRelationsResponse
@@ -729,7 +788,7 @@ export type ListMemoRelationsResponse = Message<"memos.api.v1.ListMemoRelationsR
  * Use `create(ListMemoRelationsResponseSchema)` to create a new message.
  */
 export const ListMemoRelationsResponseSchema: GenMessage<ListMemoRelationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 15);
+  messageDesc(file_api_v1_memo_service, 16);
 
 /**
  * @generated from message memos.api.v1.CreateMemoCommentRequest
@@ -763,7 +822,7 @@ export type CreateMemoCommentRequest = Message<"memos.api.v1.CreateMemoCommentRe
  * Use `create(CreateMemoCommentRequestSchema)` to create a new message.
  */
 export const CreateMemoCommentRequestSchema: GenMessage<CreateMemoCommentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 16);
+  messageDesc(file_api_v1_memo_service, 17);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsRequest
@@ -804,7 +863,7 @@ export type ListMemoCommentsRequest = Message<"memos.api.v1.ListMemoCommentsRequ
  * Use `create(ListMemoCommentsRequestSchema)` to create a new message.
  */
 export const ListMemoCommentsRequestSchema: GenMessage<ListMemoCommentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 17);
+  messageDesc(file_api_v1_memo_service, 18);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsResponse
@@ -837,7 +896,7 @@ export type ListMemoCommentsResponse = Message<"memos.api.v1.ListMemoCommentsRes
  * Use `create(ListMemoCommentsResponseSchema)` to create a new message.
  */
 export const ListMemoCommentsResponseSchema: GenMessage<ListMemoCommentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 18);
+  messageDesc(file_api_v1_memo_service, 19);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsRequest
@@ -871,7 +930,7 @@ export type ListMemoReactionsRequest = Message<"memos.api.v1.ListMemoReactionsRe
  * Use `create(ListMemoReactionsRequestSchema)` to create a new message.
  */
 export const ListMemoReactionsRequestSchema: GenMessage<ListMemoReactionsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 19);
+  messageDesc(file_api_v1_memo_service, 20);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsResponse
@@ -904,7 +963,7 @@ export type ListMemoReactionsResponse = Message<"memos.api.v1.ListMemoReactionsR
  * Use `create(ListMemoReactionsResponseSchema)` to create a new message.
  */
 export const ListMemoReactionsResponseSchema: GenMessage<ListMemoReactionsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 20);
+  messageDesc(file_api_v1_memo_service, 21);
 
 /**
  * @generated from message memos.api.v1.UpsertMemoReactionRequest
@@ -931,7 +990,7 @@ export type UpsertMemoReactionRequest = Message<"memos.api.v1.UpsertMemoReaction
  * Use `create(UpsertMemoReactionRequestSchema)` to create a new message.
  */
 export const UpsertMemoReactionRequestSchema: GenMessage<UpsertMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 21);
+  messageDesc(file_api_v1_memo_service, 22);
 
 /**
  * @generated from message memos.api.v1.DeleteMemoReactionRequest
@@ -951,7 +1010,596 @@ export type DeleteMemoReactionRequest = Message<"memos.api.v1.DeleteMemoReaction
  * Use `create(DeleteMemoReactionRequestSchema)` to create a new message.
  */
 export const DeleteMemoReactionRequestSchema: GenMessage<DeleteMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 22);
+  messageDesc(file_api_v1_memo_service, 23);
+
+/**
+ * @generated from message memos.api.v1.MemoRevision
+ */
+export type MemoRevision = Message<"memos.api.v1.MemoRevision"> & {
+  /**
+   * The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The resource name of the user who made the change.
+   * Format: users/{user}
+   *
+   * @generated from field: string creator = 2;
+   */
+  creator: string;
+
+  /**
+   * Output only. The time the revision was recorded.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * Output only. The content of the memo at this revision.
+   *
+   * @generated from field: string content = 4;
+   */
+  content: string;
+
+  /**
+   * Output only. The visibility of the memo at this revision.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 5;
+   */
+  visibility: Visibility;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoRevision.
+ * Use `create(MemoRevisionSchema)` to create a new message.
+ */
+export const MemoRevisionSchema: GenMessage<MemoRevision> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 24);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsRequest
+ */
+export type ListMemoRevisionsRequest = Message<"memos.api.v1.ListMemoRevisionsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of revisions to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token for pagination.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsRequest.
+ * Use `create(ListMemoRevisionsRequestSchema)` to create a new message.
+ */
+export const ListMemoRevisionsRequestSchema: GenMessage<ListMemoRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 25);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsResponse
+ */
+export type ListMemoRevisionsResponse = Message<"memos.api.v1.ListMemoRevisionsResponse"> & {
+  /**
+   * The list of revisions, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRevision revisions = 1;
+   */
+  revisions: MemoRevision[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsResponse.
+ * Use `create(ListMemoRevisionsResponseSchema)` to create a new message.
+ */
+export const ListMemoRevisionsResponseSchema: GenMessage<ListMemoRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 26);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRevisionRequest
+ */
+export type GetMemoRevisionRequest = Message<"memos.api.v1.GetMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRevisionRequest.
+ * Use `create(GetMemoRevisionRequestSchema)` to create a new message.
+ */
+export const GetMemoRevisionRequestSchema: GenMessage<GetMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 27);
+
+/**
+ * @generated from message memos.api.v1.DiffMemoRevisionsRequest
+ */
+export type DiffMemoRevisionsRequest = Message<"memos.api.v1.DiffMemoRevisionsRequest"> & {
+  /**
+   * Required. The resource name of the revision to compare.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The resource name of the revision to compare against.
+   * Defaults to the revision right before `name`.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string base = 2;
+   */
+  base: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DiffMemoRevisionsRequest.
+ * Use `create(DiffMemoRevisionsRequestSchema)` to create a new message.
+ */
+export const DiffMemoRevisionsRequestSchema: GenMessage<DiffMemoRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 28);
+
+/**
+ * @generated from message memos.api.v1.DiffMemoRevisionsResponse
+ */
+export type DiffMemoRevisionsResponse = Message<"memos.api.v1.DiffMemoRevisionsResponse"> & {
+  /**
+   * The unified diff of the content from `base` to `name`.
+   * Empty when the content is unchanged.
+   *
+   * @generated from field: string diff = 1;
+   */
+  diff: string;
+
+  /**
+   * The resource name of the revision used as the base.
+   * Empty when `name` is the first revision.
+   *
+   * @generated from field: string base = 2;
+   */
+  base: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DiffMemoRevisionsResponse.
+ * Use `create(DiffMemoRevisionsResponseSchema)` to create a new message.
+ */
+export const DiffMemoRevisionsResponseSchema: GenMessage<DiffMemoRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 29);
+
+/**
+ * @generated from message memos.api.v1.RestoreMemoRevisionRequest
+ */
+export type RestoreMemoRevisionRequest = Message<"memos.api.v1.RestoreMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision to restore.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RestoreMemoRevisionRequest.
+ * Use `create(RestoreMemoRevisionRequestSchema)` to create a new message.
+ */
+export const RestoreMemoRevisionRequestSchema: GenMessage<RestoreMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 30);
+
+/**
+ * Task is a task list item in a memo, e.g. `- [ ] Buy milk due:2024-05-01`.
+ *
+ * @generated from message memos.api.v1.Task
+ */
+export type Task = Message<"memos.api.v1.Task"> & {
+  /**
+   * The resource name of the memo containing the task.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The position of the task among the tasks of the memo, starting at 0.
+   *
+   * @generated from field: int32 index = 2;
+   */
+  index: number;
+
+  /**
+   * The raw markdown text of the task, without the checkbox and due date.
+   *
+   * @generated from field: string content = 3;
+   */
+  content: string;
+
+  /**
+   * Whether the task is checked.
+   *
+   * @generated from field: bool completed = 4;
+   */
+  completed: boolean;
+
+  /**
+   * The 1-based line number of the task in the memo content.
+   *
+   * @generated from field: int32 line = 5;
+   */
+  line: number;
+
+  /**
+   * The due date written as `due:YYYY-MM-DD` in the task, or empty.
+   *
+   * @generated from field: string due_date = 6;
+   */
+  dueDate: string;
+};
+
+/**
+ * Describes the message memos.api.v1.Task.
+ * Use `create(TaskSchema)` to create a new message.
+ */
+export const TaskSchema: GenMessage<Task> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 31);
+
+/**
+ * @generated from message memos.api.v1.ListTasksRequest
+ */
+export type ListTasksRequest = Message<"memos.api.v1.ListTasksRequest"> & {
+  /**
+   * Optional. Filter to apply to the memos containing the tasks.
+   * Filter is a CEL expression to filter memos.
+   * Refer to `Shortcut.filter`.
+   *
+   * @generated from field: string filter = 1;
+   */
+  filter: string;
+
+  /**
+   * Optional. If true, completed tasks are included in the response.
+   *
+   * @generated from field: bool show_completed = 2;
+   */
+  showCompleted: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.ListTasksRequest.
+ * Use `create(ListTasksRequestSchema)` to create a new message.
+ */
+export const ListTasksRequestSchema: GenMessage<ListTasksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 32);
+
+/**
+ * @generated from message memos.api.v1.ListTasksResponse
+ */
+export type ListTasksResponse = Message<"memos.api.v1.ListTasksResponse"> & {
+  /**
+   * The tasks, grouped by memo in display order and in document order within a memo.
+   *
+   * @generated from field: repeated memos.api.v1.Task tasks = 1;
+   */
+  tasks: Task[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListTasksResponse.
+ * Use `create(ListTasksResponseSchema)` to create a new message.
+ */
+export const ListTasksResponseSchema: GenMessage<ListTasksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 33);
+
+/**
+ * @generated from message memos.api.v1.SetTaskCompletedRequest
+ */
+export type SetTaskCompletedRequest = Message<"memos.api.v1.SetTaskCompletedRequest"> & {
+  /**
+   * Required. The resource name of the memo containing the task.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The index of the task, as returned in `Task.index`.
+   *
+   * @generated from field: int32 index = 2;
+   */
+  index: number;
+
+  /**
+   * Required. Whether the task is checked.
+   *
+   * @generated from field: bool completed = 3;
+   */
+  completed: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.SetTaskCompletedRequest.
+ * Use `create(SetTaskCompletedRequestSchema)` to create a new message.
+ */
+export const SetTaskCompletedRequestSchema: GenMessage<SetTaskCompletedRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 34);
+
+/**
+ * @generated from message memos.api.v1.ArchiveMemoLinkRequest
+ */
+export type ArchiveMemoLinkRequest = Message<"memos.api.v1.ArchiveMemoLinkRequest"> & {
+  /**
+   * Required. The resource name of the memo linking to the page.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The URL of the page to archive. It must be linked from the memo content.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ArchiveMemoLinkRequest.
+ * Use `create(ArchiveMemoLinkRequestSchema)` to create a new message.
+ */
+export const ArchiveMemoLinkRequestSchema: GenMessage<ArchiveMemoLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 35);
+
+/**
+ * @generated from message memos.api.v1.MemoReminder
+ */
+export type MemoReminder = Message<"memos.api.v1.MemoReminder"> & {
+  /**
+   * The resource name of the reminder.
+   * Format: memos/{memo}/reminders/{reminder}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The time of a one-time reminder. The reminder is removed once it fires.
+   * Exactly one of `remind_time` and `cron` must be set.
+   *
+   * @generated from field: google.protobuf.Timestamp remind_time = 2;
+   */
+  remindTime?: Timestamp;
+
+  /**
+   * Optional. The cron expression of a recurring reminder, e.g. "0 9 * * 1" or "@daily".
+   * Times are in the time zone of the memo creator's general setting, or the server time zone if
+   * none is set, unless the expression starts with "CRON_TZ=<zone> ".
+   *
+   * @generated from field: string cron = 3;
+   */
+  cron: string;
+
+  /**
+   * Output only. The next time the reminder fires.
+   *
+   * @generated from field: google.protobuf.Timestamp next_remind_time = 4;
+   */
+  nextRemindTime?: Timestamp;
+
+  /**
+   * Output only. The creation time of the reminder.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 5;
+   */
+  createTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoReminder.
+ * Use `create(MemoReminderSchema)` to create a new message.
+ */
+export const MemoReminderSchema: GenMessage<MemoReminder> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 36);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoReminderRequest
+ */
+export type CreateMemoReminderRequest = Message<"memos.api.v1.CreateMemoReminderRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The reminder to create.
+   *
+   * @generated from field: memos.api.v1.MemoReminder reminder = 2;
+   */
+  reminder?: MemoReminder;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoReminderRequest.
+ * Use `create(CreateMemoReminderRequestSchema)` to create a new message.
+ */
+export const CreateMemoReminderRequestSchema: GenMessage<CreateMemoReminderRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 37);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRemindersRequest
+ */
+export type ListMemoRemindersRequest = Message<"memos.api.v1.ListMemoRemindersRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRemindersRequest.
+ * Use `create(ListMemoRemindersRequestSchema)` to create a new message.
+ */
+export const ListMemoRemindersRequestSchema: GenMessage<ListMemoRemindersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 38);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRemindersResponse
+ */
+export type ListMemoRemindersResponse = Message<"memos.api.v1.ListMemoRemindersResponse"> & {
+  /**
+   * The reminders of the memo, soonest first.
+   *
+   * @generated from field: repeated memos.api.v1.MemoReminder reminders = 1;
+   */
+  reminders: MemoReminder[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRemindersResponse.
+ * Use `create(ListMemoRemindersResponseSchema)` to create a new message.
+ */
+export const ListMemoRemindersResponseSchema: GenMessage<ListMemoRemindersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 39);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoReminderRequest
+ */
+export type UpdateMemoReminderRequest = Message<"memos.api.v1.UpdateMemoReminderRequest"> & {
+  /**
+   * Required. The reminder to update.
+   *
+   * @generated from field: memos.api.v1.MemoReminder reminder = 1;
+   */
+  reminder?: MemoReminder;
+
+  /**
+   * Required. The fields to update. Supported fields: remind_time, cron.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoReminderRequest.
+ * Use `create(UpdateMemoReminderRequestSchema)` to create a new message.
+ */
+export const UpdateMemoReminderRequestSchema: GenMessage<UpdateMemoReminderRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 40);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoReminderRequest
+ */
+export type DeleteMemoReminderRequest = Message<"memos.api.v1.DeleteMemoReminderRequest"> & {
+  /**
+   * Required. The resource name of the reminder.
+   * Format: memos/{memo}/reminders/{reminder}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoReminderRequest.
+ * Use `create(DeleteMemoReminderRequestSchema)` to create a new message.
+ */
+export const DeleteMemoReminderRequestSchema: GenMessage<DeleteMemoReminderRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 41);
+
+/**
+ * @generated from message memos.api.v1.GetMemoDigestRequest
+ */
+export type GetMemoDigestRequest = Message<"memos.api.v1.GetMemoDigestRequest"> & {
+  /**
+   * Optional. The day of the digest in the user's time zone, formatted as YYYY-MM-DD.
+   * Defaults to today.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoDigestRequest.
+ * Use `create(GetMemoDigestRequestSchema)` to create a new message.
+ */
+export const GetMemoDigestRequestSchema: GenMessage<GetMemoDigestRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 42);
+
+/**
+ * @generated from message memos.api.v1.MemoDigest
+ */
+export type MemoDigest = Message<"memos.api.v1.MemoDigest"> & {
+  /**
+   * The day of the digest in the user's time zone, formatted as YYYY-MM-DD.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * The memos created on the same day in previous years, most recent first.
+   *
+   * @generated from field: repeated memos.api.v1.Memo on_this_day_memos = 2;
+   */
+  onThisDayMemos: Memo[];
+
+  /**
+   * The memos tagged #review that are due for review, most recent first.
+   * A memo is due 1, 3, 7, 14 and 30 days after it was created, and then every time
+   * the interval since its creation has doubled again: 60, 120, 240 days and so on.
+   *
+   * @generated from field: repeated memos.api.v1.Memo review_memos = 3;
+   */
+  reviewMemos: Memo[];
+};
+
+/**
+ * Describes the message memos.api.v1.MemoDigest.
+ * Use `create(MemoDigestSchema)` to create a new message.
+ */
+export const MemoDigestSchema: GenMessage<MemoDigest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 43);
 
 /**
  * @generated from enum memos.api.v1.Visibility
@@ -1128,6 +1776,126 @@ export const MemoService: GenService<{
     input: typeof DeleteMemoReactionRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListMemoRevisions lists revisions of a memo, newest first.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoRevisions
+   */
+  listMemoRevisions: {
+    methodKind: "unary";
+    input: typeof ListMemoRevisionsRequestSchema;
+    output: typeof ListMemoRevisionsResponseSchema;
+  },
+  /**
+   * GetMemoRevision gets a revision of a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoRevision
+   */
+  getMemoRevision: {
+    methodKind: "unary";
+    input: typeof GetMemoRevisionRequestSchema;
+    output: typeof MemoRevisionSchema;
+  },
+  /**
+   * DiffMemoRevisions returns a unified diff between two revisions of a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.DiffMemoRevisions
+   */
+  diffMemoRevisions: {
+    methodKind: "unary";
+    input: typeof DiffMemoRevisionsRequestSchema;
+    output: typeof DiffMemoRevisionsResponseSchema;
+  },
+  /**
+   * RestoreMemoRevision restores a memo to the content and visibility of a revision.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RestoreMemoRevision
+   */
+  restoreMemoRevision: {
+    methodKind: "unary";
+    input: typeof RestoreMemoRevisionRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * ListTasks lists the task list items in the current user's memos.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListTasks
+   */
+  listTasks: {
+    methodKind: "unary";
+    input: typeof ListTasksRequestSchema;
+    output: typeof ListTasksResponseSchema;
+  },
+  /**
+   * SetTaskCompleted checks or unchecks a task list item in a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.SetTaskCompleted
+   */
+  setTaskCompleted: {
+    methodKind: "unary";
+    input: typeof SetTaskCompletedRequestSchema;
+    output: typeof TaskSchema;
+  },
+  /**
+   * ArchiveMemoLink saves a readable snapshot of a page linked from a memo as an attachment of the memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ArchiveMemoLink
+   */
+  archiveMemoLink: {
+    methodKind: "unary";
+    input: typeof ArchiveMemoLinkRequestSchema;
+    output: typeof AttachmentSchema;
+  },
+  /**
+   * CreateMemoReminder adds a reminder to a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CreateMemoReminder
+   */
+  createMemoReminder: {
+    methodKind: "unary";
+    input: typeof CreateMemoReminderRequestSchema;
+    output: typeof MemoReminderSchema;
+  },
+  /**
+   * ListMemoReminders lists the reminders of a memo, soonest first.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoReminders
+   */
+  listMemoReminders: {
+    methodKind: "unary";
+    input: typeof ListMemoRemindersRequestSchema;
+    output: typeof ListMemoRemindersResponseSchema;
+  },
+  /**
+   * UpdateMemoReminder updates the time or recurrence of a reminder.
+   *
+   * @generated from rpc memos.api.v1.MemoService.UpdateMemoReminder
+   */
+  updateMemoReminder: {
+    methodKind: "unary";
+    input: typeof UpdateMemoReminderRequestSchema;
+    output: typeof MemoReminderSchema;
+  },
+  /**
+   * DeleteMemoReminder deletes a reminder of a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.DeleteMemoReminder
+   */
+  deleteMemoReminder: {
+    methodKind: "unary";
+    input: typeof DeleteMemoReminderRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetMemoDigest returns the current user's memos to look back at on a day.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoDigest
+   */
+  getMemoDigest: {
+    methodKind: "unary";
+    input: typeof GetMemoDigestRequestSchema;
+    output: typeof MemoDigestSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_memo_service, 0);
 
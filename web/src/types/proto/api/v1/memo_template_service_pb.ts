@@ -0,0 +1,304 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/memo_template_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { MemoSchema, Visibility } from "./memo_service_pb";
+import { file_api_v1_memo_service } from "./memo_service_pb";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/memo_template_service.proto.
+ */
+export const file_api_v1_memo_template_service: GenFile = /*@__PURE__*/
+  fileDesc("CiJhcGkvdjEvbWVtb190ZW1wbGF0ZV9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEioQIKDE1lbW9UZW1wbGF0ZRIRCgRuYW1lGAEgASgJQgPgQQgSEgoFdGl0bGUYAiABKAlCA+BBAhIUCgdjb250ZW50GAMgASgJQgPgQQISMQoKdmlzaWJpbGl0eRgEIAEoDjIYLm1lbW9zLmFwaS52MS5WaXNpYmlsaXR5QgPgQQESEQoEY3JvbhgFIAEoCUID4EEBEjYKDW5leHRfcnVuX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6VupBUwoZbWVtb3MuYXBpLnYxL01lbW9UZW1wbGF0ZRIhdXNlcnMve3VzZXJ9L3RlbXBsYXRlcy97dGVtcGxhdGV9Kgl0ZW1wbGF0ZXMyCHRlbXBsYXRlIk0KGExpc3RNZW1vVGVtcGxhdGVzUmVxdWVzdBIxCgZwYXJlbnQYASABKAlCIeBBAvpBGxIZbWVtb3MuYXBpLnYxL01lbW9UZW1wbGF0ZSJKChlMaXN0TWVtb1RlbXBsYXRlc1Jlc3BvbnNlEi0KCXRlbXBsYXRlcxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vVGVtcGxhdGUiSQoWR2V0TWVtb1RlbXBsYXRlUmVxdWVzdBIvCgRuYW1lGAEgASgJQiHgQQL6QRsKGW1lbW9zLmFwaS52MS9NZW1vVGVtcGxhdGUigQEKGUNyZWF0ZU1lbW9UZW1wbGF0ZVJlcXVlc3QSMQoGcGFyZW50GAEgASgJQiHgQQL6QRsSGW1lbW9zLmFwaS52MS9NZW1vVGVtcGxhdGUSMQoIdGVtcGxhdGUYAiABKAsyGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlQgPgQQIihAEKGVVwZGF0ZU1lbW9UZW1wbGF0ZVJlcXVlc3QSMQoIdGVtcGxhdGUYASABKAsyGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiTAoZRGVsZXRlTWVtb1RlbXBsYXRlUmVxdWVzdBIvCgRuYW1lGAEgASgJQiHgQQL6QRsKGW1lbW9zLmFwaS52MS9NZW1vVGVtcGxhdGUiUAodQ3JlYXRlTWVtb0Zyb21UZW1wbGF0ZVJlcXVlc3QSLwoEbmFtZRgBIAEoCUIh4EEC+kEbChltZW1vcy5hcGkudjEvTWVtb1RlbXBsYXRlMrgHChNNZW1vVGVtcGxhdGVTZXJ2aWNlEpkBChFMaXN0TWVtb1RlbXBsYXRlcxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1RlbXBsYXRlc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9UZW1wbGF0ZXNSZXNwb25zZSIz2kEGcGFyZW50gtPkkwIkEiIvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vdGVtcGxhdGVzEoYBCg9HZXRNZW1vVGVtcGxhdGUSJC5tZW1vcy5hcGkudjEuR2V0TWVtb1RlbXBsYXRlUmVxdWVzdBoaLm1lbW9zLmFwaS52MS5NZW1vVGVtcGxhdGUiMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9dXNlcnMvKi90ZW1wbGF0ZXMvKn0SoQEKEkNyZWF0ZU1lbW9UZW1wbGF0ZRInLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vVGVtcGxhdGVSZXF1ZXN0GhoubWVtb3MuYXBpLnYxLk1lbW9UZW1wbGF0ZSJG2kEPcGFyZW50LHRlbXBsYXRlgtPkkwIuOgh0ZW1wbGF0ZSIiL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3RlbXBsYXRlcxKvAQoSVXBkYXRlTWVtb1RlbXBsYXRlEicubWVtb3MuYXBpLnYxLlVwZGF0ZU1lbW9UZW1wbGF0ZVJlcXVlc3QaGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlIlTaQRR0ZW1wbGF0ZSx1cGRhdGVfbWFza4LT5JMCNzoIdGVtcGxhdGUyKy9hcGkvdjEve3RlbXBsYXRlLm5hbWU9dXNlcnMvKi90ZW1wbGF0ZXMvKn0SiAEKEkRlbGV0ZU1lbW9UZW1wbGF0ZRInLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vVGVtcGxhdGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPXVzZXJzLyovdGVtcGxhdGVzLyp9EpoBChZDcmVhdGVNZW1vRnJvbVRlbXBsYXRlEisubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9Gcm9tVGVtcGxhdGVSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iP9pBBG5hbWWC0+STAjI6ASoiLS9hcGkvdjEve25hbWU9dXNlcnMvKi90ZW1wbGF0ZXMvKn06Y3JlYXRlTWVtb0KwAQoQY29tLm1lbW9zLmFwaS52MUIYTWVtb1RlbXBsYXRlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_memo_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.MemoTemplate
+ */
+export type MemoTemplate = Message<"memos.api.v1.MemoTemplate"> & {
+  /**
+   * The resource name of the memo template.
+   * Format: users/{user}/templates/{template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The title of the memo template.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The content of the memos created from the template.
+   * The placeholders {{date}}, {{time}}, {{weekday}}, {{week}}, {{month}} and {{year}}
+   * are replaced with the time the memo is created at in the time zone of the user,
+   * e.g. `2026-10-16`, `09:30`, `Friday`, `2026-W42`, `2026-10` and `2026`.
+   *
+   * @generated from field: string content = 3;
+   */
+  content: string;
+
+  /**
+   * The visibility of the memos created from the template.
+   * Defaults to private.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 4;
+   */
+  visibility: Visibility;
+
+  /**
+   * A cron expression to create memos from the template on, e.g. `0 9 * * 1-5`.
+   * Supports the standard five fields, descriptors such as `@weekly` and a `CRON_TZ=` prefix.
+   * Without `CRON_TZ=`, the expression is evaluated in the time zone of the user.
+   * Leave empty to only create memos from the template manually.
+   *
+   * @generated from field: string cron = 5;
+   */
+  cron: string;
+
+  /**
+   * Output only. The time the next memo is created from the template.
+   * Unset without a cron expression.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_time = 6;
+   */
+  nextRunTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoTemplate.
+ * Use `create(MemoTemplateSchema)` to create a new message.
+ */
+export const MemoTemplateSchema: GenMessage<MemoTemplate> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListMemoTemplatesRequest
+ */
+export type ListMemoTemplatesRequest = Message<"memos.api.v1.ListMemoTemplatesRequest"> & {
+  /**
+   * Required. The parent resource where memo templates are listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoTemplatesRequest.
+ * Use `create(ListMemoTemplatesRequestSchema)` to create a new message.
+ */
+export const ListMemoTemplatesRequestSchema: GenMessage<ListMemoTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListMemoTemplatesResponse
+ */
+export type ListMemoTemplatesResponse = Message<"memos.api.v1.ListMemoTemplatesResponse"> & {
+  /**
+   * The list of memo templates.
+   *
+   * @generated from field: repeated memos.api.v1.MemoTemplate templates = 1;
+   */
+  templates: MemoTemplate[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoTemplatesResponse.
+ * Use `create(ListMemoTemplatesResponseSchema)` to create a new message.
+ */
+export const ListMemoTemplatesResponseSchema: GenMessage<ListMemoTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 2);
+
+/**
+ * @generated from message memos.api.v1.GetMemoTemplateRequest
+ */
+export type GetMemoTemplateRequest = Message<"memos.api.v1.GetMemoTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template to retrieve.
+   * Format: users/{user}/templates/{template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoTemplateRequest.
+ * Use `create(GetMemoTemplateRequestSchema)` to create a new message.
+ */
+export const GetMemoTemplateRequestSchema: GenMessage<GetMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoTemplateRequest
+ */
+export type CreateMemoTemplateRequest = Message<"memos.api.v1.CreateMemoTemplateRequest"> & {
+  /**
+   * Required. The parent resource where this memo template will be created.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The memo template to create.
+   *
+   * @generated from field: memos.api.v1.MemoTemplate template = 2;
+   */
+  template?: MemoTemplate;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoTemplateRequest.
+ * Use `create(CreateMemoTemplateRequestSchema)` to create a new message.
+ */
+export const CreateMemoTemplateRequestSchema: GenMessage<CreateMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 4);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoTemplateRequest
+ */
+export type UpdateMemoTemplateRequest = Message<"memos.api.v1.UpdateMemoTemplateRequest"> & {
+  /**
+   * Required. The memo template resource which replaces the resource on the server.
+   *
+   * @generated from field: memos.api.v1.MemoTemplate template = 1;
+   */
+  template?: MemoTemplate;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoTemplateRequest.
+ * Use `create(UpdateMemoTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateMemoTemplateRequestSchema: GenMessage<UpdateMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 5);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoTemplateRequest
+ */
+export type DeleteMemoTemplateRequest = Message<"memos.api.v1.DeleteMemoTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template to delete.
+   * Format: users/{user}/templates/{template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoTemplateRequest.
+ * Use `create(DeleteMemoTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteMemoTemplateRequestSchema: GenMessage<DeleteMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 6);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoFromTemplateRequest
+ */
+export type CreateMemoFromTemplateRequest = Message<"memos.api.v1.CreateMemoFromTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template to create the memo from.
+   * Format: users/{user}/templates/{template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoFromTemplateRequest.
+ * Use `create(CreateMemoFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateMemoFromTemplateRequestSchema: GenMessage<CreateMemoFromTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 7);
+
+/**
+ * @generated from service memos.api.v1.MemoTemplateService
+ */
+export const MemoTemplateService: GenService<{
+  /**
+   * ListMemoTemplates returns a list of memo templates for a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.ListMemoTemplates
+   */
+  listMemoTemplates: {
+    methodKind: "unary";
+    input: typeof ListMemoTemplatesRequestSchema;
+    output: typeof ListMemoTemplatesResponseSchema;
+  },
+  /**
+   * GetMemoTemplate gets a memo template by name.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.GetMemoTemplate
+   */
+  getMemoTemplate: {
+    methodKind: "unary";
+    input: typeof GetMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * CreateMemoTemplate creates a new memo template for a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.CreateMemoTemplate
+   */
+  createMemoTemplate: {
+    methodKind: "unary";
+    input: typeof CreateMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * UpdateMemoTemplate updates a memo template for a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.UpdateMemoTemplate
+   */
+  updateMemoTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * DeleteMemoTemplate deletes a memo template for a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.DeleteMemoTemplate
+   */
+  deleteMemoTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteMemoTemplateRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * CreateMemoFromTemplate creates a memo from a memo template, filling in its placeholders.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.CreateMemoFromTemplate
+   */
+  createMemoFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateMemoFromTemplateRequestSchema;
+    output: typeof MemoSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_memo_template_service, 0);
+
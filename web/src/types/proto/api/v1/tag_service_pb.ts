@@ -0,0 +1,352 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/tag_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/tag_service.proto.
+ */
+export const file_api_v1_tag_service: GenFile = /*@__PURE__*/
+  fileDesc("ChhhcGkvdjEvdGFnX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSL1AQoDVGFnEhAKA3RhZxgBIAEoCUID4EECEhcKCm1lbW9fY291bnQYAiABKAVCA+BBAxIdChB0b3RhbF9tZW1vX2NvdW50GAMgASgFQgPgQQMSNwoObGFzdF91c2VkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEgoFY29sb3IYBSABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgGIAEoCUID4EEBEhMKBnBpbm5lZBgHIAEoCEID4EEBEigKCGNoaWxkcmVuGAggAygLMhEubWVtb3MuYXBpLnYxLlRhZ0ID4EEDIhEKD0xpc3RUYWdzUmVxdWVzdCIzChBMaXN0VGFnc1Jlc3BvbnNlEh8KBHRhZ3MYASADKAsyES5tZW1vcy5hcGkudjEuVGFnIm0KEFVwZGF0ZVRhZ1JlcXVlc3QSIwoDdGFnGAEgASgLMhEubWVtb3MuYXBpLnYxLlRhZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlYKEFJlbmFtZVRhZ1JlcXVlc3QSEAoDdGFnGAEgASgJQgPgQQISFAoHbmV3X3RhZxgCIAEoCUID4EECEhoKDXZhbGlkYXRlX29ubHkYAyABKAhCA+BBASIwChFSZW5hbWVUYWdSZXNwb25zZRIbChNhZmZlY3RlZF9tZW1vX2NvdW50GAEgASgFImEKEE1lcmdlVGFnc1JlcXVlc3QSGAoLc291cmNlX3RhZ3MYASADKAlCA+BBAhIXCgp0YXJnZXRfdGFnGAIgASgJQgPgQQISGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBIjAKEU1lcmdlVGFnc1Jlc3BvbnNlEhsKE2FmZmVjdGVkX21lbW9fY291bnQYASABKAUiQAoQRGVsZXRlVGFnUmVxdWVzdBIQCgN0YWcYASABKAlCA+BBAhIaCg12YWxpZGF0ZV9vbmx5GAIgASgIQgPgQQEiMAoRRGVsZXRlVGFnUmVzcG9uc2USGwoTYWZmZWN0ZWRfbWVtb19jb3VudBgBIAEoBTKjBAoKVGFnU2VydmljZRJfCghMaXN0VGFncxIdLm1lbW9zLmFwaS52MS5MaXN0VGFnc1JlcXVlc3QaHi5tZW1vcy5hcGkudjEuTGlzdFRhZ3NSZXNwb25zZSIUgtPkkwIOEgwvYXBpL3YxL3RhZ3MSawoJVXBkYXRlVGFnEh4ubWVtb3MuYXBpLnYxLlVwZGF0ZVRhZ1JlcXVlc3QaES5tZW1vcy5hcGkudjEuVGFnIivaQQ90YWcsdXBkYXRlX21hc2uC0+STAhM6A3RhZzIML2FwaS92MS90YWdzEmwKCVJlbmFtZVRhZxIeLm1lbW9zLmFwaS52MS5SZW5hbWVUYWdSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLlJlbmFtZVRhZ1Jlc3BvbnNlIh6C0+STAhg6ASoiEy9hcGkvdjEvdGFnczpyZW5hbWUSawoJTWVyZ2VUYWdzEh4ubWVtb3MuYXBpLnYxLk1lcmdlVGFnc1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuTWVyZ2VUYWdzUmVzcG9uc2UiHYLT5JMCFzoBKiISL2FwaS92MS90YWdzOm1lcmdlEmwKCURlbGV0ZVRhZxIeLm1lbW9zLmFwaS52MS5EZWxldGVUYWdSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkRlbGV0ZVRhZ1Jlc3BvbnNlIh6C0+STAhg6ASoiEy9hcGkvdjEvdGFnczpkZWxldGVCpwEKEGNvbS5tZW1vcy5hcGkudjFCD1RhZ1NlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.Tag
+ */
+export type Tag = Message<"memos.api.v1.Tag"> & {
+  /**
+   * The tag path without the leading #, e.g. `work/project-a`.
+   *
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Output only. The number of memos using exactly this tag.
+   *
+   * @generated from field: int32 memo_count = 2;
+   */
+  memoCount: number;
+
+  /**
+   * Output only. The number of memos using this tag or any of its nested tags.
+   *
+   * @generated from field: int32 total_memo_count = 3;
+   */
+  totalMemoCount: number;
+
+  /**
+   * Output only. The last update time of the memos using this tag or any of its nested tags.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_time = 4;
+   */
+  lastUsedTime?: Timestamp;
+
+  /**
+   * Optional. The display color of the tag in #RRGGBB format.
+   *
+   * @generated from field: string color = 5;
+   */
+  color: string;
+
+  /**
+   * Optional. The description of the tag.
+   *
+   * @generated from field: string description = 6;
+   */
+  description: string;
+
+  /**
+   * Optional. Whether the tag is pinned.
+   *
+   * @generated from field: bool pinned = 7;
+   */
+  pinned: boolean;
+
+  /**
+   * Output only. The nested tags, e.g. `work/project-a` is a child of `work`.
+   *
+   * @generated from field: repeated memos.api.v1.Tag children = 8;
+   */
+  children: Tag[];
+};
+
+/**
+ * Describes the message memos.api.v1.Tag.
+ * Use `create(TagSchema)` to create a new message.
+ */
+export const TagSchema: GenMessage<Tag> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListTagsRequest
+ */
+export type ListTagsRequest = Message<"memos.api.v1.ListTagsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagsRequest.
+ * Use `create(ListTagsRequestSchema)` to create a new message.
+ */
+export const ListTagsRequestSchema: GenMessage<ListTagsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListTagsResponse
+ */
+export type ListTagsResponse = Message<"memos.api.v1.ListTagsResponse"> & {
+  /**
+   * The top-level tags. Pinned tags come first, then tags are ordered by name.
+   *
+   * @generated from field: repeated memos.api.v1.Tag tags = 1;
+   */
+  tags: Tag[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagsResponse.
+ * Use `create(ListTagsResponseSchema)` to create a new message.
+ */
+export const ListTagsResponseSchema: GenMessage<ListTagsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 2);
+
+/**
+ * @generated from message memos.api.v1.UpdateTagRequest
+ */
+export type UpdateTagRequest = Message<"memos.api.v1.UpdateTagRequest"> & {
+  /**
+   * Required. The tag to update.
+   *
+   * @generated from field: memos.api.v1.Tag tag = 1;
+   */
+  tag?: Tag;
+
+  /**
+   * Required. The list of fields to update: color, description or pinned.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateTagRequest.
+ * Use `create(UpdateTagRequestSchema)` to create a new message.
+ */
+export const UpdateTagRequestSchema: GenMessage<UpdateTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 3);
+
+/**
+ * @generated from message memos.api.v1.RenameTagRequest
+ */
+export type RenameTagRequest = Message<"memos.api.v1.RenameTagRequest"> & {
+  /**
+   * Required. The tag to rename, without the leading #.
+   * Nested tags are renamed as well, e.g. `work/project` becomes `job/project`.
+   *
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Required. The new name of the tag, without the leading #.
+   *
+   * @generated from field: string new_tag = 2;
+   */
+  newTag: string;
+
+  /**
+   * Optional. If true, the affected memos are counted but not updated.
+   *
+   * @generated from field: bool validate_only = 3;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.RenameTagRequest.
+ * Use `create(RenameTagRequestSchema)` to create a new message.
+ */
+export const RenameTagRequestSchema: GenMessage<RenameTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 4);
+
+/**
+ * @generated from message memos.api.v1.RenameTagResponse
+ */
+export type RenameTagResponse = Message<"memos.api.v1.RenameTagResponse"> & {
+  /**
+   * The number of memos that were updated, or would be updated if validate_only is set.
+   *
+   * @generated from field: int32 affected_memo_count = 1;
+   */
+  affectedMemoCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.RenameTagResponse.
+ * Use `create(RenameTagResponseSchema)` to create a new message.
+ */
+export const RenameTagResponseSchema: GenMessage<RenameTagResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 5);
+
+/**
+ * @generated from message memos.api.v1.MergeTagsRequest
+ */
+export type MergeTagsRequest = Message<"memos.api.v1.MergeTagsRequest"> & {
+  /**
+   * Required. The tags to merge, without the leading #.
+   *
+   * @generated from field: repeated string source_tags = 1;
+   */
+  sourceTags: string[];
+
+  /**
+   * Required. The tag to merge into, without the leading #.
+   *
+   * @generated from field: string target_tag = 2;
+   */
+  targetTag: string;
+
+  /**
+   * Optional. If true, the affected memos are counted but not updated.
+   *
+   * @generated from field: bool validate_only = 3;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.MergeTagsRequest.
+ * Use `create(MergeTagsRequestSchema)` to create a new message.
+ */
+export const MergeTagsRequestSchema: GenMessage<MergeTagsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 6);
+
+/**
+ * @generated from message memos.api.v1.MergeTagsResponse
+ */
+export type MergeTagsResponse = Message<"memos.api.v1.MergeTagsResponse"> & {
+  /**
+   * The number of memos that were updated, or would be updated if validate_only is set.
+   *
+   * @generated from field: int32 affected_memo_count = 1;
+   */
+  affectedMemoCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.MergeTagsResponse.
+ * Use `create(MergeTagsResponseSchema)` to create a new message.
+ */
+export const MergeTagsResponseSchema: GenMessage<MergeTagsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 7);
+
+/**
+ * @generated from message memos.api.v1.DeleteTagRequest
+ */
+export type DeleteTagRequest = Message<"memos.api.v1.DeleteTagRequest"> & {
+  /**
+   * Required. The tag to delete, without the leading #.
+   *
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Optional. If true, the affected memos are counted but not updated.
+   *
+   * @generated from field: bool validate_only = 2;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteTagRequest.
+ * Use `create(DeleteTagRequestSchema)` to create a new message.
+ */
+export const DeleteTagRequestSchema: GenMessage<DeleteTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 8);
+
+/**
+ * @generated from message memos.api.v1.DeleteTagResponse
+ */
+export type DeleteTagResponse = Message<"memos.api.v1.DeleteTagResponse"> & {
+  /**
+   * The number of memos that were updated, or would be updated if validate_only is set.
+   *
+   * @generated from field: int32 affected_memo_count = 1;
+   */
+  affectedMemoCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteTagResponse.
+ * Use `create(DeleteTagResponseSchema)` to create a new message.
+ */
+export const DeleteTagResponseSchema: GenMessage<DeleteTagResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 9);
+
+/**
+ * @generated from service memos.api.v1.TagService
+ */
+export const TagService: GenService<{
+  /**
+   * ListTags lists the tags of the current user as a tree of nested tags.
+   *
+   * @generated from rpc memos.api.v1.TagService.ListTags
+   */
+  listTags: {
+    methodKind: "unary";
+    input: typeof ListTagsRequestSchema;
+    output: typeof ListTagsResponseSchema;
+  },
+  /**
+   * UpdateTag updates the color, description and pinned flag of a tag of the current user.
+   *
+   * @generated from rpc memos.api.v1.TagService.UpdateTag
+   */
+  updateTag: {
+    methodKind: "unary";
+    input: typeof UpdateTagRequestSchema;
+    output: typeof TagSchema;
+  },
+  /**
+   * RenameTag renames a tag and its nested tags in all memos of the current user.
+   *
+   * @generated from rpc memos.api.v1.TagService.RenameTag
+   */
+  renameTag: {
+    methodKind: "unary";
+    input: typeof RenameTagRequestSchema;
+    output: typeof RenameTagResponseSchema;
+  },
+  /**
+   * MergeTags renames the source tags to the target tag in all memos of the current user.
+   *
+   * @generated from rpc memos.api.v1.TagService.MergeTags
+   */
+  mergeTags: {
+    methodKind: "unary";
+    input: typeof MergeTagsRequestSchema;
+    output: typeof MergeTagsResponseSchema;
+  },
+  /**
+   * DeleteTag removes a tag and its nested tags from all memos of the current user.
+   *
+   * @generated from rpc memos.api.v1.TagService.DeleteTag
+   */
+  deleteTag: {
+    methodKind: "unary";
+    input: typeof DeleteTagRequestSchema;
+    output: typeof DeleteTagResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_tag_service, 0);
+
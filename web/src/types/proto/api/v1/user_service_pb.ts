@@ -18,7 +18,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/user_service.proto.
  */
 export const file_api_v1_user_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi1gMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIxCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIJCgVBRE1JThACEggKBFVTRVIQAzo36kE0ChFtZW1vcy5hcGkudjEvVXNlchIMdXNlcnMve3VzZXJ9GgRuYW1lKgV1c2VyczIEdXNlciJzChBMaXN0VXNlcnNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBCABKAhCA+BBASJjChFMaXN0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIm0KDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISMgoJcmVhZF9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIogBChFDcmVhdGVVc2VyUmVxdWVzdBIoCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCBuBBAuBBBBIUCgd1c2VyX2lkGAIgASgJQgPgQQESGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBEhcKCnJlcXVlc3RfaWQYBCABKAlCA+BBASKMAQoRVXBkYXRlVXNlclJlcXVlc3QSJQoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISGgoNYWxsb3dfbWlzc2luZxgDIAEoCEID4EEBIlAKEURlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISEgoFZm9yY2UYAiABKAhCA+BBASLYAwoJVXNlclN0YXRzEhEKBG5hbWUYASABKAlCA+BBCBI7ChdtZW1vX2Rpc3BsYXlfdGltZXN0YW1wcxgCIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoPbWVtb190eXBlX3N0YXRzGAMgASgLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5NZW1vVHlwZVN0YXRzEjgKCXRhZ19jb3VudBgEIAMoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuVGFnQ291bnRFbnRyeRIUCgxwaW5uZWRfbWVtb3MYBSADKAkSGAoQdG90YWxfbWVtb19jb3VudBgGIAEoBRovCg1UYWdDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaXwoNTWVtb1R5cGVTdGF0cxISCgpsaW5rX2NvdW50GAEgASgFEhIKCmNvZGVfY291bnQYAiABKAUSEgoKdG9kb19jb3VudBgDIAEoBRISCgp1bmRvX2NvdW50GAQgASgFOj/qQTwKFm1lbW9zLmFwaS52MS9Vc2VyU3RhdHMSDHVzZXJzL3t1c2VyfSoJdXNlclN0YXRzMgl1c2VyU3RhdHMiPgoTR2V0VXNlclN0YXRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIhkKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0IkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMi4AMKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChB3ZWJob29rc19zZXR0aW5nGAUgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLldlYmhvb2tzU2V0dGluZ0gAGlcKDkdlbmVyYWxTZXR0aW5nEhMKBmxvY2FsZRgBIAEoCUID4EEBEhwKD21lbW9fdmlzaWJpbGl0eRgDIAEoCUID4EEBEhIKBXRoZW1lGAQgASgJQgPgQQEaPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIjUKA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESDAoIV0VCSE9PS1MQBDpZ6kFWChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcSH3VzZXJzL3t1c2VyfS9zZXR0aW5ncy97c2V0dGluZ30qDHVzZXJTZXR0aW5nczILdXNlclNldHRpbmdCBwoFdmFsdWUiRwoVR2V0VXNlclNldHRpbmdSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL1VzZXJTZXR0aW5nIoEBChhVcGRhdGVVc2VyU2V0dGluZ1JlcXVlc3QSLwoHc2V0dGluZxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECInUKF0xpc3RVc2VyU2V0dGluZ3NSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEidAoYTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlEisKCHNldHRpbmdzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIvICChNQZXJzb25hbEFjY2Vzc1Rva2VuEhEKBG5hbWUYASABKAlCA+BBCBIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEBEjMKCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARI1CgxsYXN0X3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6jAHqQYgBCiBtZW1vcy5hcGkudjEvUGVyc29uYWxBY2Nlc3NUb2tlbhI5dXNlcnMve3VzZXJ9L3BlcnNvbmFsQWNjZXNzVG9rZW5zL3twZXJzb25hbF9hY2Nlc3NfdG9rZW59KhRwZXJzb25hbEFjY2Vzc1Rva2VuczITcGVyc29uYWxBY2Nlc3NUb2tlbiJ9Ch9MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEikgEKIExpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEkEKFnBlcnNvbmFsX2FjY2Vzc190b2tlbnMYASADKAsyIS5tZW1vcy5hcGkudjEuUGVyc29uYWxBY2Nlc3NUb2tlbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSKFAQogQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESHAoPZXhwaXJlc19pbl9kYXlzGAMgASgFQgPgQQEidAohQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlc3BvbnNlEkAKFXBlcnNvbmFsX2FjY2Vzc190b2tlbhgBIAEoCzIhLm1lbW9zLmFwaS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuEg0KBXRva2VuGAIgASgJIloKIERlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0EjYKBG5hbWUYASABKAlCKOBBAvpBIgogbWVtb3MuYXBpLnYxL1BlcnNvbmFsQWNjZXNzVG9rZW4iqgEKC1VzZXJXZWJob29rEgwKBG5hbWUYASABKAkSCwoDdXJsGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIuChdMaXN0VXNlcldlYmhvb2tzUmVxdWVzdBITCgZwYXJlbnQYASABKAlCA+BBAiJHChhMaXN0VXNlcldlYmhvb2tzUmVzcG9uc2USKwoId2ViaG9va3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siYAoYQ3JlYXRlVXNlcldlYmhvb2tSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEi8KB3dlYmhvb2sYAiABKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tCA+BBAiJ8ChhVcGRhdGVVc2VyV2ViaG9va1JlcXVlc3QSLwoHd2ViaG9vaxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0ID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayItChhEZWxldGVVc2VyV2ViaG9va1JlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIooEChBVc2VyTm90aWZpY2F0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIpCgZzZW5kZXIYAiABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISOgoGc3RhdHVzGAMgASgOMiUubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uU3RhdHVzQgPgQQESNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNgoEdHlwZRgFIAEoDjIjLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLlR5cGVCA+BBAxIdCgthY3Rpdml0eV9pZBgGIAEoBUID4EEBSACIAQEiOgoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgoKBlVOUkVBRBABEgwKCEFSQ0hJVkVEEAIiLgoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEAoMTUVNT19DT01NRU5UEAE6cOpBbQodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24SKXVzZXJzL3t1c2VyfS9ub3RpZmljYXRpb25zL3tub3RpZmljYXRpb259GgRuYW1lKg1ub3RpZmljYXRpb25zMgxub3RpZmljYXRpb25CDgoMX2FjdGl2aXR5X2lkIo8BChxMaXN0VXNlck5vdGlmaWNhdGlvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESEwoGZmlsdGVyGAQgASgJQgPgQQEibwodTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2USNQoNbm90aWZpY2F0aW9ucxgBIAMoCzIeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKQAQodVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QSOQoMbm90aWZpY2F0aW9uGAEgASgLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb25CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9Vc2VyTm90aWZpY2F0aW9uMoMXCgtVc2VyU2VydmljZRJjCglMaXN0VXNlcnMSHi5tZW1vcy5hcGkudjEuTGlzdFVzZXJzUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXNwb25zZSIVgtPkkwIPEg0vYXBpL3YxL3VzZXJzEmIKB0dldFVzZXISHC5tZW1vcy5hcGkudjEuR2V0VXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIl2kEEbmFtZYLT5JMCGBIWL2FwaS92MS97bmFtZT11c2Vycy8qfRJlCgpDcmVhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiItpBBHVzZXKC0+STAhU6BHVzZXIiDS9hcGkvdjEvdXNlcnMSfwoKVXBkYXRlVXNlchIfLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIjzaQRB1c2VyLHVwZGF0ZV9tYXNrgtPkkwIjOgR1c2VyMhsvYXBpL3YxL3t1c2VyLm5hbWU9dXNlcnMvKn0SbAoKRGVsZXRlVXNlchIfLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT11c2Vycy8qfRJ+ChBMaXN0QWxsVXNlclN0YXRzEiUubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL3VzZXJzOnN0YXRzEnoKDEdldFVzZXJTdGF0cxIhLm1lbW9zLmFwaS52MS5HZXRVc2VyU3RhdHNSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJTdGF0cyIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT11c2Vycy8qfTpnZXRTdGF0cxKCAQoOR2V0VXNlclNldHRpbmcSIy5tZW1vcy5hcGkudjEuR2V0VXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIjDaQQRuYW1lgtPkkwIjEiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SqAEKEVVwZGF0ZVVzZXJTZXR0aW5nEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZyJQ2kETc2V0dGluZyx1cGRhdGVfbWFza4LT5JMCNDoHc2V0dGluZzIpL2FwaS92MS97c2V0dGluZy5uYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SlQEKEExpc3RVc2VyU2V0dGluZ3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9zZXR0aW5ncxK5AQoYTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zEi0ubWVtb3MuYXBpLnYxLkxpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QaLi5tZW1vcy5hcGkudjEuTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVzcG9uc2UiPtpBBnBhcmVudILT5JMCLxItL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3BlcnNvbmFsQWNjZXNzVG9rZW5zErYBChlDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuEi4ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXNwb25zZSI4gtPkkwIyOgEqIi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMSoQEKGURlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW4SLi5tZW1vcy5hcGkudjEuRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiPNpBBG5hbWWC0+STAi8qLS9hcGkvdjEve25hbWU9dXNlcnMvKi9wZXJzb25hbEFjY2Vzc1Rva2Vucy8qfRKVAQoQTGlzdFVzZXJXZWJob29rcxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEpsBChFDcmVhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siQ9pBDnBhcmVudCx3ZWJob29rgtPkkwIsOgd3ZWJob29rIiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViaG9va3MSqAEKEVVwZGF0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJQ2kETd2ViaG9vayx1cGRhdGVfbWFza4LT5JMCNDoHd2ViaG9vazIpL2FwaS92MS97d2ViaG9vay5uYW1lPXVzZXJzLyovd2ViaG9va3MvKn0ShQEKEURlbGV0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EqkBChVMaXN0VXNlck5vdGlmaWNhdGlvbnMSKi5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVxdWVzdBorLm1lbW9zLmFwaS52MS5MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZSI32kEGcGFyZW50gtPkkwIoEiYvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbm90aWZpY2F0aW9ucxLLAQoWVXBkYXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uImTaQRhub3RpZmljYXRpb24sdXBkYXRlX21hc2uC0+STAkM6DG5vdGlmaWNhdGlvbjIzL2FwaS92MS97bm90aWZpY2F0aW9uLm5hbWU9dXNlcnMvKi9ub3RpZmljYXRpb25zLyp9EpQBChZEZWxldGVVc2VyTm90aWZpY2F0aW9uEisubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjXaQQRuYW1lgtPkkwIoKiYvYXBpL3YxL3tuYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfUKoAQoQY29tLm1lbW9zLmFwaS52MUIQVXNlclNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi1gMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIxCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIJCgVBRE1JThACEggKBFVTRVIQAzo36kE0ChFtZW1vcy5hcGkudjEvVXNlchIMdXNlcnMve3VzZXJ9GgRuYW1lKgV1c2VyczIEdXNlciJzChBMaXN0VXNlcnNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBCABKAhCA+BBASJjChFMaXN0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIm0KDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISMgoJcmVhZF9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIogBChFDcmVhdGVVc2VyUmVxdWVzdBIoCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCBuBBAuBBBBIUCgd1c2VyX2lkGAIgASgJQgPgQQESGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBEhcKCnJlcXVlc3RfaWQYBCABKAlCA+BBASKMAQoRVXBkYXRlVXNlclJlcXVlc3QSJQoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISGgoNYWxsb3dfbWlzc2luZxgDIAEoCEID4EEBIlAKEURlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISEgoFZm9yY2UYAiABKAhCA+BBASLYAwoJVXNlclN0YXRzEhEKBG5hbWUYASABKAlCA+BBCBI7ChdtZW1vX2Rpc3BsYXlfdGltZXN0YW1wcxgCIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoPbWVtb190eXBlX3N0YXRzGAMgASgLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5NZW1vVHlwZVN0YXRzEjgKCXRhZ19jb3VudBgEIAMoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuVGFnQ291bnRFbnRyeRIUCgxwaW5uZWRfbWVtb3MYBSADKAkSGAoQdG90YWxfbWVtb19jb3VudBgGIAEoBRovCg1UYWdDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaXwoNTWVtb1R5cGVTdGF0cxISCgpsaW5rX2NvdW50GAEgASgFEhIKCmNvZGVfY291bnQYAiABKAUSEgoKdG9kb19jb3VudBgDIAEoBRISCgp1bmRvX2NvdW50GAQgASgFOj/qQTwKFm1lbW9zLmFwaS52MS9Vc2VyU3RhdHMSDHVzZXJzL3t1c2VyfSoJdXNlclN0YXRzMgl1c2VyU3RhdHMiPgoTR2V0VXNlclN0YXRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIhkKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0IkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMiqAUKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChB3ZWJob29rc19zZXR0aW5nGAUgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLldlYmhvb2tzU2V0dGluZ0gAEk0KFG5vdGlmaWNhdGlvbl9zZXR0aW5nGAYgASgLMi0ubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLk5vdGlmaWNhdGlvblNldHRpbmdIABpuCg5HZW5lcmFsU2V0dGluZxITCgZsb2NhbGUYASABKAlCA+BBARIcCg9tZW1vX3Zpc2liaWxpdHkYAyABKAlCA+BBARISCgV0aGVtZRgEIAEoCUID4EEBEhUKCHRpbWV6b25lGAUgASgJQgPgQQEaPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rGk4KE05vdGlmaWNhdGlvblNldHRpbmcSGgoNZW1haWxfZW5hYmxlZBgBIAEoCEID4EEBEhsKDmRpZ2VzdF9lbmFibGVkGAIgASgIQgPgQQEiRwoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARIMCghXRUJIT09LUxAEEhAKDE5PVElGSUNBVElPThAFOlnqQVYKGG1lbW9zLmFwaS52MS9Vc2VyU2V0dGluZxIfdXNlcnMve3VzZXJ9L3NldHRpbmdzL3tzZXR0aW5nfSoMdXNlclNldHRpbmdzMgt1c2VyU2V0dGluZ0IHCgV2YWx1ZSJHChVHZXRVc2VyU2V0dGluZ1JlcXVlc3QSLgoEbmFtZRgBIAEoCUIg4EEC+kEaChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcigQEKGFVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBIvCgdzZXR0aW5nGAEgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIidQoXTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJ0ChhMaXN0VXNlclNldHRpbmdzUmVzcG9uc2USKwoIc2V0dGluZ3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUi8gIKE1BlcnNvbmFsQWNjZXNzVG9rZW4SEQoEbmFtZRgBIAEoCUID4EEIEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESMwoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEjUKDGxhc3RfdXNlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzqMAepBiAEKIG1lbW9zLmFwaS52MS9QZXJzb25hbEFjY2Vzc1Rva2VuEjl1c2Vycy97dXNlcn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMve3BlcnNvbmFsX2FjY2Vzc190b2tlbn0qFHBlcnNvbmFsQWNjZXNzVG9rZW5zMhNwZXJzb25hbEFjY2Vzc1Rva2VuIn0KH0xpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASKSAQogTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVzcG9uc2USQQoWcGVyc29uYWxfYWNjZXNzX3Rva2VucxgBIAMoCzIhLm1lbW9zLmFwaS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIoUBCiBDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISGAoLZGVzY3JpcHRpb24YAiABKAlCA+BBARIcCg9leHBpcmVzX2luX2RheXMYAyABKAVCA+BBASJ0CiFDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVzcG9uc2USQAoVcGVyc29uYWxfYWNjZXNzX3Rva2VuGAEgASgLMiEubWVtb3MuYXBpLnYxLlBlcnNvbmFsQWNjZXNzVG9rZW4SDQoFdG9rZW4YAiABKAkiWgogRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSNgoEbmFtZRgBIAEoCUIo4EEC+kEiCiBtZW1vcy5hcGkudjEvUGVyc29uYWxBY2Nlc3NUb2tlbiLZAQoNVXNlclR3b0ZhY3RvchIRCgRuYW1lGAEgASgJQgPgQQgSFAoHZW5hYmxlZBgCIAEoCEID4EEDEjQKC2VuYWJsZV90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEiUKGHJlbWFpbmluZ19yZWNvdmVyeV9jb2RlcxgEIAEoBUID4EEDOkLqQT8KGm1lbW9zLmFwaS52MS9Vc2VyVHdvRmFjdG9yEhZ1c2Vycy97dXNlcn0vdHdvRmFjdG9yMgl0d29GYWN0b3IiSwoXR2V0VXNlclR3b0ZhY3RvclJlcXVlc3QSMAoEbmFtZRgBIAEoCUIi4EEC+kEcChptZW1vcy5hcGkudjEvVXNlclR3b0ZhY3RvciJNChlTZXR1cFVzZXJUd29GYWN0b3JSZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJUd29GYWN0b3IiQQoaU2V0dXBVc2VyVHdvRmFjdG9yUmVzcG9uc2USDgoGc2VjcmV0GAEgASgJEhMKC290cGF1dGhfdXJpGAIgASgJImEKGkVuYWJsZVVzZXJUd29GYWN0b3JSZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJUd29GYWN0b3ISEQoEY29kZRgCIAEoCUID4EECImYKG0VuYWJsZVVzZXJUd29GYWN0b3JSZXNwb25zZRIvCgp0d29fZmFjdG9yGAEgASgLMhsubWVtb3MuYXBpLnYxLlVzZXJUd29GYWN0b3ISFgoOcmVjb3ZlcnlfY29kZXMYAiADKAkiYgobRGlzYWJsZVVzZXJUd29GYWN0b3JSZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJUd29GYWN0b3ISEQoEY29kZRgCIAEoCUID4EEBIokCCgdQYXNza2V5EhEKBG5hbWUYASABKAlCA+BBCBIUCgxkaXNwbGF5X25hbWUYAiABKAkSFwoKdHJhbnNwb3J0cxgDIAMoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjcKDmxhc3RfdXNlZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOk3qQUoKFG1lbW9zLmFwaS52MS9QYXNza2V5Eh91c2Vycy97dXNlcn0vcGFzc2tleXMve3Bhc3NrZXl9KghwYXNza2V5czIHcGFzc2tleSJEChdMaXN0VXNlclBhc3NrZXlzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXIiQwoYTGlzdFVzZXJQYXNza2V5c1Jlc3BvbnNlEicKCHBhc3NrZXlzGAEgAygLMhUubWVtb3MuYXBpLnYxLlBhc3NrZXkiRgoYRGVsZXRlVXNlclBhc3NrZXlSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUbWVtb3MuYXBpLnYxL1Bhc3NrZXkiyAMKC1VzZXJXZWJob29rEgwKBG5hbWUYASABKAkSCwoDdXJsGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxITCgZzZWNyZXQYBiABKAlCA+BBAxIbCg5hY3Rpdml0eV90eXBlcxgHIAMoCUID4EEBEhMKBmZpbHRlchgIIAEoCUID4EEBEkQKDnBheWxvYWRfZm9ybWF0GAkgASgOMicubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rLlBheWxvYWRGb3JtYXRCA+BBARIdChBwYXlsb2FkX3RlbXBsYXRlGAogASgJQgPgQQEicAoNUGF5bG9hZEZvcm1hdBIeChpQQVlMT0FEX0ZPUk1BVF9VTlNQRUNJRklFRBAAEgkKBU1FTU9TEAESCQoFU0xBQ0sQAhILCgdESVNDT1JEEAMSDgoKTUFUVEVSTU9TVBAEEgwKCFRFTVBMQVRFEAUiLgoXTGlzdFVzZXJXZWJob29rc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQIiRwoYTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rImAKGENyZWF0ZVVzZXJXZWJob29rUmVxdWVzdBITCgZwYXJlbnQYASABKAlCA+BBAhIvCgd3ZWJob29rGAIgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQIifAoYVXBkYXRlVXNlcldlYmhvb2tSZXF1ZXN0Ei8KB3dlYmhvb2sYASABKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siLQoYRGVsZXRlVXNlcldlYmhvb2tSZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAiL6AwoTVXNlcldlYmhvb2tEZWxpdmVyeRIRCgRuYW1lGAEgASgJQgPgQQgSGgoNYWN0aXZpdHlfdHlwZRgCIAEoCUID4EEDEhAKA3VybBgDIAEoCUID4EEDEhQKB3BheWxvYWQYBCABKAlCA+BBAxI7CgVzdGF0ZRgFIAEoDjInLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0RlbGl2ZXJ5LlN0YXRlQgPgQQMSFQoIYXR0ZW1wdHMYBiABKAVCA+BBAxIYCgtzdGF0dXNfY29kZRgHIAEoBUID4EEDEhoKDXJlc3BvbnNlX2JvZHkYCCABKAlCA+BBAxISCgVlcnJvchgJIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjoKEW5leHRfYXR0ZW1wdF90aW1lGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIkYKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEg0KCVNVQ0NFRURFRBACEgoKBkZBSUxFRBADImgKIExpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJzCiFMaXN0VXNlcldlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2USNQoKZGVsaXZlcmllcxgBIAMoCzIhLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0RlbGl2ZXJ5EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI4CiNSZWRlbGl2ZXJVc2VyV2ViaG9va0RlbGl2ZXJ5UmVxdWVzdBIRCgRuYW1lGAEgASgJQgPgQQIisQQKEFVzZXJOb3RpZmljYXRpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEikKBnNlbmRlchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI6CgZzdGF0dXMYAyABKA4yJS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5TdGF0dXNCA+BBARI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI2CgR0eXBlGAUgASgOMiMubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uVHlwZUID4EEDEh0KC2FjdGl2aXR5X2lkGAYgASgFQgPgQQFIAIgBASI6CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCgoGVU5SRUFEEAESDAoIQVJDSElWRUQQAiJVCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQARILCgdNRU5USU9OEAISDAoIUkVNSU5ERVIQAxIKCgZESUdFU1QQBDpw6kFtCh1tZW1vcy5hcGkudjEvVXNlck5vdGlmaWNhdGlvbhIpdXNlcnMve3VzZXJ9L25vdGlmaWNhdGlvbnMve25vdGlmaWNhdGlvbn0aBG5hbWUqDW5vdGlmaWNhdGlvbnMyDG5vdGlmaWNhdGlvbkIOCgxfYWN0aXZpdHlfaWQijwEKHExpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARITCgZmaWx0ZXIYBCABKAlCA+BBASJvCh1MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIpABCh1VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBI5Cgxub3RpZmljYXRpb24YASABKAsyHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbkID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24ymSEKC1VzZXJTZXJ2aWNlEmMKCUxpc3RVc2VycxIeLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlIhWC0+STAg8SDS9hcGkvdjEvdXNlcnMSYgoHR2V0VXNlchIcLm1lbW9zLmFwaS52MS5HZXRVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9EmUKCkNyZWF0ZVVzZXISHy5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIi2kEEdXNlcoLT5JMCFToEdXNlciINL2FwaS92MS91c2VycxJ/CgpVcGRhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiPNpBEHVzZXIsdXBkYXRlX21hc2uC0+STAiM6BHVzZXIyGy9hcGkvdjEve3VzZXIubmFtZT11c2Vycy8qfRJsCgpEZWxldGVVc2VyEh8ubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiXaQQRuYW1lgtPkkwIYKhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9En4KEExpc3RBbGxVc2VyU3RhdHMSJS5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1Jlc3BvbnNlIhuC0+STAhUSEy9hcGkvdjEvdXNlcnM6c3RhdHMSegoMR2V0VXNlclN0YXRzEiEubWVtb3MuYXBpLnYxLkdldFVzZXJTdGF0c1JlcXVlc3QaFy5tZW1vcy5hcGkudjEuVXNlclN0YXRzIi7aQQRuYW1lgtPkkwIhEh8vYXBpL3YxL3tuYW1lPXVzZXJzLyp9OmdldFN0YXRzEoIBCg5HZXRVc2VyU2V0dGluZxIjLm1lbW9zLmFwaS52MS5HZXRVc2VyU2V0dGluZ1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmciMNpBBG5hbWWC0+STAiMSIS9hcGkvdjEve25hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKoAQoRVXBkYXRlVXNlclNldHRpbmcSJi5tZW1vcy5hcGkudjEuVXBkYXRlVXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIlDaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI0OgdzZXR0aW5nMikvYXBpL3YxL3tzZXR0aW5nLm5hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKVAQoQTGlzdFVzZXJTZXR0aW5ncxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3NldHRpbmdzErkBChhMaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnMSLS5tZW1vcy5hcGkudjEuTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVxdWVzdBouLm1lbW9zLmFwaS52MS5MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXNwb25zZSI+2kEGcGFyZW50gtPkkwIvEi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMStgEKGUNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW4SLi5tZW1vcy5hcGkudjEuQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaLy5tZW1vcy5hcGkudjEuQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlc3BvbnNlIjiC0+STAjI6ASoiLS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9wZXJzb25hbEFjY2Vzc1Rva2VucxKhAQoZRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlbhIuLm1lbW9zLmFwaS52MS5EZWxldGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI82kEEbmFtZYLT5JMCLyotL2FwaS92MS97bmFtZT11c2Vycy8qL3BlcnNvbmFsQWNjZXNzVG9rZW5zLyp9EocBChBHZXRVc2VyVHdvRmFjdG9yEiUubWVtb3MuYXBpLnYxLkdldFVzZXJUd29GYWN0b3JSZXF1ZXN0GhsubWVtb3MuYXBpLnYxLlVzZXJUd29GYWN0b3IiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9dXNlcnMvKi90d29GYWN0b3J9EqEBChJTZXR1cFVzZXJUd29GYWN0b3ISJy5tZW1vcy5hcGkudjEuU2V0dXBVc2VyVHdvRmFjdG9yUmVxdWVzdBooLm1lbW9zLmFwaS52MS5TZXR1cFVzZXJUd29GYWN0b3JSZXNwb25zZSI42kEEbmFtZYLT5JMCKzoBKiImL2FwaS92MS97bmFtZT11c2Vycy8qL3R3b0ZhY3Rvcn06c2V0dXASqgEKE0VuYWJsZVVzZXJUd29GYWN0b3ISKC5tZW1vcy5hcGkudjEuRW5hYmxlVXNlclR3b0ZhY3RvclJlcXVlc3QaKS5tZW1vcy5hcGkudjEuRW5hYmxlVXNlclR3b0ZhY3RvclJlc3BvbnNlIj7aQQluYW1lLGNvZGWC0+STAiw6ASoiJy9hcGkvdjEve25hbWU9dXNlcnMvKi90d29GYWN0b3J9OmVuYWJsZRKVAQoURGlzYWJsZVVzZXJUd29GYWN0b3ISKS5tZW1vcy5hcGkudjEuRGlzYWJsZVVzZXJUd29GYWN0b3JSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjraQQRuYW1lgtPkkwItOgEqIigvYXBpL3YxL3tuYW1lPXVzZXJzLyovdHdvRmFjdG9yfTpkaXNhYmxlEpUBChBMaXN0VXNlclBhc3NrZXlzEiUubWVtb3MuYXBpLnYxLkxpc3RVc2VyUGFzc2tleXNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RVc2VyUGFzc2tleXNSZXNwb25zZSIy2kEGcGFyZW50gtPkkwIjEiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGFzc2tleXMShQEKEURlbGV0ZVVzZXJQYXNza2V5EiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJQYXNza2V5UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3Bhc3NrZXlzLyp9EpUBChBMaXN0VXNlcldlYmhvb2tzEiUubWVtb3MuYXBpLnYxLkxpc3RVc2VyV2ViaG9va3NSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RVc2VyV2ViaG9va3NSZXNwb25zZSIy2kEGcGFyZW50gtPkkwIjEiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViaG9va3MSmwEKEUNyZWF0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJXZWJob29rUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJD2kEOcGFyZW50LHdlYmhvb2uC0+STAiw6B3dlYmhvb2siIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS93ZWJob29rcxKoAQoRVXBkYXRlVXNlcldlYmhvb2sSJi5tZW1vcy5hcGkudjEuVXBkYXRlVXNlcldlYmhvb2tSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIlDaQRN3ZWJob29rLHVwZGF0ZV9tYXNrgtPkkwI0Ogd3ZWJob29rMikvYXBpL3YxL3t3ZWJob29rLm5hbWU9dXNlcnMvKi93ZWJob29rcy8qfRKFAQoRRGVsZXRlVXNlcldlYmhvb2sSJi5tZW1vcy5hcGkudjEuRGVsZXRlVXNlcldlYmhvb2tSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjDaQQRuYW1lgtPkkwIjKiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovd2ViaG9va3MvKn0SvQEKGUxpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXMSLi5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rRGVsaXZlcmllc1JlcXVlc3QaLy5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlIj/aQQZwYXJlbnSC0+STAjASLi9hcGkvdjEve3BhcmVudD11c2Vycy8qL3dlYmhvb2tzLyp9L2RlbGl2ZXJpZXMSwAEKHFJlZGVsaXZlclVzZXJXZWJob29rRGVsaXZlcnkSMS5tZW1vcy5hcGkudjEuUmVkZWxpdmVyVXNlcldlYmhvb2tEZWxpdmVyeVJlcXVlc3QaIS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tEZWxpdmVyeSJK2kEEbmFtZYLT5JMCPToBKiI4L2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyovZGVsaXZlcmllcy8qfTpyZWRlbGl2ZXISqQEKFUxpc3RVc2VyTm90aWZpY2F0aW9ucxIqLm1lbW9zLmFwaS52MS5MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXF1ZXN0GisubWVtb3MuYXBpLnYxLkxpc3RVc2VyTm90aWZpY2F0aW9uc1Jlc3BvbnNlIjfaQQZwYXJlbnSC0+STAigSJi9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9ub3RpZmljYXRpb25zEssBChZVcGRhdGVVc2VyTm90aWZpY2F0aW9uEisubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24iZNpBGG5vdGlmaWNhdGlvbix1cGRhdGVfbWFza4LT5JMCQzoMbm90aWZpY2F0aW9uMjMvYXBpL3YxL3tub3RpZmljYXRpb24ubmFtZT11c2Vycy8qL25vdGlmaWNhdGlvbnMvKn0SlAEKFkRlbGV0ZVVzZXJOb3RpZmljYXRpb24SKy5tZW1vcy5hcGkudjEuRGVsZXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNdpBBG5hbWWC0+STAigqJi9hcGkvdjEve25hbWU9dXNlcnMvKi9ub3RpZmljYXRpb25zLyp9QqgBChBjb20ubWVtb3MuYXBpLnYxQhBVc2VyU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.User
@@ -532,6 +532,12 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
      */
     value: UserSetting_WebhooksSetting;
     case: "webhooksSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.NotificationSetting notification_setting = 6;
+     */
+    value: UserSetting_NotificationSetting;
+    case: "notificationSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -570,6 +576,14 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
    * @generated from field: string theme = 4;
    */
   theme: string;
+
+  /**
+   * The time zone of the user as an IANA name, e.g. "Europe/Berlin".
+   * If not set, the time zone of the server is used.
+   *
+   * @generated from field: string timezone = 5;
+   */
+  timezone: string;
 };
 
 /**
@@ -600,6 +614,37 @@ export type UserSetting_WebhooksSetting = Message<"memos.api.v1.UserSetting.Webh
 export const UserSetting_WebhooksSettingSchema: GenMessage<UserSetting_WebhooksSetting> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 11, 1);
 
+/**
+ * User notification preferences.
+ *
+ * @generated from message memos.api.v1.UserSetting.NotificationSetting
+ */
+export type UserSetting_NotificationSetting = Message<"memos.api.v1.UserSetting.NotificationSetting"> & {
+  /**
+   * Whether to receive inbox notifications by email.
+   * Requires email notifications to be configured on the instance.
+   *
+   * @generated from field: bool email_enabled = 1;
+   */
+  emailEnabled: boolean;
+
+  /**
+   * Whether to receive a daily digest of the memos created on the same day in previous years
+   * and the memos tagged #review that are due for review.
+   * The digest is delivered to the inbox, and by email if email_enabled is set.
+   *
+   * @generated from field: bool digest_enabled = 2;
+   */
+  digestEnabled: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.NotificationSetting.
+ * Use `create(UserSetting_NotificationSettingSchema)` to create a new message.
+ */
+export const UserSetting_NotificationSettingSchema: GenMessage<UserSetting_NotificationSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 11, 2);
+
 /**
  * Enumeration of user setting keys.
  *
@@ -624,6 +669,13 @@ export enum UserSetting_Key {
    * @generated from enum value: WEBHOOKS = 4;
    */
   WEBHOOKS = 4,
+
+  /**
+   * NOTIFICATION is the key for user notification preferences.
+   *
+   * @generated from enum value: NOTIFICATION = 5;
+   */
+  NOTIFICATION = 5,
 }
 
 /**
@@ -953,6 +1005,304 @@ export type DeletePersonalAccessTokenRequest = Message<"memos.api.v1.DeletePerso
 export const DeletePersonalAccessTokenRequestSchema: GenMessage<DeletePersonalAccessTokenRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 21);
 
+/**
+ * UserTwoFactor is the two-factor authentication status of a user.
+ *
+ * @generated from message memos.api.v1.UserTwoFactor
+ */
+export type UserTwoFactor = Message<"memos.api.v1.UserTwoFactor"> & {
+  /**
+   * The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Output only. Whether sign-in with a password requires a TOTP or recovery code.
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Output only. When two-factor authentication was enabled.
+   *
+   * @generated from field: google.protobuf.Timestamp enable_time = 3;
+   */
+  enableTime?: Timestamp;
+
+  /**
+   * Output only. The number of unused recovery codes.
+   *
+   * @generated from field: int32 remaining_recovery_codes = 4;
+   */
+  remainingRecoveryCodes: number;
+};
+
+/**
+ * Describes the message memos.api.v1.UserTwoFactor.
+ * Use `create(UserTwoFactorSchema)` to create a new message.
+ */
+export const UserTwoFactorSchema: GenMessage<UserTwoFactor> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 22);
+
+/**
+ * @generated from message memos.api.v1.GetUserTwoFactorRequest
+ */
+export type GetUserTwoFactorRequest = Message<"memos.api.v1.GetUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserTwoFactorRequest.
+ * Use `create(GetUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const GetUserTwoFactorRequestSchema: GenMessage<GetUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 23);
+
+/**
+ * @generated from message memos.api.v1.SetupUserTwoFactorRequest
+ */
+export type SetupUserTwoFactorRequest = Message<"memos.api.v1.SetupUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SetupUserTwoFactorRequest.
+ * Use `create(SetupUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const SetupUserTwoFactorRequestSchema: GenMessage<SetupUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 24);
+
+/**
+ * @generated from message memos.api.v1.SetupUserTwoFactorResponse
+ */
+export type SetupUserTwoFactorResponse = Message<"memos.api.v1.SetupUserTwoFactorResponse"> & {
+  /**
+   * The base32 encoded TOTP secret, for manual entry in an authenticator app.
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * The otpauth:// URI of the secret, usually shown as a QR code.
+   *
+   * @generated from field: string otpauth_uri = 2;
+   */
+  otpauthUri: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SetupUserTwoFactorResponse.
+ * Use `create(SetupUserTwoFactorResponseSchema)` to create a new message.
+ */
+export const SetupUserTwoFactorResponseSchema: GenMessage<SetupUserTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 25);
+
+/**
+ * @generated from message memos.api.v1.EnableUserTwoFactorRequest
+ */
+export type EnableUserTwoFactorRequest = Message<"memos.api.v1.EnableUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. A current TOTP code generated from the pending secret.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.EnableUserTwoFactorRequest.
+ * Use `create(EnableUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const EnableUserTwoFactorRequestSchema: GenMessage<EnableUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 26);
+
+/**
+ * @generated from message memos.api.v1.EnableUserTwoFactorResponse
+ */
+export type EnableUserTwoFactorResponse = Message<"memos.api.v1.EnableUserTwoFactorResponse"> & {
+  /**
+   * The two-factor authentication status.
+   *
+   * @generated from field: memos.api.v1.UserTwoFactor two_factor = 1;
+   */
+  twoFactor?: UserTwoFactor;
+
+  /**
+   * One-time recovery codes to sign in without the authenticator app.
+   * This is the only time the recovery codes will be visible.
+   *
+   * @generated from field: repeated string recovery_codes = 2;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.EnableUserTwoFactorResponse.
+ * Use `create(EnableUserTwoFactorResponseSchema)` to create a new message.
+ */
+export const EnableUserTwoFactorResponseSchema: GenMessage<EnableUserTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 27);
+
+/**
+ * @generated from message memos.api.v1.DisableUserTwoFactorRequest
+ */
+export type DisableUserTwoFactorRequest = Message<"memos.api.v1.DisableUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * A current TOTP code or an unused recovery code.
+   * Required unless an admin resets the two-factor authentication of another user.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DisableUserTwoFactorRequest.
+ * Use `create(DisableUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const DisableUserTwoFactorRequestSchema: GenMessage<DisableUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 28);
+
+/**
+ * @generated from message memos.api.v1.Passkey
+ */
+export type Passkey = Message<"memos.api.v1.Passkey"> & {
+  /**
+   * The resource name of the passkey.
+   * Format: users/{user}/passkeys/{passkey}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The name to recognize the passkey by.
+   *
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * Output only. The transports of the passkey, e.g. "internal" or "usb".
+   *
+   * @generated from field: repeated string transports = 3;
+   */
+  transports: string[];
+
+  /**
+   * Output only. The registration timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * Output only. The last sign-in timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_time = 5;
+   */
+  lastUsedTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.Passkey.
+ * Use `create(PasskeySchema)` to create a new message.
+ */
+export const PasskeySchema: GenMessage<Passkey> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 29);
+
+/**
+ * @generated from message memos.api.v1.ListUserPasskeysRequest
+ */
+export type ListUserPasskeysRequest = Message<"memos.api.v1.ListUserPasskeysRequest"> & {
+  /**
+   * Required. The parent resource whose passkeys will be listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPasskeysRequest.
+ * Use `create(ListUserPasskeysRequestSchema)` to create a new message.
+ */
+export const ListUserPasskeysRequestSchema: GenMessage<ListUserPasskeysRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 30);
+
+/**
+ * @generated from message memos.api.v1.ListUserPasskeysResponse
+ */
+export type ListUserPasskeysResponse = Message<"memos.api.v1.ListUserPasskeysResponse"> & {
+  /**
+   * The list of passkeys.
+   *
+   * @generated from field: repeated memos.api.v1.Passkey passkeys = 1;
+   */
+  passkeys: Passkey[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPasskeysResponse.
+ * Use `create(ListUserPasskeysResponseSchema)` to create a new message.
+ */
+export const ListUserPasskeysResponseSchema: GenMessage<ListUserPasskeysResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 31);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserPasskeyRequest
+ */
+export type DeleteUserPasskeyRequest = Message<"memos.api.v1.DeleteUserPasskeyRequest"> & {
+  /**
+   * Required. The resource name of the passkey to delete.
+   * Format: users/{user}/passkeys/{passkey}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserPasskeyRequest.
+ * Use `create(DeleteUserPasskeyRequestSchema)` to create a new message.
+ */
+export const DeleteUserPasskeyRequestSchema: GenMessage<DeleteUserPasskeyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 32);
+
 /**
  * UserWebhook represents a webhook owned by a user.
  *
@@ -994,6 +1344,51 @@ export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
    * @generated from field: google.protobuf.Timestamp update_time = 5;
    */
   updateTime?: Timestamp;
+
+  /**
+   * The secret used to sign webhook requests with HMAC-SHA256.
+   * Only returned when the webhook is created or its secret is rotated.
+   *
+   * @generated from field: string secret = 6;
+   */
+  secret: string;
+
+  /**
+   * Optional. The activity types the webhook subscribes to, e.g. "memos.memo.created".
+   * Supported types: memos.memo.created, memos.memo.updated, memos.memo.deleted,
+   * memos.memo.visibility.changed, memos.memo.comment.created, memos.memo.reaction.added,
+   * memos.memo.reaction.removed, memos.memo.reminder and memos.attachment.created.
+   * If empty, the webhook receives memos.memo.created, memos.memo.updated and memos.memo.deleted.
+   *
+   * @generated from field: repeated string activity_types = 7;
+   */
+  activityTypes: string[];
+
+  /**
+   * Optional. A CEL expression using the memo filter syntax, e.g. `tag in ["deploy"]`.
+   * The webhook only fires for activities whose memo matches the filter.
+   * Activities without a memo, such as attachments not linked to a memo, never match a filter.
+   *
+   * @generated from field: string filter = 8;
+   */
+  filter: string;
+
+  /**
+   * Optional. The format of the request body.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.PayloadFormat payload_format = 9;
+   */
+  payloadFormat: UserWebhook_PayloadFormat;
+
+  /**
+   * Optional. A Go text/template rendering the request body when payload_format is TEMPLATE.
+   * The template receives the payload fields (.ActivityType, .Creator, .Memo, ...) along with
+   * .Title, .Snippet, .Link and .Tags, and may use the `json` and `join` functions.
+   * The body is sent as application/json if it is valid JSON and as text/plain otherwise.
+   *
+   * @generated from field: string payload_template = 10;
+   */
+  payloadTemplate: string;
 };
 
 /**
@@ -1001,7 +1396,60 @@ export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
  * Use `create(UserWebhookSchema)` to create a new message.
  */
 export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 22);
+  messageDesc(file_api_v1_user_service, 33);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhook.PayloadFormat
+ */
+export enum UserWebhook_PayloadFormat {
+  /**
+   * Defaults to MEMOS.
+   *
+   * @generated from enum value: PAYLOAD_FORMAT_UNSPECIFIED = 0;
+   */
+  PAYLOAD_FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * The Memos JSON payload with the activity type, creator and memo.
+   *
+   * @generated from enum value: MEMOS = 1;
+   */
+  MEMOS = 1,
+
+  /**
+   * A Slack incoming webhook message.
+   *
+   * @generated from enum value: SLACK = 2;
+   */
+  SLACK = 2,
+
+  /**
+   * A Discord webhook message.
+   *
+   * @generated from enum value: DISCORD = 3;
+   */
+  DISCORD = 3,
+
+  /**
+   * A Mattermost incoming webhook message.
+   *
+   * @generated from enum value: MATTERMOST = 4;
+   */
+  MATTERMOST = 4,
+
+  /**
+   * A body rendered from payload_template.
+   *
+   * @generated from enum value: TEMPLATE = 5;
+   */
+  TEMPLATE = 5,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhook.PayloadFormat.
+ */
+export const UserWebhook_PayloadFormatSchema: GenEnum<UserWebhook_PayloadFormat> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 33, 0);
 
 /**
  * @generated from message memos.api.v1.ListUserWebhooksRequest
@@ -1021,7 +1469,7 @@ export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequ
  * Use `create(ListUserWebhooksRequestSchema)` to create a new message.
  */
 export const ListUserWebhooksRequestSchema: GenMessage<ListUserWebhooksRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 23);
+  messageDesc(file_api_v1_user_service, 34);
 
 /**
  * @generated from message memos.api.v1.ListUserWebhooksResponse
@@ -1040,7 +1488,7 @@ export type ListUserWebhooksResponse = Message<"memos.api.v1.ListUserWebhooksRes
  * Use `create(ListUserWebhooksResponseSchema)` to create a new message.
  */
 export const ListUserWebhooksResponseSchema: GenMessage<ListUserWebhooksResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 24);
+  messageDesc(file_api_v1_user_service, 35);
 
 /**
  * @generated from message memos.api.v1.CreateUserWebhookRequest
@@ -1067,7 +1515,7 @@ export type CreateUserWebhookRequest = Message<"memos.api.v1.CreateUserWebhookRe
  * Use `create(CreateUserWebhookRequestSchema)` to create a new message.
  */
 export const CreateUserWebhookRequestSchema: GenMessage<CreateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 25);
+  messageDesc(file_api_v1_user_service, 36);
 
 /**
  * @generated from message memos.api.v1.UpdateUserWebhookRequest
@@ -1082,6 +1530,7 @@ export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRe
 
   /**
    * The list of fields to update.
+   * Include "secret" to rotate the signing secret; the new secret is returned in the response.
    *
    * @generated from field: google.protobuf.FieldMask update_mask = 2;
    */
@@ -1093,7 +1542,7 @@ export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRe
  * Use `create(UpdateUserWebhookRequestSchema)` to create a new message.
  */
 export const UpdateUserWebhookRequestSchema: GenMessage<UpdateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 26);
+  messageDesc(file_api_v1_user_service, 37);
 
 /**
  * @generated from message memos.api.v1.DeleteUserWebhookRequest
@@ -1113,7 +1562,221 @@ export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRe
  * Use `create(DeleteUserWebhookRequestSchema)` to create a new message.
  */
 export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 27);
+  messageDesc(file_api_v1_user_service, 38);
+
+/**
+ * UserWebhookDelivery is a single request sent to a webhook, including its retries.
+ *
+ * @generated from message memos.api.v1.UserWebhookDelivery
+ */
+export type UserWebhookDelivery = Message<"memos.api.v1.UserWebhookDelivery"> & {
+  /**
+   * The name of the delivery.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The type of activity that triggered the delivery, e.g. "memos.memo.created".
+   *
+   * @generated from field: string activity_type = 2;
+   */
+  activityType: string;
+
+  /**
+   * The URL the delivery is sent to.
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * The JSON request body.
+   *
+   * @generated from field: string payload = 4;
+   */
+  payload: string;
+
+  /**
+   * @generated from field: memos.api.v1.UserWebhookDelivery.State state = 5;
+   */
+  state: UserWebhookDelivery_State;
+
+  /**
+   * The number of attempts made so far.
+   *
+   * @generated from field: int32 attempts = 6;
+   */
+  attempts: number;
+
+  /**
+   * The HTTP status code of the latest attempt, or 0 if the endpoint did not respond.
+   *
+   * @generated from field: int32 status_code = 7;
+   */
+  statusCode: number;
+
+  /**
+   * The response body of the latest attempt, truncated.
+   *
+   * @generated from field: string response_body = 8;
+   */
+  responseBody: string;
+
+  /**
+   * The error of the latest attempt, if it failed.
+   *
+   * @generated from field: string error = 9;
+   */
+  error: string;
+
+  /**
+   * The creation time of the delivery.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 10;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The time of the latest attempt.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 11;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The time of the next attempt. Only set for pending deliveries.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_time = 12;
+   */
+  nextAttemptTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWebhookDelivery.
+ * Use `create(UserWebhookDeliverySchema)` to create a new message.
+ */
+export const UserWebhookDeliverySchema: GenMessage<UserWebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 39);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhookDelivery.State
+ */
+export enum UserWebhookDelivery_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is waiting for its next attempt.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The endpoint accepted the delivery.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The delivery failed after the maximum number of attempts.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhookDelivery.State.
+ */
+export const UserWebhookDelivery_StateSchema: GenEnum<UserWebhookDelivery_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 39, 0);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesRequest
+ */
+export type ListUserWebhookDeliveriesRequest = Message<"memos.api.v1.ListUserWebhookDeliveriesRequest"> & {
+  /**
+   * The parent webhook.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of deliveries to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListUserWebhookDeliveries` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesRequest.
+ * Use `create(ListUserWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListUserWebhookDeliveriesRequestSchema: GenMessage<ListUserWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 40);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesResponse
+ */
+export type ListUserWebhookDeliveriesResponse = Message<"memos.api.v1.ListUserWebhookDeliveriesResponse"> & {
+  /**
+   * The list of deliveries, ordered from newest to oldest.
+   *
+   * @generated from field: repeated memos.api.v1.UserWebhookDelivery deliveries = 1;
+   */
+  deliveries: UserWebhookDelivery[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesResponse.
+ * Use `create(ListUserWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListUserWebhookDeliveriesResponseSchema: GenMessage<ListUserWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 41);
+
+/**
+ * @generated from message memos.api.v1.RedeliverUserWebhookDeliveryRequest
+ */
+export type RedeliverUserWebhookDeliveryRequest = Message<"memos.api.v1.RedeliverUserWebhookDeliveryRequest"> & {
+  /**
+   * The name of the delivery to redeliver.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RedeliverUserWebhookDeliveryRequest.
+ * Use `create(RedeliverUserWebhookDeliveryRequestSchema)` to create a new message.
+ */
+export const RedeliverUserWebhookDeliveryRequestSchema: GenMessage<RedeliverUserWebhookDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 42);
 
 /**
  * @generated from message memos.api.v1.UserNotification
@@ -1169,7 +1832,7 @@ export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
  * Use `create(UserNotificationSchema)` to create a new message.
  */
 export const UserNotificationSchema: GenMessage<UserNotification> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 28);
+  messageDesc(file_api_v1_user_service, 43);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Status
@@ -1195,7 +1858,7 @@ export enum UserNotification_Status {
  * Describes the enum memos.api.v1.UserNotification.Status.
  */
 export const UserNotification_StatusSchema: GenEnum<UserNotification_Status> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 28, 0);
+  enumDesc(file_api_v1_user_service, 43, 0);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Type
@@ -1210,13 +1873,28 @@ export enum UserNotification_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * @generated from enum value: MENTION = 2;
+   */
+  MENTION = 2,
+
+  /**
+   * @generated from enum value: REMINDER = 3;
+   */
+  REMINDER = 3,
+
+  /**
+   * @generated from enum value: DIGEST = 4;
+   */
+  DIGEST = 4,
 }
 
 /**
  * Describes the enum memos.api.v1.UserNotification.Type.
  */
 export const UserNotification_TypeSchema: GenEnum<UserNotification_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 28, 1);
+  enumDesc(file_api_v1_user_service, 43, 1);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsRequest
@@ -1251,7 +1929,7 @@ export type ListUserNotificationsRequest = Message<"memos.api.v1.ListUserNotific
  * Use `create(ListUserNotificationsRequestSchema)` to create a new message.
  */
 export const ListUserNotificationsRequestSchema: GenMessage<ListUserNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 29);
+  messageDesc(file_api_v1_user_service, 44);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsResponse
@@ -1273,7 +1951,7 @@ export type ListUserNotificationsResponse = Message<"memos.api.v1.ListUserNotifi
  * Use `create(ListUserNotificationsResponseSchema)` to create a new message.
  */
 export const ListUserNotificationsResponseSchema: GenMessage<ListUserNotificationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 30);
+  messageDesc(file_api_v1_user_service, 45);
 
 /**
  * @generated from message memos.api.v1.UpdateUserNotificationRequest
@@ -1295,7 +1973,7 @@ export type UpdateUserNotificationRequest = Message<"memos.api.v1.UpdateUserNoti
  * Use `create(UpdateUserNotificationRequestSchema)` to create a new message.
  */
 export const UpdateUserNotificationRequestSchema: GenMessage<UpdateUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 31);
+  messageDesc(file_api_v1_user_service, 46);
 
 /**
  * @generated from message memos.api.v1.DeleteUserNotificationRequest
@@ -1314,7 +1992,7 @@ export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNoti
  * Use `create(DeleteUserNotificationRequestSchema)` to create a new message.
  */
 export const DeleteUserNotificationRequestSchema: GenMessage<DeleteUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 32);
+  messageDesc(file_api_v1_user_service, 47);
 
 /**
  * @generated from service memos.api.v1.UserService
@@ -1455,6 +2133,70 @@ export const UserService: GenService<{
     input: typeof DeletePersonalAccessTokenRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * GetUserTwoFactor returns the two-factor authentication status of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserTwoFactor
+   */
+  getUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof GetUserTwoFactorRequestSchema;
+    output: typeof UserTwoFactorSchema;
+  },
+  /**
+   * SetupUserTwoFactor generates a new TOTP secret for a user.
+   * The secret stays pending until it is confirmed with EnableUserTwoFactor.
+   *
+   * @generated from rpc memos.api.v1.UserService.SetupUserTwoFactor
+   */
+  setupUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof SetupUserTwoFactorRequestSchema;
+    output: typeof SetupUserTwoFactorResponseSchema;
+  },
+  /**
+   * EnableUserTwoFactor confirms the pending TOTP secret with a code and enables two-factor authentication.
+   * The recovery codes are only returned once upon enabling.
+   *
+   * @generated from rpc memos.api.v1.UserService.EnableUserTwoFactor
+   */
+  enableUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof EnableUserTwoFactorRequestSchema;
+    output: typeof EnableUserTwoFactorResponseSchema;
+  },
+  /**
+   * DisableUserTwoFactor disables two-factor authentication of a user.
+   * Users confirm with a TOTP or recovery code, admins can reset other users without a code.
+   *
+   * @generated from rpc memos.api.v1.UserService.DisableUserTwoFactor
+   */
+  disableUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof DisableUserTwoFactorRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserPasskeys returns the passkeys of a user.
+   * Passkeys are registered with AuthService.BeginPasskeyRegistration and FinishPasskeyRegistration.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserPasskeys
+   */
+  listUserPasskeys: {
+    methodKind: "unary";
+    input: typeof ListUserPasskeysRequestSchema;
+    output: typeof ListUserPasskeysResponseSchema;
+  },
+  /**
+   * DeleteUserPasskey deletes a passkey of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserPasskey
+   */
+  deleteUserPasskey: {
+    methodKind: "unary";
+    input: typeof DeleteUserPasskeyRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * ListUserWebhooks returns a list of webhooks for a user.
    *
@@ -1495,6 +2237,27 @@ export const UserService: GenService<{
     input: typeof DeleteUserWebhookRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListUserWebhookDeliveries lists recent deliveries of a webhook.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserWebhookDeliveries
+   */
+  listUserWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListUserWebhookDeliveriesRequestSchema;
+    output: typeof ListUserWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * RedeliverUserWebhookDelivery queues the payload of a delivery to be sent again.
+   * A new delivery is created so the original attempt stays in the delivery log.
+   *
+   * @generated from rpc memos.api.v1.UserService.RedeliverUserWebhookDelivery
+   */
+  redeliverUserWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof RedeliverUserWebhookDeliveryRequestSchema;
+    output: typeof UserWebhookDeliverySchema;
+  },
   /**
    * ListUserNotifications lists notifications for a user.
    *
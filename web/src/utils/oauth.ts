@@ -7,6 +7,7 @@ interface OAuthState {
   timestamp: number;
   returnUrl?: string;
   codeVerifier?: string; // PKCE code_verifier
+  nonce?: string; // OpenID Connect nonce, bound into the ID token
 }
 
 // Generate a cryptographically secure random state value
@@ -39,12 +40,16 @@ function base64UrlEncode(buffer: Uint8Array): string {
   return base64.replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
 }
 
-// Store OAuth state and PKCE parameters in sessionStorage
-// Returns both state and codeChallenge for use in authorization URL
-export async function storeOAuthState(identityProviderId: number, returnUrl?: string): Promise<{ state: string; codeChallenge: string }> {
+// Store OAuth state, PKCE parameters and the OpenID Connect nonce in sessionStorage
+// Returns state, codeChallenge and nonce for use in authorization URL
+export async function storeOAuthState(
+  identityProviderId: number,
+  returnUrl?: string,
+): Promise<{ state: string; codeChallenge: string; nonce: string }> {
   const state = generateSecureState();
   const codeVerifier = generateCodeVerifier();
   const codeChallenge = await generateCodeChallenge(codeVerifier);
+  const nonce = generateSecureState();
 
   const stateData: OAuthState = {
     state,
@@ -52,6 +57,7 @@ export async function storeOAuthState(identityProviderId: number, returnUrl?: st
     timestamp: Date.now(),
     returnUrl,
     codeVerifier, // Store for later retrieval in callback
+    nonce,
   };
 
   try {
@@ -61,12 +67,14 @@ export async function storeOAuthState(identityProviderId: number, returnUrl?: st
     throw new Error("Failed to initialize OAuth flow");
   }
 
-  return { state, codeChallenge };
+  return { state, codeChallenge, nonce };
 }
 
 // Validate and retrieve OAuth state from storage (CSRF protection)
-// Returns identityProviderId, returnUrl, and codeVerifier for PKCE
-export function validateOAuthState(stateParam: string): { identityProviderId: number; returnUrl?: string; codeVerifier?: string } | null {
+// Returns identityProviderId, returnUrl, codeVerifier for PKCE, and the OpenID Connect nonce
+export function validateOAuthState(
+  stateParam: string,
+): { identityProviderId: number; returnUrl?: string; codeVerifier?: string; nonce?: string } | null {
   try {
     const storedData = sessionStorage.getItem(STATE_STORAGE_KEY);
     if (!storedData) {
@@ -96,6 +104,7 @@ export function validateOAuthState(stateParam: string): { identityProviderId: nu
       identityProviderId: stateData.identityProviderId,
       returnUrl: stateData.returnUrl,
       codeVerifier: stateData.codeVerifier, // Return PKCE code_verifier
+      nonce: stateData.nonce,
     };
   } catch (error) {
     console.error("Failed to validate OAuth state:", error);